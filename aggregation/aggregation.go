@@ -0,0 +1,93 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aggregation provides the selection checks that determine if a validator is
+// an aggregator for its attestation committee or sync subcommittee.
+package aggregation
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// IsAggregator returns true if the validator that generated the slot signature is an
+// aggregator for its committee, as per is_aggregator in the spec.  The slot signature
+// is the validator's signature over the slot, so commits to the slot and committee
+// membership being checked.
+func IsAggregator(config *Config, committeeLength uint64, slotSignature phase0.BLSSignature) (bool, error) {
+	if config == nil {
+		return false, errors.New("no config supplied")
+	}
+	if config.TargetAggregatorsPerCommittee == 0 {
+		return false, errors.New("no target aggregators per committee configuration")
+	}
+	if committeeLength == 0 {
+		return false, errors.New("committee length must be greater than 0")
+	}
+
+	modulo := committeeLength / config.TargetAggregatorsPerCommittee
+	if modulo < 1 {
+		modulo = 1
+	}
+
+	return signatureModulo(slotSignature, modulo), nil
+}
+
+// IsSyncCommitteeAggregator returns true if the validator that generated the selection
+// proof is an aggregator for its sync subcommittee, as per is_sync_committee_aggregator
+// in the spec.
+func IsSyncCommitteeAggregator(config *Config, selectionProof phase0.BLSSignature) (bool, error) {
+	if config == nil {
+		return false, errors.New("no config supplied")
+	}
+	if config.SyncCommitteeSize == 0 ||
+		config.SyncCommitteeSubnetCount == 0 ||
+		config.TargetAggregatorsPerSyncSubcommittee == 0 {
+		return false, errors.New("no sync committee configuration")
+	}
+
+	modulo := config.SyncCommitteeSize / config.SyncCommitteeSubnetCount / config.TargetAggregatorsPerSyncSubcommittee
+	if modulo < 1 {
+		modulo = 1
+	}
+
+	return signatureModulo(selectionProof, modulo), nil
+}
+
+// SyncSubcommitteeIndex returns the index of the sync subcommittee for the validator at
+// the given position in the sync committee.  This is the subcommittee index used in
+// the validator's sync aggregator selection data.
+func SyncSubcommitteeIndex(config *Config, position uint64) (uint64, error) {
+	if config == nil {
+		return 0, errors.New("no config supplied")
+	}
+	if config.SyncCommitteeSize == 0 || config.SyncCommitteeSubnetCount == 0 {
+		return 0, errors.New("no sync committee configuration")
+	}
+	if position >= config.SyncCommitteeSize {
+		return 0, errors.New("position outside of sync committee")
+	}
+
+	return position / (config.SyncCommitteeSize / config.SyncCommitteeSubnetCount), nil
+}
+
+// signatureModulo returns true if the first 8 bytes of the hash of the signature,
+// as a little-endian integer, are divisible by the modulo.
+func signatureModulo(signature phase0.BLSSignature, modulo uint64) bool {
+	hash := sha256.Sum256(signature[:])
+
+	return binary.LittleEndian.Uint64(hash[:8])%modulo == 0
+}
@@ -0,0 +1,214 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/aggregation"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *aggregation.Config {
+	return &aggregation.Config{
+		TargetAggregatorsPerCommittee:        16,
+		SyncCommitteeSize:                    512,
+		SyncCommitteeSubnetCount:             4,
+		TargetAggregatorsPerSyncSubcommittee: 16,
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		spec map[string]interface{}
+		sync bool
+		err  string
+	}{
+		{
+			name: "Empty",
+			spec: map[string]interface{}{},
+			err:  "TARGET_AGGREGATORS_PER_COMMITTEE not found in spec",
+		},
+		{
+			name: "WrongType",
+			spec: map[string]interface{}{
+				"TARGET_AGGREGATORS_PER_COMMITTEE": "16",
+			},
+			err: "TARGET_AGGREGATORS_PER_COMMITTEE of unexpected type",
+		},
+		{
+			name: "Zero",
+			spec: map[string]interface{}{
+				"TARGET_AGGREGATORS_PER_COMMITTEE": uint64(0),
+			},
+			err: "TARGET_AGGREGATORS_PER_COMMITTEE must be greater than 0",
+		},
+		{
+			name: "SyncPartial",
+			spec: map[string]interface{}{
+				"TARGET_AGGREGATORS_PER_COMMITTEE": uint64(16),
+				"SYNC_COMMITTEE_SIZE":              uint64(512),
+				"SYNC_COMMITTEE_SUBNET_COUNT":      uint64(4),
+			},
+			err: "TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE not found in spec",
+		},
+		{
+			name: "SyncZero",
+			spec: map[string]interface{}{
+				"TARGET_AGGREGATORS_PER_COMMITTEE":         uint64(16),
+				"SYNC_COMMITTEE_SIZE":                      uint64(512),
+				"SYNC_COMMITTEE_SUBNET_COUNT":              uint64(0),
+				"TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE": uint64(16),
+			},
+			err: "SYNC_COMMITTEE_SUBNET_COUNT must be greater than 0",
+		},
+		{
+			name: "Phase0",
+			spec: map[string]interface{}{
+				"TARGET_AGGREGATORS_PER_COMMITTEE": uint64(16),
+			},
+		},
+		{
+			name: "Altair",
+			spec: map[string]interface{}{
+				"TARGET_AGGREGATORS_PER_COMMITTEE":         uint64(16),
+				"SYNC_COMMITTEE_SIZE":                      uint64(512),
+				"SYNC_COMMITTEE_SUBNET_COUNT":              uint64(4),
+				"TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE": uint64(16),
+			},
+			sync: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := aggregation.NewConfig(test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(16), config.TargetAggregatorsPerCommittee)
+			require.Equal(t, test.sync, config.SyncCommitteeSize != 0)
+		})
+	}
+}
+
+func TestIsAggregator(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          *aggregation.Config
+		committeeLength uint64
+		signature       phase0.BLSSignature
+		expected        bool
+		err             string
+	}{
+		{
+			name:            "NoConfig",
+			committeeLength: 128,
+			err:             "no config supplied",
+		},
+		{
+			name:            "HandBuiltConfig",
+			config:          &aggregation.Config{},
+			committeeLength: 128,
+			err:             "no target aggregators per committee configuration",
+		},
+		{
+			name:   "ZeroLength",
+			config: testConfig(),
+			err:    "committee length must be greater than 0",
+		},
+		{
+			name:            "Aggregator",
+			config:          testConfig(),
+			committeeLength: 128,
+			signature:       phase0.BLSSignature{0x01},
+			expected:        true,
+		},
+		{
+			name:            "NotAggregator",
+			config:          testConfig(),
+			committeeLength: 128,
+			signature:       phase0.BLSSignature{0x00},
+		},
+		{
+			name:            "SmallerModulo",
+			config:          testConfig(),
+			committeeLength: 64,
+			signature:       phase0.BLSSignature{0x04},
+			expected:        true,
+		},
+		{
+			name:            "SmallCommittee",
+			config:          testConfig(),
+			committeeLength: 10,
+			signature:       phase0.BLSSignature{0x07},
+			expected:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := aggregation.IsAggregator(test.config, test.committeeLength, test.signature)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestIsSyncCommitteeAggregator(t *testing.T) {
+	res, err := aggregation.IsSyncCommitteeAggregator(testConfig(), phase0.BLSSignature{0x02})
+	require.NoError(t, err)
+	require.True(t, res)
+
+	res, err = aggregation.IsSyncCommitteeAggregator(testConfig(), phase0.BLSSignature{0x03})
+	require.NoError(t, err)
+	require.False(t, res)
+
+	_, err = aggregation.IsSyncCommitteeAggregator(nil, phase0.BLSSignature{})
+	require.EqualError(t, err, "no config supplied")
+	_, err = aggregation.IsSyncCommitteeAggregator(&aggregation.Config{TargetAggregatorsPerCommittee: 16}, phase0.BLSSignature{})
+	require.EqualError(t, err, "no sync committee configuration")
+	_, err = aggregation.IsSyncCommitteeAggregator(&aggregation.Config{SyncCommitteeSize: 512}, phase0.BLSSignature{})
+	require.EqualError(t, err, "no sync committee configuration")
+}
+
+func TestSyncSubcommitteeIndex(t *testing.T) {
+	config := testConfig()
+
+	index, err := aggregation.SyncSubcommitteeIndex(config, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+	index, err = aggregation.SyncSubcommitteeIndex(config, 127)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+	index, err = aggregation.SyncSubcommitteeIndex(config, 128)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), index)
+	index, err = aggregation.SyncSubcommitteeIndex(config, 511)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), index)
+
+	_, err = aggregation.SyncSubcommitteeIndex(config, 512)
+	require.EqualError(t, err, "position outside of sync committee")
+	_, err = aggregation.SyncSubcommitteeIndex(&aggregation.Config{SyncCommitteeSize: 512}, 0)
+	require.EqualError(t, err, "no sync committee configuration")
+}
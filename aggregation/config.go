@@ -0,0 +1,77 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Config contains the spec values required to carry out aggregator selection.
+type Config struct {
+	TargetAggregatorsPerCommittee uint64
+	// The following values are only available from Altair onwards, and are 0 if not
+	// present in the spec.
+	SyncCommitteeSize                    uint64
+	SyncCommitteeSubnetCount             uint64
+	TargetAggregatorsPerSyncSubcommittee uint64
+}
+
+// NewConfig creates a configuration from a spec, as returned by a SpecProvider.
+func NewConfig(spec map[string]interface{}) (*Config, error) {
+	config := &Config{}
+
+	tmp, exists := spec["TARGET_AGGREGATORS_PER_COMMITTEE"]
+	if !exists {
+		return nil, errors.New("TARGET_AGGREGATORS_PER_COMMITTEE not found in spec")
+	}
+	targetAggregatorsPerCommittee, isUint := tmp.(uint64)
+	if !isUint {
+		return nil, errors.New("TARGET_AGGREGATORS_PER_COMMITTEE of unexpected type")
+	}
+	if targetAggregatorsPerCommittee == 0 {
+		return nil, errors.New("TARGET_AGGREGATORS_PER_COMMITTEE must be greater than 0")
+	}
+	config.TargetAggregatorsPerCommittee = targetAggregatorsPerCommittee
+
+	// Sync committee values are optional, as they are not present prior to Altair.
+	if _, exists := spec["SYNC_COMMITTEE_SIZE"]; !exists {
+		return config, nil
+	}
+	uintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "SYNC_COMMITTEE_SIZE", value: &config.SyncCommitteeSize},
+		{key: "SYNC_COMMITTEE_SUBNET_COUNT", value: &config.SyncCommitteeSubnetCount},
+		{key: "TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE", value: &config.TargetAggregatorsPerSyncSubcommittee},
+	}
+	for _, uintValue := range uintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", uintValue.key)
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		if val == 0 {
+			return nil, fmt.Errorf("%s must be greater than 0", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+
+	return config, nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                zerolog.Level
+	client                  consensusclient.Service
+	validators              []phase0.ValidatorIndex
+	proposerSlashingHandler ProposerSlashingHandlerFunc
+	attesterSlashingHandler AttesterSlashingHandlerFunc
+	voluntaryExitHandler    VoluntaryExitHandlerFunc
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which to obtain information.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithValidators sets the validators for which alerts are raised.
+func WithValidators(validators []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validators = validators
+	})
+}
+
+// WithProposerSlashingHandler sets the handler called for proposer slashings of the validators.
+func WithProposerSlashingHandler(handler ProposerSlashingHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proposerSlashingHandler = handler
+	})
+}
+
+// WithAttesterSlashingHandler sets the handler called for attester slashings of the validators.
+func WithAttesterSlashingHandler(handler AttesterSlashingHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.attesterSlashingHandler = handler
+	})
+}
+
+// WithVoluntaryExitHandler sets the handler called for voluntary exits of the validators.
+func WithVoluntaryExitHandler(handler VoluntaryExitHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.voluntaryExitHandler = handler
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if len(parameters.validators) == 0 {
+		return nil, errors.New("no validators specified")
+	}
+	if parameters.proposerSlashingHandler == nil &&
+		parameters.attesterSlashingHandler == nil &&
+		parameters.voluntaryExitHandler == nil {
+		return nil, errors.New("no handlers specified")
+	}
+
+	return &parameters, nil
+}
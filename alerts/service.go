@@ -0,0 +1,327 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alerts raises alerts for slashings and voluntary exits of a set of
+// validators, as seen in the operation pools of a node and in blocks.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Source is the source of the evidence for an alert.
+type Source int
+
+const (
+	// SourcePool is evidence received by the node's operation pool, but not yet on chain.
+	SourcePool Source = iota
+	// SourceBlock is evidence included in a block.
+	SourceBlock
+)
+
+// String returns a string representation of the source.
+func (s Source) String() string {
+	switch s {
+	case SourcePool:
+		return "pool"
+	case SourceBlock:
+		return "block"
+	default:
+		return "unknown"
+	}
+}
+
+// Inclusion is the block in which evidence was included.
+// It is only present for alerts with a source of SourceBlock.
+type Inclusion struct {
+	// Slot is the slot of the block.
+	Slot phase0.Slot
+	// BlockRoot is the root of the block.
+	BlockRoot phase0.Root
+}
+
+// ProposerSlashingAlert is an alert for a proposer slashing of a validator.
+type ProposerSlashingAlert struct {
+	// ValidatorIndex is the index of the slashed validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Source is the source of the evidence.
+	Source Source
+	// Inclusion is the block that included the evidence, if the source is a block.
+	Inclusion *Inclusion
+	// Evidence is the proposer slashing.
+	Evidence *phase0.ProposerSlashing
+}
+
+// AttesterSlashingAlert is an alert for an attester slashing of one or more validators.
+type AttesterSlashingAlert struct {
+	// ValidatorIndices are the indices of the slashed validators, in ascending order.
+	// Only validators for which alerts are raised are included.
+	ValidatorIndices []phase0.ValidatorIndex
+	// Source is the source of the evidence.
+	Source Source
+	// Inclusion is the block that included the evidence, if the source is a block.
+	Inclusion *Inclusion
+	// Evidence is the attester slashing.
+	Evidence *phase0.AttesterSlashing
+}
+
+// VoluntaryExitAlert is an alert for a voluntary exit of a validator.
+type VoluntaryExitAlert struct {
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Source is the source of the evidence.
+	Source Source
+	// Inclusion is the block that included the evidence, if the source is a block.
+	Inclusion *Inclusion
+	// Evidence is the signed voluntary exit.
+	Evidence *phase0.SignedVoluntaryExit
+}
+
+// ProposerSlashingHandlerFunc is the handler for proposer slashing alerts.
+type ProposerSlashingHandlerFunc func(ctx context.Context, alert *ProposerSlashingAlert)
+
+// AttesterSlashingHandlerFunc is the handler for attester slashing alerts.
+type AttesterSlashingHandlerFunc func(ctx context.Context, alert *AttesterSlashingAlert)
+
+// VoluntaryExitHandlerFunc is the handler for voluntary exit alerts.
+type VoluntaryExitHandlerFunc func(ctx context.Context, alert *VoluntaryExitAlert)
+
+// Service raises alerts for slashings and voluntary exits of a set of validators.
+// Evidence is alerted both when it is seen in the node's operation pool and when it
+// is included in a block, so the same evidence can result in multiple alerts.
+// Handlers are called synchronously from the events stream, so should return promptly.
+type Service struct {
+	log                     zerolog.Logger
+	blockProvider           consensusclient.SignedBeaconBlockProvider
+	validators              map[phase0.ValidatorIndex]bool
+	proposerSlashingHandler ProposerSlashingHandlerFunc
+	attesterSlashingHandler AttesterSlashingHandlerFunc
+	voluntaryExitHandler    VoluntaryExitHandlerFunc
+}
+
+// New creates a new alerts service.
+// Alerts are raised until the supplied context is done.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "alerts").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	blockProvider, isProvider := parameters.client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+
+	validators := make(map[phase0.ValidatorIndex]bool, len(parameters.validators))
+	for _, index := range parameters.validators {
+		validators[index] = true
+	}
+
+	s := &Service{
+		log:                     log,
+		blockProvider:           blockProvider,
+		validators:              validators,
+		proposerSlashingHandler: parameters.proposerSlashingHandler,
+		attesterSlashingHandler: parameters.attesterSlashingHandler,
+		voluntaryExitHandler:    parameters.voluntaryExitHandler,
+	}
+
+	topics := []string{"block"}
+	if s.proposerSlashingHandler != nil {
+		topics = append(topics, "proposer_slashing")
+	}
+	if s.attesterSlashingHandler != nil {
+		topics = append(topics, "attester_slashing")
+	}
+	if s.voluntaryExitHandler != nil {
+		topics = append(topics, "voluntary_exit")
+	}
+	if err := eventsProvider.Events(ctx, topics, func(event *apiv1.Event) {
+		s.handleEvent(ctx, event)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to events")
+	}
+
+	return s, nil
+}
+
+// handleEvent handles an event from the events stream.
+func (s *Service) handleEvent(ctx context.Context, event *apiv1.Event) {
+	switch data := event.Data.(type) {
+	case *phase0.ProposerSlashing:
+		s.checkProposerSlashing(ctx, data, SourcePool, nil)
+	case *phase0.AttesterSlashing:
+		s.checkAttesterSlashing(ctx, data, SourcePool, nil)
+	case *phase0.SignedVoluntaryExit:
+		s.checkVoluntaryExit(ctx, data, SourcePool, nil)
+	case *apiv1.BlockEvent:
+		if err := s.checkBlock(ctx, data); err != nil {
+			s.log.Error().Uint64("slot", uint64(data.Slot)).Err(err).Msg("Failed to check block for alerts")
+		}
+	}
+}
+
+// checkBlock checks the operations in a block.
+func (s *Service) checkBlock(ctx context.Context, event *apiv1.BlockEvent) error {
+	block, err := s.blockProvider.SignedBeaconBlock(ctx, fmt.Sprintf("%#x", event.Block))
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block")
+	}
+	if block == nil {
+		return errors.New("block not returned")
+	}
+	inclusion := &Inclusion{
+		Slot:      event.Slot,
+		BlockRoot: event.Block,
+	}
+
+	if s.proposerSlashingHandler != nil {
+		proposerSlashings, err := block.ProposerSlashings()
+		if err != nil {
+			return err
+		}
+		for _, proposerSlashing := range proposerSlashings {
+			s.checkProposerSlashing(ctx, proposerSlashing, SourceBlock, inclusion)
+		}
+	}
+	if s.attesterSlashingHandler != nil {
+		attesterSlashings, err := block.AttesterSlashings()
+		if err != nil {
+			return err
+		}
+		for _, attesterSlashing := range attesterSlashings {
+			s.checkAttesterSlashing(ctx, attesterSlashing, SourceBlock, inclusion)
+		}
+	}
+	if s.voluntaryExitHandler != nil {
+		voluntaryExits, err := block.VoluntaryExits()
+		if err != nil {
+			return err
+		}
+		for _, voluntaryExit := range voluntaryExits {
+			s.checkVoluntaryExit(ctx, voluntaryExit, SourceBlock, inclusion)
+		}
+	}
+
+	return nil
+}
+
+// checkProposerSlashing raises an alert if the proposer slashing is for one of the validators.
+func (s *Service) checkProposerSlashing(ctx context.Context,
+	proposerSlashing *phase0.ProposerSlashing,
+	source Source,
+	inclusion *Inclusion,
+) {
+	if s.proposerSlashingHandler == nil ||
+		proposerSlashing == nil ||
+		proposerSlashing.SignedHeader1 == nil ||
+		proposerSlashing.SignedHeader1.Message == nil {
+		return
+	}
+	index := proposerSlashing.SignedHeader1.Message.ProposerIndex
+	if !s.validators[index] {
+		return
+	}
+
+	s.log.Trace().Uint64("validator_index", uint64(index)).Stringer("source", source).Msg("Proposer slashing")
+	s.proposerSlashingHandler(ctx, &ProposerSlashingAlert{
+		ValidatorIndex: index,
+		Source:         source,
+		Inclusion:      inclusion,
+		Evidence:       proposerSlashing,
+	})
+}
+
+// checkAttesterSlashing raises an alert if the attester slashing is for any of the validators.
+func (s *Service) checkAttesterSlashing(ctx context.Context,
+	attesterSlashing *phase0.AttesterSlashing,
+	source Source,
+	inclusion *Inclusion,
+) {
+	if s.attesterSlashingHandler == nil ||
+		attesterSlashing == nil ||
+		attesterSlashing.Attestation1 == nil ||
+		attesterSlashing.Attestation2 == nil {
+		return
+	}
+
+	// Validators are only slashed if they are in both attestations.
+	attestation1Indices := make(map[uint64]bool, len(attesterSlashing.Attestation1.AttestingIndices))
+	for _, index := range attesterSlashing.Attestation1.AttestingIndices {
+		attestation1Indices[index] = true
+	}
+	indices := make([]phase0.ValidatorIndex, 0)
+	for _, index := range attesterSlashing.Attestation2.AttestingIndices {
+		if attestation1Indices[index] && s.validators[phase0.ValidatorIndex(index)] {
+			indices = append(indices, phase0.ValidatorIndex(index))
+			// Avoid duplicates.
+			delete(attestation1Indices, index)
+		}
+	}
+	if len(indices) == 0 {
+		return
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	s.log.Trace().Int("validators", len(indices)).Stringer("source", source).Msg("Attester slashing")
+	s.attesterSlashingHandler(ctx, &AttesterSlashingAlert{
+		ValidatorIndices: indices,
+		Source:           source,
+		Inclusion:        inclusion,
+		Evidence:         attesterSlashing,
+	})
+}
+
+// checkVoluntaryExit raises an alert if the voluntary exit is for one of the validators.
+func (s *Service) checkVoluntaryExit(ctx context.Context,
+	voluntaryExit *phase0.SignedVoluntaryExit,
+	source Source,
+	inclusion *Inclusion,
+) {
+	if s.voluntaryExitHandler == nil ||
+		voluntaryExit == nil ||
+		voluntaryExit.Message == nil {
+		return
+	}
+	index := voluntaryExit.Message.ValidatorIndex
+	if !s.validators[index] {
+		return
+	}
+
+	s.log.Trace().Uint64("validator_index", uint64(index)).Stringer("source", source).Msg("Voluntary exit")
+	s.voluntaryExitHandler(ctx, &VoluntaryExitAlert{
+		ValidatorIndex: index,
+		Source:         source,
+		Inclusion:      inclusion,
+		Evidence:       voluntaryExit,
+	})
+}
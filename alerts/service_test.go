@@ -0,0 +1,151 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/alerts"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// eventsClient is a client that supplies events and a single block.
+type eventsClient struct {
+	topics  []string
+	handler consensusclient.EventHandlerFunc
+	block   *spec.VersionedSignedBeaconBlock
+}
+
+func (c *eventsClient) Name() string    { return "events" }
+func (c *eventsClient) Address() string { return "events" }
+
+func (c *eventsClient) Events(_ context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	c.topics = topics
+	c.handler = handler
+
+	return nil
+}
+
+func (c *eventsClient) SignedBeaconBlock(_ context.Context, _ string) (*spec.VersionedSignedBeaconBlock, error) {
+	return c.block, nil
+}
+
+func proposerSlashing(index phase0.ValidatorIndex) *phase0.ProposerSlashing {
+	return &phase0.ProposerSlashing{
+		SignedHeader1: &phase0.SignedBeaconBlockHeader{Message: &phase0.BeaconBlockHeader{ProposerIndex: index}},
+		SignedHeader2: &phase0.SignedBeaconBlockHeader{Message: &phase0.BeaconBlockHeader{ProposerIndex: index}},
+	}
+}
+
+func voluntaryExit(index phase0.ValidatorIndex) *phase0.SignedVoluntaryExit {
+	return &phase0.SignedVoluntaryExit{Message: &phase0.VoluntaryExit{ValidatorIndex: index}}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	attesterSlashing := &phase0.AttesterSlashing{
+		Attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 2, 3, 5}},
+		Attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{5, 2, 4}},
+	}
+	client := &eventsClient{
+		block: &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionPhase0,
+			Phase0: &phase0.SignedBeaconBlock{
+				Message: &phase0.BeaconBlock{
+					Slot: 10,
+					Body: &phase0.BeaconBlockBody{
+						ProposerSlashings: []*phase0.ProposerSlashing{proposerSlashing(1), proposerSlashing(9)},
+						AttesterSlashings: []*phase0.AttesterSlashing{attesterSlashing},
+						VoluntaryExits:    []*phase0.SignedVoluntaryExit{voluntaryExit(3)},
+					},
+				},
+			},
+		},
+	}
+
+	proposerSlashings := make([]*alerts.ProposerSlashingAlert, 0)
+	attesterSlashings := make([]*alerts.AttesterSlashingAlert, 0)
+	voluntaryExits := make([]*alerts.VoluntaryExitAlert, 0)
+	_, err := alerts.New(ctx,
+		alerts.WithLogLevel(zerolog.Disabled),
+		alerts.WithClient(client),
+		alerts.WithValidators([]phase0.ValidatorIndex{1, 2, 3, 5}),
+		alerts.WithProposerSlashingHandler(func(_ context.Context, alert *alerts.ProposerSlashingAlert) {
+			proposerSlashings = append(proposerSlashings, alert)
+		}),
+		alerts.WithAttesterSlashingHandler(func(_ context.Context, alert *alerts.AttesterSlashingAlert) {
+			attesterSlashings = append(attesterSlashings, alert)
+		}),
+		alerts.WithVoluntaryExitHandler(func(_ context.Context, alert *alerts.VoluntaryExitAlert) {
+			voluntaryExits = append(voluntaryExits, alert)
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"block", "proposer_slashing", "attester_slashing", "voluntary_exit"}, client.topics)
+
+	// Pool events.
+	client.handler(&apiv1.Event{Topic: "proposer_slashing", Data: proposerSlashing(2)})
+	client.handler(&apiv1.Event{Topic: "proposer_slashing", Data: proposerSlashing(8)})
+	client.handler(&apiv1.Event{Topic: "attester_slashing", Data: attesterSlashing})
+	client.handler(&apiv1.Event{Topic: "voluntary_exit", Data: voluntaryExit(7)})
+
+	require.Len(t, proposerSlashings, 1)
+	require.Equal(t, phase0.ValidatorIndex(2), proposerSlashings[0].ValidatorIndex)
+	require.Equal(t, alerts.SourcePool, proposerSlashings[0].Source)
+	require.Nil(t, proposerSlashings[0].Inclusion)
+	require.Len(t, attesterSlashings, 1)
+	require.Equal(t, []phase0.ValidatorIndex{2, 5}, attesterSlashings[0].ValidatorIndices)
+	require.Equal(t, attesterSlashing, attesterSlashings[0].Evidence)
+	require.Len(t, voluntaryExits, 0)
+
+	// Block inclusion.
+	client.handler(&apiv1.Event{Topic: "block", Data: &apiv1.BlockEvent{Slot: 10, Block: phase0.Root{0x01}}})
+
+	require.Len(t, proposerSlashings, 2)
+	require.Equal(t, phase0.ValidatorIndex(1), proposerSlashings[1].ValidatorIndex)
+	require.Equal(t, alerts.SourceBlock, proposerSlashings[1].Source)
+	require.Equal(t, &alerts.Inclusion{Slot: 10, BlockRoot: phase0.Root{0x01}}, proposerSlashings[1].Inclusion)
+	require.Len(t, attesterSlashings, 2)
+	require.Equal(t, alerts.SourceBlock, attesterSlashings[1].Source)
+	require.Len(t, voluntaryExits, 1)
+	require.Equal(t, phase0.ValidatorIndex(3), voluntaryExits[0].ValidatorIndex)
+	require.Equal(t, alerts.SourceBlock, voluntaryExits[0].Source)
+}
+
+func TestServiceParameters(t *testing.T) {
+	ctx := context.Background()
+
+	handler := alerts.WithVoluntaryExitHandler(func(_ context.Context, _ *alerts.VoluntaryExitAlert) {})
+	validators := alerts.WithValidators([]phase0.ValidatorIndex{1})
+
+	_, err := alerts.New(ctx, handler, validators)
+	require.EqualError(t, err, "problem with parameters: no client specified")
+	_, err = alerts.New(ctx, alerts.WithClient(&eventsClient{}), handler)
+	require.EqualError(t, err, "problem with parameters: no validators specified")
+	_, err = alerts.New(ctx, alerts.WithClient(&eventsClient{}), validators)
+	require.EqualError(t, err, "problem with parameters: no handlers specified")
+
+	// Only topics with handlers are subscribed.
+	client := &eventsClient{}
+	_, err = alerts.New(ctx, alerts.WithClient(client), validators, handler)
+	require.NoError(t, err)
+	require.Equal(t, []string{"block", "voluntary_exit"}, client.topics)
+}
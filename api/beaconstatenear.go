@@ -0,0 +1,52 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// StateSearchDirection is the direction in which to search for an available state.
+type StateSearchDirection int
+
+const (
+	// StateSearchNearest searches both before and after the requested slot, taking
+	// the nearest available state.  Ties are resolved in favour of the earlier state.
+	StateSearchNearest StateSearchDirection = iota
+	// StateSearchBackward searches only before the requested slot.
+	StateSearchBackward
+	// StateSearchForward searches only after the requested slot.
+	StateSearchForward
+)
+
+// BeaconStateNearOptions are the options for fetching the available beacon state
+// nearest to a slot.
+type BeaconStateNearOptions struct {
+	// Direction is the direction in which to search.
+	Direction StateSearchDirection
+	// MaxDistance is the maximum number of slots between the requested slot and the
+	// slot of the state returned.  0 means only the requested slot is considered.
+	MaxDistance uint64
+}
+
+// BeaconStateNear is a beacon state served in place of a requested state.
+type BeaconStateNear struct {
+	// RequestedSlot is the slot requested.
+	RequestedSlot phase0.Slot
+	// Slot is the slot of the state served.
+	Slot phase0.Slot
+	// State is the state served.
+	State *spec.VersionedBeaconState
+}
@@ -0,0 +1,117 @@
+// Code generated by copygen. DO NOT EDIT.
+package api
+
+import (
+	"math/big"
+)
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconStateNear) Copy() *BeaconStateNear {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.State = b.State.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconStateNearOptions) Copy() *BeaconStateNearOptions {
+	if b == nil {
+		return nil
+	}
+	res := *b
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *PageOptions) Copy() *PageOptions {
+	if p == nil {
+		return nil
+	}
+	res := *p
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedBlindedBeaconBlock) Copy() *VersionedBlindedBeaconBlock {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedExecutionPayload) Copy() *VersionedExecutionPayload {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedProposal) Copy() *VersionedProposal {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	if v.ExecutionPayloadValue != nil {
+		res.ExecutionPayloadValue = new(big.Int).Set(v.ExecutionPayloadValue)
+	}
+	if v.ConsensusBlockValue != nil {
+		res.ConsensusBlockValue = new(big.Int).Set(v.ConsensusBlockValue)
+	}
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.BellatrixBlinded = v.BellatrixBlinded.Copy()
+	res.Capella = v.Capella.Copy()
+	res.CapellaBlinded = v.CapellaBlinded.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedSignedBlindedBeaconBlock) Copy() *VersionedSignedBlindedBeaconBlock {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedSignedValidatorRegistration) Copy() *VersionedSignedValidatorRegistration {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.V1 = v.V1.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedValidatorRegistration) Copy() *VersionedValidatorRegistration {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.V1 = v.V1.Copy()
+
+	return &res
+}
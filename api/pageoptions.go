@@ -0,0 +1,23 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// PageOptions are the options for requests that return a page of a list that may
+// be unbounded in size.
+type PageOptions struct {
+	// Offset is the number of entries in the list to skip before the page starts.
+	Offset uint64
+	// Limit is the maximum number of entries in the page.  0 means no limit.
+	Limit uint64
+}
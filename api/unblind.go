@@ -0,0 +1,114 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// UnblindProposal combines a signed blinded beacon block with the execution payload
+// revealed for it, returning the signed beacon block ready for submission.  The
+// payload is checked against the header in the blinded block, so the signature of
+// the blinded block remains valid for the returned block.
+// The returned block shares its data with the supplied block and payload.
+func UnblindProposal(block *VersionedSignedBlindedBeaconBlock,
+	payload *VersionedExecutionPayload,
+) (
+	*spec.VersionedSignedBeaconBlock,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no blinded block supplied")
+	}
+	if payload == nil {
+		return nil, errors.New("no execution payload supplied")
+	}
+	if block.Version != payload.Version {
+		return nil, fmt.Errorf("blinded block version %s does not match execution payload version %s", block.Version, payload.Version)
+	}
+
+	res := &spec.VersionedSignedBeaconBlock{
+		Version: block.Version,
+	}
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		if err := bellatrix.VerifyPayloadMatchesHeader(payload.Bellatrix, block.Bellatrix.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, fmt.Errorf("execution payload does not match blinded block: %w", err)
+		}
+		message := block.Bellatrix.Message
+		res.Bellatrix = &bellatrix.SignedBeaconBlock{
+			Message: &bellatrix.BeaconBlock{
+				Slot:          message.Slot,
+				ProposerIndex: message.ProposerIndex,
+				ParentRoot:    message.ParentRoot,
+				StateRoot:     message.StateRoot,
+				Body: &bellatrix.BeaconBlockBody{
+					RANDAOReveal:      message.Body.RANDAOReveal,
+					ETH1Data:          message.Body.ETH1Data,
+					Graffiti:          message.Body.Graffiti,
+					ProposerSlashings: message.Body.ProposerSlashings,
+					AttesterSlashings: message.Body.AttesterSlashings,
+					Attestations:      message.Body.Attestations,
+					Deposits:          message.Body.Deposits,
+					VoluntaryExits:    message.Body.VoluntaryExits,
+					SyncAggregate:     message.Body.SyncAggregate,
+					ExecutionPayload:  payload.Bellatrix,
+				},
+			},
+			Signature: block.Bellatrix.Signature,
+		}
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		if err := capella.VerifyPayloadMatchesHeader(payload.Capella, block.Capella.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, fmt.Errorf("execution payload does not match blinded block: %w", err)
+		}
+		message := block.Capella.Message
+		res.Capella = &capella.SignedBeaconBlock{
+			Message: &capella.BeaconBlock{
+				Slot:          message.Slot,
+				ProposerIndex: message.ProposerIndex,
+				ParentRoot:    message.ParentRoot,
+				StateRoot:     message.StateRoot,
+				Body: &capella.BeaconBlockBody{
+					RANDAOReveal:          message.Body.RANDAOReveal,
+					ETH1Data:              message.Body.ETH1Data,
+					Graffiti:              message.Body.Graffiti,
+					ProposerSlashings:     message.Body.ProposerSlashings,
+					AttesterSlashings:     message.Body.AttesterSlashings,
+					Attestations:          message.Body.Attestations,
+					Deposits:              message.Body.Deposits,
+					VoluntaryExits:        message.Body.VoluntaryExits,
+					SyncAggregate:         message.Body.SyncAggregate,
+					ExecutionPayload:      payload.Capella,
+					BLSToExecutionChanges: message.Body.BLSToExecutionChanges,
+				},
+			},
+			Signature: block.Capella.Signature,
+		}
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnblindProposal(t *testing.T) {
+	payload := &capella.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+		BlockNumber:   3,
+		ExtraData:     []byte{},
+		BlockHash:     phase0.Hash32{0x04},
+		Transactions:  []bellatrix.Transaction{{0x05, 0x06}},
+		Withdrawals:   []*capella.Withdrawal{{Index: 7, ValidatorIndex: 8, Amount: 9}},
+		BaseFeePerGas: [32]byte{0x0a},
+	}
+	header, err := payload.Header()
+	require.NoError(t, err)
+
+	blindedBlock := &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBlindedBeaconBlock{
+			Message: &apiv1capella.BlindedBeaconBlock{
+				Slot:          1,
+				ProposerIndex: 2,
+				ParentRoot:    phase0.Root{0x03},
+				StateRoot:     phase0.Root{0x04},
+				Body: &apiv1capella.BlindedBeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: bitfield.NewBitvector512(),
+					},
+					ExecutionPayloadHeader: header,
+				},
+			},
+			Signature: phase0.BLSSignature{0x05},
+		},
+	}
+
+	block, err := api.UnblindProposal(blindedBlock, &api.VersionedExecutionPayload{
+		Version: spec.DataVersionCapella,
+		Capella: payload,
+	})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionCapella, block.Version)
+	require.Equal(t, payload, block.Capella.Message.Body.ExecutionPayload)
+	require.Equal(t, phase0.BLSSignature{0x05}, block.Capella.Signature)
+
+	// The signature remains valid as the roots match.
+	blindedRoot, err := blindedBlock.Root()
+	require.NoError(t, err)
+	root, err := block.Root()
+	require.NoError(t, err)
+	require.Equal(t, blindedRoot, root)
+
+	// Mismatched payload.
+	otherPayload := *payload
+	otherPayload.BlockNumber = 4
+	_, err = api.UnblindProposal(blindedBlock, &api.VersionedExecutionPayload{
+		Version: spec.DataVersionCapella,
+		Capella: &otherPayload,
+	})
+	require.EqualError(t, err, "execution payload does not match blinded block: block number mismatch: payload 4, header 3")
+
+	// Mismatched version.
+	_, err = api.UnblindProposal(blindedBlock, &api.VersionedExecutionPayload{
+		Version:   spec.DataVersionBellatrix,
+		Bellatrix: &bellatrix.ExecutionPayload{},
+	})
+	require.EqualError(t, err, "blinded block version capella does not match execution payload version bellatrix")
+
+	// Missing data.
+	_, err = api.UnblindProposal(blindedBlock, nil)
+	require.EqualError(t, err, "no execution payload supplied")
+	_, err = api.UnblindProposal(blindedBlock, &api.VersionedExecutionPayload{Version: spec.DataVersionCapella})
+	require.EqualError(t, err, "execution payload does not match blinded block: no execution payload supplied")
+	_, err = api.UnblindProposal(&api.VersionedSignedBlindedBeaconBlock{Version: spec.DataVersionCapella}, &api.VersionedExecutionPayload{Version: spec.DataVersionCapella})
+	require.EqualError(t, err, "no capella block: data missing")
+}
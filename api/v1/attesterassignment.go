@@ -0,0 +1,67 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttesterAssignment is the position of a validator in the beacon committees for an epoch.
+type AttesterAssignment struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Slot is the slot in which the validator should attest.
+	Slot phase0.Slot
+	// CommitteeIndex is the index of the committee in which the validator has been placed.
+	CommitteeIndex phase0.CommitteeIndex
+	// CommitteeLength is the length of the committee in which the validator has been placed.
+	CommitteeLength uint64
+	// CommitteesAtSlot is the number of committees in the slot.
+	CommitteesAtSlot uint64
+	// ValidatorCommitteeIndex is the index of the validator in the list of validators in the committee.
+	ValidatorCommitteeIndex uint64
+}
+
+// AttesterAssignments inverts the beacon committees for an epoch to provide the
+// assignment of each validator in the committees, keyed by validator index.
+func AttesterAssignments(committees []*BeaconCommittee) map[phase0.ValidatorIndex]*AttesterAssignment {
+	committeesAtSlot := make(map[phase0.Slot]uint64)
+	validators := 0
+	for _, committee := range committees {
+		if committee == nil {
+			continue
+		}
+		committeesAtSlot[committee.Slot]++
+		validators += len(committee.Validators)
+	}
+
+	res := make(map[phase0.ValidatorIndex]*AttesterAssignment, validators)
+	for _, committee := range committees {
+		if committee == nil {
+			continue
+		}
+		for i, validatorIndex := range committee.Validators {
+			res[validatorIndex] = &AttesterAssignment{
+				ValidatorIndex:          validatorIndex,
+				Slot:                    committee.Slot,
+				CommitteeIndex:          committee.Index,
+				CommitteeLength:         uint64(len(committee.Validators)),
+				CommitteesAtSlot:        committeesAtSlot[committee.Slot],
+				ValidatorCommitteeIndex: uint64(i),
+			}
+		}
+	}
+
+	return res
+}
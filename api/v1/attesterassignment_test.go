@@ -0,0 +1,67 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttesterAssignments(t *testing.T) {
+	tests := []struct {
+		name       string
+		committees []*api.BeaconCommittee
+		res        map[phase0.ValidatorIndex]*api.AttesterAssignment
+	}{
+		{
+			name: "Nil",
+			res:  map[phase0.ValidatorIndex]*api.AttesterAssignment{},
+		},
+		{
+			name: "NilCommittee",
+			committees: []*api.BeaconCommittee{
+				nil,
+				{Slot: 1, Index: 0, Validators: []phase0.ValidatorIndex{7}},
+			},
+			res: map[phase0.ValidatorIndex]*api.AttesterAssignment{
+				7: {ValidatorIndex: 7, Slot: 1, CommitteeIndex: 0, CommitteeLength: 1, CommitteesAtSlot: 1, ValidatorCommitteeIndex: 0},
+			},
+		},
+		{
+			name: "Multiple",
+			committees: []*api.BeaconCommittee{
+				{Slot: 32, Index: 0, Validators: []phase0.ValidatorIndex{5, 3}},
+				{Slot: 32, Index: 1, Validators: []phase0.ValidatorIndex{1, 4, 2}},
+				{Slot: 33, Index: 0, Validators: []phase0.ValidatorIndex{0}},
+			},
+			res: map[phase0.ValidatorIndex]*api.AttesterAssignment{
+				0: {ValidatorIndex: 0, Slot: 33, CommitteeIndex: 0, CommitteeLength: 1, CommitteesAtSlot: 1, ValidatorCommitteeIndex: 0},
+				1: {ValidatorIndex: 1, Slot: 32, CommitteeIndex: 1, CommitteeLength: 3, CommitteesAtSlot: 2, ValidatorCommitteeIndex: 0},
+				2: {ValidatorIndex: 2, Slot: 32, CommitteeIndex: 1, CommitteeLength: 3, CommitteesAtSlot: 2, ValidatorCommitteeIndex: 2},
+				3: {ValidatorIndex: 3, Slot: 32, CommitteeIndex: 0, CommitteeLength: 2, CommitteesAtSlot: 2, ValidatorCommitteeIndex: 1},
+				4: {ValidatorIndex: 4, Slot: 32, CommitteeIndex: 1, CommitteeLength: 3, CommitteesAtSlot: 2, ValidatorCommitteeIndex: 1},
+				5: {ValidatorIndex: 5, Slot: 32, CommitteeIndex: 0, CommitteeLength: 2, CommitteesAtSlot: 2, ValidatorCommitteeIndex: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.res, api.AttesterAssignments(test.committees))
+		})
+	}
+}
@@ -139,6 +139,9 @@ func (a *AttesterDuty) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (a *AttesterDuty) String() string {
+	if a == nil {
+		return ""
+	}
 	data, err := json.Marshal(a)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
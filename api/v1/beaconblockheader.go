@@ -80,6 +80,9 @@ func (b *BeaconBlockHeader) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (b *BeaconBlockHeader) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := json.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
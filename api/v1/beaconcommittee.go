@@ -96,6 +96,9 @@ func (b *BeaconCommittee) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (b *BeaconCommittee) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := json.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
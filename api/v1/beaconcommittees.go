@@ -0,0 +1,83 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconCommitteesFilter restricts the beacon committees returned by a request.
+// Nil fields are not filtered on.
+type BeaconCommitteesFilter struct {
+	// Epoch is the epoch for which to return committees.  If not supplied the
+	// epoch of the state is used.
+	Epoch *phase0.Epoch
+	// Index is the committee index for which to return committees.
+	Index *phase0.CommitteeIndex
+	// Slot is the slot for which to return committees.
+	Slot *phase0.Slot
+}
+
+// BeaconCommittees is a set of beacon committees, indexed for lookup by slot and index.
+type BeaconCommittees struct {
+	// Committees are the committees, in the order they were supplied.
+	Committees []*BeaconCommittee
+
+	bySlotAndIndex map[phase0.Slot]map[phase0.CommitteeIndex]*BeaconCommittee
+	bySlot         map[phase0.Slot][]*BeaconCommittee
+}
+
+// NewBeaconCommittees creates a set of beacon committees from a list.
+func NewBeaconCommittees(committees []*BeaconCommittee) *BeaconCommittees {
+	b := &BeaconCommittees{
+		Committees:     committees,
+		bySlotAndIndex: make(map[phase0.Slot]map[phase0.CommitteeIndex]*BeaconCommittee),
+		bySlot:         make(map[phase0.Slot][]*BeaconCommittee),
+	}
+	for _, committee := range committees {
+		if committee == nil {
+			continue
+		}
+		if _, exists := b.bySlotAndIndex[committee.Slot]; !exists {
+			b.bySlotAndIndex[committee.Slot] = make(map[phase0.CommitteeIndex]*BeaconCommittee)
+		}
+		b.bySlotAndIndex[committee.Slot][committee.Index] = committee
+		b.bySlot[committee.Slot] = append(b.bySlot[committee.Slot], committee)
+	}
+	for _, slotCommittees := range b.bySlot {
+		sort.Slice(slotCommittees, func(i, j int) bool {
+			return slotCommittees[i].Index < slotCommittees[j].Index
+		})
+	}
+
+	return b
+}
+
+// Committee returns the committee with the given slot and index, or nil if it is not present.
+func (b *BeaconCommittees) Committee(slot phase0.Slot, index phase0.CommitteeIndex) *BeaconCommittee {
+	return b.bySlotAndIndex[slot][index]
+}
+
+// CommitteesAtSlot returns the committees for the given slot, in index order.
+func (b *BeaconCommittees) CommitteesAtSlot(slot phase0.Slot) []*BeaconCommittee {
+	return b.bySlot[slot]
+}
+
+// CommitteesBySlot returns the committees grouped by slot, with the committees for each
+// slot in index order.  The returned map must not be modified.
+func (b *BeaconCommittees) CommitteesBySlot() map[phase0.Slot][]*BeaconCommittee {
+	return b.bySlot
+}
@@ -0,0 +1,46 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconCommittees(t *testing.T) {
+	committees := []*api.BeaconCommittee{
+		{Slot: 1, Index: 1, Validators: []phase0.ValidatorIndex{3}},
+		{Slot: 1, Index: 0, Validators: []phase0.ValidatorIndex{1, 2}},
+		nil,
+		{Slot: 2, Index: 0, Validators: []phase0.ValidatorIndex{4}},
+	}
+	b := api.NewBeaconCommittees(committees)
+
+	require.Equal(t, committees, b.Committees)
+	require.Equal(t, committees[1], b.Committee(1, 0))
+	require.Equal(t, committees[0], b.Committee(1, 1))
+	require.Equal(t, committees[3], b.Committee(2, 0))
+	require.Nil(t, b.Committee(2, 1))
+	require.Nil(t, b.Committee(3, 0))
+
+	require.Equal(t, []*api.BeaconCommittee{committees[1], committees[0]}, b.CommitteesAtSlot(1))
+	require.Nil(t, b.CommitteesAtSlot(3))
+
+	bySlot := b.CommitteesBySlot()
+	require.Len(t, bySlot, 2)
+	require.Equal(t, []*api.BeaconCommittee{committees[3]}, bySlot[2])
+}
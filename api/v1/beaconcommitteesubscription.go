@@ -104,6 +104,9 @@ func (b *BeaconCommitteeSubscription) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (b *BeaconCommitteeSubscription) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := json.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
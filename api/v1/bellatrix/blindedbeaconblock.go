@@ -16,8 +16,10 @@ package bellatrix
 import (
 	"fmt"
 
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
 // BlindedBeaconBlock represents a blinded beacon block.
@@ -31,9 +33,38 @@ type BlindedBeaconBlock struct {
 
 // String returns a string version of the structure.
 func (b *BlindedBeaconBlock) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
 	}
 	return string(data)
 }
+
+// GetSlot returns the slot of the block.
+func (b *BlindedBeaconBlock) GetSlot() phase0.Slot {
+	return b.Slot
+}
+
+// GetProposerIndex returns the proposer index of the block.
+func (b *BlindedBeaconBlock) GetProposerIndex() phase0.ValidatorIndex {
+	return b.ProposerIndex
+}
+
+// BodyRoot returns the root of the block's body.
+func (b *BlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
+	if b.Body == nil {
+		return phase0.Root{}, errors.New("no body")
+	}
+	return b.Body.HashTreeRoot()
+}
+
+// ExecutionPayloadHeader returns the execution payload header of the block.
+func (b *BlindedBeaconBlock) ExecutionPayloadHeader() apiv1.ExecutionPayloadHeader {
+	if b.Body == nil || b.Body.ExecutionPayloadHeader == nil {
+		return nil
+	}
+	return b.Body.ExecutionPayloadHeader
+}
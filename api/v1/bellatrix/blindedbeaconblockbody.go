@@ -38,6 +38,9 @@ type BlindedBeaconBlockBody struct {
 
 // String returns a string version of the structure.
 func (b *BlindedBeaconBlockBody) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -28,6 +28,9 @@ type SignedBlindedBeaconBlock struct {
 
 // String returns a string version of the structure.
 func (s *SignedBlindedBeaconBlock) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
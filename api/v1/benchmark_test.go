@@ -0,0 +1,72 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// benchmarkValidators is the number of validators in the benchmark response.
+const benchmarkValidators = 1000000
+
+// benchmarkValidatorsResponse returns a validators response of mainnet size.
+func benchmarkValidatorsResponse() []*api.Validator {
+	validators := make([]*api.Validator, benchmarkValidators)
+	for i := range validators {
+		validators[i] = &api.Validator{
+			Index:   phase0.ValidatorIndex(i),
+			Balance: 32000000000,
+			Status:  api.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{
+				PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8), byte(i >> 16)},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+				ExitEpoch:             phase0.Epoch(0xffffffffffffffff),
+				WithdrawableEpoch:     phase0.Epoch(0xffffffffffffffff),
+			},
+		}
+	}
+
+	return validators
+}
+
+func BenchmarkValidatorsMarshalJSON(b *testing.B) {
+	validators := benchmarkValidatorsResponse()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(validators); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidatorsUnmarshalJSON(b *testing.B) {
+	data, err := json.Marshal(benchmarkValidatorsResponse())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var validators []*api.Validator
+		if err := json.Unmarshal(data, &validators); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BlindedBeaconBlock is the interface implemented by the blinded beacon blocks of all forks.
+type BlindedBeaconBlock interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() phase0.Slot
+	// GetProposerIndex returns the proposer index of the block.
+	GetProposerIndex() phase0.ValidatorIndex
+	// BodyRoot returns the root of the block's body.
+	BodyRoot() (phase0.Root, error)
+	// ExecutionPayloadHeader returns the execution payload header of the block.
+	ExecutionPayloadHeader() ExecutionPayloadHeader
+	// HashTreeRoot returns the root of the block.
+	HashTreeRoot() ([32]byte, error)
+}
+
+// ExecutionPayloadHeader is the interface implemented by the execution payload headers of all forks.
+type ExecutionPayloadHeader interface {
+	// GetParentHash returns the parent hash of the execution payload header.
+	GetParentHash() phase0.Hash32
+	// GetFeeRecipient returns the fee recipient of the execution payload header.
+	GetFeeRecipient() bellatrix.ExecutionAddress
+	// GetBlockNumber returns the block number of the execution payload header.
+	GetBlockNumber() uint64
+	// GetTimestamp returns the timestamp of the execution payload header.
+	GetTimestamp() uint64
+	// GetBlockHash returns the block hash of the execution payload header.
+	GetBlockHash() phase0.Hash32
+	// GetTransactionsRoot returns the transactions root of the execution payload header.
+	GetTransactionsRoot() phase0.Root
+	// HashTreeRoot returns the root of the execution payload header.
+	HashTreeRoot() ([32]byte, error)
+}
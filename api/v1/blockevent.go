@@ -81,6 +81,9 @@ func (e *BlockEvent) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *BlockEvent) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,81 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BroadcastValidation defines the validation a beacon node carries out on a block
+// before broadcasting it.
+type BroadcastValidation int
+
+const (
+	// BroadcastValidationGossip carries out the lightweight checks required for the block
+	// to pass gossip validation.  This is the fastest option, and the default.
+	BroadcastValidationGossip BroadcastValidation = iota
+	// BroadcastValidationConsensus carries out full consensus validation, importing the
+	// block, before broadcasting it.
+	BroadcastValidationConsensus
+	// BroadcastValidationConsensusAndEquivocation carries out full consensus validation,
+	// and additionally checks that the block does not equivocate with another block for
+	// the same slot and proposer, before broadcasting it.
+	BroadcastValidationConsensusAndEquivocation
+)
+
+var broadcastValidationStrings = [...]string{
+	"gossip",
+	"consensus",
+	"consensus_and_equivocation",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BroadcastValidation) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", b.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BroadcastValidation) UnmarshalJSON(input []byte) error {
+	var err error
+	switch strings.ToLower(string(input)) {
+	case `"gossip"`:
+		*b = BroadcastValidationGossip
+	case `"consensus"`:
+		*b = BroadcastValidationConsensus
+	case `"consensus_and_equivocation"`:
+		*b = BroadcastValidationConsensusAndEquivocation
+	default:
+		err = fmt.Errorf("unrecognised broadcast validation %s", string(input))
+	}
+	return err
+}
+
+// String returns a string representation of the broadcast validation.
+func (b BroadcastValidation) String() string {
+	if b < 0 || int(b) >= len(broadcastValidationStrings) {
+		return "unknown"
+	}
+	return broadcastValidationStrings[b]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BroadcastValidation) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BroadcastValidation) UnmarshalText(input []byte) error {
+	return b.UnmarshalJSON([]byte(fmt.Sprintf("%q", input)))
+}
@@ -0,0 +1,69 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastValidationJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected api.BroadcastValidation
+		err      string
+	}{
+		{
+			name:     "Gossip",
+			input:    []byte(`"gossip"`),
+			expected: api.BroadcastValidationGossip,
+		},
+		{
+			name:     "Consensus",
+			input:    []byte(`"consensus"`),
+			expected: api.BroadcastValidationConsensus,
+		},
+		{
+			name:     "ConsensusAndEquivocation",
+			input:    []byte(`"consensus_and_equivocation"`),
+			expected: api.BroadcastValidationConsensusAndEquivocation,
+		},
+		{
+			name:  "Unknown",
+			input: []byte(`"full"`),
+			err:   `unrecognised broadcast validation "full"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BroadcastValidation
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(test.input), `"`+res.String()+`"`)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by copygen. DO NOT EDIT.
+package capella
+
+// Copy returns a deep copy of the structure.
+func (b *BlindedBeaconBlock) Copy() *BlindedBeaconBlock {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BlindedBeaconBlockBody) Copy() *BlindedBeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+	res.ExecutionPayloadHeader = b.ExecutionPayloadHeader.Copy()
+	if b.BLSToExecutionChanges != nil {
+		res.BLSToExecutionChanges = append(b.BLSToExecutionChanges[:0:0], b.BLSToExecutionChanges...)
+		for i := range b.BLSToExecutionChanges {
+			res.BLSToExecutionChanges[i] = b.BLSToExecutionChanges[i].Copy()
+		}
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedBlindedBeaconBlock) Copy() *SignedBlindedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
@@ -104,6 +104,9 @@ func (s *SignedBlindedBeaconBlock) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SignedBlindedBeaconBlock) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,79 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import "fmt"
+
+// ReadinessReason is the reason a node is not ready to be used for proposals.
+type ReadinessReason int
+
+const (
+	// ReadinessReasonNone means the node is ready.
+	ReadinessReasonNone ReadinessReason = iota
+	// ReadinessReasonSyncing means the node is syncing.
+	ReadinessReasonSyncing
+	// ReadinessReasonELOffline means the node's execution client is offline.
+	ReadinessReasonELOffline
+	// ReadinessReasonOptimistic means the node's head has not been validated by its
+	// execution client.
+	ReadinessReasonOptimistic
+	// ReadinessReasonInsufficientPeers means the node has too few connected peers.
+	ReadinessReasonInsufficientPeers
+)
+
+var readinessReasonStrings = [...]string{
+	"none",
+	"syncing",
+	"el_offline",
+	"optimistic",
+	"insufficient_peers",
+}
+
+// String returns a string representation of the readiness reason.
+func (r ReadinessReason) String() string {
+	if r < 0 || int(r) >= len(readinessReasonStrings) {
+		return "unknown"
+	}
+	return readinessReasonStrings[r]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r ReadinessReason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *ReadinessReason) UnmarshalText(input []byte) error {
+	for i, str := range readinessReasonStrings {
+		if str == string(input) {
+			*r = ReadinessReason(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unrecognised readiness reason %s", string(input))
+}
+
+// ChainReadiness is the readiness of a node to be used for proposals, as determined
+// from its sync state and peers.
+type ChainReadiness struct {
+	// Ready is true if the node is ready to be used for proposals.
+	Ready bool
+	// Reason is the reason the node is not ready, if it is not.
+	Reason ReadinessReason
+	// SyncState is the sync state of the node.
+	SyncState *SyncState
+	// PeerCount is the peer count of the node.  This is not present if the node was
+	// found to be unready from its sync state alone.
+	PeerCount *PeerCount
+}
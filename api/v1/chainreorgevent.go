@@ -139,6 +139,9 @@ func (e *ChainReorgEvent) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *ChainReorgEvent) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
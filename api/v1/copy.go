@@ -0,0 +1,372 @@
+// Code generated by copygen. DO NOT EDIT.
+package v1
+
+// Copy returns a deep copy of the structure.
+func (a *AttesterAssignment) Copy() *AttesterAssignment {
+	if a == nil {
+		return nil
+	}
+	res := *a
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (a *AttesterDuty) Copy() *AttesterDuty {
+	if a == nil {
+		return nil
+	}
+	res := *a
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Header = b.Header.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconCommittee) Copy() *BeaconCommittee {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	if b.Validators != nil {
+		res.Validators = append(b.Validators[:0:0], b.Validators...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconCommitteeSubscription) Copy() *BeaconCommitteeSubscription {
+	if b == nil {
+		return nil
+	}
+	res := *b
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconCommitteesFilter) Copy() *BeaconCommitteesFilter {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	if b.Epoch != nil {
+		copied := *b.Epoch
+		res.Epoch = &copied
+	}
+	if b.Index != nil {
+		copied := *b.Index
+		res.Index = &copied
+	}
+	if b.Slot != nil {
+		copied := *b.Slot
+		res.Slot = &copied
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BlockEvent) Copy() *BlockEvent {
+	if b == nil {
+		return nil
+	}
+	res := *b
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BlockGossipEvent) Copy() *BlockGossipEvent {
+	if b == nil {
+		return nil
+	}
+	res := *b
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (c *ChainReadiness) Copy() *ChainReadiness {
+	if c == nil {
+		return nil
+	}
+	res := *c
+	res.SyncState = c.SyncState.Copy()
+	res.PeerCount = c.PeerCount.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (c *ChainReorgEvent) Copy() *ChainReorgEvent {
+	if c == nil {
+		return nil
+	}
+	res := *c
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (d *DepositContract) Copy() *DepositContract {
+	if d == nil {
+		return nil
+	}
+	res := *d
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+// Data is copied shallowly.
+func (e *Event) Copy() *Event {
+	if e == nil {
+		return nil
+	}
+	res := *e
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (f *Finality) Copy() *Finality {
+	if f == nil {
+		return nil
+	}
+	res := *f
+	res.Finalized = f.Finalized.Copy()
+	res.Justified = f.Justified.Copy()
+	res.PreviousJustified = f.PreviousJustified.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (f *FinalizedCheckpointEvent) Copy() *FinalizedCheckpointEvent {
+	if f == nil {
+		return nil
+	}
+	res := *f
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (f *ForkChoiceNode) Copy() *ForkChoiceNode {
+	if f == nil {
+		return nil
+	}
+	res := *f
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (g *Genesis) Copy() *Genesis {
+	if g == nil {
+		return nil
+	}
+	res := *g
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (h *HeadEvent) Copy() *HeadEvent {
+	if h == nil {
+		return nil
+	}
+	res := *h
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (n *NodeVersion) Copy() *NodeVersion {
+	if n == nil {
+		return nil
+	}
+	res := *n
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *Peer) Copy() *Peer {
+	if p == nil {
+		return nil
+	}
+	res := *p
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *PeerCount) Copy() *PeerCount {
+	if p == nil {
+		return nil
+	}
+	res := *p
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *ProposalPreparation) Copy() *ProposalPreparation {
+	if p == nil {
+		return nil
+	}
+	res := *p
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *ProposerDuty) Copy() *ProposerDuty {
+	if p == nil {
+		return nil
+	}
+	res := *p
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedValidatorRegistration) Copy() *SignedValidatorRegistration {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SubmissionReceipt) Copy() *SubmissionReceipt {
+	if s == nil {
+		return nil
+	}
+	res := *s
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncCommittee) Copy() *SyncCommittee {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.Validators != nil {
+		res.Validators = append(s.Validators[:0:0], s.Validators...)
+	}
+	if s.ValidatorAggregates != nil {
+		res.ValidatorAggregates = append(s.ValidatorAggregates[:0:0], s.ValidatorAggregates...)
+		for i := range s.ValidatorAggregates {
+			if s.ValidatorAggregates[i] != nil {
+				res.ValidatorAggregates[i] = append(s.ValidatorAggregates[i][:0:0], s.ValidatorAggregates[i]...)
+			}
+		}
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncCommitteeDuty) Copy() *SyncCommitteeDuty {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.ValidatorSyncCommitteeIndices != nil {
+		res.ValidatorSyncCommitteeIndices = append(s.ValidatorSyncCommitteeIndices[:0:0], s.ValidatorSyncCommitteeIndices...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncCommitteeSubscription) Copy() *SyncCommitteeSubscription {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.SyncCommitteeIndices != nil {
+		res.SyncCommitteeIndices = append(s.SyncCommitteeIndices[:0:0], s.SyncCommitteeIndices...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncState) Copy() *SyncState {
+	if s == nil {
+		return nil
+	}
+	res := *s
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *Validator) Copy() *Validator {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Validator = v.Validator.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *ValidatorBalance) Copy() *ValidatorBalance {
+	if v == nil {
+		return nil
+	}
+	res := *v
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *ValidatorBalanceHistory) Copy() *ValidatorBalanceHistory {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	if v.Epochs != nil {
+		res.Epochs = append(v.Epochs[:0:0], v.Epochs...)
+	}
+	if v.Balances != nil {
+		res.Balances = append(v.Balances[:0:0], v.Balances...)
+	}
+	if v.Unavailable != nil {
+		res.Unavailable = append(v.Unavailable[:0:0], v.Unavailable...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *ValidatorRegistration) Copy() *ValidatorRegistration {
+	if v == nil {
+		return nil
+	}
+	res := *v
+
+	return &res
+}
@@ -20,13 +20,14 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/pkg/errors"
 )
 
 // DepositContract represents the details of the Ethereum 1 deposit contract for a chain.
 type DepositContract struct {
 	ChainID uint64
-	Address []byte
+	Address bellatrix.ExecutionAddress
 }
 
 // depositContractJSON is the standard API representation of the struct.
@@ -60,18 +61,23 @@ func (d *DepositContract) UnmarshalJSON(input []byte) error {
 	if depositContractJSON.Address == "" {
 		return errors.New("address missing")
 	}
-	if d.Address, err = hex.DecodeString(strings.TrimPrefix(depositContractJSON.Address, "0x")); err != nil {
+	address, err := hex.DecodeString(strings.TrimPrefix(depositContractJSON.Address, "0x"))
+	if err != nil {
 		return errors.Wrap(err, "invalid value for address")
 	}
-	if len(d.Address) != eth1AddressLength {
-		return fmt.Errorf("incorrect length %d for address", len(d.Address))
+	if len(address) != eth1AddressLength {
+		return fmt.Errorf("incorrect length %d for address", len(address))
 	}
+	copy(d.Address[:], address)
 
 	return nil
 }
 
 // String returns a string version of the structure.
 func (d *DepositContract) String() string {
+	if d == nil {
+		return ""
+	}
 	data, err := json.Marshal(d)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
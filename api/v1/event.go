@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -32,13 +33,17 @@ type Event struct {
 
 // SupportedEventTopics is a map of supported event topics.
 var SupportedEventTopics = map[string]bool{
-	"attestation":            true,
-	"block":                  true,
-	"chain_reorg":            true,
-	"finalized_checkpoint":   true,
-	"head":                   true,
-	"voluntary_exit":         true,
-	"contribution_and_proof": true,
+	"attestation":             true,
+	"block":                   true,
+	"chain_reorg":             true,
+	"finalized_checkpoint":    true,
+	"head":                    true,
+	"voluntary_exit":          true,
+	"contribution_and_proof":  true,
+	"block_gossip":            true,
+	"attester_slashing":       true,
+	"proposer_slashing":       true,
+	"bls_to_execution_change": true,
 }
 
 // eventJSON is the spec representation of the struct.
@@ -96,6 +101,14 @@ func (e *Event) UnmarshalJSON(input []byte) error {
 		e.Data = &phase0.SignedVoluntaryExit{}
 	case "contribution_and_proof":
 		e.Data = &altair.SignedContributionAndProof{}
+	case "block_gossip":
+		e.Data = &BlockGossipEvent{}
+	case "attester_slashing":
+		e.Data = &phase0.AttesterSlashing{}
+	case "proposer_slashing":
+		e.Data = &phase0.ProposerSlashing{}
+	case "bls_to_execution_change":
+		e.Data = &capella.SignedBLSToExecutionChange{}
 	default:
 		return fmt.Errorf("unsupported event topic %s", eventJSON.Topic)
 	}
@@ -113,6 +126,9 @@ func (e *Event) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *Event) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
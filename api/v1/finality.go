@@ -73,6 +73,9 @@ func (f *Finality) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (f *Finality) String() string {
+	if f == nil {
+		return ""
+	}
 	data, err := json.Marshal(f)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -91,6 +91,9 @@ func (e *FinalizedCheckpointEvent) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *FinalizedCheckpointEvent) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
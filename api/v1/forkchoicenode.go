@@ -0,0 +1,134 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkChoiceNode is a node in the fork choice of the node.
+type ForkChoiceNode struct {
+	Slot               phase0.Slot
+	BlockRoot          phase0.Root
+	ParentRoot         phase0.Root
+	JustifiedEpoch     phase0.Epoch
+	FinalizedEpoch     phase0.Epoch
+	Weight             uint64
+	Validity           string
+	ExecutionBlockHash phase0.Hash32
+}
+
+// forkChoiceNodeJSON is the spec representation of the struct.
+type forkChoiceNodeJSON struct {
+	Slot               string `json:"slot"`
+	BlockRoot          string `json:"block_root"`
+	ParentRoot         string `json:"parent_root"`
+	JustifiedEpoch     string `json:"justified_epoch"`
+	FinalizedEpoch     string `json:"finalized_epoch"`
+	Weight             string `json:"weight"`
+	Validity           string `json:"validity"`
+	ExecutionBlockHash string `json:"execution_block_hash"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkChoiceNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkChoiceNodeJSON{
+		Slot:               fmt.Sprintf("%d", f.Slot),
+		BlockRoot:          fmt.Sprintf("%#x", f.BlockRoot),
+		ParentRoot:         fmt.Sprintf("%#x", f.ParentRoot),
+		JustifiedEpoch:     fmt.Sprintf("%d", f.JustifiedEpoch),
+		FinalizedEpoch:     fmt.Sprintf("%d", f.FinalizedEpoch),
+		Weight:             fmt.Sprintf("%d", f.Weight),
+		Validity:           f.Validity,
+		ExecutionBlockHash: fmt.Sprintf("%#x", f.ExecutionBlockHash),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkChoiceNode) UnmarshalJSON(input []byte) error {
+	var forkChoiceNodeJSON forkChoiceNodeJSON
+	if err := json.Unmarshal(input, &forkChoiceNodeJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	numbers := []struct {
+		name  string
+		input string
+		value *uint64
+	}{
+		{name: "slot", input: forkChoiceNodeJSON.Slot, value: (*uint64)(&f.Slot)},
+		{name: "justified epoch", input: forkChoiceNodeJSON.JustifiedEpoch, value: (*uint64)(&f.JustifiedEpoch)},
+		{name: "finalized epoch", input: forkChoiceNodeJSON.FinalizedEpoch, value: (*uint64)(&f.FinalizedEpoch)},
+		{name: "weight", input: forkChoiceNodeJSON.Weight, value: &f.Weight},
+	}
+	for _, number := range numbers {
+		if number.input == "" {
+			return fmt.Errorf("%s missing", number.name)
+		}
+		val, err := strconv.ParseUint(number.input, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for %s", number.name)
+		}
+		*number.value = val
+	}
+
+	hashes := []struct {
+		name  string
+		input string
+		value []byte
+	}{
+		{name: "block root", input: forkChoiceNodeJSON.BlockRoot, value: f.BlockRoot[:]},
+		{name: "parent root", input: forkChoiceNodeJSON.ParentRoot, value: f.ParentRoot[:]},
+		{name: "execution block hash", input: forkChoiceNodeJSON.ExecutionBlockHash, value: f.ExecutionBlockHash[:]},
+	}
+	for _, hash := range hashes {
+		if hash.input == "" {
+			return fmt.Errorf("%s missing", hash.name)
+		}
+		val, err := hex.DecodeString(strings.TrimPrefix(hash.input, "0x"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for %s", hash.name)
+		}
+		if len(val) != len(hash.value) {
+			return fmt.Errorf("incorrect length %d for %s", len(val), hash.name)
+		}
+		copy(hash.value, val)
+	}
+
+	if forkChoiceNodeJSON.Validity == "" {
+		return errors.New("validity missing")
+	}
+	f.Validity = forkChoiceNodeJSON.Validity
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkChoiceNode) String() string {
+	if f == nil {
+		return ""
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestForkChoiceNodeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.forkChoiceNodeJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","justified_epoch":"1","finalized_epoch":"0","weight":"32000000000","validity":"valid","execution_block_hash":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "WeightInvalid",
+			input: []byte(`{"slot":"10","block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","justified_epoch":"1","finalized_epoch":"0","weight":"-1","validity":"valid","execution_block_hash":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}`),
+			err:   "invalid value for weight: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockRootShort",
+			input: []byte(`{"slot":"10","block_root":"0x0102","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","justified_epoch":"1","finalized_epoch":"0","weight":"32000000000","validity":"valid","execution_block_hash":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}`),
+			err:   "incorrect length 2 for block root",
+		},
+		{
+			name:  "ExecutionBlockHashInvalid",
+			input: []byte(`{"slot":"10","block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","justified_epoch":"1","finalized_epoch":"0","weight":"32000000000","validity":"valid","execution_block_hash":"invalid"}`),
+			err:   "invalid value for execution block hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ValidityMissing",
+			input: []byte(`{"slot":"10","block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","justified_epoch":"1","finalized_epoch":"0","weight":"32000000000","execution_block_hash":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}`),
+			err:   "validity missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"10","block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","justified_epoch":"1","finalized_epoch":"0","weight":"32000000000","validity":"valid","execution_block_hash":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ForkChoiceNode
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
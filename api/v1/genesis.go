@@ -95,6 +95,9 @@ func (g *Genesis) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (g *Genesis) String() string {
+	if g == nil {
+		return ""
+	}
 	data, err := json.Marshal(g)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -132,6 +132,9 @@ func (e *HeadEvent) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *HeadEvent) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
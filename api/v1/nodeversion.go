@@ -0,0 +1,130 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"strings"
+)
+
+// Known beacon node implementations.
+const (
+	NodeImplementationUnknown    = "unknown"
+	NodeImplementationGrandine   = "grandine"
+	NodeImplementationLighthouse = "lighthouse"
+	NodeImplementationLodestar   = "lodestar"
+	NodeImplementationNimbus     = "nimbus"
+	NodeImplementationPrysm      = "prysm"
+	NodeImplementationTeku       = "teku"
+)
+
+var nodeImplementations = []string{
+	NodeImplementationGrandine,
+	NodeImplementationLighthouse,
+	NodeImplementationLodestar,
+	NodeImplementationNimbus,
+	NodeImplementationPrysm,
+	NodeImplementationTeku,
+}
+
+// NodeVersion is the structured form of a node version string.
+type NodeVersion struct {
+	// Implementation is the implementation of the node, for example "lighthouse".
+	Implementation string
+	// Version is the version of the node without a leading "v", for example "4.0.1".
+	Version string
+	// Commit is the commit from which the node was built, if present.
+	Commit string
+	// Platform is the platform on which the node is running, if present.
+	Platform string
+}
+
+// ParseNodeVersion parses a node version string, as returned by the node version
+// endpoint, on a best-effort basis.  Fields that cannot be determined are left empty,
+// and the implementation is NodeImplementationUnknown if it is not recognised.
+func ParseNodeVersion(input string) *NodeVersion {
+	res := &NodeVersion{
+		Implementation: NodeImplementationUnknown,
+	}
+
+	input = strings.TrimSpace(input)
+	// Some nodes supply their platform in parentheses, for example "Prysm/v4.0.2 (linux amd64)".
+	if start := strings.Index(input, "("); start != -1 {
+		if end := strings.LastIndex(input, ")"); end > start {
+			res.Platform = strings.TrimSpace(input[start+1 : end])
+			input = strings.TrimSpace(input[:start] + input[end+1:])
+		}
+	}
+
+	parts := strings.Split(input, "/")
+	res.Implementation = nodeImplementation(parts[0])
+	if res.Implementation == NodeImplementationUnknown {
+		// Fall back to searching the full string.
+		res.Implementation = nodeImplementation(input)
+	}
+	if len(parts) > 1 {
+		res.Version, res.Commit = splitNodeVersion(parts[1])
+	}
+	if len(parts) > 2 {
+		for _, part := range parts[2:] {
+			switch {
+			case part == "":
+			case res.Commit == "" && isCommit(part):
+				res.Commit = part
+			case res.Platform == "":
+				res.Platform = part
+			}
+		}
+	}
+
+	return res
+}
+
+// nodeImplementation returns the known implementation mentioned in the input.
+func nodeImplementation(input string) string {
+	input = strings.ToLower(input)
+	for _, implementation := range nodeImplementations {
+		if strings.Contains(input, implementation) {
+			return implementation
+		}
+	}
+
+	return NodeImplementationUnknown
+}
+
+// splitNodeVersion splits a version such as "v4.0.1-3ee3d3b" in to its version and commit.
+func splitNodeVersion(input string) (string, string) {
+	input = strings.TrimPrefix(input, "v")
+	components := strings.Split(input, "-")
+	for i := 1; i < len(components); i++ {
+		if isCommit(components[i]) {
+			return strings.Join(components[:i], "-"), components[i]
+		}
+	}
+
+	return input, ""
+}
+
+// isCommit returns true if the input looks like an abbreviated or full commit hash.
+func isCommit(input string) bool {
+	if len(input) < 6 || len(input) > 40 {
+		return false
+	}
+	for _, c := range input {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+
+	return true
+}
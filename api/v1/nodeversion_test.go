@@ -0,0 +1,127 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *api.NodeVersion
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationUnknown,
+			},
+		},
+		{
+			name:  "Unknown",
+			input: "Beacon/1.2.3",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationUnknown,
+				Version:        "1.2.3",
+			},
+		},
+		{
+			name:  "Lighthouse",
+			input: "Lighthouse/v4.0.1-3ee3d3b/x86_64-linux",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationLighthouse,
+				Version:        "4.0.1",
+				Commit:         "3ee3d3b",
+				Platform:       "x86_64-linux",
+			},
+		},
+		{
+			name:  "LighthouseRC",
+			input: "Lighthouse/v4.0.1-rc.0-3ee3d3b/aarch64-linux",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationLighthouse,
+				Version:        "4.0.1-rc.0",
+				Commit:         "3ee3d3b",
+				Platform:       "aarch64-linux",
+			},
+		},
+		{
+			name:  "Prysm",
+			input: "Prysm/v4.0.2 (linux amd64)",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationPrysm,
+				Version:        "4.0.2",
+				Platform:       "linux amd64",
+			},
+		},
+		{
+			name:  "PrysmCommit",
+			input: "Prysm/v4.0.2/4f3f2ad6a8e6b6e4fe2b5f7a7a2c2e2b5c9c4c13 (linux amd64)",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationPrysm,
+				Version:        "4.0.2",
+				Commit:         "4f3f2ad6a8e6b6e4fe2b5f7a7a2c2e2b5c9c4c13",
+				Platform:       "linux amd64",
+			},
+		},
+		{
+			name:  "Teku",
+			input: "teku/v23.4.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-17",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationTeku,
+				Version:        "23.4.0",
+				Platform:       "linux-x86_64",
+			},
+		},
+		{
+			name:  "Nimbus",
+			input: "Nimbus/v23.4.0-a6f1c1-stateofus",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationNimbus,
+				Version:        "23.4.0",
+				Commit:         "a6f1c1",
+			},
+		},
+		{
+			name:  "Lodestar",
+			input: "Lodestar/v1.8.0/a4b29cf",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationLodestar,
+				Version:        "1.8.0",
+				Commit:         "a4b29cf",
+			},
+		},
+		{
+			name:  "Grandine",
+			input: "Grandine/0.2.0-a1b2c3d/x86_64-linux",
+			expected: &api.NodeVersion{
+				Implementation: api.NodeImplementationGrandine,
+				Version:        "0.2.0",
+				Commit:         "a1b2c3d",
+				Platform:       "x86_64-linux",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, api.ParseNodeVersion(test.input))
+		})
+	}
+}
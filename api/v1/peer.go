@@ -0,0 +1,93 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Peer is a peer of the node.
+type Peer struct {
+	// PeerID is the libp2p identifier of the peer.
+	PeerID string
+	// ENR is the ethereum node record of the peer, if known.
+	ENR string
+	// LastSeenP2PAddress is the multiaddress at which the peer was last seen.
+	LastSeenP2PAddress string
+	// State is the connection state of the peer.
+	State string
+	// Direction is the direction of the connection to the peer.
+	Direction string
+}
+
+// peerJSON is the spec representation of the struct.
+type peerJSON struct {
+	PeerID             string `json:"peer_id"`
+	ENR                string `json:"enr,omitempty"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *Peer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&peerJSON{
+		PeerID:             p.PeerID,
+		ENR:                p.ENR,
+		LastSeenP2PAddress: p.LastSeenP2PAddress,
+		State:              p.State,
+		Direction:          p.Direction,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Peer) UnmarshalJSON(input []byte) error {
+	var peerJSON peerJSON
+	if err := json.Unmarshal(input, &peerJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if peerJSON.PeerID == "" {
+		return errors.New("peer ID missing")
+	}
+	p.PeerID = peerJSON.PeerID
+	// ENR is not always known so do not complain if not present.
+	p.ENR = peerJSON.ENR
+	p.LastSeenP2PAddress = peerJSON.LastSeenP2PAddress
+	if peerJSON.State == "" {
+		return errors.New("state missing")
+	}
+	p.State = peerJSON.State
+	if peerJSON.Direction == "" {
+		return errors.New("direction missing")
+	}
+	p.Direction = peerJSON.Direction
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *Peer) String() string {
+	if p == nil {
+		return ""
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestPeerJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.peerJSON",
+		},
+		{
+			name:  "PeerIDMissing",
+			input: []byte(`{"last_seen_p2p_address":"/ip4/10.0.0.1/tcp/9000","state":"connected","direction":"inbound"}`),
+			err:   "peer ID missing",
+		},
+		{
+			name:  "StateMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAmKyAgNcrQnYrNXgcYfLTB2jfrEMXAkG2M9rcYGotAHd7H","last_seen_p2p_address":"/ip4/10.0.0.1/tcp/9000","direction":"inbound"}`),
+			err:   "state missing",
+		},
+		{
+			name:  "DirectionMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAmKyAgNcrQnYrNXgcYfLTB2jfrEMXAkG2M9rcYGotAHd7H","last_seen_p2p_address":"/ip4/10.0.0.1/tcp/9000","state":"connected"}`),
+			err:   "direction missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"peer_id":"16Uiu2HAmKyAgNcrQnYrNXgcYfLTB2jfrEMXAkG2M9rcYGotAHd7H","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","last_seen_p2p_address":"/ip4/10.0.0.1/tcp/9000","state":"connected","direction":"inbound"}`),
+		},
+		{
+			name:  "GoodNoENR",
+			input: []byte(`{"peer_id":"16Uiu2HAmKyAgNcrQnYrNXgcYfLTB2jfrEMXAkG2M9rcYGotAHd7H","last_seen_p2p_address":"/ip4/10.0.0.1/tcp/9000","state":"disconnected","direction":"outbound"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.Peer
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// PeerCount is the number of the node's peers in each connection state.
+type PeerCount struct {
+	// Disconnected is the number of disconnected peers.
+	Disconnected uint64
+	// Connecting is the number of peers being connected.
+	Connecting uint64
+	// Connected is the number of connected peers.
+	Connected uint64
+	// Disconnecting is the number of peers being disconnected.
+	Disconnecting uint64
+}
+
+// peerCountJSON is the spec representation of the struct.
+type peerCountJSON struct {
+	Disconnected  string `json:"disconnected"`
+	Connecting    string `json:"connecting"`
+	Connected     string `json:"connected"`
+	Disconnecting string `json:"disconnecting"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PeerCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&peerCountJSON{
+		Disconnected:  fmt.Sprintf("%d", p.Disconnected),
+		Connecting:    fmt.Sprintf("%d", p.Connecting),
+		Connected:     fmt.Sprintf("%d", p.Connected),
+		Disconnecting: fmt.Sprintf("%d", p.Disconnecting),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PeerCount) UnmarshalJSON(input []byte) error {
+	var peerCountJSON peerCountJSON
+	if err := json.Unmarshal(input, &peerCountJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	values := []struct {
+		name  string
+		input string
+		value *uint64
+	}{
+		{name: "disconnected", input: peerCountJSON.Disconnected, value: &p.Disconnected},
+		{name: "connecting", input: peerCountJSON.Connecting, value: &p.Connecting},
+		{name: "connected", input: peerCountJSON.Connected, value: &p.Connected},
+		{name: "disconnecting", input: peerCountJSON.Disconnecting, value: &p.Disconnecting},
+	}
+	for _, value := range values {
+		if value.input == "" {
+			return fmt.Errorf("%s missing", value.name)
+		}
+		val, err := strconv.ParseUint(value.input, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for %s", value.name)
+		}
+		*value.value = val
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PeerCount) String() string {
+	if p == nil {
+		return ""
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
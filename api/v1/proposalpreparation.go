@@ -79,6 +79,9 @@ func (p *ProposalPreparation) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (p *ProposalPreparation) String() string {
+	if p == nil {
+		return ""
+	}
 	data, err := json.Marshal(p)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
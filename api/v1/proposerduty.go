@@ -88,6 +88,9 @@ func (p *ProposerDuty) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (p *ProposerDuty) String() string {
+	if p == nil {
+		return ""
+	}
 	data, err := json.Marshal(p)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -104,6 +104,9 @@ func (s *SignedValidatorRegistration) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SignedValidatorRegistration) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
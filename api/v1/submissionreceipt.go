@@ -0,0 +1,31 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// SubmissionReceipt is the outcome of submitting a block with broadcast validation.
+type SubmissionReceipt struct {
+	// BroadcastValidation is the validation requested for the block.
+	BroadcastValidation BroadcastValidation
+	// Broadcast is true if the block passed the requested validation and was broadcast.
+	// If this is false the block was rejected, and Message contains the node's reason.
+	Broadcast bool
+	// Imported is true if the block was also successfully imported by the node.
+	// A block can be broadcast but fail to import, in which case Message contains the
+	// node's reason.
+	Imported bool
+	// StatusCode is the HTTP status code returned by the node.
+	StatusCode int
+	// Message is the message returned by the node, if any.
+	Message string
+}
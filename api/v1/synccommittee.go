@@ -105,6 +105,9 @@ func (s *SyncCommittee) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncCommittee) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -99,6 +99,9 @@ func (s *SyncCommitteeDuty) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncCommitteeDuty) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -94,6 +94,9 @@ func (s *SyncCommitteeSubscription) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncCommitteeSubscription) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
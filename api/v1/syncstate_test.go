@@ -71,6 +71,15 @@ func TestSyncStateJSON(t *testing.T) {
 			name:  "Good",
 			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true}`),
 		},
+		{
+			name:  "ELOfflineInvalid",
+			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true,"el_offline":"true"}`),
+			err:   "invalid JSON: json: cannot unmarshal string into Go struct field syncStateJSON.el_offline of type bool",
+		},
+		{
+			name:  "ELOffline",
+			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true,"el_offline":true}`),
+		},
 	}
 
 	for _, test := range tests {
@@ -84,6 +84,9 @@ func (v *Validator) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (v *Validator) String() string {
+	if v == nil {
+		return ""
+	}
 	data, err := json.Marshal(v)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
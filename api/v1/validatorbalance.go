@@ -72,6 +72,9 @@ func (v *ValidatorBalance) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (v *ValidatorBalance) String() string {
+	if v == nil {
+		return ""
+	}
 	data, err := json.Marshal(v)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,31 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ValidatorBalanceHistory is a series of balances of a validator over a number of epochs.
+type ValidatorBalanceHistory struct {
+	// Index is the index of the validator.
+	Index phase0.ValidatorIndex
+	// Epochs are the epochs at which balances were sampled, in increasing order.
+	Epochs []phase0.Epoch
+	// Balances are the balances of the validator at the start of each of the epochs.
+	Balances []phase0.Gwei
+	// Unavailable are the epochs whose states could not be obtained, for example
+	// because they have been pruned by a node that is not an archive node.
+	Unavailable []phase0.Epoch
+}
@@ -140,6 +140,9 @@ func (v *ValidatorRegistration) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (v *ValidatorRegistration) String() string {
+	if v == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(v)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
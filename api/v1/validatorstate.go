@@ -61,7 +61,7 @@ var validatorStateStrings = [...]string{
 
 // MarshalJSON implements json.Marshaler.
 func (v *ValidatorState) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf("%q", validatorStateStrings[*v])), nil
+	return []byte(fmt.Sprintf("%q", v.String())), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -94,10 +94,24 @@ func (v *ValidatorState) UnmarshalJSON(input []byte) error {
 	return err
 }
 
+// String returns a string representation of the state.
 func (v ValidatorState) String() string {
+	if v < 0 || int(v) >= len(validatorStateStrings) {
+		return "unknown"
+	}
 	return validatorStateStrings[v]
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (v ValidatorState) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ValidatorState) UnmarshalText(input []byte) error {
+	return v.UnmarshalJSON([]byte(fmt.Sprintf("%q", input)))
+}
+
 // IsPending returns true if the validator is pending.
 func (v ValidatorState) IsPending() bool {
 	return v == ValidatorStatePendingInitialized ||
@@ -261,3 +261,16 @@ func TestValidatorToState(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorStateText(t *testing.T) {
+	input := map[api.ValidatorState]api.ReadinessReason{
+		api.ValidatorStateActiveOngoing: api.ReadinessReasonNone,
+		api.ValidatorStateExitedSlashed: api.ReadinessReasonELOffline,
+	}
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	var output map[api.ValidatorState]api.ReadinessReason
+	require.NoError(t, json.Unmarshal(data, &output))
+	require.Equal(t, input, output)
+}
@@ -15,7 +15,9 @@ package api
 
 import (
 	"errors"
+	"fmt"
 
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -32,20 +34,44 @@ type VersionedBlindedBeaconBlock struct {
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBlindedBeaconBlock) IsEmpty() bool {
-	return v.Bellatrix == nil
+	return v == nil || (v.Bellatrix == nil && v.Capella == nil)
+}
+
+// Block returns the blinded beacon block.
+func (v *VersionedBlindedBeaconBlock) Block() (apiv1.BlindedBeaconBlock, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
 }
 
 // Slot returns the slot of the beacon block.
 func (v *VersionedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return 0, errors.New("no bellatrix block")
+			return 0, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Slot, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return 0, errors.New("no capella block")
+			return 0, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Slot, nil
 	default:
@@ -55,15 +81,18 @@ func (v *VersionedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
-			return nil, errors.New("no bellatrix block")
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Body.Attestations, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
-			return nil, errors.New("no capella block")
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Body.Attestations, nil
 	default:
@@ -73,15 +102,18 @@ func (v *VersionedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, err
 
 // Root returns the root of the beacon block.
 func (v *VersionedBlindedBeaconBlock) Root() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.HashTreeRoot()
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.HashTreeRoot()
 	default:
@@ -91,15 +123,18 @@ func (v *VersionedBlindedBeaconBlock) Root() (phase0.Root, error) {
 
 // BodyRoot returns the body root of the beacon block.
 func (v *VersionedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Body.HashTreeRoot()
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Body == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Body.HashTreeRoot()
 	default:
@@ -109,15 +144,18 @@ func (v *VersionedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 
 // ParentRoot returns the parent root of the beacon block.
 func (v *VersionedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.ParentRoot, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.ParentRoot, nil
 	default:
@@ -127,15 +165,18 @@ func (v *VersionedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 
 // StateRoot returns the state root of the beacon block.
 func (v *VersionedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.StateRoot, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.StateRoot, nil
 	default:
@@ -145,27 +186,30 @@ func (v *VersionedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 
 // TransactionsRoot returns the transactions root of the beacon block.
 func (v *VersionedBlindedBeaconBlock) TransactionsRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		if v.Bellatrix.Body == nil {
-			return phase0.Root{}, errors.New("no bellatrix block body")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block body: %w", spec.ErrDataMissing)
 		}
-		if v.Bellatrix.Body.ExecutionPayloadHeader == nil {
-			return phase0.Root{}, errors.New("no bellatrix block body execution payload header")
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil || v.Bellatrix.Body.ExecutionPayloadHeader == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block body execution payload header: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Body.ExecutionPayloadHeader.TransactionsRoot, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		if v.Capella.Body == nil {
-			return phase0.Root{}, errors.New("no capella block body")
+			return phase0.Root{}, fmt.Errorf("no capella block body: %w", spec.ErrDataMissing)
 		}
-		if v.Capella.Body.ExecutionPayloadHeader == nil {
-			return phase0.Root{}, errors.New("no capella block body execution payload header")
+		if v.Capella == nil || v.Capella.Body == nil || v.Capella.Body.ExecutionPayloadHeader == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block body execution payload header: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Body.ExecutionPayloadHeader.TransactionsRoot, nil
 	default:
@@ -173,29 +217,53 @@ func (v *VersionedBlindedBeaconBlock) TransactionsRoot() (phase0.Root, error) {
 	}
 }
 
+// ProposerIndex returns the proposer index of the blinded beacon block.
+func (v *VersionedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.ProposerIndex, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return 0, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.ProposerIndex, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
 // FeeRecipient returns the fee recipient of the blinded beacon block.
 func (v *VersionedBlindedBeaconBlock) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	if v == nil {
+		return bellatrix.ExecutionAddress{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix block")
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		if v.Bellatrix.Body == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix block body")
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no bellatrix block body: %w", spec.ErrDataMissing)
 		}
-		if v.Bellatrix.Body.ExecutionPayloadHeader == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix block body execution payload header")
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil || v.Bellatrix.Body.ExecutionPayloadHeader == nil {
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no bellatrix block body execution payload header: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Body.ExecutionPayloadHeader.FeeRecipient, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no capella block")
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		if v.Capella.Body == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no capella block body")
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no capella block body: %w", spec.ErrDataMissing)
 		}
-		if v.Capella.Body.ExecutionPayloadHeader == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no capella block body execution payload header")
+		if v.Capella == nil || v.Capella.Body == nil || v.Capella.Body.ExecutionPayloadHeader == nil {
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no capella block body execution payload header: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Body.ExecutionPayloadHeader.FeeRecipient, nil
 	default:
@@ -205,27 +273,30 @@ func (v *VersionedBlindedBeaconBlock) FeeRecipient() (bellatrix.ExecutionAddress
 
 // Timestamp returns the timestamp of the blinded beacon block.
 func (v *VersionedBlindedBeaconBlock) Timestamp() (uint64, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return 0, errors.New("no bellatrix block")
+			return 0, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		if v.Bellatrix.Body == nil {
-			return 0, errors.New("no bellatrix block body")
+			return 0, fmt.Errorf("no bellatrix block body: %w", spec.ErrDataMissing)
 		}
-		if v.Bellatrix.Body.ExecutionPayloadHeader == nil {
-			return 0, errors.New("no bellatrix block body execution payload header")
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil || v.Bellatrix.Body.ExecutionPayloadHeader == nil {
+			return 0, fmt.Errorf("no bellatrix block body execution payload header: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Body.ExecutionPayloadHeader.Timestamp, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
-			return 0, errors.New("no capella block")
+			return 0, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		if v.Capella.Body == nil {
-			return 0, errors.New("no capella block body")
+			return 0, fmt.Errorf("no capella block body: %w", spec.ErrDataMissing)
 		}
-		if v.Capella.Body.ExecutionPayloadHeader == nil {
-			return 0, errors.New("no capella block body execution payload header")
+		if v.Capella == nil || v.Capella.Body == nil || v.Capella.Body.ExecutionPayloadHeader == nil {
+			return 0, fmt.Errorf("no capella block body execution payload header: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Body.ExecutionPayloadHeader.Timestamp, nil
 	default:
@@ -235,6 +306,9 @@ func (v *VersionedBlindedBeaconBlock) Timestamp() (uint64, error) {
 
 // String returns a string version of the structure.
 func (v *VersionedBlindedBeaconBlock) String() string {
+	if v == nil {
+		return ""
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
@@ -250,3 +324,36 @@ func (v *VersionedBlindedBeaconBlock) String() string {
 		return "unknown version"
 	}
 }
+
+// Validate checks that the structure holds complete data for its version, and no data for other versions.
+func (v *VersionedBlindedBeaconBlock) Validate() error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
+			return fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		if v.Bellatrix.Body.ExecutionPayloadHeader == nil {
+			return fmt.Errorf("no bellatrix execution payload header: %w", spec.ErrDataMissing)
+		}
+		if v.Capella != nil {
+			return errors.New("data present for multiple versions")
+		}
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Body == nil {
+			return fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		if v.Capella.Body.ExecutionPayloadHeader == nil {
+			return fmt.Errorf("no capella execution payload header: %w", spec.ErrDataMissing)
+		}
+		if v.Bellatrix != nil {
+			return errors.New("data present for multiple versions")
+		}
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
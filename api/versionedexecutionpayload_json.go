@@ -0,0 +1,93 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// versionedExecutionPayloadJSON is the builder API representation of the struct,
+// as returned by getPayload.
+type versionedExecutionPayloadJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *VersionedExecutionPayload) MarshalJSON() ([]byte, error) {
+	var data interface{}
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no bellatrix payload")
+		}
+		data = v.Bellatrix
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no capella payload")
+		}
+		data = v.Capella
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&versionedExecutionPayloadJSON{
+		Version: v.Version,
+		Data:    rawData,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VersionedExecutionPayload) UnmarshalJSON(input []byte) error {
+	var data versionedExecutionPayloadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if len(data.Data) == 0 {
+		return errors.New("data missing")
+	}
+
+	switch data.Version {
+	case spec.DataVersionBellatrix:
+		payload := &bellatrix.ExecutionPayload{}
+		if err := json.Unmarshal(data.Data, payload); err != nil {
+			return errors.Wrap(err, "invalid bellatrix payload")
+		}
+		v.Bellatrix = payload
+	case spec.DataVersionCapella:
+		payload := &capella.ExecutionPayload{}
+		if err := json.Unmarshal(data.Data, payload); err != nil {
+			return errors.Wrap(err, "invalid capella payload")
+		}
+		v.Capella = payload
+	default:
+		return errors.New("unsupported version")
+	}
+	v.Version = data.Version
+
+	return nil
+}
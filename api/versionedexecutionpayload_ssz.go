@@ -0,0 +1,132 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// The SSZ encoding of a versioned execution payload is the SSZ encoding of the
+// payload for its version; the version itself is not encoded, so must be set prior
+// to unmarshalling.
+
+// MarshalSSZ ssz marshals the VersionedExecutionPayload object.
+func (v *VersionedExecutionPayload) MarshalSSZ() ([]byte, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no bellatrix payload")
+		}
+		return v.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no capella payload")
+		}
+		return v.Capella.MarshalSSZ()
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// MarshalSSZTo ssz marshals the VersionedExecutionPayload object to a target array.
+func (v *VersionedExecutionPayload) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no bellatrix payload")
+		}
+		return v.Bellatrix.MarshalSSZTo(buf)
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no capella payload")
+		}
+		return v.Capella.MarshalSSZTo(buf)
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedExecutionPayload object.
+// The version must be set prior to calling this function.
+func (v *VersionedExecutionPayload) UnmarshalSSZ(buf []byte) error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		payload := &bellatrix.ExecutionPayload{}
+		if err := payload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		v.Bellatrix = payload
+	case spec.DataVersionCapella:
+		payload := &capella.ExecutionPayload{}
+		if err := payload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		v.Capella = payload
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedExecutionPayload object.
+func (v *VersionedExecutionPayload) SizeSSZ() int {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0
+		}
+		return v.Bellatrix.SizeSSZ()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return 0
+		}
+		return v.Capella.SizeSSZ()
+	default:
+		return 0
+	}
+}
+
+// HashTreeRoot ssz hashes the VersionedExecutionPayload object.
+func (v *VersionedExecutionPayload) HashTreeRoot() ([32]byte, error) {
+	if v == nil {
+		return [32]byte{}, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return [32]byte{}, errors.Wrap(spec.ErrDataMissing, "no bellatrix payload")
+		}
+		return v.Bellatrix.HashTreeRoot()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return [32]byte{}, errors.Wrap(spec.ErrDataMissing, "no capella payload")
+		}
+		return v.Capella.HashTreeRoot()
+	default:
+		return [32]byte{}, errors.New("unsupported version")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedExecutionPayloadJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type api.versionedExecutionPayloadJSON",
+		},
+		{
+			name:  "VersionUnsupported",
+			input: []byte(`{"version":"phase0","data":{}}`),
+			err:   "unsupported version",
+		},
+		{
+			name:  "DataMissing",
+			input: []byte(`{"version":"bellatrix"}`),
+			err:   "data missing",
+		},
+		{
+			name:  "DataInvalid",
+			input: []byte(`{"version":"capella","data":{}}`),
+			err:   "invalid capella payload: parent hash missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.VersionedExecutionPayload
+			err := json.Unmarshal(test.input, &res)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestVersionedExecutionPayloadRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload *api.VersionedExecutionPayload
+	}{
+		{
+			name: "Bellatrix",
+			payload: &api.VersionedExecutionPayload{
+				Version: spec.DataVersionBellatrix,
+				Bellatrix: &bellatrix.ExecutionPayload{
+					ParentHash:    phase0.Hash32{0x01},
+					FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+					BlockNumber:   3,
+					ExtraData:     []byte{},
+					BlockHash:     phase0.Hash32{0x04},
+					Transactions:  []bellatrix.Transaction{{0x05, 0x06}},
+					BaseFeePerGas: [32]byte{0x0a},
+				},
+			},
+		},
+		{
+			name: "Capella",
+			payload: &api.VersionedExecutionPayload{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.ExecutionPayload{
+					ParentHash:    phase0.Hash32{0x01},
+					FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+					BlockNumber:   3,
+					ExtraData:     []byte{},
+					BlockHash:     phase0.Hash32{0x04},
+					Transactions:  []bellatrix.Transaction{{0x05, 0x06}},
+					Withdrawals:   []*capella.Withdrawal{{Index: 7, ValidatorIndex: 8, Amount: 9}},
+					BaseFeePerGas: [32]byte{0x0a},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.payload)
+			require.NoError(t, err)
+			var fromJSON api.VersionedExecutionPayload
+			require.NoError(t, json.Unmarshal(data, &fromJSON))
+			require.Equal(t, test.payload, &fromJSON)
+
+			data, err = test.payload.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, test.payload.SizeSSZ(), len(data))
+			fromSSZ := &api.VersionedExecutionPayload{Version: test.payload.Version}
+			require.NoError(t, fromSSZ.UnmarshalSSZ(data))
+			require.Equal(t, test.payload, fromSSZ)
+
+			root, err := test.payload.HashTreeRoot()
+			require.NoError(t, err)
+			fromSSZRoot, err := fromSSZ.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, root, fromSSZRoot)
+		})
+	}
+
+	_, err := (&api.VersionedExecutionPayload{Version: spec.DataVersionCapella}).MarshalSSZ()
+	require.EqualError(t, err, "no capella payload: data missing")
+}
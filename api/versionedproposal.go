@@ -0,0 +1,193 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedProposal contains a versioned proposal, which is either a full or a blinded block.
+type VersionedProposal struct {
+	Version spec.DataVersion
+	// ExecutionPayloadBlinded is true if the proposal contains a blinded block.
+	ExecutionPayloadBlinded bool
+	// ExecutionPayloadValue is the value of the execution payload to the proposer, in Wei.
+	ExecutionPayloadValue *big.Int
+	// ConsensusBlockValue is the consensus layer reward of the block to the proposer, in Wei.
+	ConsensusBlockValue *big.Int
+	Phase0              *phase0.BeaconBlock
+	Altair              *altair.BeaconBlock
+	Bellatrix           *bellatrix.BeaconBlock
+	BellatrixBlinded    *apiv1bellatrix.BlindedBeaconBlock
+	Capella             *capella.BeaconBlock
+	CapellaBlinded      *apiv1capella.BlindedBeaconBlock
+}
+
+// IsEmpty returns true if there is no proposal.
+func (v *VersionedProposal) IsEmpty() bool {
+	return v == nil || (v.Phase0 == nil &&
+		v.Altair == nil &&
+		v.Bellatrix == nil &&
+		v.BellatrixBlinded == nil &&
+		v.Capella == nil &&
+		v.CapellaBlinded == nil)
+}
+
+// Value returns the total value of the proposal to the proposer, in Wei.
+func (v *VersionedProposal) Value() *big.Int {
+	value := big.NewInt(0)
+	if v == nil {
+		return value
+	}
+	if v.ExecutionPayloadValue != nil {
+		value.Add(value, v.ExecutionPayloadValue)
+	}
+	if v.ConsensusBlockValue != nil {
+		value.Add(value, v.ConsensusBlockValue)
+	}
+
+	return value
+}
+
+// Slot returns the slot of the proposal.
+func (v *VersionedProposal) Slot() (phase0.Slot, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, fmt.Errorf("no phase0 block: %w", spec.ErrDataMissing)
+		}
+		return v.Phase0.Slot, nil
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			return 0, fmt.Errorf("no altair block: %w", spec.ErrDataMissing)
+		}
+		return v.Altair.Slot, nil
+	case spec.DataVersionBellatrix:
+		if v.ExecutionPayloadBlinded {
+			if v.BellatrixBlinded == nil {
+				return 0, fmt.Errorf("no bellatrix blinded block: %w", spec.ErrDataMissing)
+			}
+			return v.BellatrixBlinded.Slot, nil
+		}
+		if v.Bellatrix == nil {
+			return 0, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.Slot, nil
+	case spec.DataVersionCapella:
+		if v.ExecutionPayloadBlinded {
+			if v.CapellaBlinded == nil {
+				return 0, fmt.Errorf("no capella blinded block: %w", spec.ErrDataMissing)
+			}
+			return v.CapellaBlinded.Slot, nil
+		}
+		if v.Capella == nil {
+			return 0, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.Slot, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// Block returns the proposal as a versioned beacon block.
+// This returns an error if the proposal is blinded.
+func (v *VersionedProposal) Block() (*spec.VersionedBeaconBlock, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	if v.ExecutionPayloadBlinded {
+		return nil, errors.New("proposal is blinded")
+	}
+
+	return &spec.VersionedBeaconBlock{
+		Version:   v.Version,
+		Phase0:    v.Phase0,
+		Altair:    v.Altair,
+		Bellatrix: v.Bellatrix,
+		Capella:   v.Capella,
+	}, nil
+}
+
+// BlindedBlock returns the proposal as a versioned blinded beacon block.
+// This returns an error if the proposal is not blinded.
+func (v *VersionedProposal) BlindedBlock() (*VersionedBlindedBeaconBlock, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	if !v.ExecutionPayloadBlinded {
+		return nil, errors.New("proposal is not blinded")
+	}
+
+	return &VersionedBlindedBeaconBlock{
+		Version:   v.Version,
+		Bellatrix: v.BellatrixBlinded,
+		Capella:   v.CapellaBlinded,
+	}, nil
+}
+
+// String returns a string version of the structure.
+func (v *VersionedProposal) String() string {
+	if v == nil {
+		return ""
+	}
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil {
+			return ""
+		}
+		return v.Phase0.String()
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+		return v.Altair.String()
+	case spec.DataVersionBellatrix:
+		if v.ExecutionPayloadBlinded {
+			if v.BellatrixBlinded == nil {
+				return ""
+			}
+			return v.BellatrixBlinded.String()
+		}
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.ExecutionPayloadBlinded {
+			if v.CapellaBlinded == nil {
+				return ""
+			}
+			return v.CapellaBlinded.String()
+		}
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	default:
+		return "unsupported version"
+	}
+}
@@ -15,7 +15,9 @@ package api
 
 import (
 	"errors"
+	"fmt"
 
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -29,17 +31,62 @@ type VersionedSignedBlindedBeaconBlock struct {
 	Capella   *apiv1capella.SignedBlindedBeaconBlock
 }
 
+// Message returns the blinded beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) Message() (apiv1.BlindedBeaconBlock, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.Message, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.Message, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// Signature returns the signature of the blinded beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) Signature() (phase0.BLSSignature, error) {
+	if v == nil {
+		return phase0.BLSSignature{}, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.BLSSignature{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.Signature, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.BLSSignature{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.Signature, nil
+	default:
+		return phase0.BLSSignature{}, errors.New("unsupported version")
+	}
+}
+
 // Slot returns the slot of the signed beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
-			return 0, errors.New("no bellatrix block")
+			return 0, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Slot, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil || v.Capella.Message == nil {
-			return 0, errors.New("no capella block")
+			return 0, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.Slot, nil
 	default:
@@ -47,17 +94,41 @@ func (v *VersionedSignedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 	}
 }
 
+// ProposerIndex returns the proposer index of the signed beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return 0, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.Message.ProposerIndex, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return 0, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.Message.ProposerIndex, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
-			return nil, errors.New("no bellatrix block")
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.Attestations, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
-			return nil, errors.New("no capella block")
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.Body.Attestations, nil
 	default:
@@ -67,15 +138,18 @@ func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestatio
 
 // Root returns the root of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) Root() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.HashTreeRoot()
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.HashTreeRoot()
 	default:
@@ -85,15 +159,18 @@ func (v *VersionedSignedBlindedBeaconBlock) Root() (phase0.Root, error) {
 
 // BodyRoot returns the body root of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.HashTreeRoot()
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.Body.HashTreeRoot()
 	default:
@@ -103,15 +180,18 @@ func (v *VersionedSignedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 
 // ParentRoot returns the parent root of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.ParentRoot, nil
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.ParentRoot, nil
 	default:
@@ -121,15 +201,18 @@ func (v *VersionedSignedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 
 // StateRoot returns the state root of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.StateRoot, nil
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.StateRoot, nil
 	default:
@@ -139,15 +222,18 @@ func (v *VersionedSignedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 
 // AttesterSlashings returns the attester slashings of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return nil, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.AttesterSlashings, nil
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return nil, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.Body.AttesterSlashings, nil
 	default:
@@ -157,18 +243,75 @@ func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*phase0.Attes
 
 // ProposerSlashings returns the proposer slashings of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return nil, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.ProposerSlashings, nil
 	case spec.DataVersionCapella:
-		if v.Capella == nil {
-			return nil, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
 		}
 		return v.Capella.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
+
+// String returns a string version of the structure.
+func (v *VersionedSignedBlindedBeaconBlock) String() string {
+	if v == nil {
+		return ""
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	default:
+		return "unknown version"
+	}
+}
+
+// Validate checks that the structure holds complete data for its version, and no data for other versions.
+func (v *VersionedSignedBlindedBeaconBlock) Validate() error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		if v.Bellatrix.Message.Body.ExecutionPayloadHeader == nil {
+			return fmt.Errorf("no bellatrix execution payload header: %w", spec.ErrDataMissing)
+		}
+		if v.Capella != nil {
+			return errors.New("data present for multiple versions")
+		}
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		if v.Capella.Message.Body.ExecutionPayloadHeader == nil {
+			return fmt.Errorf("no capella execution payload header: %w", spec.ErrDataMissing)
+		}
+		if v.Bellatrix != nil {
+			return errors.New("data present for multiple versions")
+		}
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
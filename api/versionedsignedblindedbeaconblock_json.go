@@ -0,0 +1,92 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// versionedSignedBlindedBeaconBlockJSON is the spec representation of the struct.
+type versionedSignedBlindedBeaconBlockJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *VersionedSignedBlindedBeaconBlock) MarshalJSON() ([]byte, error) {
+	var data interface{}
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no bellatrix block")
+		}
+		data = v.Bellatrix
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.Wrap(spec.ErrDataMissing, "no capella block")
+		}
+		data = v.Capella
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&versionedSignedBlindedBeaconBlockJSON{
+		Version: v.Version,
+		Data:    rawData,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VersionedSignedBlindedBeaconBlock) UnmarshalJSON(input []byte) error {
+	var data versionedSignedBlindedBeaconBlockJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if len(data.Data) == 0 {
+		return errors.New("data missing")
+	}
+
+	switch data.Version {
+	case spec.DataVersionBellatrix:
+		block := &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		if err := json.Unmarshal(data.Data, block); err != nil {
+			return errors.Wrap(err, "invalid bellatrix block")
+		}
+		v.Bellatrix = block
+	case spec.DataVersionCapella:
+		block := &apiv1capella.SignedBlindedBeaconBlock{}
+		if err := json.Unmarshal(data.Data, block); err != nil {
+			return errors.Wrap(err, "invalid capella block")
+		}
+		v.Capella = block
+	default:
+		return errors.New("unsupported version")
+	}
+	v.Version = data.Version
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// The SSZ encoding of a versioned signed blinded beacon block is the SSZ encoding of
+// the block for its version; the version itself is not encoded, so must be set prior
+// to unmarshalling.
+
+// MarshalSSZ ssz marshals the VersionedSignedBlindedBeaconBlock object.
+func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.MarshalSSZ()
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// MarshalSSZTo ssz marshals the VersionedSignedBlindedBeaconBlock object to a target array.
+func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.MarshalSSZTo(buf)
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.MarshalSSZTo(buf)
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedSignedBlindedBeaconBlock object.
+// The version must be set prior to calling this function.
+func (v *VersionedSignedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		block := &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		if err := block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		v.Bellatrix = block
+	case spec.DataVersionCapella:
+		block := &apiv1capella.SignedBlindedBeaconBlock{}
+		if err := block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		v.Capella = block
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedSignedBlindedBeaconBlock object.
+func (v *VersionedSignedBlindedBeaconBlock) SizeSSZ() int {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0
+		}
+		return v.Bellatrix.SizeSSZ()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return 0
+		}
+		return v.Capella.SizeSSZ()
+	default:
+		return 0
+	}
+}
+
+// HashTreeRoot ssz hashes the VersionedSignedBlindedBeaconBlock object.
+func (v *VersionedSignedBlindedBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	if v == nil {
+		return [32]byte{}, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || !blindedBellatrixBodyHashable(v.Bellatrix.Message.Body) {
+			return [32]byte{}, fmt.Errorf("no bellatrix block: %w", spec.ErrDataMissing)
+		}
+		return v.Bellatrix.HashTreeRoot()
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || !blindedCapellaBodyHashable(v.Capella.Message.Body) {
+			return [32]byte{}, fmt.Errorf("no capella block: %w", spec.ErrDataMissing)
+		}
+		return v.Capella.HashTreeRoot()
+	default:
+		return [32]byte{}, errors.New("unsupported version")
+	}
+}
+
+// blindedBellatrixBodyHashable returns true if the body holds the data required to hash it.
+func blindedBellatrixBodyHashable(body *apiv1bellatrix.BlindedBeaconBlockBody) bool {
+	return body != nil && body.ETH1Data != nil && body.SyncAggregate != nil && body.ExecutionPayloadHeader != nil
+}
+
+// blindedCapellaBodyHashable returns true if the body holds the data required to hash it.
+func blindedCapellaBodyHashable(body *apiv1capella.BlindedBeaconBlockBody) bool {
+	return body != nil && body.ETH1Data != nil && body.SyncAggregate != nil && body.ExecutionPayloadHeader != nil
+}
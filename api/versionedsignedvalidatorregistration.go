@@ -15,6 +15,7 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -31,10 +32,13 @@ type VersionedSignedValidatorRegistration struct {
 
 // FeeRecipient returns the fee recipient of the signed validator registration.
 func (v *VersionedSignedValidatorRegistration) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	if v == nil {
+		return bellatrix.ExecutionAddress{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
-		if v.V1 == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no validator registration")
+		if v.V1 == nil || v.V1.Message == nil {
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Message.FeeRecipient, nil
 	default:
@@ -44,10 +48,13 @@ func (v *VersionedSignedValidatorRegistration) FeeRecipient() (bellatrix.Executi
 
 // GasLimit returns the gas limit of the signed validator registration.
 func (v *VersionedSignedValidatorRegistration) GasLimit() (uint64, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
-		if v.V1 == nil {
-			return 0, errors.New("no validator registration")
+		if v.V1 == nil || v.V1.Message == nil {
+			return 0, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Message.GasLimit, nil
 	default:
@@ -57,10 +64,13 @@ func (v *VersionedSignedValidatorRegistration) GasLimit() (uint64, error) {
 
 // Timestamp returns the timestamp of the signed validator registration.
 func (v *VersionedSignedValidatorRegistration) Timestamp() (time.Time, error) {
+	if v == nil {
+		return time.Time{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
-		if v.V1 == nil {
-			return time.Time{}, errors.New("no validator registration")
+		if v.V1 == nil || v.V1.Message == nil {
+			return time.Time{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Message.Timestamp, nil
 	default:
@@ -70,10 +80,13 @@ func (v *VersionedSignedValidatorRegistration) Timestamp() (time.Time, error) {
 
 // PubKey returns the public key of the signed validator registration.
 func (v *VersionedSignedValidatorRegistration) PubKey() (phase0.BLSPubKey, error) {
+	if v == nil {
+		return phase0.BLSPubKey{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
-		if v.V1 == nil {
-			return phase0.BLSPubKey{}, errors.New("no validator registration")
+		if v.V1 == nil || v.V1.Message == nil {
+			return phase0.BLSPubKey{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Message.Pubkey, nil
 	default:
@@ -83,13 +96,63 @@ func (v *VersionedSignedValidatorRegistration) PubKey() (phase0.BLSPubKey, error
 
 // Root returns the root of the validator registration
 func (v *VersionedSignedValidatorRegistration) Root() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
-		if v.V1 == nil {
-			return phase0.Root{}, errors.New("no V1 registration")
+		if v.V1 == nil || v.V1.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no V1 registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
 }
+
+// SigningRoot returns the root signed by the validator registration signature in the given domain.
+func (v *VersionedSignedValidatorRegistration) SigningRoot(domain phase0.Domain) (phase0.Root, error) {
+	root, err := v.Root()
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	signingData := &phase0.SigningData{
+		ObjectRoot: root,
+		Domain:     domain,
+	}
+
+	return signingData.HashTreeRoot()
+}
+
+// String returns a string version of the structure.
+func (v *VersionedSignedValidatorRegistration) String() string {
+	if v == nil {
+		return ""
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return ""
+		}
+		return v.V1.String()
+	default:
+		return "unknown version"
+	}
+}
+
+// Validate checks that the structure holds complete data for its version.
+func (v *VersionedSignedValidatorRegistration) Validate() error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil || v.V1.Message == nil {
+			return fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
+		}
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestVersionedSignedValidatorRegistrationSSZ(t *testing.T) {
+	input := []byte(`{"message":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"100","timestamp":"100","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`)
+	var registration apiv1.SignedValidatorRegistration
+	require.NoError(t, json.Unmarshal(input, &registration))
+
+	versioned := &api.VersionedSignedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1:      &registration,
+	}
+	data, err := versioned.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, 180, len(data))
+	require.Equal(t, versioned.SizeSSZ(), len(data))
+	root, err := versioned.HashTreeRoot()
+	require.NoError(t, err)
+
+	res := &api.VersionedSignedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+	}
+	require.NoError(t, res.UnmarshalSSZ(data))
+	resRoot, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, resRoot)
+	rt, err := json.Marshal(res.V1)
+	require.NoError(t, err)
+	require.Equal(t, string(input), string(rt))
+
+	// Unsigned registration.
+	unsigned := &api.VersionedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1:      registration.Message,
+	}
+	data, err = unsigned.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, 84, len(data))
+	unsignedRes := &api.VersionedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+	}
+	require.NoError(t, unsignedRes.UnmarshalSSZ(data))
+	rt, err = json.Marshal(unsignedRes.V1)
+	require.NoError(t, err)
+	expected, err := json.Marshal(registration.Message)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(rt))
+}
+
+func TestVersionedSignedValidatorRegistrationSigningRoot(t *testing.T) {
+	input := []byte(`{"message":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"100","timestamp":"100","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`)
+	var registration apiv1.SignedValidatorRegistration
+	require.NoError(t, json.Unmarshal(input, &registration))
+	domain := phase0.Domain{0x00, 0x00, 0x00, 0x01}
+
+	messageRoot, err := registration.Message.HashTreeRoot()
+	require.NoError(t, err)
+	expected, err := (&phase0.SigningData{ObjectRoot: messageRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+
+	signed := &api.VersionedSignedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1:      &registration,
+	}
+	signingRoot, err := signed.SigningRoot(domain)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), signingRoot)
+
+	unsigned := &api.VersionedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1:      registration.Message,
+	}
+	signingRoot, err = unsigned.SigningRoot(domain)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), signingRoot)
+
+	_, err = (&api.VersionedSignedValidatorRegistration{Version: spec.BuilderVersionV1}).SigningRoot(domain)
+	require.EqualError(t, err, "no V1 registration: data missing")
+}
+
+func TestVersionedSignedValidatorRegistrationUnsupported(t *testing.T) {
+	registration := &api.VersionedSignedValidatorRegistration{Version: 99}
+	_, err := registration.MarshalSSZ()
+	require.EqualError(t, err, "unsupported version")
+	require.EqualError(t, registration.UnmarshalSSZ([]byte{0x00}), "unsupported version")
+	_, err = registration.HashTreeRoot()
+	require.EqualError(t, err, "unsupported version")
+}
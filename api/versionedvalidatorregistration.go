@@ -15,6 +15,7 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -31,15 +32,18 @@ type VersionedValidatorRegistration struct {
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedValidatorRegistration) IsEmpty() bool {
-	return v.V1 == nil
+	return v == nil || v.V1 == nil
 }
 
 // FeeRecipient returns the fee recipient of the validator registration.
 func (v *VersionedValidatorRegistration) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	if v == nil {
+		return bellatrix.ExecutionAddress{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
 		if v.V1 == nil {
-			return bellatrix.ExecutionAddress{}, errors.New("no validator registration")
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.FeeRecipient, nil
 	default:
@@ -49,10 +53,13 @@ func (v *VersionedValidatorRegistration) FeeRecipient() (bellatrix.ExecutionAddr
 
 // GasLimit returns the gas limit of the validator registration.
 func (v *VersionedValidatorRegistration) GasLimit() (uint64, error) {
+	if v == nil {
+		return 0, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
 		if v.V1 == nil {
-			return 0, errors.New("no validator registration")
+			return 0, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.GasLimit, nil
 	default:
@@ -62,10 +69,13 @@ func (v *VersionedValidatorRegistration) GasLimit() (uint64, error) {
 
 // Timestamp returns the timestamp of the validator registration.
 func (v *VersionedValidatorRegistration) Timestamp() (time.Time, error) {
+	if v == nil {
+		return time.Time{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
 		if v.V1 == nil {
-			return time.Time{}, errors.New("no validator registration")
+			return time.Time{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Timestamp, nil
 	default:
@@ -75,10 +85,13 @@ func (v *VersionedValidatorRegistration) Timestamp() (time.Time, error) {
 
 // PubKey returns the public key of the validator registration.
 func (v *VersionedValidatorRegistration) PubKey() (phase0.BLSPubKey, error) {
+	if v == nil {
+		return phase0.BLSPubKey{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
 		if v.V1 == nil {
-			return phase0.BLSPubKey{}, errors.New("no validator registration")
+			return phase0.BLSPubKey{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.Pubkey, nil
 	default:
@@ -88,13 +101,63 @@ func (v *VersionedValidatorRegistration) PubKey() (phase0.BLSPubKey, error) {
 
 // Root returns the root of the validator registration.
 func (v *VersionedValidatorRegistration) Root() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, spec.ErrDataMissing
+	}
 	switch v.Version {
 	case spec.BuilderVersionV1:
 		if v.V1 == nil {
-			return phase0.Root{}, errors.New("no V1 registration")
+			return phase0.Root{}, fmt.Errorf("no V1 registration: %w", spec.ErrDataMissing)
 		}
 		return v.V1.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
 }
+
+// SigningRoot returns the root to sign for the validator registration in the given domain.
+func (v *VersionedValidatorRegistration) SigningRoot(domain phase0.Domain) (phase0.Root, error) {
+	root, err := v.Root()
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	signingData := &phase0.SigningData{
+		ObjectRoot: root,
+		Domain:     domain,
+	}
+
+	return signingData.HashTreeRoot()
+}
+
+// String returns a string version of the structure.
+func (v *VersionedValidatorRegistration) String() string {
+	if v == nil {
+		return ""
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return ""
+		}
+		return v.V1.String()
+	default:
+		return "unknown version"
+	}
+}
+
+// Validate checks that the structure holds complete data for its version.
+func (v *VersionedValidatorRegistration) Validate() error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
+		}
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
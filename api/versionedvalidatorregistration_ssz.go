@@ -0,0 +1,107 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// The SSZ encoding of a versioned validator registration is the SSZ encoding of the
+// registration for its version; the version itself is not encoded, so must be set
+// prior to unmarshalling.
+
+// MarshalSSZ ssz marshals the VersionedValidatorRegistration object.
+func (v *VersionedValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return nil, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
+		}
+		return v.V1.MarshalSSZ()
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// MarshalSSZTo ssz marshals the VersionedValidatorRegistration object to a target array.
+func (v *VersionedValidatorRegistration) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if v == nil {
+		return nil, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return nil, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
+		}
+		return v.V1.MarshalSSZTo(buf)
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedValidatorRegistration object.
+// The version must be set prior to calling this function.
+func (v *VersionedValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	if v == nil {
+		return spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		registration := &apiv1.ValidatorRegistration{}
+		if err := registration.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		v.V1 = registration
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedValidatorRegistration object.
+func (v *VersionedValidatorRegistration) SizeSSZ() int {
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return 0
+		}
+		return v.V1.SizeSSZ()
+	default:
+		return 0
+	}
+}
+
+// HashTreeRoot ssz hashes the VersionedValidatorRegistration object.
+func (v *VersionedValidatorRegistration) HashTreeRoot() ([32]byte, error) {
+	if v == nil {
+		return [32]byte{}, spec.ErrDataMissing
+	}
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return [32]byte{}, fmt.Errorf("no validator registration: %w", spec.ErrDataMissing)
+		}
+		return v.V1.HashTreeRoot()
+	default:
+		return [32]byte{}, errors.New("unsupported version")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestations
+
+import (
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bitfields"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// CommitteeBitsAttestation is an attestation in the form used from Electra onwards, in
+// which the committee index in the data is 0 and the committee bits select the committees
+// at the slot.  The aggregation bits are the concatenation of the bits for each selected
+// committee in increasing committee index order.
+type CommitteeBitsAttestation struct {
+	AggregationBits bitfield.Bitlist
+	Data            *phase0.AttestationData
+	Signature       phase0.BLSSignature
+	CommitteeBits   bitfield.Bitvector64
+}
+
+// ToCommitteeBits converts an attestation in the form used prior to Electra, with the
+// committee index in the data, to the committee bits form.
+// The signature is that of the supplied attestation, which signs data with the committee
+// index set, so does not verify against the converted attestation.
+func ToCommitteeBits(attestation *phase0.Attestation) (*CommitteeBitsAttestation, error) {
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+	committeeBits := bitfield.NewBitvector64()
+	if uint64(attestation.Data.Index) >= committeeBits.Len() {
+		return nil, fmt.Errorf("committee index %d too large for committee bits", attestation.Data.Index)
+	}
+	committeeBits.SetBitAt(uint64(attestation.Data.Index), true)
+
+	data := attestation.Data.Copy()
+	data.Index = 0
+
+	return &CommitteeBitsAttestation{
+		AggregationBits: copyBitlist(attestation.AggregationBits),
+		Data:            data,
+		Signature:       attestation.Signature,
+		CommitteeBits:   committeeBits,
+	}, nil
+}
+
+// FromCommitteeBits converts an attestation in the committee bits form to the form used
+// prior to Electra, with the committee index in the data.  This is only possible if the
+// attestation has a single committee bit set; to obtain views of attestations for multiple
+// committees use SplitByCommittee.
+// The signature is that of the supplied attestation, which signs data with a committee
+// index of 0, so does not verify against the converted attestation.
+func FromCommitteeBits(attestation *CommitteeBitsAttestation) (*phase0.Attestation, error) {
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+	committeeIndices := bitfields.Indices(attestation.CommitteeBits)
+	if len(committeeIndices) != 1 {
+		return nil, fmt.Errorf("attestation has %d committee bits set; expected 1", len(committeeIndices))
+	}
+
+	data := attestation.Data.Copy()
+	data.Index = phase0.CommitteeIndex(committeeIndices[0])
+
+	return &phase0.Attestation{
+		AggregationBits: copyBitlist(attestation.AggregationBits),
+		Data:            data,
+		Signature:       attestation.Signature,
+	}, nil
+}
+
+// SplitByCommittee splits an attestation in the committee bits form in to one attestation
+// per selected committee, in the form used prior to Electra, in increasing committee index
+// order.  The committees must include every selected committee for the attestation's slot,
+// as returned by BeaconCommittees().
+// The signature of each view is that of the supplied attestation, so the views are for
+// aggregation bookkeeping and cannot be verified or submitted individually.
+func SplitByCommittee(attestation *CommitteeBitsAttestation,
+	committees []*apiv1.BeaconCommittee,
+) (
+	[]*phase0.Attestation,
+	error,
+) {
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+	committeeIndices := bitfields.Indices(attestation.CommitteeBits)
+	if len(committeeIndices) == 0 {
+		return nil, errors.New("no committee bits set")
+	}
+
+	lengths := make([]uint64, len(committeeIndices))
+	total := uint64(0)
+	for i, committeeIndex := range committeeIndices {
+		committee := findCommittee(committees, attestation.Data.Slot, phase0.CommitteeIndex(committeeIndex))
+		if committee == nil {
+			return nil, fmt.Errorf("no committee %d for slot %d", committeeIndex, attestation.Data.Slot)
+		}
+		lengths[i] = uint64(len(committee.Validators))
+		total += lengths[i]
+	}
+	if attestation.AggregationBits.Len() != total {
+		return nil, fmt.Errorf("aggregation bits length %d does not match total committee size %d",
+			attestation.AggregationBits.Len(), total)
+	}
+
+	res := make([]*phase0.Attestation, len(committeeIndices))
+	offset := uint64(0)
+	for i, committeeIndex := range committeeIndices {
+		aggregationBits := bitfield.NewBitlist(lengths[i])
+		for j := uint64(0); j < lengths[i]; j++ {
+			if attestation.AggregationBits.BitAt(offset + j) {
+				aggregationBits.SetBitAt(j, true)
+			}
+		}
+		offset += lengths[i]
+
+		data := attestation.Data.Copy()
+		data.Index = phase0.CommitteeIndex(committeeIndex)
+		res[i] = &phase0.Attestation{
+			AggregationBits: aggregationBits,
+			Data:            data,
+			Signature:       attestation.Signature,
+		}
+	}
+
+	return res, nil
+}
+
+// copyBitlist returns a copy of the bitlist.
+func copyBitlist(b bitfield.Bitlist) bitfield.Bitlist {
+	if b == nil {
+		return nil
+	}
+	res := make(bitfield.Bitlist, len(b))
+	copy(res, b)
+
+	return res
+}
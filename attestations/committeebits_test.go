@@ -0,0 +1,140 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestations_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/attestations"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func attestationData(index phase0.CommitteeIndex) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            10,
+		Index:           index,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 1},
+		Target:          &phase0.Checkpoint{Epoch: 2},
+	}
+}
+
+func TestCommitteeBitsRoundTrip(t *testing.T) {
+	attestation := &phase0.Attestation{
+		AggregationBits: bitlist(3, 0, 2),
+		Data:            attestationData(1),
+		Signature:       phase0.BLSSignature{0x02},
+	}
+
+	converted, err := attestations.ToCommitteeBits(attestation)
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(0), converted.Data.Index)
+	require.Equal(t, committeeBits(1), converted.CommitteeBits)
+	require.Equal(t, attestation.AggregationBits, converted.AggregationBits)
+	require.Equal(t, attestation.Signature, converted.Signature)
+	// Original is untouched.
+	require.Equal(t, phase0.CommitteeIndex(1), attestation.Data.Index)
+
+	back, err := attestations.FromCommitteeBits(converted)
+	require.NoError(t, err)
+	require.Equal(t, attestation, back)
+
+	_, err = attestations.ToCommitteeBits(nil)
+	require.EqualError(t, err, "no attestation data supplied")
+	_, err = attestations.ToCommitteeBits(&phase0.Attestation{Data: attestationData(64)})
+	require.EqualError(t, err, "committee index 64 too large for committee bits")
+
+	_, err = attestations.FromCommitteeBits(nil)
+	require.EqualError(t, err, "no attestation data supplied")
+	_, err = attestations.FromCommitteeBits(&attestations.CommitteeBitsAttestation{
+		Data:          attestationData(0),
+		CommitteeBits: committeeBits(0, 2),
+	})
+	require.EqualError(t, err, "attestation has 2 committee bits set; expected 1")
+}
+
+func TestSplitByCommittee(t *testing.T) {
+	tests := []struct {
+		name        string
+		attestation *attestations.CommitteeBitsAttestation
+		expected    []*phase0.Attestation
+		err         string
+	}{
+		{
+			name: "Nil",
+			err:  "no attestation data supplied",
+		},
+		{
+			name: "NoCommitteeBits",
+			attestation: &attestations.CommitteeBitsAttestation{
+				AggregationBits: bitlist(4),
+				Data:            attestationData(0),
+				CommitteeBits:   committeeBits(),
+			},
+			err: "no committee bits set",
+		},
+		{
+			name: "CommitteeMissing",
+			attestation: &attestations.CommitteeBitsAttestation{
+				AggregationBits: bitlist(4),
+				Data:            attestationData(0),
+				CommitteeBits:   committeeBits(0, 5),
+			},
+			err: "no committee 5 for slot 10",
+		},
+		{
+			name: "LengthMismatch",
+			attestation: &attestations.CommitteeBitsAttestation{
+				AggregationBits: bitlist(4),
+				Data:            attestationData(0),
+				CommitteeBits:   committeeBits(0, 2),
+			},
+			err: "aggregation bits length 4 does not match total committee size 6",
+		},
+		{
+			name: "Good",
+			attestation: &attestations.CommitteeBitsAttestation{
+				AggregationBits: bitlist(6, 1, 3, 5),
+				Data:            attestationData(0),
+				Signature:       phase0.BLSSignature{0x03},
+				CommitteeBits:   committeeBits(0, 2),
+			},
+			expected: []*phase0.Attestation{
+				{
+					AggregationBits: bitlist(4, 1, 3),
+					Data:            attestationData(0),
+					Signature:       phase0.BLSSignature{0x03},
+				},
+				{
+					AggregationBits: bitlist(2, 1),
+					Data:            attestationData(2),
+					Signature:       phase0.BLSSignature{0x03},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := attestations.SplitByCommittee(test.attestation, testCommittees)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package attestations expands attestation aggregation bits to the indices of the
+// validators that participated, given the committee assignments for the slot.
+package attestations
+
+import (
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bitfields"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Participants returns the indices of the validators that participated in the
+// attestation, in committee order.  The committees must include the committee
+// for the attestation's slot and index, as returned by BeaconCommittees().
+func Participants(attestation *phase0.Attestation,
+	committees []*apiv1.BeaconCommittee,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+
+	committee := findCommittee(committees, attestation.Data.Slot, attestation.Data.Index)
+	if committee == nil {
+		return nil, fmt.Errorf("no committee %d for slot %d", attestation.Data.Index, attestation.Data.Slot)
+	}
+	if attestation.AggregationBits.Len() != uint64(len(committee.Validators)) {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committee size %d",
+			attestation.AggregationBits.Len(), len(committee.Validators))
+	}
+
+	participants := make([]phase0.ValidatorIndex, 0, attestation.AggregationBits.Count())
+	bitfields.ForEach(attestation.AggregationBits, func(index uint64) bool {
+		participants = append(participants, committee.Validators[index])
+		return true
+	})
+
+	return participants, nil
+}
+
+// ParticipantsWithCommitteeBits returns the indices of the validators that
+// participated in an attestation that aggregates across committees, as from
+// Electra onwards.  The committee bits select the committees at the slot, and the
+// aggregation bits are the concatenation of the bits for each selected committee
+// in increasing committee index order.
+func ParticipantsWithCommitteeBits(slot phase0.Slot,
+	aggregationBits bitfield.Bitlist,
+	committeeBits bitfield.Bitvector64,
+	committees []*apiv1.BeaconCommittee,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	committeeIndices := bitfields.Indices(committeeBits)
+	if len(committeeIndices) == 0 {
+		return nil, errors.New("no committee bits set")
+	}
+
+	validators := make([]phase0.ValidatorIndex, 0)
+	for _, committeeIndex := range committeeIndices {
+		committee := findCommittee(committees, slot, phase0.CommitteeIndex(committeeIndex))
+		if committee == nil {
+			return nil, fmt.Errorf("no committee %d for slot %d", committeeIndex, slot)
+		}
+		validators = append(validators, committee.Validators...)
+	}
+	if aggregationBits.Len() != uint64(len(validators)) {
+		return nil, fmt.Errorf("aggregation bits length %d does not match total committee size %d",
+			aggregationBits.Len(), len(validators))
+	}
+
+	participants := make([]phase0.ValidatorIndex, 0, aggregationBits.Count())
+	bitfields.ForEach(aggregationBits, func(index uint64) bool {
+		participants = append(participants, validators[index])
+		return true
+	})
+
+	return participants, nil
+}
+
+// ParticipatingDuties returns the attester duties whose validators participated in
+// the attestation.  Duties need only be supplied for the validators of interest;
+// duties for other slots or committees are ignored.
+func ParticipatingDuties(attestation *phase0.Attestation,
+	duties []*apiv1.AttesterDuty,
+) (
+	[]*apiv1.AttesterDuty,
+	error,
+) {
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+
+	participating := make([]*apiv1.AttesterDuty, 0)
+	for _, duty := range duties {
+		if duty.Slot != attestation.Data.Slot || duty.CommitteeIndex != attestation.Data.Index {
+			continue
+		}
+		if duty.CommitteeLength != attestation.AggregationBits.Len() {
+			return nil, fmt.Errorf("aggregation bits length %d does not match committee length %d of validator %d",
+				attestation.AggregationBits.Len(), duty.CommitteeLength, duty.ValidatorIndex)
+		}
+		if attestation.AggregationBits.BitAt(duty.ValidatorCommitteeIndex) {
+			participating = append(participating, duty)
+		}
+	}
+
+	return participating, nil
+}
+
+// ParticipatingDutiesWithCommitteeBits returns the attester duties whose validators
+// participated in an attestation that aggregates across committees, as from Electra
+// onwards.  As the position of each committee in the aggregation bits depends on
+// the lengths of the committees before it, the supplied duties must cover every
+// selected committee.
+func ParticipatingDutiesWithCommitteeBits(slot phase0.Slot,
+	aggregationBits bitfield.Bitlist,
+	committeeBits bitfield.Bitvector64,
+	duties []*apiv1.AttesterDuty,
+) (
+	[]*apiv1.AttesterDuty,
+	error,
+) {
+	committeeLengths := make(map[phase0.CommitteeIndex]uint64)
+	for _, duty := range duties {
+		if duty.Slot != slot {
+			continue
+		}
+		committeeLengths[duty.CommitteeIndex] = duty.CommitteeLength
+	}
+
+	committeeIndices := bitfields.Indices(committeeBits)
+	if len(committeeIndices) == 0 {
+		return nil, errors.New("no committee bits set")
+	}
+	offsets := make(map[phase0.CommitteeIndex]uint64, len(committeeIndices))
+	offset := uint64(0)
+	for _, committeeIndex := range committeeIndices {
+		committeeLength, exists := committeeLengths[phase0.CommitteeIndex(committeeIndex)]
+		if !exists {
+			return nil, fmt.Errorf("length of committee %d for slot %d unknown", committeeIndex, slot)
+		}
+		offsets[phase0.CommitteeIndex(committeeIndex)] = offset
+		offset += committeeLength
+	}
+	if aggregationBits.Len() != offset {
+		return nil, fmt.Errorf("aggregation bits length %d does not match total committee size %d",
+			aggregationBits.Len(), offset)
+	}
+
+	participating := make([]*apiv1.AttesterDuty, 0)
+	for _, duty := range duties {
+		if duty.Slot != slot {
+			continue
+		}
+		committeeOffset, selected := offsets[duty.CommitteeIndex]
+		if !selected {
+			continue
+		}
+		if aggregationBits.BitAt(committeeOffset + duty.ValidatorCommitteeIndex) {
+			participating = append(participating, duty)
+		}
+	}
+
+	return participating, nil
+}
+
+// findCommittee finds the committee with the given slot and index.
+func findCommittee(committees []*apiv1.BeaconCommittee,
+	slot phase0.Slot,
+	index phase0.CommitteeIndex,
+) *apiv1.BeaconCommittee {
+	for _, committee := range committees {
+		if committee != nil && committee.Slot == slot && committee.Index == index {
+			return committee
+		}
+	}
+
+	return nil
+}
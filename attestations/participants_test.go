@@ -0,0 +1,190 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestations_test
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/attestations"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func bitlist(length uint64, set ...uint64) bitfield.Bitlist {
+	b := bitfield.NewBitlist(length)
+	for _, index := range set {
+		b.SetBitAt(index, true)
+	}
+	return b
+}
+
+func committeeBits(set ...uint64) bitfield.Bitvector64 {
+	b := bitfield.NewBitvector64()
+	for _, index := range set {
+		b.SetBitAt(index, true)
+	}
+	return b
+}
+
+var testCommittees = []*apiv1.BeaconCommittee{
+	{Slot: 10, Index: 0, Validators: []phase0.ValidatorIndex{100, 101, 102, 103}},
+	{Slot: 10, Index: 1, Validators: []phase0.ValidatorIndex{200, 201, 202}},
+	{Slot: 10, Index: 2, Validators: []phase0.ValidatorIndex{300, 301}},
+}
+
+func TestParticipants(t *testing.T) {
+	tests := []struct {
+		name         string
+		attestation  *phase0.Attestation
+		participants []phase0.ValidatorIndex
+		err          string
+	}{
+		{
+			name: "Nil",
+			err:  "no attestation data supplied",
+		},
+		{
+			name: "CommitteeMissing",
+			attestation: &phase0.Attestation{
+				AggregationBits: bitlist(4, 0),
+				Data:            &phase0.AttestationData{Slot: 11, Index: 0},
+			},
+			err: "no committee 0 for slot 11",
+		},
+		{
+			name: "LengthMismatch",
+			attestation: &phase0.Attestation{
+				AggregationBits: bitlist(3, 0),
+				Data:            &phase0.AttestationData{Slot: 10, Index: 0},
+			},
+			err: "aggregation bits length 3 does not match committee size 4",
+		},
+		{
+			name: "Good",
+			attestation: &phase0.Attestation{
+				AggregationBits: bitlist(3, 0, 2),
+				Data:            &phase0.AttestationData{Slot: 10, Index: 1},
+			},
+			participants: []phase0.ValidatorIndex{200, 202},
+		},
+		{
+			name: "NoneSet",
+			attestation: &phase0.Attestation{
+				AggregationBits: bitlist(2),
+				Data:            &phase0.AttestationData{Slot: 10, Index: 2},
+			},
+			participants: []phase0.ValidatorIndex{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			participants, err := attestations.Participants(test.attestation, testCommittees)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.participants, participants)
+			}
+		})
+	}
+}
+
+func TestParticipantsWithCommitteeBits(t *testing.T) {
+	tests := []struct {
+		name            string
+		aggregationBits bitfield.Bitlist
+		committeeBits   bitfield.Bitvector64
+		participants    []phase0.ValidatorIndex
+		err             string
+	}{
+		{
+			name:            "NoCommitteeBits",
+			aggregationBits: bitlist(4, 0),
+			committeeBits:   committeeBits(),
+			err:             "no committee bits set",
+		},
+		{
+			name:            "CommitteeMissing",
+			aggregationBits: bitlist(4, 0),
+			committeeBits:   committeeBits(0, 3),
+			err:             "no committee 3 for slot 10",
+		},
+		{
+			name:            "LengthMismatch",
+			aggregationBits: bitlist(7, 0),
+			committeeBits:   committeeBits(0, 2),
+			err:             "aggregation bits length 7 does not match total committee size 6",
+		},
+		{
+			name:            "Good",
+			aggregationBits: bitlist(6, 1, 3, 4),
+			committeeBits:   committeeBits(0, 2),
+			participants:    []phase0.ValidatorIndex{101, 103, 300},
+		},
+		{
+			name:            "All",
+			aggregationBits: bitlist(9, 0, 1, 2, 3, 4, 5, 6, 7, 8),
+			committeeBits:   committeeBits(0, 1, 2),
+			participants:    []phase0.ValidatorIndex{100, 101, 102, 103, 200, 201, 202, 300, 301},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			participants, err := attestations.ParticipantsWithCommitteeBits(10, test.aggregationBits, test.committeeBits, testCommittees)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.participants, participants)
+			}
+		})
+	}
+}
+
+func TestParticipatingDuties(t *testing.T) {
+	duties := []*apiv1.AttesterDuty{
+		{Slot: 10, ValidatorIndex: 101, CommitteeIndex: 0, CommitteeLength: 4, ValidatorCommitteeIndex: 1},
+		{Slot: 10, ValidatorIndex: 102, CommitteeIndex: 0, CommitteeLength: 4, ValidatorCommitteeIndex: 2},
+		{Slot: 10, ValidatorIndex: 301, CommitteeIndex: 2, CommitteeLength: 2, ValidatorCommitteeIndex: 1},
+		{Slot: 11, ValidatorIndex: 500, CommitteeIndex: 0, CommitteeLength: 4, ValidatorCommitteeIndex: 1},
+	}
+
+	participating, err := attestations.ParticipatingDuties(&phase0.Attestation{
+		AggregationBits: bitlist(4, 1, 3),
+		Data:            &phase0.AttestationData{Slot: 10, Index: 0},
+	}, duties)
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.AttesterDuty{duties[0]}, participating)
+
+	_, err = attestations.ParticipatingDuties(&phase0.Attestation{
+		AggregationBits: bitlist(5, 1),
+		Data:            &phase0.AttestationData{Slot: 10, Index: 0},
+	}, duties)
+	require.EqualError(t, err, "aggregation bits length 5 does not match committee length 4 of validator 101")
+
+	// Committee bits, with committee 0 occupying bits 0-3 and committee 2 bits 4-5.
+	participating, err = attestations.ParticipatingDutiesWithCommitteeBits(10, bitlist(6, 2, 5), committeeBits(0, 2), duties)
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.AttesterDuty{duties[1], duties[2]}, participating)
+
+	_, err = attestations.ParticipatingDutiesWithCommitteeBits(10, bitlist(6, 2, 5), committeeBits(0, 1), duties)
+	require.EqualError(t, err, "length of committee 1 for slot 10 unknown")
+
+	_, err = attestations.ParticipatingDutiesWithCommitteeBits(10, bitlist(5, 2), committeeBits(0, 2), duties)
+	require.EqualError(t, err, "aggregation bits length 5 does not match total committee size 6")
+}
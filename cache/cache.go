@@ -0,0 +1,55 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a common cache abstraction for the caches held by clients,
+// along with a bounded in-memory implementation.  Callers that share state between
+// processes can supply their own implementation, for example one backed by Redis.
+package cache
+
+import (
+	"time"
+)
+
+// Cache is the interface for a cache.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value for the key, and true if it was present.
+	Get(key string) (interface{}, bool)
+	// Set sets the value for the key, using the cache's default time to live.
+	Set(key string, value interface{})
+	// SetWithTTL sets the value for the key, expiring it after the given duration.
+	// A duration of 0 means that the value does not expire.
+	SetWithTTL(key string, value interface{}, ttl time.Duration)
+	// Delete removes the value for the key.
+	Delete(key string)
+	// Purge removes all values.
+	Purge()
+	// Len returns the number of values held.
+	Len() int
+}
+
+// Metrics is the interface for cache metrics.
+type Metrics interface {
+	// Hit is called when a value is found in the cache.
+	Hit()
+	// Miss is called when a value is not found in the cache, or has expired.
+	Miss()
+	// Evict is called when a value is removed to make room for another.
+	Evict()
+}
+
+type nullMetrics struct{}
+
+func (nullMetrics) Hit()   {}
+func (nullMetrics) Miss()  {}
+func (nullMetrics) Evict() {}
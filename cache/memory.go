@@ -0,0 +1,151 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// memoryCache is an in-memory cache with least recently used eviction.
+type memoryCache struct {
+	ttl        time.Duration
+	maxEntries int
+	metrics    Metrics
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// lru holds the entries, most recently used first.
+	lru *list.List
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// New creates a new in-memory cache.
+func New(params ...Parameter) (Cache, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	return &memoryCache{
+		ttl:        parameters.ttl,
+		maxEntries: parameters.maxEntries,
+		metrics:    parameters.metrics,
+		now:        parameters.now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}, nil
+}
+
+// Get returns the value for the key, and true if it was present.
+func (c *memoryCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, exists := c.entries[key]
+	if !exists {
+		c.metrics.Miss()
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if !e.expires.IsZero() && !c.now().Before(e.expires) {
+		c.remove(element)
+		c.metrics.Miss()
+		return nil, false
+	}
+
+	c.lru.MoveToFront(element)
+	c.metrics.Hit()
+
+	return e.value, true
+}
+
+// Set sets the value for the key, using the cache's default time to live.
+func (c *memoryCache) Set(key string, value interface{}) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL sets the value for the key, expiring it after the given duration.
+// A duration of 0 means that the value does not expire.
+func (c *memoryCache) SetWithTTL(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+
+	if element, exists := c.entries[key]; exists {
+		e := element.Value.(*entry)
+		e.value = value
+		e.expires = expires
+		c.lru.MoveToFront(element)
+
+		return
+	}
+
+	if c.maxEntries > 0 && c.lru.Len() >= c.maxEntries {
+		c.remove(c.lru.Back())
+		c.metrics.Evict()
+	}
+	c.entries[key] = c.lru.PushFront(&entry{
+		key:     key,
+		value:   value,
+		expires: expires,
+	})
+}
+
+// Delete removes the value for the key.
+func (c *memoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, exists := c.entries[key]; exists {
+		c.remove(element)
+	}
+}
+
+// Purge removes all values.
+func (c *memoryCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// Len returns the number of values held.
+// Expired values that have yet to be accessed are included.
+func (c *memoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// remove removes an element; the caller must hold the lock.
+func (c *memoryCache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*entry).key)
+}
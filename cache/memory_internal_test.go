@@ -0,0 +1,140 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	hits      int
+	misses    int
+	evictions int
+}
+
+func (m *testMetrics) Hit()   { m.hits++ }
+func (m *testMetrics) Miss()  { m.misses++ }
+func (m *testMetrics) Evict() { m.evictions++ }
+
+func TestNewParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []Parameter
+		err    string
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name:   "TTLNegative",
+			params: []Parameter{WithTTL(-time.Second)},
+			err:    "problem with parameters: TTL cannot be negative",
+		},
+		{
+			name:   "MaxEntriesNegative",
+			params: []Parameter{WithMaxEntries(-1)},
+			err:    "problem with parameters: max entries cannot be negative",
+		},
+		{
+			name:   "MetricsNil",
+			params: []Parameter{WithMetrics(nil)},
+			err:    "problem with parameters: no metrics specified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMaxEntries(t *testing.T) {
+	metrics := &testMetrics{}
+	c, err := New(WithMaxEntries(2), WithMetrics(metrics))
+	require.NoError(t, err)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	// Use a, so that b is the least recently used.
+	_, found := c.Get("a")
+	require.True(t, found)
+	c.Set("c", 3)
+
+	require.Equal(t, 2, c.Len())
+	_, found = c.Get("b")
+	require.False(t, found)
+	value, found := c.Get("a")
+	require.True(t, found)
+	require.Equal(t, 1, value)
+	value, found = c.Get("c")
+	require.True(t, found)
+	require.Equal(t, 3, value)
+
+	require.Equal(t, 3, metrics.hits)
+	require.Equal(t, 1, metrics.misses)
+	require.Equal(t, 1, metrics.evictions)
+}
+
+func TestTTL(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	c, err := New(WithTTL(time.Minute), withNow(func() time.Time { return now }))
+	require.NoError(t, err)
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 0)
+	c.SetWithTTL("c", 3, time.Hour)
+
+	now = now.Add(time.Minute)
+	_, found := c.Get("a")
+	require.False(t, found)
+	_, found = c.Get("b")
+	require.True(t, found)
+	_, found = c.Get("c")
+	require.True(t, found)
+	require.Equal(t, 2, c.Len())
+
+	// Setting an existing key refreshes its expiry.
+	c.Set("c", 4)
+	now = now.Add(59 * time.Second)
+	value, found := c.Get("c")
+	require.True(t, found)
+	require.Equal(t, 4, value)
+}
+
+func TestDeleteAndPurge(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Delete("a")
+	c.Delete("missing")
+	_, found := c.Get("a")
+	require.False(t, found)
+	require.Equal(t, 1, c.Len())
+
+	c.Purge()
+	require.Equal(t, 0, c.Len())
+	_, found = c.Get("b")
+	require.False(t, found)
+}
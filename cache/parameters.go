@@ -0,0 +1,94 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	ttl        time.Duration
+	maxEntries int
+	metrics    Metrics
+	now        func() time.Time
+}
+
+// Parameter is the interface for cache parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithTTL sets the default time to live for values in the cache.
+// Defaults to 0, which means that values do not expire.
+func WithTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.ttl = ttl
+	})
+}
+
+// WithMaxEntries sets the maximum number of values held in the cache.  When the
+// cache is full the least recently used value is evicted.
+// Defaults to 0, which means that the number of values is unbounded.
+func WithMaxEntries(maxEntries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxEntries = maxEntries
+	})
+}
+
+// WithMetrics sets the metrics for the cache.
+func WithMetrics(metrics Metrics) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.metrics = metrics
+	})
+}
+
+// withNow sets the clock for the cache, for testing.
+func withNow(now func() time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.now = now
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		metrics: nullMetrics{},
+		now:     time.Now,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.ttl < 0 {
+		return nil, errors.New("TTL cannot be negative")
+	}
+	if parameters.maxEntries < 0 {
+		return nil, errors.New("max entries cannot be negative")
+	}
+	if parameters.metrics == nil {
+		return nil, errors.New("no metrics specified")
+	}
+
+	return &parameters, nil
+}
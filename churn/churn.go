@@ -0,0 +1,147 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package churn estimates the churn of validators entering and leaving the
+// active set, and the resultant activation and exit wait times.
+package churn
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// farFutureEpoch is the value of FAR_FUTURE_EPOCH, used for unset epochs.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// ValidatorChurnLimit returns the number of validators that can enter or leave the
+// active set each epoch, given the number of active validators.
+func ValidatorChurnLimit(config *Config, activeValidatorCount uint64) uint64 {
+	churnLimit := activeValidatorCount / config.ChurnLimitQuotient
+	if churnLimit < config.MinPerEpochChurnLimit {
+		return config.MinPerEpochChurnLimit
+	}
+
+	return churnLimit
+}
+
+// ActivationChurnLimit returns the number of validators that can enter the active
+// set each epoch, given the number of active validators.  From Deneb onwards this
+// is capped at MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT.
+func ActivationChurnLimit(config *Config, activeValidatorCount uint64) uint64 {
+	churnLimit := ValidatorChurnLimit(config, activeValidatorCount)
+	if config.MaxPerEpochActivationChurnLimit != 0 && churnLimit > config.MaxPerEpochActivationChurnLimit {
+		return config.MaxPerEpochActivationChurnLimit
+	}
+
+	return churnLimit
+}
+
+// BalanceChurnLimit returns the balance that can enter or leave the active set each
+// epoch from Electra onwards, given the total active balance.
+func BalanceChurnLimit(config *Config, totalActiveBalance phase0.Gwei) (phase0.Gwei, error) {
+	if config.MinPerEpochChurnLimitElectra == 0 {
+		return 0, errors.New("balance churn not available prior to electra")
+	}
+
+	churnLimit := totalActiveBalance / phase0.Gwei(config.ChurnLimitQuotient)
+	if churnLimit < config.MinPerEpochChurnLimitElectra {
+		churnLimit = config.MinPerEpochChurnLimitElectra
+	}
+
+	return churnLimit - churnLimit%config.EffectiveBalanceIncrement, nil
+}
+
+// ActivationExitChurnLimit returns the balance that can be activated or exited each
+// epoch from Electra onwards, given the total active balance.
+func ActivationExitChurnLimit(config *Config, totalActiveBalance phase0.Gwei) (phase0.Gwei, error) {
+	churnLimit, err := BalanceChurnLimit(config, totalActiveBalance)
+	if err != nil {
+		return 0, err
+	}
+	if config.MaxPerEpochActivationExitChurnLimit != 0 && churnLimit > config.MaxPerEpochActivationExitChurnLimit {
+		return config.MaxPerEpochActivationExitChurnLimit, nil
+	}
+
+	return churnLimit, nil
+}
+
+// ActivationExitEpoch returns the epoch at which an activation or exit triggered in
+// the given epoch takes effect.
+func ActivationExitEpoch(config *Config, epoch phase0.Epoch) phase0.Epoch {
+	return epoch + 1 + phase0.Epoch(config.MaxSeedLookahead)
+}
+
+// ExitQueueEpoch returns the exit epoch that a validator initiating its exit in the
+// current epoch would be assigned, given the validators in the state.
+func ExitQueueEpoch(config *Config, validators []*phase0.Validator, currentEpoch phase0.Epoch) phase0.Epoch {
+	exitQueueEpoch := ActivationExitEpoch(config, currentEpoch)
+	activeValidatorCount := uint64(0)
+	for _, validator := range validators {
+		if validator.ExitEpoch != farFutureEpoch && validator.ExitEpoch > exitQueueEpoch {
+			exitQueueEpoch = validator.ExitEpoch
+		}
+		if validator.ActivationEpoch <= currentEpoch && currentEpoch < validator.ExitEpoch {
+			activeValidatorCount++
+		}
+	}
+
+	exitQueueChurn := uint64(0)
+	for _, validator := range validators {
+		if validator.ExitEpoch == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+	if exitQueueChurn >= ValidatorChurnLimit(config, activeValidatorCount) {
+		exitQueueEpoch++
+	}
+
+	return exitQueueEpoch
+}
+
+// ActivationWait returns the estimated time until a validator at the given position
+// in the activation queue becomes active.  This assumes that the validator's
+// eligibility has already been finalized.
+func ActivationWait(config *Config, activeValidatorCount uint64, queuePosition uint64) time.Duration {
+	epochs := queuePosition/ActivationChurnLimit(config, activeValidatorCount) + 1 + config.MaxSeedLookahead
+
+	return epochsDuration(config, epochs)
+}
+
+// ExitWait returns the estimated time until a validator initiating its exit in the
+// current epoch leaves the active set, given the validators in the state.
+func ExitWait(config *Config, validators []*phase0.Validator, currentEpoch phase0.Epoch) time.Duration {
+	exitQueueEpoch := ExitQueueEpoch(config, validators, currentEpoch)
+
+	return epochsDuration(config, uint64(exitQueueEpoch-currentEpoch))
+}
+
+// BalanceWait returns the estimated time until a balance that is queued behind the
+// given queued balance is activated or exited, from Electra onwards.
+func BalanceWait(config *Config, totalActiveBalance phase0.Gwei, queuedBalance phase0.Gwei) (time.Duration, error) {
+	churnLimit, err := ActivationExitChurnLimit(config, totalActiveBalance)
+	if err != nil {
+		return 0, err
+	}
+	if churnLimit == 0 {
+		return 0, errors.New("activation exit churn limit is 0")
+	}
+	epochs := uint64(queuedBalance/churnLimit) + 1 + config.MaxSeedLookahead
+
+	return epochsDuration(config, epochs), nil
+}
+
+func epochsDuration(config *Config, epochs uint64) time.Duration {
+	return time.Duration(epochs*config.SlotsPerEpoch) * config.SlotDuration
+}
@@ -0,0 +1,152 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package churn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/churn"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *churn.Config {
+	return &churn.Config{
+		SlotDuration:              12 * time.Second,
+		SlotsPerEpoch:             32,
+		MaxSeedLookahead:          4,
+		MinPerEpochChurnLimit:     4,
+		ChurnLimitQuotient:        65536,
+		EffectiveBalanceIncrement: 1000000000,
+	}
+}
+
+func testElectraConfig() *churn.Config {
+	config := testConfig()
+	config.MaxPerEpochActivationChurnLimit = 8
+	config.MinPerEpochChurnLimitElectra = 128000000000
+	config.MaxPerEpochActivationExitChurnLimit = 256000000000
+
+	return config
+}
+
+func TestNewConfig(t *testing.T) {
+	spec := map[string]interface{}{
+		"SECONDS_PER_SLOT":            12 * time.Second,
+		"SLOTS_PER_EPOCH":             uint64(32),
+		"MAX_SEED_LOOKAHEAD":          uint64(4),
+		"MIN_PER_EPOCH_CHURN_LIMIT":   uint64(4),
+		"CHURN_LIMIT_QUOTIENT":        uint64(65536),
+		"EFFECTIVE_BALANCE_INCREMENT": uint64(1000000000),
+	}
+	config, err := churn.NewConfig(spec)
+	require.NoError(t, err)
+	require.Equal(t, testConfig(), config)
+
+	spec["MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"] = uint64(8)
+	spec["MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"] = uint64(128000000000)
+	spec["MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"] = uint64(256000000000)
+	config, err = churn.NewConfig(spec)
+	require.NoError(t, err)
+	require.Equal(t, testElectraConfig(), config)
+
+	spec["MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"] = "bad"
+	_, err = churn.NewConfig(spec)
+	require.EqualError(t, err, "MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA of unexpected type")
+
+	delete(spec, "CHURN_LIMIT_QUOTIENT")
+	_, err = churn.NewConfig(spec)
+	require.EqualError(t, err, "CHURN_LIMIT_QUOTIENT not found in spec")
+
+	spec["CHURN_LIMIT_QUOTIENT"] = uint64(0)
+	_, err = churn.NewConfig(spec)
+	require.EqualError(t, err, "CHURN_LIMIT_QUOTIENT must be greater than 0")
+
+	delete(spec, "SECONDS_PER_SLOT")
+	_, err = churn.NewConfig(spec)
+	require.EqualError(t, err, "SECONDS_PER_SLOT not found in spec")
+}
+
+func TestChurnLimits(t *testing.T) {
+	config := testConfig()
+	electraConfig := testElectraConfig()
+
+	// Below the minimum.
+	require.Equal(t, uint64(4), churn.ValidatorChurnLimit(config, 100000))
+	// Above the minimum.
+	require.Equal(t, uint64(15), churn.ValidatorChurnLimit(config, 1000000))
+	require.Equal(t, uint64(15), churn.ActivationChurnLimit(config, 1000000))
+	// Capped from Deneb onwards.
+	require.Equal(t, uint64(8), churn.ActivationChurnLimit(electraConfig, 1000000))
+
+	_, err := churn.BalanceChurnLimit(config, 0)
+	require.EqualError(t, err, "balance churn not available prior to electra")
+
+	// Below the minimum.
+	limit, err := churn.BalanceChurnLimit(electraConfig, 1000000000000000)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(128000000000), limit)
+	// Above the minimum, rounded down to the increment.
+	limit, err = churn.BalanceChurnLimit(electraConfig, 32000000000000000)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(488000000000), limit)
+	// Capped for activations and exits.
+	limit, err = churn.ActivationExitChurnLimit(electraConfig, 32000000000000000)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(256000000000), limit)
+}
+
+func TestExitQueueEpoch(t *testing.T) {
+	config := testConfig()
+
+	validators := make([]*phase0.Validator, 16)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			ExitEpoch: 0xffffffffffffffff,
+		}
+	}
+
+	// Empty queue.
+	require.Equal(t, phase0.Epoch(15), churn.ExitQueueEpoch(config, validators, 10))
+	require.Equal(t, 5*32*12*time.Second, churn.ExitWait(config, validators, 10))
+
+	// Queue with space in its last epoch.
+	validators[0].ExitEpoch = 20
+	validators[1].ExitEpoch = 20
+	require.Equal(t, phase0.Epoch(20), churn.ExitQueueEpoch(config, validators, 10))
+
+	// Queue with its last epoch full.
+	validators[2].ExitEpoch = 20
+	validators[3].ExitEpoch = 20
+	require.Equal(t, phase0.Epoch(21), churn.ExitQueueEpoch(config, validators, 10))
+}
+
+func TestActivationWait(t *testing.T) {
+	config := testConfig()
+
+	// First in the queue.
+	require.Equal(t, 5*32*12*time.Second, churn.ActivationWait(config, 1000000, 0))
+	// Behind two epochs of churn.
+	require.Equal(t, 7*32*12*time.Second, churn.ActivationWait(config, 1000000, 30))
+}
+
+func TestBalanceWait(t *testing.T) {
+	_, err := churn.BalanceWait(testConfig(), 32000000000000000, 0)
+	require.EqualError(t, err, "balance churn not available prior to electra")
+
+	wait, err := churn.BalanceWait(testElectraConfig(), 32000000000000000, 512000000000)
+	require.NoError(t, err)
+	require.Equal(t, 7*32*12*time.Second, wait)
+}
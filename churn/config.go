@@ -0,0 +1,111 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package churn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Config contains the spec values required to calculate churn.
+type Config struct {
+	SlotDuration              time.Duration
+	SlotsPerEpoch             uint64
+	MaxSeedLookahead          uint64
+	MinPerEpochChurnLimit     uint64
+	ChurnLimitQuotient        uint64
+	EffectiveBalanceIncrement phase0.Gwei
+	// MaxPerEpochActivationChurnLimit is only available from Deneb onwards, and is 0
+	// if not present in the spec.
+	MaxPerEpochActivationChurnLimit uint64
+	// The following values are only available from Electra onwards, and are 0 if not
+	// present in the spec.
+	MinPerEpochChurnLimitElectra        phase0.Gwei
+	MaxPerEpochActivationExitChurnLimit phase0.Gwei
+}
+
+// NewConfig creates a configuration from a spec, as returned by a SpecProvider.
+func NewConfig(spec map[string]interface{}) (*Config, error) {
+	config := &Config{}
+
+	tmp, exists := spec["SECONDS_PER_SLOT"]
+	if !exists {
+		return nil, errors.New("SECONDS_PER_SLOT not found in spec")
+	}
+	slotDuration, isDuration := tmp.(time.Duration)
+	if !isDuration {
+		return nil, errors.New("SECONDS_PER_SLOT of unexpected type")
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("SECONDS_PER_SLOT must be greater than 0")
+	}
+	config.SlotDuration = slotDuration
+
+	var effectiveBalanceIncrement uint64
+	uintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "SLOTS_PER_EPOCH", value: &config.SlotsPerEpoch},
+		{key: "MAX_SEED_LOOKAHEAD", value: &config.MaxSeedLookahead},
+		{key: "MIN_PER_EPOCH_CHURN_LIMIT", value: &config.MinPerEpochChurnLimit},
+		{key: "CHURN_LIMIT_QUOTIENT", value: &config.ChurnLimitQuotient},
+		{key: "EFFECTIVE_BALANCE_INCREMENT", value: &effectiveBalanceIncrement},
+	}
+	for _, uintValue := range uintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", uintValue.key)
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		if val == 0 && uintValue.key != "MAX_SEED_LOOKAHEAD" {
+			return nil, fmt.Errorf("%s must be greater than 0", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+	config.EffectiveBalanceIncrement = phase0.Gwei(effectiveBalanceIncrement)
+
+	// Later values are optional, as they are not present prior to their fork.
+	var minPerEpochChurnLimitElectra uint64
+	var maxPerEpochActivationExitChurnLimit uint64
+	optionalUintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT", value: &config.MaxPerEpochActivationChurnLimit},
+		{key: "MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA", value: &minPerEpochChurnLimitElectra},
+		{key: "MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT", value: &maxPerEpochActivationExitChurnLimit},
+	}
+	for _, uintValue := range optionalUintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			continue
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+	config.MinPerEpochChurnLimitElectra = phase0.Gwei(minPerEpochChurnLimitElectra)
+	config.MaxPerEpochActivationExitChurnLimit = phase0.Gwei(maxPerEpochActivationExitChurnLimit)
+
+	return config, nil
+}
@@ -0,0 +1,37 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MediaRange is a content type with its quality, as used in Accept headers.
+type MediaRange struct {
+	// ContentType is the content type, without parameters.
+	ContentType string
+	// Quality is the relative preference for the content type, between 0 and 1.
+	Quality float64
+}
+
+// FormatAccept formats media ranges as an Accept header.
+func FormatAccept(mediaRanges []*MediaRange) string {
+	items := make([]string, 0, len(mediaRanges))
+	for _, mediaRange := range mediaRanges {
+		items = append(items, mediaRange.ContentType+";q="+strconv.FormatFloat(mediaRange.Quality, 'f', -1, 64))
+	}
+
+	return strings.Join(items, ",")
+}
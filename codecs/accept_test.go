@@ -0,0 +1,29 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatAccept(t *testing.T) {
+	header := codecs.FormatAccept([]*codecs.MediaRange{
+		{ContentType: "application/octet-stream", Quality: 1},
+		{ContentType: "application/json", Quality: 0.9},
+	})
+	require.Equal(t, "application/octet-stream;q=1,application/json;q=0.9", header)
+}
@@ -0,0 +1,45 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codecs provides a registry of wire formats that can be used to encode and decode
+// spec types.  JSON and SSZ codecs are built in; additional codecs can be registered by
+// consumers that need alternative formats.
+package codecs
+
+// Codec encodes and decodes objects in a specific wire format.
+type Codec interface {
+	// Name returns the unique name of the codec.
+	Name() string
+	// ContentType returns the MIME content type of the wire format.
+	ContentType() string
+	// Supports returns true if the codec can encode and decode the given object.
+	Supports(v interface{}) bool
+	// Marshal encodes the object.
+	Marshal(v interface{}) ([]byte, error)
+	// Unmarshal decodes the data in to the object.
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	// JSON is the built-in JSON codec.
+	JSON Codec = &jsonCodec{}
+	// SSZ is the built-in SSZ codec.
+	SSZ Codec = &sszCodec{}
+	// LenientJSON is a JSON codec that also decodes the camelCase field names used by
+	// Prysm's gRPC gateway, for ingesting data from mixed sources.  Only field names are
+	// converted: the gateway also encodes byte values as base64 rather than hex, and as
+	// these cannot be told apart from other strings without the type of the field they
+	// are not converted, so such data fails to decode.  It shares its content type with
+	// JSON so is not in the default registry.
+	LenientJSON Codec = &lenientJSONCodec{}
+)
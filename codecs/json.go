@@ -0,0 +1,46 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"encoding/json"
+)
+
+// jsonCodec encodes and decodes objects using their JSON representation.
+type jsonCodec struct{}
+
+// Name returns the unique name of the codec.
+func (c *jsonCodec) Name() string {
+	return "json"
+}
+
+// ContentType returns the MIME content type of the wire format.
+func (c *jsonCodec) ContentType() string {
+	return "application/json"
+}
+
+// Supports returns true if the codec can encode and decode the given object.
+func (c *jsonCodec) Supports(v interface{}) bool {
+	return v != nil
+}
+
+// Marshal encodes the object.
+func (c *jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes the data in to the object.
+func (c *jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,131 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// lenientJSONCodec encodes objects using their JSON representation, and decodes objects
+// from JSON that uses either the standard snake_case field names or the camelCase field
+// names emitted by Prysm's gRPC gateway and related tooling.
+type lenientJSONCodec struct{}
+
+// Name returns the unique name of the codec.
+func (c *lenientJSONCodec) Name() string {
+	return "json-lenient"
+}
+
+// ContentType returns the MIME content type of the wire format.
+func (c *lenientJSONCodec) ContentType() string {
+	return "application/json"
+}
+
+// Supports returns true if the codec can encode and decode the given object.
+func (c *lenientJSONCodec) Supports(v interface{}) bool {
+	return v != nil
+}
+
+// Marshal encodes the object.  Output always uses the standard field names.
+func (c *lenientJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes the data in to the object, mapping camelCase field names to their
+// snake_case equivalents first.  Values must be in their standard encoding; in particular
+// base64 byte values are not converted to hex.
+func (c *lenientJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	normalised, err := json.Marshal(snakeCaseKeys(generic))
+	if err != nil {
+		return errors.Wrap(err, "failed to normalise JSON")
+	}
+
+	return json.Unmarshal(normalised, v)
+}
+
+// snakeCaseKeys returns the value with the keys of all objects within it converted to
+// snake_case.  Keys that are already snake_case take precedence over converted keys.
+func snakeCaseKeys(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(val))
+		for key, item := range val {
+			snakeKey := snakeCase(key)
+			if _, exists := val[snakeKey]; exists && snakeKey != key {
+				continue
+			}
+			res[snakeKey] = snakeCaseKeys(item)
+		}
+		return res
+	case []interface{}:
+		for i := range val {
+			val[i] = snakeCaseKeys(val[i])
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// snakeCase converts a camelCase name to snake_case, for example "signedHeader1" to
+// "signed_header_1".  Names already containing underscores are returned unchanged.
+func snakeCase(name string) string {
+	if strings.Contains(name, "_") {
+		return name
+	}
+
+	var builder strings.Builder
+	// word is the current word, used to keep the digit of names such as eth1_data
+	// attached to their word.
+	var word strings.Builder
+	prevLetter := false
+	for _, r := range name {
+		switch {
+		case unicode.IsUpper(r):
+			if builder.Len() > 0 {
+				builder.WriteRune('_')
+			}
+			word.Reset()
+			builder.WriteRune(unicode.ToLower(r))
+			word.WriteRune(unicode.ToLower(r))
+			prevLetter = true
+		case unicode.IsDigit(r):
+			if prevLetter && word.String() != "eth" {
+				builder.WriteRune('_')
+				word.Reset()
+			}
+			builder.WriteRune(r)
+			word.WriteRune(r)
+			prevLetter = false
+		default:
+			builder.WriteRune(r)
+			word.WriteRune(r)
+			prevLetter = true
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,42 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"slot":                    "slot",
+		"parentRoot":              "parent_root",
+		"parent_root":             "parent_root",
+		"signedHeader1":           "signed_header_1",
+		"attestation2":            "attestation_2",
+		"eth1Data":                "eth1_data",
+		"eth1DepositIndex":        "eth1_deposit_index",
+		"depth1":                  "depth_1",
+		"blsToExecutionChanges":   "bls_to_execution_changes",
+		"ExecutionPayloadHeader":  "execution_payload_header",
+		"previousJustifiedEpoch2": "previous_justified_epoch_2",
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			require.Equal(t, expected, snakeCase(input))
+		})
+	}
+}
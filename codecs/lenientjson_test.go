@@ -0,0 +1,77 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLenientJSON(t *testing.T) {
+	standard := []byte(`{"signed_header_1":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","state_root":"0x0202020202020202020202020202020202020202020202020202020202020202","body_root":"0x0303030303030303030303030303030303030303030303030303030303030303"},"signature":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404"},"signed_header_2":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","state_root":"0x0202020202020202020202020202020202020202020202020202020202020202","body_root":"0x0505050505050505050505050505050505050505050505050505050505050505"},"signature":"0x060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606"}}`)
+	prysm := []byte(`{"signedHeader1":{"message":{"slot":"1","proposerIndex":"2","parentRoot":"0x0101010101010101010101010101010101010101010101010101010101010101","stateRoot":"0x0202020202020202020202020202020202020202020202020202020202020202","bodyRoot":"0x0303030303030303030303030303030303030303030303030303030303030303"},"signature":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404"},"signedHeader2":{"message":{"slot":"1","proposerIndex":"2","parentRoot":"0x0101010101010101010101010101010101010101010101010101010101010101","stateRoot":"0x0202020202020202020202020202020202020202020202020202020202020202","bodyRoot":"0x0505050505050505050505050505050505050505050505050505050505050505"},"signature":"0x060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606"}}`)
+
+	var expected phase0.ProposerSlashing
+	require.NoError(t, json.Unmarshal(standard, &expected))
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Standard",
+			input: standard,
+		},
+		{
+			name:  "Prysm",
+			input: prysm,
+		},
+		{
+			name:  "Invalid",
+			input: []byte(`{`),
+			err:   "invalid JSON: unexpected EOF",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res phase0.ProposerSlashing
+			err := codecs.LenientJSON.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expected, res)
+				data, err := codecs.LenientJSON.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(standard), string(data))
+			}
+		})
+	}
+
+	// Standard names take precedence when both are present.
+	var eth1Data phase0.ETH1Data
+	require.NoError(t, codecs.LenientJSON.Unmarshal([]byte(`{"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","depositRoot":"0x0202020202020202020202020202020202020202020202020202020202020202","depositCount":"3","blockHash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`), &eth1Data))
+	require.Equal(t, phase0.Root{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}, eth1Data.DepositRoot)
+	require.Equal(t, uint64(3), eth1Data.DepositCount)
+
+	// Byte values encoded as base64 are not converted.
+	err := codecs.LenientJSON.Unmarshal([]byte(`{"depositRoot":"AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=","depositCount":"3","blockHash":"AwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwM="}`), &eth1Data)
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Registry holds a set of codecs, indexed by name and content type.
+type Registry struct {
+	mutex  sync.RWMutex
+	codecs map[string]Codec
+}
+
+// defaultRegistry is the registry used by the package-level functions.
+var defaultRegistry = NewRegistry()
+
+// NewRegistry creates a new registry containing the built-in codecs.
+func NewRegistry() *Registry {
+	return &Registry{
+		codecs: map[string]Codec{
+			JSON.Name(): JSON,
+			SSZ.Name():  SSZ,
+		},
+	}
+}
+
+// Register adds a codec to the registry.
+func (r *Registry) Register(codec Codec) error {
+	if codec == nil {
+		return errors.New("no codec supplied")
+	}
+	if codec.Name() == "" {
+		return errors.New("codec has no name")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, exists := r.codecs[codec.Name()]; exists {
+		return fmt.Errorf("codec %s already registered", codec.Name())
+	}
+	for _, existing := range r.codecs {
+		if existing.ContentType() == codec.ContentType() {
+			return fmt.Errorf("content type %s already registered by codec %s", codec.ContentType(), existing.Name())
+		}
+	}
+	r.codecs[codec.Name()] = codec
+
+	return nil
+}
+
+// Codec returns the codec with the given name.
+func (r *Registry) Codec(name string) (Codec, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	codec, exists := r.codecs[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown codec %s", name)
+	}
+
+	return codec, nil
+}
+
+// ForContentType returns the codec for the given content type.
+func (r *Registry) ForContentType(contentType string) (Codec, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, codec := range r.codecs {
+		if codec.ContentType() == contentType {
+			return codec, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no codec for content type %s", contentType)
+}
+
+// Names returns the names of all registered codecs, in alphabetical order.
+func (r *Registry) Names() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.codecs))
+	for name := range r.codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Register adds a codec to the default registry.
+func Register(codec Codec) error {
+	return defaultRegistry.Register(codec)
+}
+
+// Get returns the codec with the given name from the default registry.
+func Get(name string) (Codec, error) {
+	return defaultRegistry.Codec(name)
+}
+
+// ForContentType returns the codec for the given content type from the default registry.
+func ForContentType(contentType string) (Codec, error) {
+	return defaultRegistry.ForContentType(contentType)
+}
+
+// Names returns the names of all codecs in the default registry.
+func Names() []string {
+	return defaultRegistry.Names()
+}
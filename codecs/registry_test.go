@@ -0,0 +1,92 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testCodec is a codec for testing.
+type testCodec struct {
+	name        string
+	contentType string
+}
+
+func (c *testCodec) Name() string                               { return c.name }
+func (c *testCodec) ContentType() string                        { return c.contentType }
+func (c *testCodec) Supports(_ interface{}) bool                { return true }
+func (c *testCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (c *testCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+
+func TestRegistry(t *testing.T) {
+	registry := codecs.NewRegistry()
+	require.Equal(t, []string{"json", "ssz"}, registry.Names())
+
+	codec, err := registry.Codec("ssz")
+	require.NoError(t, err)
+	require.Equal(t, codecs.SSZ, codec)
+
+	codec, err = registry.ForContentType("application/json")
+	require.NoError(t, err)
+	require.Equal(t, codecs.JSON, codec)
+
+	_, err = registry.Codec("cbor")
+	require.EqualError(t, err, "unknown codec cbor")
+	_, err = registry.ForContentType("application/cbor")
+	require.EqualError(t, err, "no codec for content type application/cbor")
+
+	require.EqualError(t, registry.Register(nil), "no codec supplied")
+	require.EqualError(t, registry.Register(&testCodec{contentType: "application/cbor"}), "codec has no name")
+	require.EqualError(t, registry.Register(&testCodec{name: "json", contentType: "application/cbor"}), "codec json already registered")
+	require.EqualError(t, registry.Register(&testCodec{name: "other", contentType: "application/json"}), "content type application/json already registered by codec json")
+
+	cbor := &testCodec{name: "cbor", contentType: "application/cbor"}
+	require.NoError(t, registry.Register(cbor))
+	require.Equal(t, []string{"cbor", "json", "ssz"}, registry.Names())
+	codec, err = registry.ForContentType("application/cbor")
+	require.NoError(t, err)
+	require.Equal(t, cbor, codec)
+
+	// Default registry is unaffected.
+	_, err = codecs.Get("cbor")
+	require.EqualError(t, err, "unknown codec cbor")
+}
+
+func TestBuiltinCodecs(t *testing.T) {
+	checkpoint := &phase0.Checkpoint{
+		Epoch: 12345,
+		Root:  phase0.Root{0x01, 0x02, 0x03},
+	}
+
+	for _, codec := range []codecs.Codec{codecs.JSON, codecs.SSZ} {
+		t.Run(codec.Name(), func(t *testing.T) {
+			require.True(t, codec.Supports(checkpoint))
+			data, err := codec.Marshal(checkpoint)
+			require.NoError(t, err)
+			res := &phase0.Checkpoint{}
+			require.NoError(t, codec.Unmarshal(data, res))
+			require.Equal(t, checkpoint, res)
+		})
+	}
+
+	require.False(t, codecs.SSZ.Supports("string"))
+	_, err := codecs.SSZ.Marshal("string")
+	require.EqualError(t, err, "type string does not support SSZ encoding")
+	require.EqualError(t, codecs.SSZ.Unmarshal(nil, "string"), "type string does not support SSZ decoding")
+}
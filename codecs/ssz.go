@@ -0,0 +1,58 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// sszCodec encodes and decodes objects using their SSZ representation.
+type sszCodec struct{}
+
+// Name returns the unique name of the codec.
+func (c *sszCodec) Name() string {
+	return "ssz"
+}
+
+// ContentType returns the MIME content type of the wire format.
+func (c *sszCodec) ContentType() string {
+	return "application/octet-stream"
+}
+
+// Supports returns true if the codec can encode and decode the given object.
+func (c *sszCodec) Supports(v interface{}) bool {
+	_, isMarshaler := v.(ssz.Marshaler)
+	_, isUnmarshaler := v.(ssz.Unmarshaler)
+	return isMarshaler && isUnmarshaler
+}
+
+// Marshal encodes the object.
+func (c *sszCodec) Marshal(v interface{}) ([]byte, error) {
+	marshaler, isMarshaler := v.(ssz.Marshaler)
+	if !isMarshaler {
+		return nil, fmt.Errorf("type %T does not support SSZ encoding", v)
+	}
+	return marshaler.MarshalSSZ()
+}
+
+// Unmarshal decodes the data in to the object.
+func (c *sszCodec) Unmarshal(data []byte, v interface{}) error {
+	unmarshaler, isUnmarshaler := v.(ssz.Unmarshaler)
+	if !isUnmarshaler {
+		return fmt.Errorf("type %T does not support SSZ decoding", v)
+	}
+	return unmarshaler.UnmarshalSSZ(data)
+}
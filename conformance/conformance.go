@@ -0,0 +1,467 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance checks that a beacon node's responses decode into this package's types.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// errUnsupported is returned by checks when the client does not support the call.
+var errUnsupported = errors.New("call not supported by client")
+
+// check is a single conformance check.
+type check struct {
+	name string
+	fork string
+	run  func(ctx context.Context) error
+}
+
+// Run runs the conformance checks against a beacon node and returns the report.
+// An error is only returned if the checks could not be run; individual failures
+// are recorded in the report.
+func Run(ctx context.Context, params ...Parameter) (*Report, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "conformance").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	client := parameters.client
+	if client == nil {
+		client, err = http.New(ctx,
+			http.WithLogLevel(parameters.logLevel),
+			http.WithAddress(parameters.address),
+			http.WithTimeout(parameters.timeout),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to connect to beacon node")
+		}
+	}
+
+	report := &Report{
+		Client:  client.Name(),
+		Address: client.Address(),
+		Started: time.Now(),
+		Results: make([]*Result, 0),
+	}
+	if provider, isProvider := client.(consensusclient.NodeVersionProvider); isProvider {
+		if nodeVersion, err := provider.NodeVersion(ctx); err == nil {
+			report.NodeVersion = nodeVersion
+		}
+	}
+
+	checks := staticChecks(client, parameters)
+	checks = append(checks, forkChecks(ctx, client)...)
+	for _, check := range checks {
+		started := time.Now()
+		err := check.run(ctx)
+		result := &Result{
+			Name:     check.name,
+			Fork:     check.fork,
+			Status:   StatusPass,
+			Duration: time.Since(started),
+		}
+		switch {
+		case errors.Is(err, errUnsupported):
+			result.Status = StatusSkip
+			result.Error = err.Error()
+		case err != nil:
+			result.Status = StatusFail
+			result.Error = err.Error()
+		}
+		log.Trace().Str("check", check.name).Str("fork", check.fork).Str("status", string(result.Status)).Err(err).Msg("Check complete")
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// staticChecks returns the checks that do not depend on the fork schedule.
+func staticChecks(client consensusclient.Service, parameters *parameters) []*check {
+	checks := []*check{
+		{
+			name: "Genesis",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.GenesisProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				genesis, err := provider.Genesis(ctx)
+				if err != nil {
+					return err
+				}
+				if genesis == nil {
+					return errors.New("no genesis returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "Spec",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.SpecProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				spec, err := provider.Spec(ctx)
+				if err != nil {
+					return err
+				}
+				if _, exists := spec["SLOTS_PER_EPOCH"]; !exists {
+					return errors.New("SLOTS_PER_EPOCH missing from spec")
+				}
+				return nil
+			},
+		},
+		{
+			name: "DepositContract",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.DepositContractProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				depositContract, err := provider.DepositContract(ctx)
+				if err != nil {
+					return err
+				}
+				if depositContract == nil {
+					return errors.New("no deposit contract returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "ForkSchedule",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.ForkScheduleProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				forkSchedule, err := provider.ForkSchedule(ctx)
+				if err != nil {
+					return err
+				}
+				if len(forkSchedule) == 0 {
+					return errors.New("empty fork schedule returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "NodeSyncing",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.NodeSyncingProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				syncState, err := provider.NodeSyncing(ctx)
+				if err != nil {
+					return err
+				}
+				if syncState == nil {
+					return errors.New("no sync state returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "Fork",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.ForkProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				fork, err := provider.Fork(ctx, "head")
+				if err != nil {
+					return err
+				}
+				if fork == nil {
+					return errors.New("no fork returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "Finality",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.FinalityProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				finality, err := provider.Finality(ctx, "head")
+				if err != nil {
+					return err
+				}
+				if finality == nil {
+					return errors.New("no finality returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "BeaconBlockHeader",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.BeaconBlockHeadersProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				header, err := provider.BeaconBlockHeader(ctx, "head")
+				if err != nil {
+					return err
+				}
+				if header == nil || header.Header == nil || header.Header.Message == nil {
+					return errors.New("no header returned")
+				}
+				root, err := header.Header.Message.HashTreeRoot()
+				if err != nil {
+					return errors.Wrap(err, "failed to calculate header root")
+				}
+				if root != header.Root {
+					return fmt.Errorf("header root %#x does not match calculated root %#x", header.Root, root)
+				}
+				return nil
+			},
+		},
+		{
+			name: "BeaconStateRoot",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.BeaconStateRootProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				root, err := provider.BeaconStateRoot(ctx, "head")
+				if err != nil {
+					return err
+				}
+				if root == nil {
+					return errors.New("no state root returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "BeaconCommittees",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.BeaconCommitteesProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				committees, err := provider.BeaconCommittees(ctx, "head")
+				if err != nil {
+					return err
+				}
+				if len(committees) == 0 {
+					return errors.New("no committees returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "ValidatorBalances",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.ValidatorBalancesProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				balances, err := provider.ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{0})
+				if err != nil {
+					return err
+				}
+				if len(balances) == 0 {
+					return errors.New("no balances returned")
+				}
+				return nil
+			},
+		},
+		{
+			name: "Validators",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.ValidatorsProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				validators, err := provider.Validators(ctx, "head", []phase0.ValidatorIndex{0})
+				if err != nil {
+					return err
+				}
+				if len(validators) == 0 {
+					return errors.New("no validators returned")
+				}
+				return nil
+			},
+		},
+	}
+
+	if parameters.includeState {
+		checks = append(checks, &check{
+			name: "BeaconState",
+			run: func(ctx context.Context) error {
+				provider, isProvider := client.(consensusclient.BeaconStateProvider)
+				if !isProvider {
+					return errUnsupported
+				}
+				state, err := provider.BeaconState(ctx, "head")
+				if err != nil {
+					return err
+				}
+				if state == nil {
+					return errors.New("no state returned")
+				}
+				return nil
+			},
+		})
+	}
+
+	return checks
+}
+
+// forkChecks returns the checks that are run for each fork that has been reached by the chain.
+func forkChecks(ctx context.Context, client consensusclient.Service) []*check {
+	checks := make([]*check, 0)
+	for _, fork := range reachedForks(ctx, client) {
+		version := fork.version
+		slot := fork.slot
+		checks = append(checks, &check{
+			name: "SignedBeaconBlock",
+			fork: version.String(),
+			run: func(ctx context.Context) error {
+				return checkSignedBeaconBlock(ctx, client, version, slot)
+			},
+		})
+	}
+
+	return checks
+}
+
+// checkSignedBeaconBlock checks the block at or shortly after the given slot decodes, has the expected
+// version, and hashes to the root provided by the node.
+func checkSignedBeaconBlock(ctx context.Context, client consensusclient.Service, version spec.DataVersion, slot phase0.Slot) error {
+	blockProvider, isProvider := client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return errUnsupported
+	}
+	rootProvider, isProvider := client.(consensusclient.BeaconBlockRootProvider)
+	if !isProvider {
+		return errUnsupported
+	}
+
+	// The fork slot itself may be empty, so try a few subsequent slots.
+	for i := phase0.Slot(0); i < 32; i++ {
+		blockID := fmt.Sprintf("%d", slot+i)
+		block, err := blockProvider.SignedBeaconBlock(ctx, blockID)
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			continue
+		}
+		if block.Version != version {
+			return fmt.Errorf("block at slot %d has version %s, expected %s", slot+i, block.Version, version)
+		}
+		root, err := block.Root()
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate block root")
+		}
+		expectedRoot, err := rootProvider.BeaconBlockRoot(ctx, blockID)
+		if err != nil {
+			return err
+		}
+		if expectedRoot == nil {
+			return fmt.Errorf("no root returned for block at slot %d", slot+i)
+		}
+		if root != *expectedRoot {
+			return fmt.Errorf("block root %#x does not match calculated root %#x", *expectedRoot, root)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no block found near slot %d", slot)
+}
+
+// reachedFork is a fork that has been reached by the chain, along with its first slot.
+type reachedFork struct {
+	version spec.DataVersion
+	slot    phase0.Slot
+}
+
+// reachedForks returns the forks that have been reached by the chain, according to its spec.
+func reachedForks(ctx context.Context, client consensusclient.Service) []*reachedFork {
+	forks := []*reachedFork{
+		{version: spec.DataVersionPhase0},
+	}
+
+	specProvider, isProvider := client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return forks
+	}
+	chainSpec, err := specProvider.Spec(ctx)
+	if err != nil {
+		return forks
+	}
+	slotsPerEpoch, isUint := chainSpec["SLOTS_PER_EPOCH"].(uint64)
+	if !isUint {
+		return forks
+	}
+
+	headSlot := phase0.Slot(0)
+	if syncingProvider, isProvider := client.(consensusclient.NodeSyncingProvider); isProvider {
+		if syncState, err := syncingProvider.NodeSyncing(ctx); err == nil && syncState != nil {
+			headSlot = syncState.HeadSlot
+		}
+	}
+
+	for _, fork := range []struct {
+		key     string
+		version spec.DataVersion
+	}{
+		{key: "ALTAIR_FORK_EPOCH", version: spec.DataVersionAltair},
+		{key: "BELLATRIX_FORK_EPOCH", version: spec.DataVersionBellatrix},
+		{key: "CAPELLA_FORK_EPOCH", version: spec.DataVersionCapella},
+	} {
+		epoch, isUint := chainSpec[fork.key].(uint64)
+		if !isUint {
+			continue
+		}
+		if epoch > uint64(headSlot)/slotsPerEpoch {
+			// Fork not yet reached.
+			continue
+		}
+		slot := phase0.Slot(epoch * slotsPerEpoch)
+		if epoch == 0 {
+			// Fork at genesis supersedes the previous version.
+			forks = forks[:len(forks)-1]
+		}
+		forks = append(forks, &reachedFork{
+			version: fork.version,
+			slot:    slot,
+		})
+	}
+
+	return forks
+}
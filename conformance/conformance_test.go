@@ -0,0 +1,83 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/conformance"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []conformance.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []conformance.Parameter{
+				conformance.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no client or address specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []conformance.Parameter{
+				conformance.WithLogLevel(zerolog.Disabled),
+				conformance.WithClient(mockClient),
+				conformance.WithTimeout(0),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "Good",
+			params: []conformance.Parameter{
+				conformance.WithLogLevel(zerolog.Disabled),
+				conformance.WithClient(mockClient),
+				conformance.WithBeaconState(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report, err := conformance.Run(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, report.Results)
+			require.Equal(t, len(report.Results), report.Passed()+report.Failed()+report.Skipped())
+
+			// Ensure the report is machine-readable.
+			data, err := json.Marshal(report)
+			require.NoError(t, err)
+			var unmarshalled map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &unmarshalled))
+			require.Contains(t, unmarshalled, "results")
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Status is the status of an individual check.
+type Status string
+
+const (
+	// StatusPass means the check passed.
+	StatusPass Status = "pass"
+	// StatusFail means the check failed.
+	StatusFail Status = "fail"
+	// StatusSkip means the check was not run, for example because the client does not support the call.
+	StatusSkip Status = "skip"
+)
+
+// Result is the result of an individual check.
+type Result struct {
+	// Name is the name of the check, usually the name of the call.
+	Name string `json:"name"`
+	// Fork is the fork to which the check applies, if any.
+	Fork string `json:"fork,omitempty"`
+	// Status is the status of the check.
+	Status Status `json:"status"`
+	// Error is the reason for failure or skipping the check, if any.
+	Error string `json:"error,omitempty"`
+	// Duration is the time taken to carry out the check.
+	Duration time.Duration `json:"duration"`
+}
+
+// Report is the report of a conformance run.
+type Report struct {
+	// Client is the name of the client that was checked.
+	Client string `json:"client"`
+	// Address is the address of the client that was checked.
+	Address string `json:"address"`
+	// NodeVersion is the version reported by the node, if available.
+	NodeVersion string `json:"node_version,omitempty"`
+	// Started is the time at which the run started.
+	Started time.Time `json:"started"`
+	// Results are the results of the individual checks.
+	Results []*Result `json:"results"`
+}
+
+// Passed returns the number of checks that passed.
+func (r *Report) Passed() int {
+	return r.count(StatusPass)
+}
+
+// Failed returns the number of checks that failed.
+func (r *Report) Failed() int {
+	return r.count(StatusFail)
+}
+
+// Skipped returns the number of checks that were skipped.
+func (r *Report) Skipped() int {
+	return r.count(StatusSkip)
+}
+
+func (r *Report) count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// String returns a string version of the structure.
+func (r *Report) String() string {
+	if r == nil {
+		return ""
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
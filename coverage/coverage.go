@@ -0,0 +1,109 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coverage reports the endpoints of the beacon API specification that are
+// implemented by a client, allowing applications to confirm at startup that the
+// client supports all of the endpoints that they require.
+package coverage
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Endpoint is an endpoint of the beacon API specification.
+type Endpoint struct {
+	// OperationID is the operation ID of the endpoint in the specification.
+	OperationID string
+	// Method is the HTTP method of the endpoint.
+	Method string
+	// Path is the path of the endpoint, with parameters in braces.
+	Path string
+}
+
+// String returns a string version of the endpoint.
+func (e *Endpoint) String() string {
+	return fmt.Sprintf("%s %s (%s)", e.Method, e.Path, e.OperationID)
+}
+
+// Result is the coverage of the beacon API specification by a client.
+type Result struct {
+	// SpecVersion is the version of the specification.
+	SpecVersion string
+	// Implemented are the endpoints implemented by the client.
+	Implemented []*Endpoint
+	// Missing are the endpoints not implemented by the client.
+	Missing []*Endpoint
+}
+
+// Report returns the coverage of the beacon API specification by the client.
+// An endpoint is implemented if the client implements the provider interface
+// that exposes it.
+//
+// Only the type of the client is inspected.  Clients that delegate to others, such as
+// that returned by multi.New, implement every provider interface regardless of the
+// clients to which they delegate, so report every exposed endpoint as implemented; to
+// confirm that the endpoints are available, report on each of the underlying clients.
+func Report(client interface{}) *Result {
+	res := &Result{
+		SpecVersion: SpecVersion,
+		Implemented: make([]*Endpoint, 0),
+		Missing:     make([]*Endpoint, 0),
+	}
+
+	clientType := reflect.TypeOf(client)
+	for _, endpoint := range endpoints {
+		provider, exposed := providers[endpoint.OperationID]
+		if clientType != nil && exposed && clientType.Implements(provider) {
+			res.Implemented = append(res.Implemented, endpoint)
+		} else {
+			res.Missing = append(res.Missing, endpoint)
+		}
+	}
+
+	return res
+}
+
+// Supports returns an error if any of the endpoints with the given operation IDs are not
+// implemented by the client, or are not in the specification.
+func (r *Result) Supports(operationIDs ...string) error {
+	implemented := make(map[string]bool, len(r.Implemented)+len(r.Missing))
+	for _, endpoint := range r.Missing {
+		implemented[endpoint.OperationID] = false
+	}
+	for _, endpoint := range r.Implemented {
+		implemented[endpoint.OperationID] = true
+	}
+
+	unknown := make([]string, 0)
+	missing := make([]string, 0)
+	for _, operationID := range operationIDs {
+		isImplemented, exists := implemented[operationID]
+		switch {
+		case !exists:
+			unknown = append(unknown, operationID)
+		case !isImplemented:
+			missing = append(missing, operationID)
+		}
+	}
+
+	switch {
+	case len(unknown) > 0:
+		return fmt.Errorf("endpoints not in specification %s: %s", r.SpecVersion, strings.Join(unknown, ", "))
+	case len(missing) > 0:
+		return fmt.Errorf("endpoints not implemented: %s", strings.Join(missing, ", "))
+	default:
+		return nil
+	}
+}
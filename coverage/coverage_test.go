@@ -0,0 +1,51 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage_test
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/coverage"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisClient is a client that only provides genesis.
+type genesisClient struct{}
+
+func (c *genesisClient) Genesis(_ context.Context) (*apiv1.Genesis, error) {
+	return &apiv1.Genesis{}, nil
+}
+
+func TestReport(t *testing.T) {
+	res := coverage.Report(&genesisClient{})
+	require.Equal(t, coverage.SpecVersion, res.SpecVersion)
+	require.Len(t, res.Implemented, 1)
+	require.Equal(t, "getGenesis", res.Implemented[0].OperationID)
+	require.Equal(t, "GET /eth/v1/beacon/genesis (getGenesis)", res.Implemented[0].String())
+	require.NoError(t, res.Supports("getGenesis"))
+	require.EqualError(t, res.Supports("getGenesis", "getSpec", "getPeers"), "endpoints not implemented: getSpec, getPeers")
+	require.EqualError(t, res.Supports("getSpec", "getUnknown"), "endpoints not in specification v2.5.0: getUnknown")
+
+	res = coverage.Report(nil)
+	require.Empty(t, res.Implemented)
+
+	res = coverage.Report((*http.Service)(nil))
+	require.NoError(t, res.Supports("getGenesis", "getSpec", "getStateV2", "produceBlockV3", "eventstream"))
+	require.NoError(t, res.Supports("getPeers", "getDebugForkChoice"))
+	require.Error(t, res.Supports("getHealth"))
+	require.Equal(t, len(res.Implemented)+len(res.Missing), len(coverage.Report(nil).Missing))
+}
@@ -0,0 +1,138 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// SpecVersion is the version of the beacon API specification against which coverage is reported.
+const SpecVersion = "v2.5.0"
+
+// provider returns the type of the provider interface pointed to by p.
+func provider(p interface{}) reflect.Type {
+	return reflect.TypeOf(p).Elem()
+}
+
+// providers are the provider interfaces through which the client exposes endpoints of
+// the specification, by operation ID.  Endpoints with no provider are not exposed by
+// the client.
+var providers = map[string]reflect.Type{
+	"getGenesis":                        provider((*client.GenesisProvider)(nil)),
+	"getStateRoot":                      provider((*client.BeaconStateRootProvider)(nil)),
+	"getStateFork":                      provider((*client.ForkProvider)(nil)),
+	"getStateFinalityCheckpoints":       provider((*client.FinalityProvider)(nil)),
+	"getStateValidators":                provider((*client.ValidatorsProvider)(nil)),
+	"getStateValidatorBalances":         provider((*client.ValidatorBalancesProvider)(nil)),
+	"getEpochCommittees":                provider((*client.BeaconCommitteesProvider)(nil)),
+	"getEpochSyncCommittees":            provider((*client.SyncCommitteesProvider)(nil)),
+	"getStateRandao":                    provider((*client.BeaconStateRandaoProvider)(nil)),
+	"getBlockHeader":                    provider((*client.BeaconBlockHeadersProvider)(nil)),
+	"publishBlock":                      provider((*client.BeaconBlockSubmitter)(nil)),
+	"publishBlindedBlock":               provider((*client.BlindedBeaconBlockSubmitter)(nil)),
+	"publishBlockV2":                    provider((*client.ValidatedBeaconBlockSubmitter)(nil)),
+	"publishBlindedBlockV2":             provider((*client.ValidatedBlindedBeaconBlockSubmitter)(nil)),
+	"getBlockV2":                        provider((*client.SignedBeaconBlockProvider)(nil)),
+	"getBlockRoot":                      provider((*client.BeaconBlockRootProvider)(nil)),
+	"getPoolAttestations":               provider((*client.AttestationPoolProvider)(nil)),
+	"submitPoolAttestations":            provider((*client.AttestationsSubmitter)(nil)),
+	"submitPoolSyncCommitteeSignatures": provider((*client.SyncCommitteeMessagesSubmitter)(nil)),
+	"submitPoolVoluntaryExit":           provider((*client.VoluntaryExitSubmitter)(nil)),
+	"submitPoolBLSToExecutionChange":    provider((*client.BLSToExecutionChangesSubmitter)(nil)),
+	"getForkSchedule":                   provider((*client.ForkScheduleProvider)(nil)),
+	"getSpec":                           provider((*client.SpecProvider)(nil)),
+	"getDepositContract":                provider((*client.DepositContractProvider)(nil)),
+	"getStateV2":                        provider((*client.BeaconStateProvider)(nil)),
+	"eventstream":                       provider((*client.EventsProvider)(nil)),
+	"getPeerCount":                      provider((*client.NodePeerCountProvider)(nil)),
+	"getNodeVersion":                    provider((*client.NodeVersionProvider)(nil)),
+	"getSyncingStatus":                  provider((*client.NodeSyncingProvider)(nil)),
+	"getAttesterDuties":                 provider((*client.AttesterDutiesProvider)(nil)),
+	"getProposerDuties":                 provider((*client.ProposerDutiesProvider)(nil)),
+	"getSyncCommitteeDuties":            provider((*client.SyncCommitteeDutiesProvider)(nil)),
+	"produceBlockV2":                    provider((*client.BeaconBlockProposalProvider)(nil)),
+	"produceBlockV3":                    provider((*client.ProposalProvider)(nil)),
+	"produceBlindedBlock":               provider((*client.BlindedBeaconBlockProposalProvider)(nil)),
+	"produceAttestationData":            provider((*client.AttestationDataProvider)(nil)),
+	"getAggregatedAttestation":          provider((*client.AggregateAttestationProvider)(nil)),
+	"publishAggregateAndProofs":         provider((*client.AggregateAttestationsSubmitter)(nil)),
+	"prepareBeaconCommitteeSubnet":      provider((*client.BeaconCommitteeSubscriptionsSubmitter)(nil)),
+	"prepareSyncCommitteeSubnets":       provider((*client.SyncCommitteeSubscriptionsSubmitter)(nil)),
+	"produceSyncCommitteeContribution":  provider((*client.SyncCommitteeContributionProvider)(nil)),
+	"publishContributionAndProofs":      provider((*client.SyncCommitteeContributionsSubmitter)(nil)),
+	"prepareBeaconProposer":             provider((*client.ProposalPreparationsSubmitter)(nil)),
+	"registerValidator":                 provider((*client.ValidatorRegistrationsSubmitter)(nil)),
+	"getPeers":                          provider((*client.PagedNodePeersProvider)(nil)),
+	"getDebugForkChoice":                provider((*client.PagedForkChoiceNodesProvider)(nil)),
+}
+
+// endpoints are the endpoints of the specification.
+var endpoints = mustParseSpec(specJSON)
+
+// parseSpec parses the endpoints from the paths of an OpenAPI document, ordered by path
+// and method.
+func parseSpec(input string) ([]*Endpoint, error) {
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(input), &spec); err != nil {
+		return nil, errors.Wrap(err, "invalid JSON")
+	}
+	if len(spec.Paths) == 0 {
+		return nil, errors.New("paths missing")
+	}
+
+	res := make([]*Endpoint, 0)
+	for path, operations := range spec.Paths {
+		for method, operation := range operations {
+			if operation.OperationID == "" {
+				return nil, fmt.Errorf("operation ID missing for %s %s", strings.ToUpper(method), path)
+			}
+			res = append(res, &Endpoint{
+				OperationID: operation.OperationID,
+				Method:      strings.ToUpper(method),
+				Path:        path,
+			})
+		}
+	}
+	sort.Slice(res, func(i int, j int) bool {
+		if res[i].Path != res[j].Path {
+			return res[i].Path < res[j].Path
+		}
+
+		return res[i].Method < res[j].Method
+	})
+
+	return res, nil
+}
+
+// mustParseSpec parses the endpoints from the paths of an OpenAPI document, panicking
+// on failure.
+func mustParseSpec(input string) []*Endpoint {
+	res, err := parseSpec(input)
+	if err != nil {
+		panic(errors.Wrap(err, "invalid bundled specification"))
+	}
+
+	return res
+}
@@ -0,0 +1,77 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		endpoints []*Endpoint
+		err       string
+	}{
+		{
+			name:  "Invalid",
+			input: `{`,
+			err:   "invalid JSON: unexpected end of JSON input",
+		},
+		{
+			name:  "PathsMissing",
+			input: `{}`,
+			err:   "paths missing",
+		},
+		{
+			name:  "OperationIDMissing",
+			input: `{"paths":{"/eth/v1/node/health":{"get":{}}}}`,
+			err:   "operation ID missing for GET /eth/v1/node/health",
+		},
+		{
+			name:  "Good",
+			input: `{"paths":{"/eth/v1/node/version":{"get":{"operationId":"getNodeVersion"}},"/eth/v1/beacon/pool/attestations":{"post":{"operationId":"submitPoolAttestations"},"get":{"operationId":"getPoolAttestations"}}}}`,
+			endpoints: []*Endpoint{
+				{OperationID: "getPoolAttestations", Method: "GET", Path: "/eth/v1/beacon/pool/attestations"},
+				{OperationID: "submitPoolAttestations", Method: "POST", Path: "/eth/v1/beacon/pool/attestations"},
+				{OperationID: "getNodeVersion", Method: "GET", Path: "/eth/v1/node/version"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoints, err := parseSpec(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.endpoints, endpoints)
+			}
+		})
+	}
+}
+
+func TestProvidersInSpec(t *testing.T) {
+	operationIDs := make(map[string]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		require.False(t, operationIDs[endpoint.OperationID], "duplicate operation ID %s", endpoint.OperationID)
+		operationIDs[endpoint.OperationID] = true
+	}
+	for operationID := range providers {
+		require.True(t, operationIDs[operationID], "provider for %s not in specification", operationID)
+	}
+}
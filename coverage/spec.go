@@ -0,0 +1,385 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+// specJSON is the OpenAPI document of the beacon API specification at SpecVersion,
+// reduced to the operation ID of each operation.  To update it for a new version of the
+// specification, run the following over its bundled OpenAPI document:
+//
+//	jq '{paths: .paths | map_values(map_values({operationId}))}'
+const specJSON = `{
+	"paths": {
+		"/eth/v1/beacon/genesis": {
+			"get": {
+				"operationId": "getGenesis"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/root": {
+			"get": {
+				"operationId": "getStateRoot"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/fork": {
+			"get": {
+				"operationId": "getStateFork"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/finality_checkpoints": {
+			"get": {
+				"operationId": "getStateFinalityCheckpoints"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/validators": {
+			"get": {
+				"operationId": "getStateValidators"
+			},
+			"post": {
+				"operationId": "postStateValidators"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/validators/{validator_id}": {
+			"get": {
+				"operationId": "getStateValidator"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/validator_balances": {
+			"get": {
+				"operationId": "getStateValidatorBalances"
+			},
+			"post": {
+				"operationId": "postStateValidatorBalances"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/committees": {
+			"get": {
+				"operationId": "getEpochCommittees"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/sync_committees": {
+			"get": {
+				"operationId": "getEpochSyncCommittees"
+			}
+		},
+		"/eth/v1/beacon/states/{state_id}/randao": {
+			"get": {
+				"operationId": "getStateRandao"
+			}
+		},
+		"/eth/v1/beacon/headers": {
+			"get": {
+				"operationId": "getBlockHeaders"
+			}
+		},
+		"/eth/v1/beacon/headers/{block_id}": {
+			"get": {
+				"operationId": "getBlockHeader"
+			}
+		},
+		"/eth/v1/beacon/blocks": {
+			"post": {
+				"operationId": "publishBlock"
+			}
+		},
+		"/eth/v1/beacon/blinded_blocks": {
+			"post": {
+				"operationId": "publishBlindedBlock"
+			}
+		},
+		"/eth/v2/beacon/blocks": {
+			"post": {
+				"operationId": "publishBlockV2"
+			}
+		},
+		"/eth/v2/beacon/blinded_blocks": {
+			"post": {
+				"operationId": "publishBlindedBlockV2"
+			}
+		},
+		"/eth/v2/beacon/blocks/{block_id}": {
+			"get": {
+				"operationId": "getBlockV2"
+			}
+		},
+		"/eth/v1/beacon/blinded_blocks/{block_id}": {
+			"get": {
+				"operationId": "getBlindedBlock"
+			}
+		},
+		"/eth/v1/beacon/blocks/{block_id}/root": {
+			"get": {
+				"operationId": "getBlockRoot"
+			}
+		},
+		"/eth/v1/beacon/blocks/{block_id}/attestations": {
+			"get": {
+				"operationId": "getBlockAttestations"
+			}
+		},
+		"/eth/v1/beacon/blob_sidecars/{block_id}": {
+			"get": {
+				"operationId": "getBlobSidecars"
+			}
+		},
+		"/eth/v1/beacon/rewards/blocks/{block_id}": {
+			"get": {
+				"operationId": "getBlockRewards"
+			}
+		},
+		"/eth/v1/beacon/rewards/attestations/{epoch}": {
+			"post": {
+				"operationId": "getAttestationsRewards"
+			}
+		},
+		"/eth/v1/beacon/rewards/sync_committee/{block_id}": {
+			"post": {
+				"operationId": "getSyncCommitteeRewards"
+			}
+		},
+		"/eth/v1/beacon/deposit_snapshot": {
+			"get": {
+				"operationId": "getDepositSnapshot"
+			}
+		},
+		"/eth/v1/beacon/light_client/bootstrap/{block_root}": {
+			"get": {
+				"operationId": "getLightClientBootstrap"
+			}
+		},
+		"/eth/v1/beacon/light_client/updates": {
+			"get": {
+				"operationId": "getLightClientUpdatesByRange"
+			}
+		},
+		"/eth/v1/beacon/light_client/finality_update": {
+			"get": {
+				"operationId": "getLightClientFinalityUpdate"
+			}
+		},
+		"/eth/v1/beacon/light_client/optimistic_update": {
+			"get": {
+				"operationId": "getLightClientOptimisticUpdate"
+			}
+		},
+		"/eth/v1/beacon/pool/attestations": {
+			"get": {
+				"operationId": "getPoolAttestations"
+			},
+			"post": {
+				"operationId": "submitPoolAttestations"
+			}
+		},
+		"/eth/v1/beacon/pool/attester_slashings": {
+			"get": {
+				"operationId": "getPoolAttesterSlashings"
+			},
+			"post": {
+				"operationId": "submitPoolAttesterSlashings"
+			}
+		},
+		"/eth/v1/beacon/pool/proposer_slashings": {
+			"get": {
+				"operationId": "getPoolProposerSlashings"
+			},
+			"post": {
+				"operationId": "submitPoolProposerSlashings"
+			}
+		},
+		"/eth/v1/beacon/pool/sync_committees": {
+			"post": {
+				"operationId": "submitPoolSyncCommitteeSignatures"
+			}
+		},
+		"/eth/v1/beacon/pool/voluntary_exits": {
+			"get": {
+				"operationId": "getPoolVoluntaryExits"
+			},
+			"post": {
+				"operationId": "submitPoolVoluntaryExit"
+			}
+		},
+		"/eth/v1/beacon/pool/bls_to_execution_changes": {
+			"get": {
+				"operationId": "getPoolBLSToExecutionChanges"
+			},
+			"post": {
+				"operationId": "submitPoolBLSToExecutionChange"
+			}
+		},
+		"/eth/v1/builder/states/{state_id}/expected_withdrawals": {
+			"get": {
+				"operationId": "getNextWithdrawals"
+			}
+		},
+		"/eth/v1/config/fork_schedule": {
+			"get": {
+				"operationId": "getForkSchedule"
+			}
+		},
+		"/eth/v1/config/spec": {
+			"get": {
+				"operationId": "getSpec"
+			}
+		},
+		"/eth/v1/config/deposit_contract": {
+			"get": {
+				"operationId": "getDepositContract"
+			}
+		},
+		"/eth/v2/debug/beacon/states/{state_id}": {
+			"get": {
+				"operationId": "getStateV2"
+			}
+		},
+		"/eth/v2/debug/beacon/heads": {
+			"get": {
+				"operationId": "getDebugChainHeadsV2"
+			}
+		},
+		"/eth/v1/debug/fork_choice": {
+			"get": {
+				"operationId": "getDebugForkChoice"
+			}
+		},
+		"/eth/v1/events": {
+			"get": {
+				"operationId": "eventstream"
+			}
+		},
+		"/eth/v1/node/identity": {
+			"get": {
+				"operationId": "getNetworkIdentity"
+			}
+		},
+		"/eth/v1/node/peers": {
+			"get": {
+				"operationId": "getPeers"
+			}
+		},
+		"/eth/v1/node/peers/{peer_id}": {
+			"get": {
+				"operationId": "getPeer"
+			}
+		},
+		"/eth/v1/node/peer_count": {
+			"get": {
+				"operationId": "getPeerCount"
+			}
+		},
+		"/eth/v1/node/version": {
+			"get": {
+				"operationId": "getNodeVersion"
+			}
+		},
+		"/eth/v1/node/syncing": {
+			"get": {
+				"operationId": "getSyncingStatus"
+			}
+		},
+		"/eth/v1/node/health": {
+			"get": {
+				"operationId": "getHealth"
+			}
+		},
+		"/eth/v1/validator/duties/attester/{epoch}": {
+			"post": {
+				"operationId": "getAttesterDuties"
+			}
+		},
+		"/eth/v1/validator/duties/proposer/{epoch}": {
+			"get": {
+				"operationId": "getProposerDuties"
+			}
+		},
+		"/eth/v1/validator/duties/sync/{epoch}": {
+			"post": {
+				"operationId": "getSyncCommitteeDuties"
+			}
+		},
+		"/eth/v2/validator/blocks/{slot}": {
+			"get": {
+				"operationId": "produceBlockV2"
+			}
+		},
+		"/eth/v3/validator/blocks/{slot}": {
+			"get": {
+				"operationId": "produceBlockV3"
+			}
+		},
+		"/eth/v1/validator/blinded_blocks/{slot}": {
+			"get": {
+				"operationId": "produceBlindedBlock"
+			}
+		},
+		"/eth/v1/validator/attestation_data": {
+			"get": {
+				"operationId": "produceAttestationData"
+			}
+		},
+		"/eth/v1/validator/aggregate_attestation": {
+			"get": {
+				"operationId": "getAggregatedAttestation"
+			}
+		},
+		"/eth/v1/validator/aggregate_and_proofs": {
+			"post": {
+				"operationId": "publishAggregateAndProofs"
+			}
+		},
+		"/eth/v1/validator/beacon_committee_subscriptions": {
+			"post": {
+				"operationId": "prepareBeaconCommitteeSubnet"
+			}
+		},
+		"/eth/v1/validator/sync_committee_subscriptions": {
+			"post": {
+				"operationId": "prepareSyncCommitteeSubnets"
+			}
+		},
+		"/eth/v1/validator/sync_committee_contribution": {
+			"get": {
+				"operationId": "produceSyncCommitteeContribution"
+			}
+		},
+		"/eth/v1/validator/contribution_and_proofs": {
+			"post": {
+				"operationId": "publishContributionAndProofs"
+			}
+		},
+		"/eth/v1/validator/prepare_beacon_proposer": {
+			"post": {
+				"operationId": "prepareBeaconProposer"
+			}
+		},
+		"/eth/v1/validator/register_validator": {
+			"post": {
+				"operationId": "registerValidator"
+			}
+		},
+		"/eth/v1/validator/beacon_committee_selections": {
+			"post": {
+				"operationId": "submitBeaconCommitteeSelections"
+			}
+		},
+		"/eth/v1/validator/sync_committee_selections": {
+			"post": {
+				"operationId": "submitSyncCommitteeSelections"
+			}
+		},
+		"/eth/v1/validator/liveness/{epoch}": {
+			"post": {
+				"operationId": "getLiveness"
+			}
+		}
+	}
+}`
@@ -0,0 +1,112 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventreplay
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel   zerolog.Level
+	client     consensusclient.Service
+	topics     []string
+	handler    consensusclient.EventHandlerFunc
+	checkpoint *phase0.Slot
+	maxGap     uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which to obtain events and block headers.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithTopics sets the topics of the events to pass to the handler.
+// Head events are always passed to the handler, regardless of this setting.
+func WithTopics(topics []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.topics = topics
+	})
+}
+
+// WithHandler sets the handler to which to pass events.
+func WithHandler(handler consensusclient.EventHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.handler = handler
+	})
+}
+
+// WithCheckpoint sets the slot of the last head event seen by the handler, for
+// example before a restart.  Head events for slots after the checkpoint that are
+// missing from the event stream are replayed to the handler.
+func WithCheckpoint(slot phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.checkpoint = &slot
+	})
+}
+
+// WithMaxGap sets the maximum number of slots to replay after a gap in the event
+// stream.  If the gap is larger than this only the most recent slots are replayed.
+func WithMaxGap(maxGap uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxGap = maxGap
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		maxGap:   64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if parameters.handler == nil {
+		return nil, errors.New("no handler specified")
+	}
+	if parameters.maxGap == 0 {
+		return nil, errors.New("no maximum gap specified")
+	}
+
+	return &parameters, nil
+}
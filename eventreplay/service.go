@@ -0,0 +1,177 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventreplay replays head events missing from an event stream.
+package eventreplay
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service passes events to a handler, synthesising head events for any slots
+// missed by the event stream so that the handler sees an unbroken sequence of
+// heads without needing its own backfill logic.
+//
+// Synthesised head events are built from block headers, so they do not contain
+// duty dependent roots.  Slots without blocks do not generate head events.
+type Service struct {
+	log             zerolog.Logger
+	headersProvider consensusclient.BeaconBlockHeadersRangeProvider
+	slotsPerEpoch   uint64
+	handler         consensusclient.EventHandlerFunc
+	maxGap          uint64
+
+	// mu serialises delivery of events to the handler.
+	mu       sync.Mutex
+	lastSlot phase0.Slot
+	hasLast  bool
+}
+
+// New creates a new event replay service, which subscribes to events from the
+// client until the supplied context is done.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "eventreplay").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	headersProvider, isProvider := parameters.client.(consensusclient.BeaconBlockHeadersRangeProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon block header ranges")
+	}
+	slotsPerEpochProvider, isProvider := parameters.client.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide slots per epoch")
+	}
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch is 0")
+	}
+
+	s := &Service{
+		log:             log,
+		headersProvider: headersProvider,
+		slotsPerEpoch:   slotsPerEpoch,
+		handler:         parameters.handler,
+		maxGap:          parameters.maxGap,
+	}
+	if parameters.checkpoint != nil {
+		s.lastSlot = *parameters.checkpoint
+		s.hasLast = true
+	}
+
+	topics := []string{"head"}
+	for _, topic := range parameters.topics {
+		if topic != "head" {
+			topics = append(topics, topic)
+		}
+	}
+	if err := eventsProvider.Events(ctx, topics, func(event *apiv1.Event) {
+		s.handleEvent(ctx, event)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to events")
+	}
+
+	return s, nil
+}
+
+// LastSlot returns the slot of the last head event passed to the handler, suitable
+// for use as a checkpoint.  The second return value is false if there has been no
+// head event.
+func (s *Service) LastSlot() (phase0.Slot, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastSlot, s.hasLast
+}
+
+// handleEvent passes an event to the handler, first replaying any missed head events.
+func (s *Service) handleEvent(ctx context.Context, event *apiv1.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event == nil {
+		return
+	}
+	headEvent, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+	if event.Topic != "head" || !isHeadEvent {
+		s.handler(event)
+		return
+	}
+
+	if s.hasLast && headEvent.Slot > s.lastSlot+1 {
+		s.replay(ctx, s.lastSlot+1, headEvent.Slot)
+	}
+
+	s.handler(event)
+	s.lastSlot = headEvent.Slot
+	s.hasLast = true
+}
+
+// replay passes head events for blocks in the range [start,end) to the handler.
+// This assumes that the caller holds the lock.
+func (s *Service) replay(ctx context.Context, start phase0.Slot, end phase0.Slot) {
+	if uint64(end-start) > s.maxGap {
+		s.log.Warn().Uint64("start", uint64(start)).Uint64("end", uint64(end)).Uint64("max_gap", s.maxGap).Msg("Gap too large; replaying most recent slots only")
+		start = end - phase0.Slot(s.maxGap)
+	}
+
+	headers, err := s.headersProvider.BeaconBlockHeadersRange(ctx, start, uint64(end-start))
+	if err != nil {
+		s.log.Error().Uint64("start", uint64(start)).Uint64("end", uint64(end)).Err(err).Msg("Failed to obtain headers for missed slots")
+		return
+	}
+
+	previousSlot := s.lastSlot
+	for _, header := range headers {
+		if header == nil || header.Header == nil || header.Header.Message == nil {
+			// No block for this slot.
+			continue
+		}
+		slot := header.Header.Message.Slot
+		s.log.Trace().Uint64("slot", uint64(slot)).Msg("Replaying missed head event")
+		s.handler(&apiv1.Event{
+			Topic: "head",
+			Data: &apiv1.HeadEvent{
+				Slot:            slot,
+				Block:           header.Root,
+				State:           header.Header.Message.StateRoot,
+				EpochTransition: uint64(slot)/s.slotsPerEpoch > uint64(previousSlot)/s.slotsPerEpoch,
+			},
+		})
+		previousSlot = slot
+		s.lastSlot = slot
+	}
+}
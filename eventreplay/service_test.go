@@ -0,0 +1,212 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventreplay_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/eventreplay"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// client is a client that allows events to be injected, and has blocks at
+// the supplied slots.
+type client struct {
+	handler consensusclient.EventHandlerFunc
+	blocks  map[phase0.Slot]bool
+	fail    bool
+}
+
+func (c *client) Name() string    { return "test" }
+func (c *client) Address() string { return "test" }
+
+func (c *client) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 4, nil
+}
+
+func (c *client) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.handler = handler
+	return nil
+}
+
+func (c *client) BeaconBlockHeadersRange(_ context.Context, startSlot phase0.Slot, count uint64) ([]*apiv1.BeaconBlockHeader, error) {
+	if c.fail {
+		return nil, errors.New("failed")
+	}
+	headers := make([]*apiv1.BeaconBlockHeader, count)
+	for i := range headers {
+		slot := startSlot + phase0.Slot(i)
+		if c.blocks[slot] {
+			headers[i] = &apiv1.BeaconBlockHeader{
+				Root: phase0.Root{byte(slot)},
+				Header: &phase0.SignedBeaconBlockHeader{
+					Message: &phase0.BeaconBlockHeader{
+						Slot:      slot,
+						StateRoot: phase0.Root{0xff, byte(slot)},
+					},
+				},
+			}
+		}
+	}
+
+	return headers, nil
+}
+
+func head(slot phase0.Slot) *apiv1.Event {
+	return &apiv1.Event{
+		Topic: "head",
+		Data:  &apiv1.HeadEvent{Slot: slot, Block: phase0.Root{byte(slot)}},
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		params   []eventreplay.Parameter
+		blocks   []phase0.Slot
+		fail     bool
+		events   []*apiv1.Event
+		expected []phase0.Slot
+	}{
+		{
+			name:     "NoGap",
+			events:   []*apiv1.Event{head(1), head(2), head(3)},
+			expected: []phase0.Slot{1, 2, 3},
+		},
+		{
+			name:     "Gap",
+			blocks:   []phase0.Slot{2, 4},
+			events:   []*apiv1.Event{head(1), head(5)},
+			expected: []phase0.Slot{1, 2, 4, 5},
+		},
+		{
+			name:     "Checkpoint",
+			params:   []eventreplay.Parameter{eventreplay.WithCheckpoint(1)},
+			blocks:   []phase0.Slot{2, 3},
+			events:   []*apiv1.Event{head(4)},
+			expected: []phase0.Slot{2, 3, 4},
+		},
+		{
+			name:     "MaxGap",
+			params:   []eventreplay.Parameter{eventreplay.WithMaxGap(2)},
+			blocks:   []phase0.Slot{2, 3, 4, 5},
+			events:   []*apiv1.Event{head(1), head(6)},
+			expected: []phase0.Slot{1, 4, 5, 6},
+		},
+		{
+			name:     "Reorg",
+			events:   []*apiv1.Event{head(3), head(2), head(3)},
+			expected: []phase0.Slot{3, 2, 3},
+		},
+		{
+			name:     "FetchFails",
+			fail:     true,
+			events:   []*apiv1.Event{head(1), head(5)},
+			expected: []phase0.Slot{1, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{
+				blocks: make(map[phase0.Slot]bool),
+				fail:   test.fail,
+			}
+			for _, slot := range test.blocks {
+				c.blocks[slot] = true
+			}
+			slots := make([]phase0.Slot, 0)
+			params := append([]eventreplay.Parameter{
+				eventreplay.WithLogLevel(zerolog.Disabled),
+				eventreplay.WithClient(c),
+				eventreplay.WithHandler(func(event *apiv1.Event) {
+					slots = append(slots, event.Data.(*apiv1.HeadEvent).Slot)
+				}),
+			}, test.params...)
+			s, err := eventreplay.New(ctx, params...)
+			require.NoError(t, err)
+
+			for _, event := range test.events {
+				c.handler(event)
+			}
+			require.Equal(t, test.expected, slots)
+			lastSlot, exists := s.LastSlot()
+			require.True(t, exists)
+			require.Equal(t, test.expected[len(test.expected)-1], lastSlot)
+		})
+	}
+}
+
+func TestReplayedEvent(t *testing.T) {
+	c := &client{
+		blocks: map[phase0.Slot]bool{3: true, 4: true},
+	}
+	events := make([]*apiv1.HeadEvent, 0)
+	_, err := eventreplay.New(context.Background(),
+		eventreplay.WithLogLevel(zerolog.Disabled),
+		eventreplay.WithClient(c),
+		eventreplay.WithTopics([]string{"block"}),
+		eventreplay.WithHandler(func(event *apiv1.Event) {
+			if headEvent, isHeadEvent := event.Data.(*apiv1.HeadEvent); isHeadEvent {
+				events = append(events, headEvent)
+			}
+		}),
+	)
+	require.NoError(t, err)
+
+	c.handler(head(2))
+	// Non-head events pass through without triggering a replay.
+	c.handler(&apiv1.Event{Topic: "block", Data: &apiv1.BlockEvent{Slot: 6}})
+	c.handler(head(5))
+
+	require.Len(t, events, 4)
+	require.Equal(t, &apiv1.HeadEvent{
+		Slot:  3,
+		Block: phase0.Root{0x03},
+		State: phase0.Root{0xff, 0x03},
+	}, events[1])
+	require.Equal(t, &apiv1.HeadEvent{
+		Slot:            4,
+		Block:           phase0.Root{0x04},
+		State:           phase0.Root{0xff, 0x04},
+		EpochTransition: true,
+	}, events[2])
+}
+
+func TestNew(t *testing.T) {
+	_, err := eventreplay.New(context.Background(),
+		eventreplay.WithHandler(func(*apiv1.Event) {}),
+	)
+	require.EqualError(t, err, "problem with parameters: no client specified")
+
+	_, err = eventreplay.New(context.Background(),
+		eventreplay.WithClient(&client{}),
+	)
+	require.EqualError(t, err, "problem with parameters: no handler specified")
+
+	_, err = eventreplay.New(context.Background(),
+		eventreplay.WithClient(&client{}),
+		eventreplay.WithHandler(func(*apiv1.Event) {}),
+		eventreplay.WithMaxGap(0),
+	)
+	require.EqualError(t, err, "problem with parameters: no maximum gap specified")
+}
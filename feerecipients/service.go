@@ -0,0 +1,226 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feerecipients
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Discrepancy is a proposal whose fee recipient differs from that configured
+// for its proposer, for example because a builder overrode it.
+type Discrepancy struct {
+	Slot          phase0.Slot
+	ProposerIndex phase0.ValidatorIndex
+	Blinded       bool
+	Expected      bellatrix.ExecutionAddress
+	Actual        bellatrix.ExecutionAddress
+}
+
+// Report is a summary of the proposals checked by the service.
+type Report struct {
+	// Checked is the number of proposals checked against a configured fee recipient.
+	Checked uint64
+	// Unconfigured is the number of proposals whose proposer had no fee recipient.
+	Unconfigured uint64
+	// Discrepancies are the proposals whose fee recipient did not match.
+	Discrepancies []*Discrepancy
+}
+
+// Service tracks the desired fee recipients of validators, and checks
+// proposals against them.
+type Service struct {
+	log zerolog.Logger
+
+	mu                  sync.RWMutex
+	defaultFeeRecipient *bellatrix.ExecutionAddress
+	feeRecipients       map[phase0.ValidatorIndex]bellatrix.ExecutionAddress
+	checked             uint64
+	unconfigured        uint64
+	discrepancies       []*Discrepancy
+}
+
+// New creates a new fee recipients service.
+func New(params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "feerecipients").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	feeRecipients := make(map[phase0.ValidatorIndex]bellatrix.ExecutionAddress, len(parameters.feeRecipients))
+	for index, feeRecipient := range parameters.feeRecipients {
+		feeRecipients[index] = feeRecipient
+	}
+
+	return &Service{
+		log:                 log,
+		defaultFeeRecipient: parameters.defaultFeeRecipient,
+		feeRecipients:       feeRecipients,
+	}, nil
+}
+
+// SetFeeRecipient sets the desired fee recipient for a validator.
+func (s *Service) SetFeeRecipient(index phase0.ValidatorIndex, feeRecipient bellatrix.ExecutionAddress) {
+	s.mu.Lock()
+	s.feeRecipients[index] = feeRecipient
+	s.mu.Unlock()
+}
+
+// RemoveFeeRecipient removes the desired fee recipient for a validator, after
+// which it falls back to the default fee recipient if present.
+func (s *Service) RemoveFeeRecipient(index phase0.ValidatorIndex) {
+	s.mu.Lock()
+	delete(s.feeRecipients, index)
+	s.mu.Unlock()
+}
+
+// FeeRecipient returns the desired fee recipient for a validator.
+// The second return value is false if the validator has no fee recipient and
+// there is no default.
+func (s *Service) FeeRecipient(index phase0.ValidatorIndex) (bellatrix.ExecutionAddress, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.feeRecipient(index)
+}
+
+// feeRecipient returns the desired fee recipient for a validator.
+// This assumes that the caller holds the lock.
+func (s *Service) feeRecipient(index phase0.ValidatorIndex) (bellatrix.ExecutionAddress, bool) {
+	if feeRecipient, exists := s.feeRecipients[index]; exists {
+		return feeRecipient, true
+	}
+	if s.defaultFeeRecipient != nil {
+		return *s.defaultFeeRecipient, true
+	}
+
+	return bellatrix.ExecutionAddress{}, false
+}
+
+// CheckProposal checks the fee recipient of a proposal against that configured
+// for its proposer.
+// It returns the discrepancy if they differ, or nil if they match or if the
+// proposer has no fee recipient.
+func (s *Service) CheckProposal(proposal *spec.VersionedBeaconBlock) (*Discrepancy, error) {
+	slot, err := proposal.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal slot")
+	}
+	proposerIndex, err := proposal.ProposerIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal proposer index")
+	}
+	feeRecipient, err := proposal.FeeRecipient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal fee recipient")
+	}
+
+	return s.check(slot, proposerIndex, feeRecipient, false), nil
+}
+
+// CheckBlindedProposal checks the fee recipient of a blinded proposal against
+// that configured for its proposer.
+// It returns the discrepancy if they differ, or nil if they match or if the
+// proposer has no fee recipient.
+func (s *Service) CheckBlindedProposal(proposal *api.VersionedBlindedBeaconBlock) (*Discrepancy, error) {
+	slot, err := proposal.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal slot")
+	}
+	proposerIndex, err := proposal.ProposerIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal proposer index")
+	}
+	feeRecipient, err := proposal.FeeRecipient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal fee recipient")
+	}
+
+	return s.check(slot, proposerIndex, feeRecipient, true), nil
+}
+
+func (s *Service) check(slot phase0.Slot,
+	proposerIndex phase0.ValidatorIndex,
+	actual bellatrix.ExecutionAddress,
+	blinded bool,
+) *Discrepancy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expected, exists := s.feeRecipient(proposerIndex)
+	if !exists {
+		s.unconfigured++
+		s.log.Trace().Uint64("slot", uint64(slot)).Uint64("proposer_index", uint64(proposerIndex)).Msg("No fee recipient configured for proposer")
+		return nil
+	}
+	s.checked++
+	if expected == actual {
+		return nil
+	}
+
+	discrepancy := &Discrepancy{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		Blinded:       blinded,
+		Expected:      expected,
+		Actual:        actual,
+	}
+	s.discrepancies = append(s.discrepancies, discrepancy)
+	s.log.Warn().
+		Uint64("slot", uint64(slot)).
+		Uint64("proposer_index", uint64(proposerIndex)).
+		Bool("blinded", blinded).
+		Str("expected", expected.String()).
+		Str("actual", actual.String()).
+		Msg("Proposal fee recipient differs from configured fee recipient")
+
+	return discrepancy
+}
+
+// Report returns a report of the proposals checked so far.
+func (s *Service) Report() *Report {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	discrepancies := make([]*Discrepancy, len(s.discrepancies))
+	copy(discrepancies, s.discrepancies)
+
+	return &Report{
+		Checked:       s.checked,
+		Unconfigured:  s.unconfigured,
+		Discrepancies: discrepancies,
+	}
+}
+
+// ResetReport clears the proposals checked so far.
+func (s *Service) ResetReport() {
+	s.mu.Lock()
+	s.checked = 0
+	s.unconfigured = 0
+	s.discrepancies = nil
+	s.mu.Unlock()
+}
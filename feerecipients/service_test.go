@@ -0,0 +1,141 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feerecipients_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	"github.com/attestantio/go-eth2-client/feerecipients"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func proposal(slot phase0.Slot, proposerIndex phase0.ValidatorIndex, feeRecipient bellatrix.ExecutionAddress) *spec.VersionedBeaconBlock {
+	return &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			Body: &capella.BeaconBlockBody{
+				ExecutionPayload: &capella.ExecutionPayload{
+					FeeRecipient: feeRecipient,
+				},
+			},
+		},
+	}
+}
+
+func TestFeeRecipient(t *testing.T) {
+	configured := bellatrix.ExecutionAddress{0x01}
+	fallback := bellatrix.ExecutionAddress{0x02}
+
+	s, err := feerecipients.New(
+		feerecipients.WithFeeRecipients(map[phase0.ValidatorIndex]bellatrix.ExecutionAddress{1: configured}),
+	)
+	require.NoError(t, err)
+
+	feeRecipient, exists := s.FeeRecipient(1)
+	require.True(t, exists)
+	require.Equal(t, configured, feeRecipient)
+	_, exists = s.FeeRecipient(2)
+	require.False(t, exists)
+
+	s, err = feerecipients.New(
+		feerecipients.WithDefaultFeeRecipient(fallback),
+	)
+	require.NoError(t, err)
+	feeRecipient, exists = s.FeeRecipient(2)
+	require.True(t, exists)
+	require.Equal(t, fallback, feeRecipient)
+
+	s.SetFeeRecipient(2, configured)
+	feeRecipient, _ = s.FeeRecipient(2)
+	require.Equal(t, configured, feeRecipient)
+
+	s.RemoveFeeRecipient(2)
+	feeRecipient, _ = s.FeeRecipient(2)
+	require.Equal(t, fallback, feeRecipient)
+}
+
+func TestCheckProposal(t *testing.T) {
+	configured := bellatrix.ExecutionAddress{0x01}
+	builder := bellatrix.ExecutionAddress{0x03}
+
+	s, err := feerecipients.New(
+		feerecipients.WithFeeRecipients(map[phase0.ValidatorIndex]bellatrix.ExecutionAddress{1: configured}),
+	)
+	require.NoError(t, err)
+
+	// Matching.
+	discrepancy, err := s.CheckProposal(proposal(10, 1, configured))
+	require.NoError(t, err)
+	require.Nil(t, discrepancy)
+
+	// Overridden.
+	discrepancy, err = s.CheckProposal(proposal(11, 1, builder))
+	require.NoError(t, err)
+	require.Equal(t, &feerecipients.Discrepancy{
+		Slot:          11,
+		ProposerIndex: 1,
+		Expected:      configured,
+		Actual:        builder,
+	}, discrepancy)
+
+	// Unconfigured.
+	discrepancy, err = s.CheckProposal(proposal(12, 2, builder))
+	require.NoError(t, err)
+	require.Nil(t, discrepancy)
+
+	// Blinded.
+	discrepancy, err = s.CheckBlindedProposal(&api.VersionedBlindedBeaconBlock{
+		Version: spec.DataVersionBellatrix,
+		Bellatrix: &apiv1bellatrix.BlindedBeaconBlock{
+			Slot:          13,
+			ProposerIndex: 1,
+			Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+				ExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{
+					FeeRecipient: builder,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, discrepancy)
+	require.True(t, discrepancy.Blinded)
+
+	// Pre-bellatrix.
+	_, err = s.CheckProposal(&spec.VersionedBeaconBlock{
+		Version: spec.DataVersionAltair,
+		Altair:  &altair.BeaconBlock{Slot: 14, ProposerIndex: 1},
+	})
+	require.EqualError(t, err, "failed to obtain proposal fee recipient: no fee recipient prior to bellatrix")
+
+	report := s.Report()
+	require.Equal(t, uint64(3), report.Checked)
+	require.Equal(t, uint64(1), report.Unconfigured)
+	require.Len(t, report.Discrepancies, 2)
+	require.Equal(t, phase0.Slot(11), report.Discrepancies[0].Slot)
+	require.Equal(t, phase0.Slot(13), report.Discrepancies[1].Slot)
+
+	s.ResetReport()
+	report = s.Report()
+	require.Equal(t, uint64(0), report.Checked)
+	require.Empty(t, report.Discrepancies)
+}
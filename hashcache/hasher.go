@@ -0,0 +1,406 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hashcache provides a beacon state hasher that caches subtree roots between
+// calls, so that repeatedly hashing a state that changes a little at a time (for example
+// a state progressing slot by slot in a simulation) only rehashes the parts that changed.
+package hashcache
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// Limits of list fields of the beacon state.
+const (
+	historicalRootsLimit   = 16777216
+	eth1DataVotesLimit     = 2048
+	validatorRegistryLimit = 1099511627776
+)
+
+// Hasher calculates hash tree roots of beacon states, caching subtree roots between calls.
+// A hasher is intended to be used for successive versions of the same state; hashing unrelated
+// states is correct but gains little.
+type Hasher struct {
+	mutex   sync.Mutex
+	workers *workers
+
+	// trees are the cached merkle trees of the fields of the state, by field index.
+	trees map[int]*tree
+	// validators are the validators as of the last call, with their roots.
+	validators     []phase0.Validator
+	validatorRoots [][32]byte
+}
+
+// New creates a new hasher.
+func New(params ...Parameter) (*Hasher, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	return &Hasher{
+		workers: &workers{
+			parallelism: parameters.parallelism,
+			minItems:    parameters.parallelismMinNodes,
+		},
+		trees: make(map[int]*tree),
+	}, nil
+}
+
+// HashTreeRoot returns the hash tree root of the state.
+func (h *Hasher) HashTreeRoot(state *spec.VersionedBeaconState) (phase0.Root, error) {
+	if state == nil {
+		return phase0.Root{}, errors.New("no state supplied")
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var fields [][32]byte
+	var err error
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		// Phase 0 states are not cached.
+		if state.Phase0 == nil {
+			return phase0.Root{}, errors.New("no Phase0 state")
+		}
+		return state.Phase0.HashTreeRoot()
+	case spec.DataVersionAltair:
+		if state.Altair == nil {
+			return phase0.Root{}, errors.New("no Altair state")
+		}
+		fields, err = h.altairFields(state.Altair)
+	case spec.DataVersionBellatrix:
+		if state.Bellatrix == nil {
+			return phase0.Root{}, errors.New("no Bellatrix state")
+		}
+		fields, err = h.bellatrixFields(state.Bellatrix)
+	case spec.DataVersionCapella:
+		if state.Capella == nil {
+			return phase0.Root{}, errors.New("no Capella state")
+		}
+		fields, err = h.capellaFields(state.Capella)
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	// The top level tree is small, so is not cached.
+	stateTree := &tree{}
+	return stateTree.root(fields, depth(uint64(len(fields)))), nil
+}
+
+// bellatrixFields returns the roots of the fields of a Bellatrix state.
+func (h *Hasher) bellatrixFields(s *bellatrix.BeaconState) ([][32]byte, error) {
+	fields, err := h.altairFields(&altair.BeaconState{
+		GenesisTime:                 s.GenesisTime,
+		GenesisValidatorsRoot:       s.GenesisValidatorsRoot,
+		Slot:                        s.Slot,
+		Fork:                        s.Fork,
+		LatestBlockHeader:           s.LatestBlockHeader,
+		BlockRoots:                  s.BlockRoots,
+		StateRoots:                  s.StateRoots,
+		HistoricalRoots:             s.HistoricalRoots,
+		ETH1Data:                    s.ETH1Data,
+		ETH1DataVotes:               s.ETH1DataVotes,
+		ETH1DepositIndex:            s.ETH1DepositIndex,
+		Validators:                  s.Validators,
+		Balances:                    s.Balances,
+		RANDAOMixes:                 s.RANDAOMixes,
+		Slashings:                   s.Slashings,
+		PreviousEpochParticipation:  s.PreviousEpochParticipation,
+		CurrentEpochParticipation:   s.CurrentEpochParticipation,
+		JustificationBits:           s.JustificationBits,
+		PreviousJustifiedCheckpoint: s.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  s.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         s.FinalizedCheckpoint,
+		InactivityScores:            s.InactivityScores,
+		CurrentSyncCommittee:        s.CurrentSyncCommittee,
+		NextSyncCommittee:           s.NextSyncCommittee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.appendContainer(&fields, s.LatestExecutionPayloadHeader); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// capellaFields returns the roots of the fields of a Capella state.
+func (h *Hasher) capellaFields(s *capella.BeaconState) ([][32]byte, error) {
+	fields, err := h.altairFields(&altair.BeaconState{
+		GenesisTime:                 s.GenesisTime,
+		GenesisValidatorsRoot:       s.GenesisValidatorsRoot,
+		Slot:                        s.Slot,
+		Fork:                        s.Fork,
+		LatestBlockHeader:           s.LatestBlockHeader,
+		BlockRoots:                  s.BlockRoots,
+		StateRoots:                  s.StateRoots,
+		HistoricalRoots:             s.HistoricalRoots,
+		ETH1Data:                    s.ETH1Data,
+		ETH1DataVotes:               s.ETH1DataVotes,
+		ETH1DepositIndex:            s.ETH1DepositIndex,
+		Validators:                  s.Validators,
+		Balances:                    s.Balances,
+		RANDAOMixes:                 s.RANDAOMixes,
+		Slashings:                   s.Slashings,
+		PreviousEpochParticipation:  s.PreviousEpochParticipation,
+		CurrentEpochParticipation:   s.CurrentEpochParticipation,
+		JustificationBits:           s.JustificationBits,
+		PreviousJustifiedCheckpoint: s.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  s.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         s.FinalizedCheckpoint,
+		InactivityScores:            s.InactivityScores,
+		CurrentSyncCommittee:        s.CurrentSyncCommittee,
+		NextSyncCommittee:           s.NextSyncCommittee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.appendContainer(&fields, s.LatestExecutionPayloadHeader); err != nil {
+		return nil, err
+	}
+	fields = append(fields, uint64Node(uint64(s.NextWithdrawalIndex)))
+	fields = append(fields, uint64Node(uint64(s.NextWithdrawalValidatorIndex)))
+
+	summaries := make([][32]byte, len(s.HistoricalSummaries))
+	for i, summary := range s.HistoricalSummaries {
+		summaries[i] = hash(summary.BlockSummaryRoot, summary.StateSummaryRoot)
+	}
+	fields = append(fields, h.list(len(fields), summaries, historicalRootsLimit, uint64(len(summaries))))
+
+	return fields, nil
+}
+
+// altairFields returns the roots of the fields common to Altair and later states.
+func (h *Hasher) altairFields(s *altair.BeaconState) ([][32]byte, error) {
+	if len(s.BlockRoots) != 8192 || len(s.StateRoots) != 8192 {
+		return nil, errors.New("incorrect number of block or state roots")
+	}
+	if len(s.RANDAOMixes) != 65536 {
+		return nil, errors.New("incorrect number of RANDAO mixes")
+	}
+	if len(s.Slashings) != 8192 {
+		return nil, errors.New("incorrect number of slashings")
+	}
+	if len(s.JustificationBits) != 1 {
+		return nil, errors.New("incorrect length of justification bits")
+	}
+
+	fields := make([][32]byte, 0, 28)
+	fields = append(fields, uint64Node(s.GenesisTime))
+	fields = append(fields, s.GenesisValidatorsRoot)
+	fields = append(fields, uint64Node(uint64(s.Slot)))
+	if err := h.appendContainer(&fields, s.Fork); err != nil {
+		return nil, err
+	}
+	if err := h.appendContainer(&fields, s.LatestBlockHeader); err != nil {
+		return nil, err
+	}
+	fields = append(fields, h.vector(len(fields), rootNodes(s.BlockRoots)))
+	fields = append(fields, h.vector(len(fields), rootNodes(s.StateRoots)))
+	fields = append(fields, h.list(len(fields), rootNodes(s.HistoricalRoots), historicalRootsLimit, uint64(len(s.HistoricalRoots))))
+	if err := h.appendContainer(&fields, s.ETH1Data); err != nil {
+		return nil, err
+	}
+	votes := make([][32]byte, len(s.ETH1DataVotes))
+	for i, vote := range s.ETH1DataVotes {
+		root, err := vote.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to hash ETH1 data vote")
+		}
+		votes[i] = root
+	}
+	fields = append(fields, h.list(len(fields), votes, eth1DataVotesLimit, uint64(len(votes))))
+	fields = append(fields, uint64Node(s.ETH1DepositIndex))
+	validators, err := h.validatorNodes(s.Validators)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, h.list(len(fields), validators, validatorRegistryLimit, uint64(len(s.Validators))))
+	fields = append(fields, h.list(len(fields), gweiNodes(s.Balances), validatorRegistryLimit*8/32, uint64(len(s.Balances))))
+	fields = append(fields, h.vector(len(fields), rootNodes(s.RANDAOMixes)))
+	fields = append(fields, h.vector(len(fields), gweiNodes(s.Slashings)))
+	fields = append(fields, h.list(len(fields), participationNodes(s.PreviousEpochParticipation), validatorRegistryLimit/32, uint64(len(s.PreviousEpochParticipation))))
+	fields = append(fields, h.list(len(fields), participationNodes(s.CurrentEpochParticipation), validatorRegistryLimit/32, uint64(len(s.CurrentEpochParticipation))))
+	var justificationBits [32]byte
+	copy(justificationBits[:], s.JustificationBits)
+	fields = append(fields, justificationBits)
+	for _, checkpoint := range []*phase0.Checkpoint{s.PreviousJustifiedCheckpoint, s.CurrentJustifiedCheckpoint, s.FinalizedCheckpoint} {
+		if err := h.appendContainer(&fields, checkpoint); err != nil {
+			return nil, err
+		}
+	}
+	fields = append(fields, h.list(len(fields), uint64Nodes(s.InactivityScores), validatorRegistryLimit*8/32, uint64(len(s.InactivityScores))))
+	if err := h.appendContainer(&fields, s.CurrentSyncCommittee); err != nil {
+		return nil, err
+	}
+	if err := h.appendContainer(&fields, s.NextSyncCommittee); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// vector returns the root of a vector field, using the cached tree for the field.
+func (h *Hasher) vector(field int, nodes [][32]byte) [32]byte {
+	return h.tree(field).root(nodes, depth(uint64(len(nodes))))
+}
+
+// list returns the root of a list field, using the cached tree for the field.
+func (h *Hasher) list(field int, nodes [][32]byte, limit uint64, length uint64) [32]byte {
+	return mixInLength(h.tree(field).root(nodes, depth(limit)), length)
+}
+
+// tree returns the cached tree for a field.
+func (h *Hasher) tree(field int) *tree {
+	t, exists := h.trees[field]
+	if !exists {
+		t = &tree{workers: h.workers}
+		h.trees[field] = t
+	}
+	return t
+}
+
+// appendContainer appends the root of a container to the fields.
+// A nil container is hashed as its empty value, as per the generated code.
+func (h *Hasher) appendContainer(fields *[][32]byte, container ssz.HashRoot) error {
+	if val := reflect.ValueOf(container); val.IsNil() {
+		container = reflect.New(val.Type().Elem()).Interface().(ssz.HashRoot)
+	}
+	root, err := container.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to hash container")
+	}
+	*fields = append(*fields, root)
+	return nil
+}
+
+// validatorNodes returns the roots of the validators, reusing the roots of validators
+// that are unchanged since the previous call.
+func (h *Hasher) validatorNodes(validators []*phase0.Validator) ([][32]byte, error) {
+	if len(h.validators) > len(validators) {
+		h.validators = h.validators[:len(validators)]
+		h.validatorRoots = h.validatorRoots[:len(validators)]
+	}
+
+	nodes := make([][32]byte, len(validators))
+	changed := make([]int, 0)
+	for i, validator := range validators {
+		if i < len(h.validators) && validatorsEqual(&h.validators[i], validator) {
+			nodes[i] = h.validatorRoots[i]
+			continue
+		}
+		changed = append(changed, i)
+	}
+
+	// Hashing validators is the most expensive part of hashing a fresh state, so spread it across workers.
+	errs := make([]error, len(changed))
+	h.workers.run(len(changed), func(start int, end int) {
+		for j := start; j < end; j++ {
+			nodes[changed[j]], errs[j] = validators[changed[j]].HashTreeRoot()
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to hash validator")
+		}
+	}
+
+	for _, i := range changed {
+		// Retain a copy of the validator, as the original may be mutated in place.
+		cached := *validators[i]
+		cached.WithdrawalCredentials = append([]byte{}, validators[i].WithdrawalCredentials...)
+		if i < len(h.validators) {
+			h.validators[i] = cached
+			h.validatorRoots[i] = nodes[i]
+		} else {
+			h.validators = append(h.validators, cached)
+			h.validatorRoots = append(h.validatorRoots, nodes[i])
+		}
+	}
+
+	return nodes, nil
+}
+
+// validatorsEqual returns true if the two validators are the same.
+func validatorsEqual(v1 *phase0.Validator, v2 *phase0.Validator) bool {
+	return v1.PublicKey == v2.PublicKey &&
+		bytes.Equal(v1.WithdrawalCredentials, v2.WithdrawalCredentials) &&
+		v1.EffectiveBalance == v2.EffectiveBalance &&
+		v1.Slashed == v2.Slashed &&
+		v1.ActivationEligibilityEpoch == v2.ActivationEligibilityEpoch &&
+		v1.ActivationEpoch == v2.ActivationEpoch &&
+		v1.ExitEpoch == v2.ExitEpoch &&
+		v1.WithdrawableEpoch == v2.WithdrawableEpoch
+}
+
+// uint64Node returns the node for a uint64 value.
+func uint64Node(val uint64) [32]byte {
+	var node [32]byte
+	binary.LittleEndian.PutUint64(node[:8], val)
+	return node
+}
+
+// rootNodes returns the nodes for a list of roots.
+func rootNodes(roots []phase0.Root) [][32]byte {
+	nodes := make([][32]byte, len(roots))
+	for i := range roots {
+		nodes[i] = roots[i]
+	}
+	return nodes
+}
+
+// uint64Nodes returns the packed nodes for a list of uint64 values.
+func uint64Nodes(vals []uint64) [][32]byte {
+	nodes := make([][32]byte, (len(vals)+3)/4)
+	for i, val := range vals {
+		binary.LittleEndian.PutUint64(nodes[i/4][(i%4)*8:], val)
+	}
+	return nodes
+}
+
+// gweiNodes returns the packed nodes for a list of Gwei values.
+func gweiNodes(vals []phase0.Gwei) [][32]byte {
+	nodes := make([][32]byte, (len(vals)+3)/4)
+	for i, val := range vals {
+		binary.LittleEndian.PutUint64(nodes[i/4][(i%4)*8:], uint64(val))
+	}
+	return nodes
+}
+
+// participationNodes returns the packed nodes for a list of participation flags.
+func participationNodes(flags []altair.ParticipationFlags) [][32]byte {
+	nodes := make([][32]byte, (len(flags)+31)/32)
+	for i, flag := range flags {
+		nodes[i/32][i%32] = byte(flag)
+	}
+	return nodes
+}
@@ -0,0 +1,239 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashcache_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/hashcache"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testValidators(count int) ([]*phase0.Validator, []phase0.Gwei) {
+	validators := make([]*phase0.Validator, count)
+	balances := make([]phase0.Gwei, count)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ActivationEpoch:       phase0.Epoch(i),
+			ExitEpoch:             0xffffffffffffffff,
+			WithdrawableEpoch:     0xffffffffffffffff,
+		}
+		balances[i] = phase0.Gwei(32000000000 + i)
+	}
+	return validators, balances
+}
+
+func testSyncCommittee() *altair.SyncCommittee {
+	return &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+}
+
+func testCapellaState() *capella.BeaconState {
+	validators, balances := testValidators(100)
+	return &capella.BeaconState{
+		GenesisTime:                  1606824023,
+		GenesisValidatorsRoot:        phase0.Root{0x01},
+		Slot:                         12345,
+		Fork:                         &phase0.Fork{PreviousVersion: phase0.Version{0x02}, CurrentVersion: phase0.Version{0x03}, Epoch: 100},
+		LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+		BlockRoots:                   make([]phase0.Root, 8192),
+		StateRoots:                   make([]phase0.Root, 8192),
+		HistoricalRoots:              []phase0.Root{{0x04}},
+		ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes:                []*phase0.ETH1Data{{BlockHash: make([]byte, 32)}},
+		Validators:                   validators,
+		Balances:                     balances,
+		RANDAOMixes:                  make([]phase0.Root, 65536),
+		Slashings:                    make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:   make([]altair.ParticipationFlags, 100),
+		CurrentEpochParticipation:    make([]altair.ParticipationFlags, 100),
+		JustificationBits:            []byte{0x03},
+		PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+		FinalizedCheckpoint:          &phase0.Checkpoint{Epoch: 380, Root: phase0.Root{0x05}},
+		InactivityScores:             make([]uint64, 100),
+		CurrentSyncCommittee:         testSyncCommittee(),
+		NextSyncCommittee:            testSyncCommittee(),
+		LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+		HistoricalSummaries:          []*capella.HistoricalSummary{{}},
+	}
+}
+
+func TestCapella(t *testing.T) {
+	t.Run("Serial", func(t *testing.T) {
+		hasher, err := hashcache.New()
+		require.NoError(t, err)
+		testCapella(t, hasher)
+	})
+	t.Run("Parallel", func(t *testing.T) {
+		hasher, err := hashcache.New(
+			hashcache.WithParallelism(4),
+			hashcache.WithParallelismMinNodes(8),
+		)
+		require.NoError(t, err)
+		testCapella(t, hasher)
+	})
+}
+
+func testCapella(t *testing.T, hasher *hashcache.Hasher) {
+	state := testCapellaState()
+	versioned := &spec.VersionedBeaconState{Version: spec.DataVersionCapella, Capella: state}
+
+	mutations := []struct {
+		name   string
+		mutate func()
+	}{
+		{name: "Initial", mutate: func() {}},
+		{name: "Unchanged", mutate: func() {}},
+		{name: "Slot", mutate: func() { state.Slot++ }},
+		{name: "BlockRoot", mutate: func() { state.BlockRoots[17] = phase0.Root{0x06} }},
+		{name: "RANDAOMix", mutate: func() { state.RANDAOMixes[65535] = phase0.Root{0x07} }},
+		{name: "Balance", mutate: func() { state.Balances[50] += 1000 }},
+		{name: "ValidatorInPlace", mutate: func() { state.Validators[20].ExitEpoch = 500 }},
+		{name: "ValidatorCredentials", mutate: func() { state.Validators[21].WithdrawalCredentials[0] = 0x01 }},
+		{name: "Participation", mutate: func() { state.CurrentEpochParticipation[99] = 0x07 }},
+		{name: "InactivityScore", mutate: func() { state.InactivityScores[0] = 4 }},
+		{
+			name: "NewValidator",
+			mutate: func() {
+				validators, _ := testValidators(101)
+				state.Validators = append(state.Validators, validators[100])
+				state.Balances = append(state.Balances, 32000000000)
+				state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 0)
+				state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, 0)
+				state.InactivityScores = append(state.InactivityScores, 0)
+			},
+		},
+		{
+			name: "RemovedValidators",
+			mutate: func() {
+				state.Validators = state.Validators[:10]
+				state.Balances = state.Balances[:10]
+			},
+		},
+		{name: "HistoricalSummary", mutate: func() { state.HistoricalSummaries = append(state.HistoricalSummaries, &capella.HistoricalSummary{}) }},
+		{name: "ETH1DataVotes", mutate: func() { state.ETH1DataVotes = nil }},
+		{name: "ExecutionPayloadHeader", mutate: func() { state.LatestExecutionPayloadHeader.BlockNumber = 10 }},
+		{name: "NilContainer", mutate: func() { state.LatestBlockHeader = nil }},
+	}
+
+	for _, mutation := range mutations {
+		mutation.mutate()
+		root, err := hasher.HashTreeRoot(versioned)
+		require.NoError(t, err, mutation.name)
+		// Generated code replaces nil containers, so calculate the expected value afterwards.
+		expected, err := state.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, phase0.Root(expected), root, mutation.name)
+	}
+}
+
+func TestAltairAndBellatrix(t *testing.T) {
+	c := testCapellaState()
+	altairState := &altair.BeaconState{
+		GenesisTime:                 c.GenesisTime,
+		Slot:                        c.Slot,
+		Fork:                        c.Fork,
+		LatestBlockHeader:           c.LatestBlockHeader,
+		BlockRoots:                  c.BlockRoots,
+		StateRoots:                  c.StateRoots,
+		ETH1Data:                    c.ETH1Data,
+		Validators:                  c.Validators,
+		Balances:                    c.Balances,
+		RANDAOMixes:                 c.RANDAOMixes,
+		Slashings:                   c.Slashings,
+		PreviousEpochParticipation:  c.PreviousEpochParticipation,
+		CurrentEpochParticipation:   c.CurrentEpochParticipation,
+		JustificationBits:           c.JustificationBits,
+		PreviousJustifiedCheckpoint: c.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  c.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         c.FinalizedCheckpoint,
+		InactivityScores:            c.InactivityScores,
+		CurrentSyncCommittee:        c.CurrentSyncCommittee,
+		NextSyncCommittee:           c.NextSyncCommittee,
+	}
+	bellatrixState := &bellatrix.BeaconState{
+		GenesisTime:                  c.GenesisTime,
+		Slot:                         c.Slot,
+		Fork:                         c.Fork,
+		LatestBlockHeader:            c.LatestBlockHeader,
+		BlockRoots:                   c.BlockRoots,
+		StateRoots:                   c.StateRoots,
+		ETH1Data:                     c.ETH1Data,
+		Validators:                   c.Validators,
+		Balances:                     c.Balances,
+		RANDAOMixes:                  c.RANDAOMixes,
+		Slashings:                    c.Slashings,
+		PreviousEpochParticipation:   c.PreviousEpochParticipation,
+		CurrentEpochParticipation:    c.CurrentEpochParticipation,
+		JustificationBits:            c.JustificationBits,
+		PreviousJustifiedCheckpoint:  c.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   c.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:          c.FinalizedCheckpoint,
+		InactivityScores:             c.InactivityScores,
+		CurrentSyncCommittee:         c.CurrentSyncCommittee,
+		NextSyncCommittee:            c.NextSyncCommittee,
+		LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{},
+	}
+
+	hasher, err := hashcache.New()
+	require.NoError(t, err)
+	root, err := hasher.HashTreeRoot(&spec.VersionedBeaconState{Version: spec.DataVersionAltair, Altair: altairState})
+	require.NoError(t, err)
+	expected, err := altairState.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), root)
+
+	root, err = hasher.HashTreeRoot(&spec.VersionedBeaconState{Version: spec.DataVersionBellatrix, Bellatrix: bellatrixState})
+	require.NoError(t, err)
+	expected, err = bellatrixState.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), root)
+}
+
+func TestNew(t *testing.T) {
+	_, err := hashcache.New(hashcache.WithParallelism(0))
+	require.EqualError(t, err, "problem with parameters: parallelism must be at least 1")
+
+	_, err = hashcache.New(hashcache.WithParallelismMinNodes(0))
+	require.EqualError(t, err, "problem with parameters: parallelism minimum nodes must be at least 1")
+
+	_, err = hashcache.New(hashcache.WithParallelism(8))
+	require.NoError(t, err)
+}
+
+func TestErrors(t *testing.T) {
+	hasher, err := hashcache.New()
+	require.NoError(t, err)
+
+	_, err = hasher.HashTreeRoot(nil)
+	require.EqualError(t, err, "no state supplied")
+
+	_, err = hasher.HashTreeRoot(&spec.VersionedBeaconState{Version: spec.DataVersionCapella})
+	require.EqualError(t, err, "no Capella state")
+
+	state := testCapellaState()
+	state.RANDAOMixes = state.RANDAOMixes[:10]
+	_, err = hasher.HashTreeRoot(&spec.VersionedBeaconState{Version: spec.DataVersionCapella, Capella: state})
+	require.EqualError(t, err, "incorrect number of RANDAO mixes")
+}
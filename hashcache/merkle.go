@@ -0,0 +1,115 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashcache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// maxDepth is the maximum depth of a merkle tree.
+const maxDepth = 64
+
+// zeroHashes are the roots of empty trees of each depth.
+var zeroHashes [maxDepth + 1][32]byte
+
+func init() {
+	for i := 0; i < maxDepth; i++ {
+		zeroHashes[i+1] = hash(zeroHashes[i], zeroHashes[i])
+	}
+}
+
+// hash returns the hash of two concatenated nodes.
+func hash(left [32]byte, right [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], left[:])
+	copy(buf[32:], right[:])
+	return sha256.Sum256(buf[:])
+}
+
+// mixInLength mixes the length of a list in to its root.
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	var lengthNode [32]byte
+	binary.LittleEndian.PutUint64(lengthNode[:8], length)
+	return hash(root, lengthNode)
+}
+
+// depth returns the depth of a tree with the given number of leaves.
+func depth(leaves uint64) int {
+	if leaves <= 1 {
+		return 0
+	}
+	return bits.Len64(leaves - 1)
+}
+
+// tree is a merkle tree that retains its intermediate nodes, so that
+// subsequent roots only require rehashing the paths of changed leaves.
+type tree struct {
+	// layers are the nodes of the tree, with layers[0] being the leaves.
+	layers [][][32]byte
+	// workers hash the nodes of large layers; nil to hash on the calling goroutine.
+	workers *workers
+}
+
+// root updates the tree with the given leaves and returns its root.
+// The tree takes ownership of the leaves.
+func (t *tree) root(leaves [][32]byte, treeDepth int) [32]byte {
+	if len(leaves) == 0 {
+		t.layers = nil
+		return zeroHashes[treeDepth]
+	}
+	if len(t.layers) != treeDepth+1 || len(leaves) < len(t.layers[0]) {
+		// Tree shape has changed; start afresh.
+		t.layers = make([][][32]byte, treeDepth+1)
+	}
+
+	dirty := make([]int, 0)
+	for i := range leaves {
+		if i >= len(t.layers[0]) || t.layers[0][i] != leaves[i] {
+			dirty = append(dirty, i)
+		}
+	}
+	t.layers[0] = leaves
+
+	for level := 1; level <= treeDepth; level++ {
+		children := t.layers[level-1]
+		size := (len(children) + 1) / 2
+		layer := t.layers[level]
+		if len(layer) < size {
+			layer = append(layer, make([][32]byte, size-len(layer))...)
+		}
+
+		parents := dirty[:0]
+		for _, child := range dirty {
+			parent := child / 2
+			if len(parents) == 0 || parents[len(parents)-1] != parent {
+				parents = append(parents, parent)
+			}
+		}
+		t.workers.run(len(parents), func(start int, end int) {
+			for _, parent := range parents[start:end] {
+				right := zeroHashes[level-1]
+				if 2*parent+1 < len(children) {
+					right = children[2*parent+1]
+				}
+				layer[parent] = hash(children[2*parent], right)
+			}
+		})
+		t.layers[level] = layer
+		dirty = parents
+	}
+
+	return t.layers[treeDepth][0]
+}
@@ -0,0 +1,54 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashcache
+
+import (
+	"sync"
+)
+
+// workers splits work between goroutines.
+type workers struct {
+	parallelism int
+	minItems    int
+}
+
+// run calls fn over the range [0,items), splitting the range in to contiguous
+// chunks handled by separate goroutines if there are enough items to warrant it.
+// fn must only write to state owned by its own chunk.
+func (w *workers) run(items int, fn func(start int, end int)) {
+	if w == nil || w.parallelism <= 1 || items < w.minItems {
+		fn(0, items)
+		return
+	}
+
+	chunks := w.parallelism
+	if chunks > items {
+		chunks = items
+	}
+	chunkSize := (items + chunks - 1) / chunks
+
+	var wg sync.WaitGroup
+	for start := 0; start < items; start += chunkSize {
+		end := start + chunkSize
+		if end > items {
+			end = items
+		}
+		wg.Add(1)
+		go func(start int, end int) {
+			defer wg.Done()
+			fn(start, end)
+		}(start, end)
+	}
+	wg.Wait()
+}
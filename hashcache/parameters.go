@@ -0,0 +1,73 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashcache
+
+import (
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	parallelism         int
+	parallelismMinNodes int
+}
+
+// Parameter is the interface for hasher parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithParallelism sets the number of goroutines used to hash large lists.
+// Defaults to 1, which hashes everything on the calling goroutine.
+func WithParallelism(parallelism int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parallelism = parallelism
+	})
+}
+
+// WithParallelismMinNodes sets the minimum number of nodes that must be hashed
+// in a single pass before the work is split between goroutines.  Below this the
+// overhead of coordinating goroutines outweighs the gain.
+func WithParallelismMinNodes(minNodes int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parallelismMinNodes = minNodes
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		parallelism:         1,
+		parallelismMinNodes: 4096,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.parallelism < 1 {
+		return nil, errors.New("parallelism must be at least 1")
+	}
+	if parameters.parallelismMinNodes < 1 {
+		return nil, errors.New("parallelism minimum nodes must be at least 1")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testAltairState() *altair.BeaconState {
+	return &altair.BeaconState{
+		GenesisTime:                 1606824023,
+		Slot:                        12345,
+		Fork:                        &phase0.Fork{},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, 8192),
+		StateRoots:                  make([]phase0.Root, 8192),
+		HistoricalRoots:             []phase0.Root{},
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes:               []*phase0.ETH1Data{},
+		Validators:                  []*phase0.Validator{},
+		Balances:                    []phase0.Gwei{},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:  []altair.ParticipationFlags{},
+		CurrentEpochParticipation:   []altair.ParticipationFlags{},
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+		InactivityScores:            []uint64{},
+		CurrentSyncCommittee:        &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		NextSyncCommittee:           &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+	}
+}
+
+func TestServerChosenFormat(t *testing.T) {
+	ctx := context.Background()
+
+	state := testAltairState()
+	stateSSZ, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	block := &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Slot: 1,
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ProposerSlashings: []*phase0.ProposerSlashing{},
+				AttesterSlashings: []*phase0.AttesterSlashing{},
+				Attestations:      []*phase0.Attestation{},
+				Deposits:          []*phase0.Deposit{},
+				VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			},
+		},
+	}
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	accepts := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accepts[r.URL.Path] = r.Header.Get("Accept")
+		switch r.URL.Path {
+		case "/eth/v2/debug/beacon/states/head":
+			if !strings.HasPrefix(r.Header.Get("Accept"), codecs.SSZ.ContentType()) {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			w.Header().Set("Content-Type", codecs.SSZ.ContentType())
+			w.Header().Set("Eth-Consensus-Version", "altair")
+			_, _ = w.Write(stateSSZ)
+		case "/eth/v2/beacon/blocks/head":
+			w.Header().Set("Content-Type", codecs.SSZ.ContentType())
+			w.Header().Set("Eth-Consensus-Version", "phase0")
+			_, _ = w.Write(blockSSZ)
+		case "/eth/v2/beacon/blocks/1":
+			// Server does not state the version of binary data, so JSON is requested instead.
+			if r.Header.Get("Accept") != codecs.JSON.ContentType() {
+				w.Header().Set("Content-Type", codecs.SSZ.ContentType())
+				_, _ = w.Write(blockSSZ)
+				return
+			}
+			w.Header().Set("Content-Type", codecs.JSON.ContentType())
+			_, _ = fmt.Fprintf(w, `{"version":"phase0","data":%s}`, blockJSON)
+		case "/eth/v1/node/version":
+			// Server ignores the requested format.
+			w.Header().Set("Content-Type", codecs.SSZ.ContentType())
+			_, _ = w.Write([]byte{0x01, 0x02})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:                    zerolog.Nop(),
+		base:                   base,
+		address:                server.URL,
+		client:                 server.Client(),
+		timeout:                time.Second,
+		codec:                  codecs.SSZ,
+		supportsV2BeaconState:  true,
+		supportsV2BeaconBlocks: true,
+	}
+
+	res, err := s.BeaconState(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, "application/octet-stream;q=1,application/json;q=0.9", accepts["/eth/v2/debug/beacon/states/head"])
+	require.Equal(t, spec.DataVersionAltair, res.Version)
+	resSSZ, err := res.Altair.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, stateSSZ, resSSZ)
+
+	for _, blockID := range []string{"head", "1"} {
+		block, err := s.SignedBeaconBlock(ctx, blockID)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionPhase0, block.Version)
+		resSSZ, err = block.Phase0.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, blockSSZ, resSSZ)
+	}
+
+	_, err = s.NodeVersion(ctx)
+	require.EqualError(t, err, "failed to request node version: unsupported response content type application/octet-stream")
+	require.Equal(t, "application/json", accepts["/eth/v1/node/version"])
+}
@@ -30,6 +30,10 @@ type aggregateAttestationDataJSON struct {
 // AggregateAttestation fetches the aggregate attestation given an attestation.
 // N.B if an aggregate attestation for the attestation is not available this will return nil without an error.
 func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root) (*phase0.Attestation, error) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", slot, attestationDataRoot))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request aggregate attestation")
@@ -28,6 +28,10 @@ type attestationDataJSON struct {
 
 // AttestationData obtains attestation data for a slot.
 func (s *Service) AttestationData(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/attestation_data?slot=%d&committee_index=%d", slot, committeeIndex))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation data")
@@ -51,6 +55,9 @@ func (s *Service) AttestationData(ctx context.Context, slot phase0.Slot, committ
 	if attestationDataJSON.Data.Index != committeeIndex {
 		return nil, errors.New("attestation data not for requested committee index")
 	}
+	if err := s.checkHeadVote(attestationDataJSON.Data); err != nil {
+		return nil, err
+	}
 
 	return attestationDataJSON.Data, nil
 }
@@ -18,38 +18,113 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
-type attestationPoolJSON struct {
-	Data []*phase0.Attestation `json:"data"`
-}
-
 // AttestationPool obtains the attestation pool for a given slot.
 func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
+	attestations, _, err := s.AttestationPoolPage(ctx, slot, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
+
+// AttestationPoolPage obtains a page of the attestation pool for a given slot.
+// The returned flag is true if the pool has further entries after the page.
+// The node does not page the pool itself, so the response is read only as far as the
+// end of the page, with earlier entries skipped without being decoded.
+func (s *Service) AttestationPoolPage(ctx context.Context,
+	slot phase0.Slot,
+	opts *api.PageOptions,
+) (
+	[]*phase0.Attestation,
+	bool,
+	error,
+) {
+	respBody, err := s.getStream(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to request attestation pool")
+	}
+	if respBody == nil {
+		return nil, false, errors.New("failed to obtain attestation pool")
+	}
+	defer respBody.Close()
+
+	attestations := make([]*phase0.Attestation, 0)
+	more, err := decodeDataEntries(respBody, "data", opts, func(decoder *json.Decoder) error {
+		attestation, err := decodePoolAttestation(decoder, slot)
+		if err != nil {
+			return err
+		}
+		attestations = append(attestations, attestation)
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to parse attestation pool")
+	}
+
+	return attestations, more, nil
+}
+
+// AttestationPoolPages iterates over the attestation pool for a given slot, calling
+// the supplied function with successive pages of up to pageSize attestations.
+// Only a single page of attestations is held in memory at any time.
+func (s *Service) AttestationPoolPages(ctx context.Context,
+	slot phase0.Slot,
+	pageSize uint64,
+	fn func([]*phase0.Attestation) error,
+) error {
+	respBody, err := s.getStream(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to request attestation pool")
+		return errors.Wrap(err, "failed to request attestation pool")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain attestation pool")
+	if respBody == nil {
+		return errors.New("failed to obtain attestation pool")
 	}
+	defer respBody.Close()
 
-	var attestationPoolJSON attestationPoolJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&attestationPoolJSON); err != nil {
-		return nil, errors.Wrap(err, "failed to parse attestation pool")
+	page := make([]*phase0.Attestation, 0, pageSize)
+	err = iteratePages(ctx, respBody, "data", pageSize,
+		func(decoder *json.Decoder) error {
+			attestation, err := decodePoolAttestation(decoder, slot)
+			if err != nil {
+				return err
+			}
+			page = append(page, attestation)
+
+			return nil
+		},
+		func() error {
+			if err := fn(page); err != nil {
+				return err
+			}
+			page = make([]*phase0.Attestation, 0, pageSize)
+
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to iterate over attestation pool")
 	}
 
-	// Ensure the data returned to us is as expected given our input.
-	if attestationPoolJSON.Data == nil {
-		return nil, errors.New("attestation pool not returned")
+	return nil
+}
+
+// decodePoolAttestation decodes a single attestation from the attestation pool,
+// ensuring that it is as expected given our input.
+func decodePoolAttestation(decoder *json.Decoder, slot phase0.Slot) (*phase0.Attestation, error) {
+	var attestation phase0.Attestation
+	if err := decoder.Decode(&attestation); err != nil {
+		return nil, err
 	}
-	for i := range attestationPoolJSON.Data {
-		if attestationPoolJSON.Data[i].Data.Slot != slot {
-			return nil, errors.New("attestation pool entry not for requested slot")
-		}
+	if attestation.Data.Slot != slot {
+		return nil, errors.New("attestation pool entry not for requested slot")
 	}
 
-	return attestationPoolJSON.Data, nil
+	return &attestation, nil
 }
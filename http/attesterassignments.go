@@ -0,0 +1,35 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AllAttesterAssignments fetches the beacon committees for the given epoch and provides
+// the assignment of each validator in them, keyed by validator index.
+// Committees are obtained from the head state, so the epoch must be one for which the
+// node can calculate committees from its head.
+func (s *Service) AllAttesterAssignments(ctx context.Context, epoch phase0.Epoch) (map[phase0.ValidatorIndex]*api.AttesterAssignment, error) {
+	committees, err := s.BeaconCommitteesAtEpoch(ctx, "head", epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon committees")
+	}
+
+	return api.AttesterAssignments(committees), nil
+}
@@ -0,0 +1,85 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// beaconBlockHeadersRangeParallelism is the maximum number of concurrent requests made when fetching a range of headers.
+const beaconBlockHeadersRangeParallelism = 16
+
+// maxBeaconBlockHeadersRange is the maximum number of slots for which headers can be fetched in a single call.
+const maxBeaconBlockHeadersRange = 8192
+
+// BeaconBlockHeadersRange provides the block headers for a range of slots.
+// The returned slice has an entry for each slot in the range in order; slots without a block have a nil entry.
+// At most 8192 slots can be fetched in a single call.
+func (s *Service) BeaconBlockHeadersRange(ctx context.Context, startSlot phase0.Slot, count uint64) ([]*api.BeaconBlockHeader, error) {
+	if count > maxBeaconBlockHeadersRange {
+		return nil, fmt.Errorf("range of %d slots exceeds maximum of %d", count, maxBeaconBlockHeadersRange)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := make([]*api.BeaconBlockHeader, count)
+	var errMu sync.Mutex
+	var firstErr error
+
+	sem := make(chan struct{}, beaconBlockHeadersRangeParallelism)
+	var wg sync.WaitGroup
+	for i := uint64(0); i < count; i++ {
+		errMu.Lock()
+		failed := firstErr != nil
+		errMu.Unlock()
+		if failed {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			slot := startSlot + phase0.Slot(i)
+			header, err := s.BeaconBlockHeader(ctx, fmt.Sprintf("%d", slot))
+			if err != nil {
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrap(err, fmt.Sprintf("failed to obtain header for slot %d", slot))
+					cancel()
+				}
+				errMu.Unlock()
+				return
+			}
+			// Each goroutine writes to a distinct index so no lock is required.
+			res[i] = header
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return res, nil
+}
@@ -0,0 +1,31 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeadersRangeMaximum(t *testing.T) {
+	s := &Service{
+		log: zerolog.Nop(),
+	}
+
+	_, err := s.BeaconBlockHeadersRange(context.Background(), 0, maxBeaconBlockHeadersRange+1)
+	require.EqualError(t, err, "range of 8193 slots exceeds maximum of 8192")
+}
@@ -0,0 +1,75 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeadersRange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	head, err := service.(client.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.NotNil(t, head)
+	headSlot := head.Header.Message.Slot
+
+	tests := []struct {
+		name  string
+		count uint64
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:  "Single",
+			count: 1,
+		},
+		{
+			name:  "Multiple",
+			count: 64,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			startSlot := headSlot + 1
+			if uint64(startSlot) > test.count {
+				startSlot -= phase0.Slot(test.count)
+			}
+			res, err := service.(client.BeaconBlockHeadersRangeProvider).BeaconBlockHeadersRange(ctx, startSlot, test.count)
+			require.NoError(t, err)
+			require.Len(t, res, int(test.count))
+			for i, header := range res {
+				if header != nil {
+					require.Equal(t, startSlot+phase0.Slot(i), header.Header.Message.Slot)
+				}
+			}
+		})
+	}
+}
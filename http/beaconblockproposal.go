@@ -18,7 +18,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
@@ -32,20 +31,12 @@ type phase0BeaconBlockProposalJSON struct {
 	Data *phase0.BeaconBlock `json:"data"`
 }
 
-type altairBeaconBlockProposalJSON struct {
-	Data *altair.BeaconBlock `json:"data"`
-}
-
-type bellatrixBeaconBlockProposalJSON struct {
-	Data *bellatrix.BeaconBlock `json:"data"`
-}
-
-type capellaBeaconBlockProposalJSON struct {
-	Data *capella.BeaconBlock `json:"data"`
-}
-
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
 	// Graffiti should be 32 bytes.
 	fixedGraffiti := [32]byte{}
 	copy(fixedGraffiti[:], graffiti)
@@ -59,91 +50,81 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 // beaconBlockProposalV2 fetches a proposed beacon block for signing.
 func (s *Service) beaconBlockProposalV2(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
 	url := fmt.Sprintf("/eth/v2/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, graffiti)
-	respBodyReader, err := s.get(ctx, url)
+	resp, err := s.getVersioned(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon block proposal")
 	}
-	if respBodyReader == nil {
+	if resp == nil {
 		return nil, errors.New("failed to obtain beacon block proposal")
 	}
 
-	var dataBodyReader bytes.Buffer
-	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
-	var metadata responseMetadata
-	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
-		return nil, errors.Wrap(err, "failed to parse response")
-	}
 	res := &spec.VersionedBeaconBlock{
-		Version: metadata.Version,
+		Version: resp.version,
 	}
 
-	switch metadata.Version {
+	switch resp.version {
 	case spec.DataVersionPhase0:
-		var resp phase0BeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		res.Phase0 = &phase0.BeaconBlock{}
+		if err := resp.codec.Unmarshal(resp.data, res.Phase0); err != nil {
 			return nil, errors.Wrap(err, "failed to parse phase 0 beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
-		if resp.Data.Slot != slot {
+		if res.Phase0.Slot != slot {
 			return nil, errors.New("beacon block proposal not for requested slot")
 		}
-		if !bytes.Equal(resp.Data.Body.RANDAOReveal[:], randaoReveal[:]) {
+		if !bytes.Equal(res.Phase0.Body.RANDAOReveal[:], randaoReveal[:]) {
 			return nil, errors.New("beacon block proposal has incorrect RANDAO reveal")
 		}
-		if !bytes.Equal(resp.Data.Body.Graffiti[:], graffiti) {
+		if !bytes.Equal(res.Phase0.Body.Graffiti[:], graffiti) {
 			return nil, errors.New("beacon block proposal has incorrect graffiti")
 		}
-		res.Phase0 = resp.Data
 	case spec.DataVersionAltair:
-		var resp altairBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		res.Altair = &altair.BeaconBlock{}
+		if err := resp.codec.Unmarshal(resp.data, res.Altair); err != nil {
 			return nil, errors.Wrap(err, "failed to parse altair beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
-		if resp.Data.Slot != slot {
+		if res.Altair.Slot != slot {
 			return nil, errors.New("beacon block proposal not for requested slot")
 		}
-		if !bytes.Equal(resp.Data.Body.RANDAOReveal[:], randaoReveal[:]) {
+		if !bytes.Equal(res.Altair.Body.RANDAOReveal[:], randaoReveal[:]) {
 			return nil, errors.New("beacon block proposal has incorrect RANDAO reveal")
 		}
-		if !bytes.Equal(resp.Data.Body.Graffiti[:], graffiti) {
+		if !bytes.Equal(res.Altair.Body.Graffiti[:], graffiti) {
 			return nil, errors.New("beacon block proposal has incorrect graffiti")
 		}
-		res.Altair = resp.Data
 	case spec.DataVersionBellatrix:
-		var resp bellatrixBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		res.Bellatrix = &bellatrix.BeaconBlock{}
+		if err := resp.codec.Unmarshal(resp.data, res.Bellatrix); err != nil {
 			return nil, errors.Wrap(err, "failed to parse bellatrix beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
-		if resp.Data.Slot != slot {
+		if res.Bellatrix.Slot != slot {
 			return nil, errors.New("beacon block proposal not for requested slot")
 		}
-		if !bytes.Equal(resp.Data.Body.RANDAOReveal[:], randaoReveal[:]) {
+		if !bytes.Equal(res.Bellatrix.Body.RANDAOReveal[:], randaoReveal[:]) {
 			return nil, errors.New("beacon block proposal has incorrect RANDAO reveal")
 		}
-		if !bytes.Equal(resp.Data.Body.Graffiti[:], graffiti) {
+		if !bytes.Equal(res.Bellatrix.Body.Graffiti[:], graffiti) {
 			return nil, errors.New("beacon block proposal has incorrect graffiti")
 		}
-		res.Bellatrix = resp.Data
 	case spec.DataVersionCapella:
-		var resp capellaBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		res.Capella = &capella.BeaconBlock{}
+		if err := resp.codec.Unmarshal(resp.data, res.Capella); err != nil {
 			return nil, errors.Wrap(err, "failed to parse capella beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
-		if resp.Data.Slot != slot {
+		if res.Capella.Slot != slot {
 			return nil, errors.New("beacon block proposal not for requested slot")
 		}
-		if !bytes.Equal(resp.Data.Body.RANDAOReveal[:], randaoReveal[:]) {
+		if !bytes.Equal(res.Capella.Body.RANDAOReveal[:], randaoReveal[:]) {
 			return nil, errors.New("beacon block proposal has incorrect RANDAO reveal")
 		}
-		if !bytes.Equal(resp.Data.Body.Graffiti[:], graffiti) {
+		if !bytes.Equal(res.Capella.Body.Graffiti[:], graffiti) {
 			return nil, errors.New("beacon block proposal has incorrect graffiti")
 		}
-		res.Capella = resp.Data
 	default:
-		return nil, fmt.Errorf("unsupported block version %s", metadata.Version)
+		return nil, fmt.Errorf("unsupported block version %s", resp.version)
 	}
 
 	return res, nil
@@ -14,11 +14,9 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
@@ -32,18 +30,6 @@ type phase0BeaconStateJSON struct {
 	Data *phase0.BeaconState `json:"data"`
 }
 
-type altairBeaconStateJSON struct {
-	Data *altair.BeaconState `json:"data"`
-}
-
-type bellatrixBeaconStateJSON struct {
-	Data *bellatrix.BeaconState `json:"data"`
-}
-
-type capellaBeaconStateJSON struct {
-	Data *capella.BeaconState `json:"data"`
-}
-
 // BeaconState fetches a beacon state.
 // N.B if the requested beacon state is not available this will return nil without an error.
 func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
@@ -75,52 +61,39 @@ func (s *Service) beaconStateV1(ctx context.Context, stateID string) (*spec.Vers
 	}, nil
 }
 
-// beaconStateV2 fetches a beacon state from the V2 endpoint.
+// beaconStateV2 fetches a beacon state from the V2 endpoint, preferring the service's codec
+// if the server supports it.
 func (s *Service) beaconStateV2(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
-	url := fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID)
-	respBodyReader, err := s.get(ctx, url)
+	resp, err := s.getVersioned(ctx, fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon state")
 	}
-	if respBodyReader == nil {
+	if resp == nil {
 		return nil, nil
 	}
 
-	var dataBodyReader bytes.Buffer
-	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
-	var metadata responseMetadata
-	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
-		return nil, errors.Wrap(err, "failed to parse response")
-	}
 	res := &spec.VersionedBeaconState{
-		Version: metadata.Version,
+		Version: resp.version,
 	}
 
-	switch metadata.Version {
+	switch resp.version {
 	case spec.DataVersionPhase0:
-		var resp phase0BeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse phase 0 beacon state")
-		}
-		res.Phase0 = resp.Data
+		res.Phase0 = &phase0.BeaconState{}
+		err = resp.codec.Unmarshal(resp.data, res.Phase0)
 	case spec.DataVersionAltair:
-		var resp altairBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse altair beacon state")
-		}
-		res.Altair = resp.Data
+		res.Altair = &altair.BeaconState{}
+		err = resp.codec.Unmarshal(resp.data, res.Altair)
 	case spec.DataVersionBellatrix:
-		var resp bellatrixBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse bellatrix beacon state")
-		}
-		res.Bellatrix = resp.Data
+		res.Bellatrix = &bellatrix.BeaconState{}
+		err = resp.codec.Unmarshal(resp.data, res.Bellatrix)
 	case spec.DataVersionCapella:
-		var resp capellaBeaconStateJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse capella beacon state")
-		}
-		res.Capella = resp.Data
+		res.Capella = &capella.BeaconState{}
+		err = resp.codec.Unmarshal(resp.data, res.Capella)
+	default:
+		return nil, fmt.Errorf("unhandled state version %s", resp.version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s beacon state", resp.version))
 	}
 
 	return res, nil
@@ -0,0 +1,143 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconStateNear fetches the beacon state at the given slot or, if the node does not
+// have it, for example because it has been pruned, the available state nearest to it
+// within the bounds of the options.  Other than the requested slot only epoch boundary
+// slots are considered, as these are the checkpoint states that pruned nodes retain,
+// and states after the node's head are not considered.
+// If opts is nil the search is in both directions up to an epoch from the requested slot.
+// N.B if no state is available within the bounds this will return nil without an error.
+func (s *Service) BeaconStateNear(ctx context.Context,
+	slot phase0.Slot,
+	opts *api.BeaconStateNearOptions,
+) (
+	*api.BeaconStateNear,
+	error,
+) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if opts == nil {
+		opts = &api.BeaconStateNearOptions{
+			Direction:   api.StateSearchNearest,
+			MaxDistance: slotsPerEpoch,
+		}
+	}
+
+	headSlot := slot
+	if opts.Direction != api.StateSearchBackward && opts.MaxDistance > 0 {
+		syncState, err := s.NodeSyncing(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain sync state")
+		}
+		if syncState == nil {
+			return nil, errors.New("no sync state returned")
+		}
+		if syncState.HeadSlot > headSlot {
+			headSlot = syncState.HeadSlot
+		}
+	}
+
+	for _, candidate := range stateSearchSlots(slot, slotsPerEpoch, opts, headSlot) {
+		state, err := s.BeaconState(ctx, fmt.Sprintf("%d", candidate))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain beacon state at slot %d", candidate)
+		}
+		if state == nil {
+			// Not available; try the next candidate.
+			continue
+		}
+		if candidate != slot {
+			s.log.Trace().Uint64("requested_slot", uint64(slot)).Uint64("slot", uint64(candidate)).Msg("Serving nearby state")
+		}
+
+		return &api.BeaconStateNear{
+			RequestedSlot: slot,
+			Slot:          candidate,
+			State:         state,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// stateSearchSlots returns the slots at which to search for a state, in order of preference.
+func stateSearchSlots(slot phase0.Slot,
+	slotsPerEpoch uint64,
+	opts *api.BeaconStateNearOptions,
+	headSlot phase0.Slot,
+) []phase0.Slot {
+	res := []phase0.Slot{slot}
+	if slotsPerEpoch == 0 {
+		return res
+	}
+
+	backward := opts.Direction != api.StateSearchForward
+	forward := opts.Direction != api.StateSearchBackward
+
+	// Epoch boundaries either side of the slot.
+	var before, after phase0.Slot
+	before = slot - slot%phase0.Slot(slotsPerEpoch)
+	if before == slot {
+		if slot >= phase0.Slot(slotsPerEpoch) {
+			before -= phase0.Slot(slotsPerEpoch)
+		} else {
+			backward = false
+		}
+	}
+	after = before + phase0.Slot(slotsPerEpoch)
+	if after == slot {
+		after += phase0.Slot(slotsPerEpoch)
+	}
+
+	for backward || forward {
+		if backward {
+			if uint64(slot-before) > opts.MaxDistance {
+				backward = false
+			}
+		}
+		if forward {
+			if uint64(after-slot) > opts.MaxDistance || after > headSlot {
+				forward = false
+			}
+		}
+
+		switch {
+		case backward && (!forward || slot-before <= after-slot):
+			res = append(res, before)
+			if before < phase0.Slot(slotsPerEpoch) {
+				backward = false
+			} else {
+				before -= phase0.Slot(slotsPerEpoch)
+			}
+		case forward:
+			res = append(res, after)
+			after += phase0.Slot(slotsPerEpoch)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,164 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateSearchSlots(t *testing.T) {
+	tests := []struct {
+		name      string
+		slot      phase0.Slot
+		direction api.StateSearchDirection
+		distance  uint64
+		head      phase0.Slot
+		expected  []phase0.Slot
+	}{
+		{
+			name:     "NoDistance",
+			slot:     70,
+			distance: 0,
+			head:     1000,
+			expected: []phase0.Slot{70},
+		},
+		{
+			name:     "Nearest",
+			slot:     70,
+			distance: 64,
+			head:     1000,
+			expected: []phase0.Slot{70, 64, 96, 32, 128},
+		},
+		{
+			name:     "NearestTie",
+			slot:     80,
+			distance: 16,
+			head:     1000,
+			expected: []phase0.Slot{80, 64, 96},
+		},
+		{
+			name:      "Backward",
+			slot:      70,
+			direction: api.StateSearchBackward,
+			distance:  64,
+			head:      1000,
+			expected:  []phase0.Slot{70, 64, 32},
+		},
+		{
+			name:      "BackwardToGenesis",
+			slot:      40,
+			direction: api.StateSearchBackward,
+			distance:  1000,
+			head:      1000,
+			expected:  []phase0.Slot{40, 32, 0},
+		},
+		{
+			name:      "Forward",
+			slot:      70,
+			direction: api.StateSearchForward,
+			distance:  64,
+			head:      1000,
+			expected:  []phase0.Slot{70, 96, 128},
+		},
+		{
+			name:      "ForwardToHead",
+			slot:      70,
+			direction: api.StateSearchForward,
+			distance:  1000,
+			head:      130,
+			expected:  []phase0.Slot{70, 96, 128},
+		},
+		{
+			name:     "EpochBoundary",
+			slot:     64,
+			distance: 32,
+			head:     1000,
+			expected: []phase0.Slot{64, 32, 96},
+		},
+		{
+			name:     "Genesis",
+			slot:     0,
+			distance: 64,
+			head:     1000,
+			expected: []phase0.Slot{0, 32, 64},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &api.BeaconStateNearOptions{
+				Direction:   test.direction,
+				MaxDistance: test.distance,
+			}
+			require.Equal(t, test.expected, stateSearchSlots(test.slot, 32, opts, test.head))
+		})
+	}
+}
+
+func TestBeaconStateNear(t *testing.T) {
+	ctx := context.Background()
+
+	available := map[string]bool{
+		"/eth/v1/debug/beacon/states/96": true,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32"}}`)
+		case r.URL.Path == "/eth/v1/node/syncing":
+			fmt.Fprint(w, `{"data":{"head_slot":"200","sync_distance":"0","is_syncing":false}}`)
+		case available[r.URL.Path]:
+			fmt.Fprint(w, `{"data":null}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		cache:   testValueCache(),
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+
+	res, err := s.BeaconStateNear(ctx, 70, nil)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, phase0.Slot(70), res.RequestedSlot)
+	require.Equal(t, phase0.Slot(96), res.Slot)
+
+	res, err = s.BeaconStateNear(ctx, 70, &api.BeaconStateNearOptions{
+		Direction:   api.StateSearchBackward,
+		MaxDistance: 64,
+	})
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
@@ -16,9 +16,7 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
@@ -28,16 +26,12 @@ import (
 	"github.com/pkg/errors"
 )
 
-type bellatrixBlindedBeaconBlockProposalJSON struct {
-	Data *apiv1bellatrix.BlindedBeaconBlock `json:"data"`
-}
-
-type capellaBlindedBeaconBlockProposalJSON struct {
-	Data *apiv1capella.BlindedBeaconBlock `json:"data"`
-}
-
 // BlindedBeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
 	// Graffiti should be 32 bytes.
 	fixedGraffiti := make([]byte, 32)
 	copy(fixedGraffiti, graffiti)
@@ -48,59 +42,51 @@ func (s *Service) BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Sl
 // blindedBeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) blindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
 	url := fmt.Sprintf("/eth/v1/validator/blinded_blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, graffiti)
-	respBodyReader, err := s.get(ctx, url)
+	resp, err := s.getVersioned(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request blinded beacon block proposal")
 	}
-	if respBodyReader == nil {
+	if resp == nil {
 		return nil, errors.New("blinded beacon block proposal response empty")
 	}
 
-	var dataBodyReader bytes.Buffer
-	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
-	var metadata responseMetadata
-	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
-		return nil, errors.Wrap(err, "failed to parse response")
-	}
 	res := &api.VersionedBlindedBeaconBlock{
-		Version: metadata.Version,
+		Version: resp.version,
 	}
 
-	switch metadata.Version {
+	switch resp.version {
 	case spec.DataVersionBellatrix:
-		var resp bellatrixBlindedBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		res.Bellatrix = &apiv1bellatrix.BlindedBeaconBlock{}
+		if err := resp.codec.Unmarshal(resp.data, res.Bellatrix); err != nil {
 			return nil, errors.Wrap(err, "failed to parse bellatrix blinded beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
-		if resp.Data.Slot != slot {
+		if res.Bellatrix.Slot != slot {
 			return nil, errors.New("blinded beacon block proposal not for requested slot")
 		}
-		if !bytes.Equal(resp.Data.Body.RANDAOReveal[:], randaoReveal[:]) {
+		if !bytes.Equal(res.Bellatrix.Body.RANDAOReveal[:], randaoReveal[:]) {
 			return nil, errors.New("blinded beacon block proposal has incorrect RANDAO reveal")
 		}
-		if !bytes.Equal(resp.Data.Body.Graffiti[:], graffiti) {
+		if !bytes.Equal(res.Bellatrix.Body.Graffiti[:], graffiti) {
 			return nil, errors.New("blinded beacon block proposal has incorrect graffiti")
 		}
-		res.Bellatrix = resp.Data
 	case spec.DataVersionCapella:
-		var resp capellaBlindedBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		res.Capella = &apiv1capella.BlindedBeaconBlock{}
+		if err := resp.codec.Unmarshal(resp.data, res.Capella); err != nil {
 			return nil, errors.Wrap(err, "failed to parse capella blinded beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
-		if resp.Data.Slot != slot {
+		if res.Capella.Slot != slot {
 			return nil, errors.New("blinded beacon block proposal not for requested slot")
 		}
-		if !bytes.Equal(resp.Data.Body.RANDAOReveal[:], randaoReveal[:]) {
+		if !bytes.Equal(res.Capella.Body.RANDAOReveal[:], randaoReveal[:]) {
 			return nil, errors.New("blinded beacon block proposal has incorrect RANDAO reveal")
 		}
-		if !bytes.Equal(resp.Data.Body.Graffiti[:], graffiti) {
+		if !bytes.Equal(res.Capella.Body.Graffiti[:], graffiti) {
 			return nil, errors.New("blinded beacon block proposal has incorrect graffiti")
 		}
-		res.Capella = resp.Data
 	default:
-		return nil, fmt.Errorf("unsupported block version %s", metadata.Version)
+		return nil, fmt.Errorf("unsupported block version %s", resp.version)
 	}
 
 	return res, nil
@@ -0,0 +1,163 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Cassette holds responses from a beacon node, recorded from live requests and replayed
+// later without access to the node.  Responses are keyed by the method, endpoint including
+// query parameters, accept header and body of the request.  Where the same request is made
+// multiple times the responses are replayed in the order they were recorded, with the last
+// response repeated once they are exhausted.
+type Cassette struct {
+	path string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	// replayed is the number of times each key has been replayed.
+	replayed map[string]int
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Key      string    `json:"key"`
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// NewCassette creates a new empty cassette that will be saved to the given path.
+func NewCassette(path string) *Cassette {
+	return &Cassette{
+		path:     path,
+		replayed: make(map[string]int),
+	}
+}
+
+// LoadCassette loads a cassette from the given path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read cassette")
+	}
+	c := NewCassette(path)
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, errors.Wrap(err, "failed to parse cassette")
+	}
+
+	return c, nil
+}
+
+// Save saves the cassette to its path.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal cassette")
+	}
+	if err := os.WriteFile(c.path, data, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write cassette")
+	}
+
+	return nil
+}
+
+// Interactions returns the interactions in the cassette, in the order they were recorded.
+func (c *Cassette) Interactions() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]*Interaction, len(c.interactions))
+	copy(res, c.interactions)
+
+	return res
+}
+
+// RecordMiddleware returns middleware that records the responses to requests in to the
+// cassette.  Requests that fail without a response are not recorded.  The cassette must be
+// saved once recording is complete.
+func (c *Cassette) RecordMiddleware() Middleware {
+	return func(next CallFunc) CallFunc {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			resp, err := next(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			c.mu.Lock()
+			c.interactions = append(c.interactions, &Interaction{
+				Key:      cassetteKey(req),
+				Request:  req,
+				Response: resp,
+			})
+			c.mu.Unlock()
+
+			return resp, nil
+		}
+	}
+}
+
+// ReplayMiddleware returns middleware that serves responses from the cassette.  Requests
+// are never passed on to the node, and requests without a recorded response fail.
+func (c *Cassette) ReplayMiddleware() Middleware {
+	return func(_ CallFunc) CallFunc {
+		return func(_ context.Context, req *Request) (*Response, error) {
+			key := cassetteKey(req)
+
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			var matched *Response
+			seen := 0
+			for _, interaction := range c.interactions {
+				if interaction.Key != key {
+					continue
+				}
+				matched = interaction.Response
+				if seen == c.replayed[key] {
+					break
+				}
+				seen++
+			}
+			if matched == nil {
+				return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.Endpoint)
+			}
+			c.replayed[key]++
+
+			return matched, nil
+		}
+	}
+}
+
+// cassetteKey returns the key for a request.
+func cassetteKey(req *Request) string {
+	key := fmt.Sprintf("%s %s", req.Method, req.Endpoint)
+	if accept := req.Headers.Get("Accept"); accept != "" {
+		key = fmt.Sprintf("%s accept=%s", key, accept)
+	}
+	if len(req.Body) > 0 {
+		key = fmt.Sprintf("%s body=%x", key, sha256.Sum256(req.Body))
+	}
+
+	return key
+}
@@ -0,0 +1,111 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassette(t *testing.T) {
+	ctx := context.Background()
+
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":"%d"}`, hits)
+	}))
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	// Record.
+	recording := NewCassette(path)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+	s.call = chain(s.do, []Middleware{recording.RecordMiddleware()})
+
+	res, err := s.get2(ctx, "/eth/v1/test?id=1", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"1"}`, string(res.body))
+	res, err = s.get2(ctx, "/eth/v1/test?id=1", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"2"}`, string(res.body))
+	res, err = s.get2(ctx, "/eth/v1/test?id=2", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"3"}`, string(res.body))
+	_, err = s.post(ctx, "/eth/v1/submit", bytes.NewBufferString(`{"a":1}`))
+	require.NoError(t, err)
+	require.Len(t, recording.Interactions(), 4)
+	require.NoError(t, recording.Save())
+	server.Close()
+
+	// Replay, with the server no longer available.
+	replaying, err := LoadCassette(path)
+	require.NoError(t, err)
+	s.call = chain(s.do, []Middleware{replaying.ReplayMiddleware()})
+
+	res, err = s.get2(ctx, "/eth/v1/test?id=2", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"3"}`, string(res.body))
+	require.Equal(t, "application/json", res.contentType)
+	res, err = s.get2(ctx, "/eth/v1/test?id=1", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"1"}`, string(res.body))
+	res, err = s.get2(ctx, "/eth/v1/test?id=1", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"2"}`, string(res.body))
+	// Responses are exhausted, so the last is repeated.
+	res, err = s.get2(ctx, "/eth/v1/test?id=1", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"2"}`, string(res.body))
+
+	resp, err := s.post2(ctx, "/eth/v1/submit", bytes.NewBufferString(`{"a":1}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.statusCode)
+
+	// Requests that differ in any of the keyed components are not matched.
+	_, err = s.get2(ctx, "/eth/v1/test?id=3", "application/json")
+	require.EqualError(t, err, "failed to call GET endpoint: no recorded response for GET /eth/v1/test?id=3")
+	_, err = s.get2(ctx, "/eth/v1/test?id=1", "application/octet-stream")
+	require.EqualError(t, err, "failed to call GET endpoint: no recorded response for GET /eth/v1/test?id=1")
+	_, err = s.post(ctx, "/eth/v1/submit", bytes.NewBufferString(`{"a":2}`))
+	require.EqualError(t, err, "failed to call POST endpoint: no recorded response for POST /eth/v1/submit")
+}
+
+func TestLoadCassetteErrors(t *testing.T) {
+	_, err := LoadCassette(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to read cassette")
+}
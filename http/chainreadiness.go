@@ -0,0 +1,77 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ChainReadiness provides the readiness of the node to be used for proposals.  The node
+// is not ready if it is syncing, its execution client is offline, its head is optimistic,
+// or it has fewer connected peers than the minimum given by WithMinPeers.  If the service
+// rejects calls when syncing then the node is considered to be syncing only when its sync
+// distance is beyond that permitted by WithRejectWhenSyncing.
+func (s *Service) ChainReadiness(ctx context.Context) (*api.ChainReadiness, error) {
+	syncState, err := s.NodeSyncing(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain sync state")
+	}
+	if syncState == nil {
+		return nil, errors.New("no sync state returned")
+	}
+
+	res := &api.ChainReadiness{
+		SyncState: syncState,
+	}
+	switch {
+	case s.syncing(syncState):
+		res.Reason = api.ReadinessReasonSyncing
+	case syncState.ELOffline:
+		res.Reason = api.ReadinessReasonELOffline
+	case syncState.IsOptimistic:
+		res.Reason = api.ReadinessReasonOptimistic
+	}
+	if res.Reason != api.ReadinessReasonNone {
+		return res, nil
+	}
+
+	peerCount, err := s.NodePeerCount(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain peer count")
+	}
+	if peerCount == nil {
+		return nil, errors.New("no peer count returned")
+	}
+	res.PeerCount = peerCount
+	if peerCount.Connected < s.minPeers {
+		res.Reason = api.ReadinessReasonInsufficientPeers
+		return res, nil
+	}
+
+	res.Ready = true
+
+	return res, nil
+}
+
+// syncing returns true if the sync state shows the node to be syncing.
+func (s *Service) syncing(syncState *api.SyncState) bool {
+	if s.rejectWhenSyncing {
+		return syncState.SyncDistance > s.maxSyncDistance
+	}
+
+	return syncState.IsSyncing
+}
@@ -0,0 +1,122 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainReadiness(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		syncing           string
+		connected         uint64
+		minPeers          uint64
+		rejectWhenSyncing bool
+		maxSyncDistance   phase0.Slot
+		reason            api.ReadinessReason
+	}{
+		{
+			name:      "Ready",
+			syncing:   `{"head_slot":"10","sync_distance":"0","is_syncing":false,"is_optimistic":false,"el_offline":false}`,
+			connected: 5,
+			minPeers:  1,
+		},
+		{
+			name:      "Syncing",
+			syncing:   `{"head_slot":"10","sync_distance":"5","is_syncing":true,"is_optimistic":false,"el_offline":false}`,
+			connected: 5,
+			minPeers:  1,
+			reason:    api.ReadinessReasonSyncing,
+		},
+		{
+			name:              "SyncingWithinDistance",
+			syncing:           `{"head_slot":"10","sync_distance":"2","is_syncing":true,"is_optimistic":false,"el_offline":false}`,
+			connected:         5,
+			minPeers:          1,
+			rejectWhenSyncing: true,
+			maxSyncDistance:   2,
+		},
+		{
+			name:      "ELOffline",
+			syncing:   `{"head_slot":"10","sync_distance":"0","is_syncing":false,"is_optimistic":true,"el_offline":true}`,
+			connected: 5,
+			minPeers:  1,
+			reason:    api.ReadinessReasonELOffline,
+		},
+		{
+			name:      "Optimistic",
+			syncing:   `{"head_slot":"10","sync_distance":"0","is_syncing":false,"is_optimistic":true}`,
+			connected: 5,
+			minPeers:  1,
+			reason:    api.ReadinessReasonOptimistic,
+		},
+		{
+			name:      "InsufficientPeers",
+			syncing:   `{"head_slot":"10","sync_distance":"0","is_syncing":false,"is_optimistic":false}`,
+			connected: 2,
+			minPeers:  3,
+			reason:    api.ReadinessReasonInsufficientPeers,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/eth/v1/node/syncing":
+					fmt.Fprintf(w, `{"data":%s}`, test.syncing)
+				case "/eth/v1/node/peer_count":
+					fmt.Fprintf(w, `{"data":{"disconnected":"0","connecting":"0","connected":"%d","disconnecting":"0"}}`, test.connected)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:               zerolog.Nop(),
+				base:              base,
+				address:           server.URL,
+				client:            server.Client(),
+				timeout:           time.Second,
+				minPeers:          test.minPeers,
+				rejectWhenSyncing: test.rejectWhenSyncing,
+				maxSyncDistance:   test.maxSyncDistance,
+			}
+
+			readiness, err := s.ChainReadiness(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.reason, readiness.Reason)
+			require.Equal(t, test.reason == api.ReadinessReasonNone, readiness.Ready)
+			require.NotNil(t, readiness.SyncState)
+		})
+	}
+}
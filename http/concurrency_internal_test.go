@@ -0,0 +1,105 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConcurrentStaticValues exercises concurrent access to the cached static values
+// whilst they are cleared; it is most useful when run with the race detector.
+func TestConcurrentStaticValues(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12","DEPOSIT_CONTRACT_ADDRESS":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`))
+		case "/eth/v1/beacon/genesis":
+			_, _ = w.Write([]byte(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`))
+		case "/eth/v1/config/fork_schedule":
+			_, _ = w.Write([]byte(`{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	s := &Service{
+		cache:   testValueCache(),
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			spec, err := s.Spec(ctx)
+			require.NoError(t, err)
+			// Callers are free to modify the returned spec.
+			spec["SLOTS_PER_EPOCH"] = uint64(0)
+			spec["DEPOSIT_CONTRACT_ADDRESS"].([]byte)[0] = 0xff
+		}()
+		go func() {
+			defer wg.Done()
+			slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, uint64(32), slotsPerEpoch)
+		}()
+		go func() {
+			defer wg.Done()
+			genesis, err := s.Genesis(ctx)
+			require.NoError(t, err)
+			genesis.GenesisTime = time.Time{}
+			forkSchedule, err := s.ForkSchedule(ctx)
+			require.NoError(t, err)
+			forkSchedule[0].Epoch = 1
+		}()
+		go func() {
+			defer wg.Done()
+			// As carried out by periodicClearStaticValues.
+			s.uncache(specCacheName, genesisCacheName)
+		}()
+	}
+	wg.Wait()
+
+	// Modifications by callers must not have affected the cached values.
+	spec, err := s.Spec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), spec["SLOTS_PER_EPOCH"])
+	require.Equal(t, byte(0x00), spec["DEPOSIT_CONTRACT_ADDRESS"].([]byte)[0])
+	genesisTime, err := s.GenesisTime(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1606824023), genesisTime.Unix())
+	forkSchedule, err := s.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), uint64(forkSchedule[0].Epoch))
+}
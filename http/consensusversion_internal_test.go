@@ -0,0 +1,69 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownConsensusVersion(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Eth-Consensus-Version", "unknownfork")
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		cache:   testValueCache(),
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+
+	// Endpoints that do not need the version are unaffected.
+	spec, err := s.cachedSpec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), spec["SLOTS_PER_EPOCH"])
+
+	// Endpoints that need the version fail when decoding.
+	httpResp := &httpResponse{
+		headers: http.Header{
+			"Eth-Consensus-Version":         []string{"unknownfork"},
+			"Eth-Execution-Payload-Blinded": []string{"false"},
+		},
+	}
+	_, err = proposalFromResponse(httpResp, &proposalJSON{}, nil, nil)
+	require.EqualError(t, err, `unsupported consensus version: unrecognised data version "unknownfork"`)
+}
@@ -26,19 +26,32 @@ type depositContractJSON struct {
 }
 
 // DepositContract provides details of the Ethereum 1 deposit contract for the chain.
+// The returned deposit contract is a copy, so callers are free to modify it.
 func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, error) {
-	s.depositContractMutex.RLock()
-	if s.depositContract != nil {
-		defer s.depositContractMutex.RUnlock()
-		return s.depositContract, nil
+	depositContract, err := s.cachedDepositContract(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return depositContract.Copy(), nil
+}
+
+// cachedDepositContract provides the deposit contract, fetching it if not already cached.
+// The returned deposit contract is shared between callers and must not be modified.
+func (s *Service) cachedDepositContract(ctx context.Context) (*api.DepositContract, error) {
+	if value, exists := s.cached(depositContractCacheName); exists {
+		if depositContract, isType := value.(*api.DepositContract); isType {
+			return depositContract, nil
+		}
 	}
-	s.depositContractMutex.RUnlock()
 
 	s.depositContractMutex.Lock()
 	defer s.depositContractMutex.Unlock()
-	if s.depositContract != nil {
-		// Someone else fetched this whilst we were waiting for the lock.
-		return s.depositContract, nil
+	if value, exists := s.cached(depositContractCacheName); exists {
+		if depositContract, isType := value.(*api.DepositContract); isType {
+			// Someone else fetched this whilst we were waiting for the lock.
+			return depositContract, nil
+		}
 	}
 
 	// Up to us to fetch the information.
@@ -54,6 +67,7 @@ func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, er
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse deposit contract")
 	}
-	s.depositContract = resp.Data
-	return s.depositContract, nil
+	s.cache.Set(s.cacheKey(depositContractCacheName), resp.Data)
+
+	return resp.Data, nil
 }
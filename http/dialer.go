@@ -0,0 +1,136 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/proxy"
+)
+
+// unixAddressPrefix is the prefix for addresses that refer to a unix domain socket.
+const unixAddressPrefix = "unix://"
+
+// dialContextFunc is a function that dials a connection.
+type dialContextFunc func(ctx context.Context, network string, address string) (net.Conn, error)
+
+// parseProxy parses and checks a proxy URL.
+func parseProxy(proxyURL string) (*url.URL, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proxy URL")
+	}
+	switch u.Scheme {
+	case "socks5", "socks5h", "http", "https":
+	default:
+		return nil, errors.New("unsupported proxy scheme")
+	}
+	if u.Host == "" {
+		return nil, errors.New("no proxy host specified")
+	}
+
+	return u, nil
+}
+
+// dialer returns a function to dial connections to the node with the given timeout,
+// through any configured unix socket or SOCKS proxy.
+func (s *Service) dialer(timeout time.Duration, keepAlive time.Duration) dialContextFunc {
+	netDialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: keepAlive,
+	}
+
+	switch {
+	case s.unixSocket != "":
+		return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+			return netDialer.DialContext(ctx, "unix", s.unixSocket)
+		}
+	case s.proxyURL != nil && (s.proxyURL.Scheme == "socks5" || s.proxyURL.Scheme == "socks5h"):
+		// Parameters are checked, so no error can occur.
+		socksDialer, _ := proxy.FromURL(s.proxyURL, netDialer)
+		return func(ctx context.Context, network string, address string) (net.Conn, error) {
+			// SOCKS dialers from this package always support contexts.
+			return socksDialer.(proxy.ContextDialer).DialContext(ctx, network, address)
+		}
+	default:
+		return netDialer.DialContext
+	}
+}
+
+// httpProxy returns the proxy function for HTTP transports.
+func (s *Service) httpProxy() func(*http.Request) (*url.URL, error) {
+	if s.proxyURL != nil && (s.proxyURL.Scheme == "http" || s.proxyURL.Scheme == "https") {
+		return http.ProxyURL(s.proxyURL)
+	}
+
+	return nil
+}
+
+// newTransport creates a new HTTP transport that connects to the node with the given
+// dial timeout.
+func (s *Service) newTransport(timeout time.Duration, keepAlive time.Duration) *http.Transport {
+	return &http.Transport{
+		Proxy:       s.httpProxy(),
+		DialContext: s.dialer(timeout, keepAlive),
+	}
+}
+
+// dialWebSocket dials the connection for a websocket at the given URL, through any
+// configured unix socket or SOCKS proxy.
+func (s *Service) dialWebSocket(ctx context.Context, timeout time.Duration, location *url.URL) (net.Conn, error) {
+	if s.httpProxy() != nil {
+		return nil, errors.New("websockets are not supported through HTTP proxies")
+	}
+
+	host := location.Host
+	if location.Port() == "" {
+		if location.Scheme == "wss" {
+			host = net.JoinHostPort(location.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(location.Hostname(), "80")
+		}
+	}
+	conn, err := s.dialer(timeout, 2*time.Second)(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if location.Scheme == "wss" {
+		tlsConn := tls.Client(conn, &tls.Config{
+			ServerName: location.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		})
+		if err := tlsConn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			_ = conn.Close()
+			return nil, errors.Wrap(err, "failed to set TLS handshake deadline")
+		}
+		if err := tlsConn.Handshake(); err != nil {
+			_ = conn.Close()
+			return nil, errors.Wrap(err, "TLS handshake failed")
+		}
+		if err := tlsConn.SetDeadline(time.Time{}); err != nil {
+			_ = conn.Close()
+			return nil, errors.Wrap(err, "failed to clear TLS handshake deadline")
+		}
+
+		return tlsConn, nil
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,231 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+const dialerTestGenesis = `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`
+
+// serveGenesis serves the genesis endpoint on the listener, recording the host of each request.
+func serveGenesis(t *testing.T, listener net.Listener, hosts chan<- string) {
+	t.Helper()
+
+	server := &http.Server{
+		ReadHeaderTimeout: time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case hosts <- r.Host:
+			default:
+			}
+			if r.URL.Path != "/eth/v1/beacon/genesis" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(dialerTestGenesis))
+		}),
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+}
+
+// dialerTestService creates a service connecting to the given address with the given parameters.
+func dialerTestService(t *testing.T, address string, params ...Parameter) *Service {
+	t.Helper()
+
+	parameters, err := parseAndCheckParameters(append([]Parameter{WithAddress(address)}, params...)...)
+	require.NoError(t, err)
+	if address == "" || parameters.unixSocket != "" {
+		address = "http://localhost"
+	}
+	base, err := url.Parse(address + "/")
+	require.NoError(t, err)
+	s := &Service{
+		cache:      testValueCache(),
+		log:        zerolog.Nop(),
+		base:       base,
+		address:    address,
+		timeout:    time.Second,
+		unixSocket: parameters.unixSocket,
+	}
+	if parameters.proxy != "" {
+		s.proxyURL, err = parseProxy(parameters.proxy)
+		require.NoError(t, err)
+	}
+	s.client = &http.Client{
+		Transport: s.newTransport(time.Second, time.Second),
+	}
+
+	return s
+}
+
+func TestUnixSocket(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "beacon.sock")
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	serveGenesis(t, listener, make(chan string, 1))
+
+	for _, s := range []*Service{
+		dialerTestService(t, "unused:5052", WithUnixSocket(path)),
+		dialerTestService(t, fmt.Sprintf("unix://%s", path)),
+	} {
+		genesis, err := s.Genesis(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(1606824023), genesis.GenesisTime.Unix())
+	}
+}
+
+func TestHTTPProxy(t *testing.T) {
+	ctx := context.Background()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	hosts := make(chan string, 1)
+	serveGenesis(t, listener, hosts)
+
+	// The proxy serves the request itself, so the target need not exist.
+	s := dialerTestService(t, "http://beacon.invalid:5052", WithProxy(fmt.Sprintf("http://%s", listener.Addr())))
+	_, err = s.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "beacon.invalid:5052", <-hosts)
+}
+
+func TestSOCKS5Proxy(t *testing.T) {
+	ctx := context.Background()
+
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	serveGenesis(t, target, make(chan string, 1))
+
+	proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer proxyListener.Close()
+	requested := make(chan string, 1)
+	go serveSOCKS5(proxyListener, requested)
+
+	s := dialerTestService(t, fmt.Sprintf("http://%s", target.Addr()), WithProxy(fmt.Sprintf("socks5://%s", proxyListener.Addr())))
+	_, err = s.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, target.Addr().String(), <-requested)
+}
+
+// serveSOCKS5 is a minimal SOCKS5 server supporting unauthenticated IPv4 connections.
+func serveSOCKS5(listener net.Listener, requested chan<- string) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func(conn net.Conn) {
+			defer conn.Close()
+
+			// Greeting: version, number of methods, methods.
+			header := make([]byte, 2)
+			if _, err := io.ReadFull(conn, header); err != nil {
+				return
+			}
+			if _, err := io.ReadFull(conn, make([]byte, header[1])); err != nil {
+				return
+			}
+			if _, err := conn.Write([]byte{0x05, 0x00}); err != nil {
+				return
+			}
+
+			// Request: version, command, reserved, address type, IPv4 address, port.
+			request := make([]byte, 10)
+			if _, err := io.ReadFull(conn, request); err != nil || request[3] != 0x01 {
+				return
+			}
+			address := net.JoinHostPort(net.IP(request[4:8]).String(), fmt.Sprintf("%d", binary.BigEndian.Uint16(request[8:10])))
+			requested <- address
+			targetConn, err := net.Dial("tcp", address)
+			if err != nil {
+				return
+			}
+			defer targetConn.Close()
+			if _, err := conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}); err != nil {
+				return
+			}
+
+			go func() {
+				_, _ = io.Copy(targetConn, conn)
+			}()
+			_, _ = io.Copy(conn, targetConn)
+		}(conn)
+	}
+}
+
+func TestDialerParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []Parameter
+		err    string
+	}{
+		{
+			name:   "UnixSocketTwice",
+			params: []Parameter{WithAddress("unix:///a.sock"), WithUnixSocket("/b.sock")},
+			err:    "unix socket supplied in both address and parameter",
+		},
+		{
+			name:   "UnixSocketAndProxy",
+			params: []Parameter{WithAddress("localhost:5052"), WithUnixSocket("/b.sock"), WithProxy("socks5://localhost:1080")},
+			err:    "cannot use both a unix socket and a proxy",
+		},
+		{
+			name:   "ProxySchemeUnsupported",
+			params: []Parameter{WithAddress("localhost:5052"), WithProxy("ftp://localhost:21")},
+			err:    "unsupported proxy scheme",
+		},
+		{
+			name:   "ProxyHostMissing",
+			params: []Parameter{WithAddress("localhost:5052"), WithProxy("socks5://")},
+			err:    "no proxy host specified",
+		},
+		{
+			name:   "Good",
+			params: []Parameter{WithAddress("localhost:5052"), WithProxy("socks5h://localhost:1080")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseAndCheckParameters(test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -46,7 +46,7 @@ func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoc
 
 	if !bytes.Equal(domainType[:], []byte{0x00, 0x00, 0x00, 0x01}) {
 		// Use the chain's genesis validators root for non-application domain types.
-		genesis, err := s.Genesis(ctx)
+		genesis, err := s.cachedGenesis(ctx)
 		if err != nil {
 			return phase0.Domain{}, errors.Wrap(err, "failed to obtain genesis")
 		}
@@ -67,7 +67,7 @@ func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoc
 
 // forkAtEpoch works through the fork schedule to obtain the current fork.
 func (s *Service) forkAtEpoch(ctx context.Context, epoch phase0.Epoch) (*phase0.Fork, error) {
-	forkSchedule, err := s.ForkSchedule(ctx)
+	forkSchedule, err := s.cachedForkSchedule(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain fork schedule")
 	}
@@ -0,0 +1,130 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/cache"
+)
+
+// ETagCache caches responses that carry an ETag, and makes subsequent requests for the
+// same endpoint conditional on the ETag.  If the node responds that the data has not
+// been modified the cached response is returned in place of the empty response, reducing
+// bandwidth for callers that poll the node for data that rarely changes, such as the
+// spec, genesis or finalized blocks.
+//
+// Nodes that do not supply ETags are unaffected.
+type ETagCache struct {
+	cache cache.Cache
+}
+
+// NewETagCache creates a new ETag cache holding up to the given number of responses.
+// When the cache is full the least recently used response is evicted.
+func NewETagCache(maxEntries int) *ETagCache {
+	if maxEntries <= 0 {
+		return &ETagCache{}
+	}
+	// Parameters are known to be valid, so no error can occur.
+	c, _ := cache.New(cache.WithMaxEntries(maxEntries))
+
+	return NewETagCacheWithCache(c)
+}
+
+// NewETagCacheWithCache creates a new ETag cache that holds its responses in the supplied cache.
+func NewETagCacheWithCache(c cache.Cache) *ETagCache {
+	return &ETagCache{
+		cache: c,
+	}
+}
+
+// Middleware returns middleware that carries out conditional GET requests using the cache.
+func (c *ETagCache) Middleware() Middleware {
+	return func(next CallFunc) CallFunc {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			if req.Method != http.MethodGet {
+				return next(ctx, req)
+			}
+
+			key := etagCacheKey(req)
+			cached := c.get(key)
+			if cached != nil {
+				headers := req.Headers.Clone()
+				if headers == nil {
+					headers = make(http.Header)
+				}
+				headers.Set("If-None-Match", cached.Headers.Get("ETag"))
+				req = &Request{
+					Method:   req.Method,
+					Endpoint: req.Endpoint,
+					Headers:  headers,
+					Body:     req.Body,
+				}
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case resp.StatusCode == http.StatusNotModified && cached != nil:
+				return cached, nil
+			case resp.StatusCode/100 == 2 && resp.Headers.Get("ETag") != "":
+				c.put(key, resp)
+			}
+
+			return resp, nil
+		}
+	}
+}
+
+// Len returns the number of responses in the cache.
+func (c *ETagCache) Len() int {
+	if c.cache == nil {
+		return 0
+	}
+
+	return c.cache.Len()
+}
+
+func (c *ETagCache) get(key string) *Response {
+	if c.cache == nil {
+		return nil
+	}
+	value, exists := c.cache.Get(key)
+	if !exists {
+		return nil
+	}
+	resp, isResponse := value.(*Response)
+	if !isResponse {
+		return nil
+	}
+
+	return resp
+}
+
+func (c *ETagCache) put(key string, resp *Response) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.Set(key, resp)
+}
+
+// etagCacheKey is the key for a request in the cache.  The accept header is included
+// as the same endpoint can return different encodings.
+func etagCacheKey(req *Request) string {
+	return req.Endpoint + " " + req.Headers.Get("Accept")
+}
@@ -0,0 +1,97 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETagCache(t *testing.T) {
+	ctx := context.Background()
+
+	var hits, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/untagged" {
+			_, _ = w.Write([]byte(`{"data":"untagged"}`))
+			return
+		}
+		etag := `"` + r.URL.Path + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(`{"data":"` + r.URL.Path + `"}`))
+	}))
+	defer server.Close()
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cache := NewETagCache(2)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+	s.call = chain(s.do, []Middleware{cache.Middleware()})
+
+	// First request populates the cache, second is served from it.
+	for i := 0; i < 2; i++ {
+		res, err := s.get2(ctx, "/spec", "application/json")
+		require.NoError(t, err)
+		require.Equal(t, `{"data":"/spec"}`, string(res.body))
+		require.Equal(t, http.StatusOK, res.statusCode)
+	}
+	require.Equal(t, 2, hits)
+	require.Equal(t, 1, notModified)
+	require.Equal(t, 1, cache.Len())
+
+	// Responses without an ETag are not cached.
+	for i := 0; i < 2; i++ {
+		res, err := s.get2(ctx, "/untagged", "application/json")
+		require.NoError(t, err)
+		require.Equal(t, `{"data":"untagged"}`, string(res.body))
+	}
+	require.Equal(t, 1, cache.Len())
+
+	// Requests with a different accept header are cached separately.
+	_, err = s.get2(ctx, "/spec", "application/octet-stream")
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.Len())
+	require.Equal(t, 1, notModified)
+
+	// The oldest entry is evicted when the cache is full.
+	_, err = s.get2(ctx, "/genesis", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.Len())
+	hits = 0
+	res, err := s.get2(ctx, "/spec", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"/spec"}`, string(res.body))
+	require.Equal(t, 1, notModified)
+	require.Equal(t, 1, hits)
+}
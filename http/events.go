@@ -17,22 +17,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
-	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/r3labs/sse/v2"
 	"github.com/rs/zerolog"
 )
 
+// StaleEventsHandlerFunc is the handler called when an events stream is found to be stale,
+// prior to it being reconnected.
+type StaleEventsHandlerFunc func(ctx context.Context, topics []string, lastActivity time.Time)
+
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
 	// #nosec G404
@@ -57,22 +63,40 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 	url := s.base.ResolveReference(reference).String()
 	log.Trace().Str("url", url).Msg("GET request to events stream")
 
-	client := sse.NewClient(url)
-	client.Connection.Transport = &http.Transport{
-		Dial: (&net.Dialer{
-			Timeout:   2 * time.Second,
-			KeepAlive: 2 * time.Second,
-		}).Dial,
+	// The event stream is long-lived, so a custom timeout for it only
+	// applies to establishing the connection.
+	dialTimeout := 2 * time.Second
+	if timeout, exists := s.timeouts[EndpointEvents]; exists {
+		dialTimeout = timeout
 	}
+	client := sse.NewClient(url)
+	client.Connection.Transport = s.newTransport(dialTimeout, 2*time.Second)
 
+	// The stream, and any handler it is running, is stopped when the service is closed.
+	if !s.track() {
+		return ErrServiceClosed
+	}
+	ctx, cancel := s.lifecycleContext(ctx)
 	go func() {
+		defer s.untrack()
+		defer cancel()
 		for {
 			select {
 			case <-time.After(time.Second):
+				if s.eventsWebSocket != "" {
+					log.Trace().Msg("Connecting to events websocket")
+					connected, err := s.streamWebSocketEvents(ctx, dialTimeout, topics, handler)
+					if connected {
+						if err != nil {
+							log.Error().Err(err).Msg("Failed to receive from events websocket")
+						}
+						log.Trace().Msg("Events websocket disconnected")
+						continue
+					}
+					log.Debug().Err(err).Msg("Failed to connect to events websocket; falling back to events stream")
+				}
 				log.Trace().Msg("Connecting to events stream")
-				if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, handler)
-				}); err != nil {
+				if err := s.streamEvents(ctx, client, topics, handler); err != nil {
 					log.Error().Err(err).Msg("Failed to subscribe to event stream")
 				}
 				log.Trace().Msg("Events stream disconnected")
@@ -86,6 +110,111 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 	return nil
 }
 
+// streamEvents streams events until the stream disconnects, or is found to be stale.
+func (s *Service) streamEvents(ctx context.Context, sseClient *sse.Client, topics []string, handler client.EventHandlerFunc) error {
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if s.staleEventsTimeout > 0 {
+		// Ensure that the client stops retrying once the connection is cancelled,
+		// so that we can reconnect.
+		sseClient.ReconnectStrategy = &eventsBackOff{ctx: connCtx}
+
+		// Any data received on the stream, including heartbeats and comments,
+		// counts as activity.
+		lastActivity := time.Now().UnixNano()
+		sseClient.ResponseValidator = func(_ *sse.Client, resp *http.Response) error {
+			if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+				return fmt.Errorf("could not connect to stream: %s", http.StatusText(resp.StatusCode))
+			}
+			atomic.StoreInt64(&lastActivity, time.Now().UnixNano())
+			resp.Body = &activityReader{
+				ReadCloser:   resp.Body,
+				lastActivity: &lastActivity,
+			}
+			return nil
+		}
+		go s.monitorEventsStaleness(connCtx, cancel, topics, &lastActivity)
+	}
+
+	return sseClient.SubscribeRawWithContext(connCtx, func(msg *sse.Event) {
+		s.handleEvent(ctx, msg, handler)
+	})
+}
+
+// activityReader records the time of the last read from a stream.
+type activityReader struct {
+	io.ReadCloser
+	lastActivity *int64
+}
+
+// Read reads from the underlying stream, recording activity if any data is received.
+func (r *activityReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		atomic.StoreInt64(r.lastActivity, time.Now().UnixNano())
+	}
+
+	return n, err
+}
+
+// monitorEventsStaleness cancels the connection if no activity is seen on the stream
+// within the stale events timeout.
+func (s *Service) monitorEventsStaleness(ctx context.Context,
+	cancel context.CancelFunc,
+	topics []string,
+	lastActivity *int64,
+) {
+	log := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(s.staleEventsTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			last := time.Unix(0, atomic.LoadInt64(lastActivity))
+			if time.Since(last) < s.staleEventsTimeout {
+				continue
+			}
+			log.Warn().Time("last_activity", last).Msg("Events stream stale; reconnecting")
+			if s.staleEventsHandler != nil {
+				s.staleEventsHandler(ctx, topics, last)
+			}
+			cancel()
+			return
+		}
+	}
+}
+
+// eventsBackOff retries connections to the events stream until its context is done.
+type eventsBackOff struct {
+	ctx     context.Context
+	backOff time.Duration
+}
+
+// NextBackOff returns the duration to wait before retrying the connection.
+func (b *eventsBackOff) NextBackOff() time.Duration {
+	if b.ctx.Err() != nil {
+		// Stop retrying.
+		return -1
+	}
+	switch {
+	case b.backOff == 0:
+		b.backOff = time.Second
+	case b.backOff < 30*time.Second:
+		b.backOff *= 2
+	}
+	return b.backOff
+}
+
+// Reset resets the backoff.
+func (b *eventsBackOff) Reset() {
+	b.backOff = 0
+}
+
 // handleEvent parses an event and passes it on to the handler.
 func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler client.EventHandlerFunc) {
 	log := zerolog.Ctx(ctx)
@@ -159,6 +288,38 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = contributionAndProofEvent
+	case "block_gossip":
+		blockGossipEvent := &api.BlockGossipEvent{}
+		err := json.Unmarshal(msg.Data, blockGossipEvent)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse block gossip event")
+			return
+		}
+		event.Data = blockGossipEvent
+	case "attester_slashing":
+		attesterSlashing := &phase0.AttesterSlashing{}
+		err := json.Unmarshal(msg.Data, attesterSlashing)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attester slashing")
+			return
+		}
+		event.Data = attesterSlashing
+	case "proposer_slashing":
+		proposerSlashing := &phase0.ProposerSlashing{}
+		err := json.Unmarshal(msg.Data, proposerSlashing)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse proposer slashing")
+			return
+		}
+		event.Data = proposerSlashing
+	case "bls_to_execution_change":
+		blsToExecutionChange := &capella.SignedBLSToExecutionChange{}
+		err := json.Unmarshal(msg.Data, blsToExecutionChange)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse BLS to execution change")
+			return
+		}
+		event.Data = blsToExecutionChange
 	case "":
 		// Used as keepalive.  Ignore.
 		return
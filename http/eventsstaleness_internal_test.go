@@ -0,0 +1,128 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaleEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Server that accepts connections to the events stream but never sends anything.
+	var connections int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&connections, 1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	var staleCalls int32
+	s := &Service{
+		log:                zerolog.Nop(),
+		base:               base,
+		address:            server.URL,
+		staleEventsTimeout: 200 * time.Millisecond,
+		staleEventsHandler: func(_ context.Context, topics []string, _ time.Time) {
+			require.Equal(t, []string{"head"}, topics)
+			atomic.AddInt32(&staleCalls, 1)
+		},
+	}
+
+	require.NoError(t, s.Events(ctx, []string{"head"}, func(*api.Event) {}))
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&staleCalls) >= 1 && atomic.LoadInt32(&connections) >= 2
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestEventsBackOff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	backOff := &eventsBackOff{ctx: ctx}
+	require.Equal(t, time.Second, backOff.NextBackOff())
+	require.Equal(t, 2*time.Second, backOff.NextBackOff())
+	backOff.Reset()
+	require.Equal(t, time.Second, backOff.NextBackOff())
+
+	cancel()
+	require.Equal(t, time.Duration(-1), backOff.NextBackOff())
+}
+
+func TestEventsHeartbeatNotStale(t *testing.T) {
+	// Server that sends only heartbeat comments on the events stream.
+	var connections int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&connections, 1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ticker.C:
+				_, _ = w.Write([]byte(":\n\n"))
+				w.(http.Flusher).Flush()
+			}
+		}
+	}))
+	defer server.Close()
+
+	// The stream must be stopped before the server can close.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	var staleCalls int32
+	s := &Service{
+		log:                zerolog.Nop(),
+		base:               base,
+		address:            server.URL,
+		staleEventsTimeout: 200 * time.Millisecond,
+		staleEventsHandler: func(_ context.Context, _ []string, _ time.Time) {
+			atomic.AddInt32(&staleCalls, 1)
+		},
+	}
+
+	require.NoError(t, s.Events(ctx, []string{"finalized_checkpoint"}, func(*api.Event) {}))
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&connections) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	time.Sleep(time.Second)
+	require.Equal(t, int32(0), atomic.LoadInt32(&staleCalls))
+	require.Equal(t, int32(1), atomic.LoadInt32(&connections))
+}
@@ -0,0 +1,107 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/r3labs/sse/v2"
+	"github.com/rs/zerolog"
+	"golang.org/x/net/websocket"
+)
+
+// webSocketEvent is a single event received over a WebSocket.
+// Each WebSocket message carries one event, with the same topic and data as the
+// equivalent server-sent event.
+type webSocketEvent struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// streamWebSocketEvents streams events from the events WebSocket until it disconnects,
+// or is found to be stale.  It returns false if the connection could not be established,
+// in which case the caller should fall back to the events stream.
+func (s *Service) streamWebSocketEvents(ctx context.Context,
+	dialTimeout time.Duration,
+	topics []string,
+	handler client.EventHandlerFunc,
+) (
+	bool,
+	error,
+) {
+	log := zerolog.Ctx(ctx)
+
+	separator := "?"
+	if strings.Contains(s.eventsWebSocket, "?") {
+		separator = "&"
+	}
+	address := fmt.Sprintf("%s%stopics=%s", s.eventsWebSocket, separator, strings.Join(topics, "&topics="))
+	config, err := websocket.NewConfig(address, s.base.String())
+	if err != nil {
+		return false, errors.Wrap(err, "invalid websocket configuration")
+	}
+	netConn, err := s.dialWebSocket(ctx, dialTimeout, config.Location)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to connect")
+	}
+	conn, err := websocket.NewClient(config, netConn)
+	if err != nil {
+		_ = netConn.Close()
+		return false, errors.Wrap(err, "failed to connect")
+	}
+
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Closing the connection unblocks the receive below when the context is done.
+	go func() {
+		<-connCtx.Done()
+		if err := conn.Close(); err != nil {
+			log.Trace().Err(err).Msg("Failed to close events websocket")
+		}
+	}()
+
+	lastActivity := time.Now().UnixNano()
+	if s.staleEventsTimeout > 0 {
+		go s.monitorEventsStaleness(connCtx, cancel, topics, &lastActivity)
+	}
+
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			if connCtx.Err() != nil {
+				// Closed by us.
+				return true, nil
+			}
+			return true, err
+		}
+		atomic.StoreInt64(&lastActivity, time.Now().UnixNano())
+
+		msg := &webSocketEvent{}
+		if err := json.Unmarshal(data, msg); err != nil {
+			log.Error().Err(err).Str("data", string(data)).Msg("Failed to parse websocket event")
+			continue
+		}
+		s.handleEvent(ctx, &sse.Event{
+			Event: []byte(msg.Event),
+			Data:  msg.Data,
+		}, handler)
+	}
+}
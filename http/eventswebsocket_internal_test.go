@@ -0,0 +1,116 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+const testHeadEventData = `{"slot":"10","block":"0x0102030000000000000000000000000000000000000000000000000000000000","state":"0x0405060000000000000000000000000000000000000000000000000000000000","epoch_transition":false,"previous_duty_dependent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","current_duty_dependent_root":"0x0000000000000000000000000000000000000000000000000000000000000000"}`
+
+func TestWebSocketEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var query atomic.Value
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		query.Store(conn.Request().URL.RawQuery)
+		require.NoError(t, websocket.Message.Send(conn, "not JSON"))
+		require.NoError(t, websocket.Message.Send(conn, fmt.Sprintf(`{"event":"head","data":%s}`, testHeadEventData)))
+		<-ctx.Done()
+	}))
+	defer server.Close()
+	// Release the handler before closing the server.
+	defer cancel()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:             zerolog.Nop(),
+		base:            base,
+		address:         server.URL,
+		eventsWebSocket: strings.Replace(server.URL, "http://", "ws://", 1),
+	}
+
+	events := make(chan *api.Event, 1)
+	require.NoError(t, s.Events(ctx, []string{"head", "block"}, func(event *api.Event) {
+		events <- event
+	}))
+
+	select {
+	case event := <-events:
+		require.Equal(t, "head", event.Topic)
+		require.Equal(t, phase0.Slot(10), event.Data.(*api.HeadEvent).Slot)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "no event received")
+	}
+	require.Equal(t, "topics=head&topics=block", query.Load())
+}
+
+func TestWebSocketEventsFallback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Server that does not support WebSockets, only the events stream.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "event: head\ndata: %s\n\n", testHeadEventData)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	// Disconnect the client before closing the server.
+	defer cancel()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:             zerolog.Nop(),
+		base:            base,
+		address:         server.URL,
+		eventsWebSocket: strings.Replace(server.URL, "http://", "ws://", 1) + "/ws",
+	}
+
+	events := make(chan *api.Event, 1)
+	require.NoError(t, s.Events(ctx, []string{"head"}, func(event *api.Event) {
+		events <- event
+	}))
+
+	select {
+	case event := <-events:
+		require.Equal(t, "head", event.Topic)
+		require.Equal(t, phase0.Slot(10), event.Data.(*api.HeadEvent).Slot)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "no event received")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ExtensionCaller provides the calls available to extensions.
+type ExtensionCaller interface {
+	// Get sends an HTTP GET request to the endpoint and returns the body.
+	// If the response from the server is a 404 this will return nil for both the reader and the error.
+	Get(ctx context.Context, endpoint string) (io.Reader, error)
+
+	// Post sends an HTTP POST request to the endpoint and returns the body.
+	Post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error)
+
+	// NodeClient provides the client for the node.
+	NodeClient(ctx context.Context) (string, error)
+}
+
+// ExtensionFactory creates an extension for a node.
+// It should return nil without an error if the node does not support the extension.
+type ExtensionFactory func(ctx context.Context, caller ExtensionCaller) (interface{}, error)
+
+var (
+	extensionFactories   = make(map[string]ExtensionFactory)
+	extensionFactoriesMu sync.RWMutex
+)
+
+// RegisterExtension registers an extension factory with the given name.
+// This is usually called from the init() function of the package that provides the extension.
+func RegisterExtension(name string, factory ExtensionFactory) {
+	extensionFactoriesMu.Lock()
+	defer extensionFactoriesMu.Unlock()
+	extensionFactories[name] = factory
+}
+
+// extensionCaller provides the calls available to extensions.
+type extensionCaller struct {
+	s *Service
+}
+
+// Get sends an HTTP GET request to the endpoint and returns the body.
+func (c *extensionCaller) Get(ctx context.Context, endpoint string) (io.Reader, error) {
+	return c.s.get(ctx, endpoint)
+}
+
+// Post sends an HTTP POST request to the endpoint and returns the body.
+func (c *extensionCaller) Post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+	return c.s.post(ctx, endpoint, body)
+}
+
+// NodeClient provides the client for the node.
+func (c *extensionCaller) NodeClient(ctx context.Context) (string, error) {
+	return c.s.NodeClient(ctx)
+}
+
+// Extensions provides the implementation-specific extensions supported by the node, keyed by name.
+func (s *Service) Extensions(ctx context.Context) (map[string]interface{}, error) {
+	s.extensionsMutex.RLock()
+	if s.extensions != nil {
+		defer s.extensionsMutex.RUnlock()
+		return s.extensions, nil
+	}
+	s.extensionsMutex.RUnlock()
+
+	s.extensionsMutex.Lock()
+	defer s.extensionsMutex.Unlock()
+	if s.extensions != nil {
+		// Someone else created these whilst we were waiting for the lock.
+		return s.extensions, nil
+	}
+
+	extensionFactoriesMu.RLock()
+	defer extensionFactoriesMu.RUnlock()
+
+	caller := &extensionCaller{s: s}
+	extensions := make(map[string]interface{}, len(extensionFactories))
+	for name, factory := range extensionFactories {
+		extension, err := factory(ctx, caller)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create extension "+name)
+		}
+		if extension != nil {
+			extensions[name] = extension
+		}
+	}
+	s.extensions = extensions
+
+	return s.extensions, nil
+}
@@ -0,0 +1,92 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ForkChoiceNodesPage obtains a page of the nodes of the fork choice of the node.
+// The returned flag is true if there are further nodes after the page.
+func (s *Service) ForkChoiceNodesPage(ctx context.Context, opts *api.PageOptions) ([]*apiv1.ForkChoiceNode, bool, error) {
+	respBody, err := s.getStream(ctx, "/eth/v1/debug/fork_choice")
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to request fork choice")
+	}
+	if respBody == nil {
+		return nil, false, errors.New("failed to obtain fork choice")
+	}
+	defer respBody.Close()
+
+	nodes := make([]*apiv1.ForkChoiceNode, 0)
+	more, err := decodeDataEntries(respBody, "fork_choice_nodes", opts, func(decoder *json.Decoder) error {
+		var node apiv1.ForkChoiceNode
+		if err := decoder.Decode(&node); err != nil {
+			return err
+		}
+		nodes = append(nodes, &node)
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to parse fork choice")
+	}
+
+	return nodes, more, nil
+}
+
+// ForkChoiceNodesPages iterates over the nodes of the fork choice of the node, calling
+// the supplied function with successive pages of up to pageSize nodes.
+// Only a single page of nodes is held in memory at any time.
+func (s *Service) ForkChoiceNodesPages(ctx context.Context, pageSize uint64, fn func([]*apiv1.ForkChoiceNode) error) error {
+	respBody, err := s.getStream(ctx, "/eth/v1/debug/fork_choice")
+	if err != nil {
+		return errors.Wrap(err, "failed to request fork choice")
+	}
+	if respBody == nil {
+		return errors.New("failed to obtain fork choice")
+	}
+	defer respBody.Close()
+
+	page := make([]*apiv1.ForkChoiceNode, 0, pageSize)
+	err = iteratePages(ctx, respBody, "fork_choice_nodes", pageSize,
+		func(decoder *json.Decoder) error {
+			var node apiv1.ForkChoiceNode
+			if err := decoder.Decode(&node); err != nil {
+				return err
+			}
+			page = append(page, &node)
+
+			return nil
+		},
+		func() error {
+			if err := fn(page); err != nil {
+				return err
+			}
+			page = make([]*apiv1.ForkChoiceNode, 0, pageSize)
+
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to iterate over fork choice")
+	}
+
+	return nil
+}
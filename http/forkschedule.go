@@ -26,19 +26,37 @@ type forkScheduleJSON struct {
 }
 
 // ForkSchedule provides details of past and future changes in the chain's fork version.
+// The returned forks are copies, so callers are free to modify them.
 func (s *Service) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
-	s.forkScheduleMutex.RLock()
-	if s.forkSchedule != nil {
-		defer s.forkScheduleMutex.RUnlock()
-		return s.forkSchedule, nil
+	forkSchedule, err := s.cachedForkSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*phase0.Fork, len(forkSchedule))
+	for i, fork := range forkSchedule {
+		res[i] = fork.Copy()
+	}
+
+	return res, nil
+}
+
+// cachedForkSchedule provides the fork schedule, fetching it if not already cached.
+// The returned forks are shared between callers and must not be modified.
+func (s *Service) cachedForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
+	if value, exists := s.cached(forkScheduleCacheName); exists {
+		if forkSchedule, isType := value.([]*phase0.Fork); isType {
+			return forkSchedule, nil
+		}
 	}
-	s.forkScheduleMutex.RUnlock()
 
 	s.forkScheduleMutex.Lock()
 	defer s.forkScheduleMutex.Unlock()
-	if s.forkSchedule != nil {
-		// Someone else fetched this whilst we were waiting for the lock.
-		return s.forkSchedule, nil
+	if value, exists := s.cached(forkScheduleCacheName); exists {
+		if forkSchedule, isType := value.([]*phase0.Fork); isType {
+			// Someone else fetched this whilst we were waiting for the lock.
+			return forkSchedule, nil
+		}
 	}
 
 	// Up to us to fetch the information.
@@ -54,6 +72,7 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse fork schedule")
 	}
-	s.forkSchedule = resp.Data
-	return s.forkSchedule, nil
+	s.cache.Set(s.cacheKey(forkScheduleCacheName), resp.Data)
+
+	return resp.Data, nil
 }
@@ -0,0 +1,111 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkTransitionEvent is emitted when responses from the node start to carry a later
+// consensus version than previously seen, indicating that the chain has transitioned
+// to a new fork.
+type ForkTransitionEvent struct {
+	// PreviousVersion is the consensus version seen before the transition.
+	PreviousVersion spec.DataVersion
+	// Version is the consensus version seen after the transition.
+	Version spec.DataVersion
+}
+
+// ForkTransitionHandlerFunc is the handler called when a fork transition is observed.
+type ForkTransitionHandlerFunc func(ctx context.Context, event *ForkTransitionEvent)
+
+// seedConsensusVersion sets the consensus version against which later responses are
+// compared to that in effect at the current slot, according to the fork schedule.
+// Without this the first response seen would set the version, and as that can be for
+// historical data a later response for current data would appear to be a transition.
+func (s *Service) seedConsensusVersion(ctx context.Context) error {
+	genesis, err := s.cachedGenesis(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis")
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return errors.New("slot duration of 0")
+	}
+
+	slot := phase0.Slot(0)
+	if elapsed := time.Since(genesis.GenesisTime); elapsed > 0 {
+		slot = phase0.Slot(elapsed / slotDuration)
+	}
+	version, err := s.DataVersionAtSlot(ctx, slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain data version for current slot")
+	}
+
+	s.consensusVersionMu.Lock()
+	if !s.consensusVersionSeen || version > s.consensusVersion {
+		s.consensusVersion = version
+		s.consensusVersionSeen = true
+	}
+	s.consensusVersionMu.Unlock()
+
+	return nil
+}
+
+// observeConsensusVersion tracks the consensus version of responses from the node.
+// If the version is later than any seen previously then fork-dependent cached values
+// are cleared, so that they are refetched the next time they are required, and the
+// fork transition handler is called.
+// Earlier versions are ignored, as they are returned for historical data.
+func (s *Service) observeConsensusVersion(ctx context.Context, version spec.DataVersion) {
+	s.consensusVersionMu.Lock()
+	if s.consensusVersionSeen && version <= s.consensusVersion {
+		s.consensusVersionMu.Unlock()
+		return
+	}
+	previousVersion := s.consensusVersion
+	transition := s.consensusVersionSeen
+	s.consensusVersion = version
+	s.consensusVersionSeen = true
+	s.consensusVersionMu.Unlock()
+
+	if !transition {
+		// First version seen, so nothing cached can be stale.
+		return
+	}
+
+	s.log.Debug().Stringer("previous_version", previousVersion).Stringer("version", version).Msg("Fork transition observed; clearing fork-dependent values")
+	s.clearForkDependentValues()
+
+	if s.forkHandler != nil {
+		s.forkHandler(ctx, &ForkTransitionEvent{
+			PreviousVersion: previousVersion,
+			Version:         version,
+		})
+	}
+}
+
+// clearForkDependentValues removes cached values that can change at a fork, so they
+// are refetched the next time they are required.
+func (s *Service) clearForkDependentValues() {
+	s.uncache(specCacheName, forkScheduleCacheName)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkTransition(t *testing.T) {
+	ctx := context.Background()
+
+	version := "bellatrix"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32"}}`)
+		case "/eth/v2/beacon/blocks/head":
+			w.Header().Set("Eth-Consensus-Version", version)
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	events := make([]*ForkTransitionEvent, 0)
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		cache:   testValueCache(),
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+		forkHandler: func(_ context.Context, event *ForkTransitionEvent) {
+			events = append(events, event)
+		},
+	}
+
+	// First version seen does not result in a transition.
+	_, err = s.cachedSpec(ctx)
+	require.NoError(t, err)
+	_, err = s.get2(ctx, "/eth/v2/beacon/blocks/head", "application/json")
+	require.NoError(t, err)
+	require.Empty(t, events)
+	_, cached := s.cached(specCacheName)
+	require.True(t, cached)
+
+	// Later version results in a transition.
+	version = "capella"
+	_, err = s.get2(ctx, "/eth/v2/beacon/blocks/head", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, []*ForkTransitionEvent{
+		{
+			PreviousVersion: spec.DataVersionBellatrix,
+			Version:         spec.DataVersionCapella,
+		},
+	}, events)
+	_, cached = s.cached(specCacheName)
+	require.False(t, cached)
+
+	// Same or earlier versions, as for historical data, do not result in a transition.
+	_, err = s.cachedSpec(ctx)
+	require.NoError(t, err)
+	_, err = s.get2(ctx, "/eth/v2/beacon/blocks/head", "application/json")
+	require.NoError(t, err)
+	version = "altair"
+	_, err = s.get2(ctx, "/eth/v2/beacon/blocks/head", "application/json")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	_, cached = s.cached(specCacheName)
+	require.True(t, cached)
+}
+
+func TestForkTransitionNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"%#x","genesis_fork_version":"0x00000000"}}`, make([]byte, 32))
+		case "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12","GENESIS_FORK_VERSION":"0x00000000","ALTAIR_FORK_VERSION":"0x01000000","BELLATRIX_FORK_VERSION":"0x02000000","CAPELLA_FORK_VERSION":"0x03000000"}}`)
+		case "/eth/v1/config/deposit_contract":
+			fmt.Fprintf(w, `{"data":{"chain_id":"1","address":"%#x"}}`, make([]byte, 20))
+		case "/eth/v1/config/fork_schedule":
+			fmt.Fprint(w, `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"},{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"1"},{"previous_version":"0x01000000","current_version":"0x02000000","epoch":"2"},{"previous_version":"0x02000000","current_version":"0x03000000","epoch":"3"}]}`)
+		case "/eth/v1/node/version":
+			fmt.Fprint(w, `{"data":{"version":"test/v1.0.0"}}`)
+		case "/eth/v2/beacon/blocks/0":
+			w.Header().Set("Eth-Consensus-Version", "phase0")
+			fmt.Fprint(w, `{}`)
+		case "/eth/v2/beacon/blocks/head":
+			w.Header().Set("Eth-Consensus-Version", "capella")
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	events := make([]*ForkTransitionEvent, 0)
+	service, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithAddress(server.URL),
+		WithCache(testValueCache()),
+		WithForkTransitionHandler(func(_ context.Context, event *ForkTransitionEvent) {
+			events = append(events, event)
+		}),
+	)
+	require.NoError(t, err)
+	s := service.(*Service)
+	defer s.Close(ctx)
+
+	// The genesis block fetched when checking API versioning does not set the baseline,
+	// so a response for current data is not a transition.
+	_, err = s.get2(ctx, "/eth/v2/beacon/blocks/head", "application/json")
+	require.NoError(t, err)
+	require.Empty(t, events)
+	_, cached := s.cached(specCacheName)
+	require.True(t, cached)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// forkVersionKeys are the spec keys of the fork versions for each data version.
+var forkVersionKeys = map[string]spec.DataVersion{
+	"GENESIS_FORK_VERSION":   spec.DataVersionPhase0,
+	"ALTAIR_FORK_VERSION":    spec.DataVersionAltair,
+	"BELLATRIX_FORK_VERSION": spec.DataVersionBellatrix,
+	"CAPELLA_FORK_VERSION":   spec.DataVersionCapella,
+}
+
+// ForkAtEpoch provides the fork in effect at the given epoch, according to the fork schedule.
+func (s *Service) ForkAtEpoch(ctx context.Context, epoch phase0.Epoch) (*phase0.Fork, error) {
+	forkSchedule, err := s.cachedForkSchedule(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fork schedule")
+	}
+
+	var res *phase0.Fork
+	for _, fork := range forkSchedule {
+		if fork.Epoch > epoch {
+			continue
+		}
+		if res == nil || fork.Epoch >= res.Epoch {
+			res = fork
+		}
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no fork found for epoch %d", epoch)
+	}
+
+	return res, nil
+}
+
+// ForkVersionAtSlot provides the fork version in effect at the given slot, according to the fork schedule.
+func (s *Service) ForkVersionAtSlot(ctx context.Context, slot phase0.Slot) (phase0.Version, error) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return phase0.Version{}, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	fork, err := s.ForkAtEpoch(ctx, phase0.Epoch(uint64(slot)/slotsPerEpoch))
+	if err != nil {
+		return phase0.Version{}, err
+	}
+
+	return fork.CurrentVersion, nil
+}
+
+// DataVersionAtSlot provides the data version in effect at the given slot, according to the fork schedule.
+func (s *Service) DataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	forkVersion, err := s.ForkVersionAtSlot(ctx, slot)
+	if err != nil {
+		return spec.DataVersionPhase0, err
+	}
+
+	config, err := s.cachedSpec(ctx)
+	if err != nil {
+		return spec.DataVersionPhase0, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	// Later forks take precedence, in case a testnet reuses a fork version.
+	found := false
+	res := spec.DataVersionPhase0
+	for key, dataVersion := range forkVersionKeys {
+		version, isVersion := config[key].(phase0.Version)
+		if !isVersion || version != forkVersion {
+			continue
+		}
+		if !found || dataVersion > res {
+			res = dataVersion
+		}
+		found = true
+	}
+	if !found {
+		return spec.DataVersionPhase0, fmt.Errorf("unknown fork version %#x", forkVersion)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,57 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	forkSchedule, err := service.(client.ForkScheduleProvider).ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, forkSchedule)
+
+	// Genesis.
+	fork, err := service.(client.ForkVersionsProvider).ForkAtEpoch(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, forkSchedule[0].CurrentVersion, fork.CurrentVersion)
+	dataVersion, err := service.(client.ForkVersionsProvider).DataVersionAtSlot(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, dataVersion <= spec.DataVersionCapella)
+
+	// Latest scheduled fork.
+	latest := forkSchedule[len(forkSchedule)-1]
+	slotsPerEpoch, err := service.(client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	forkVersion, err := service.(client.ForkVersionsProvider).ForkVersionAtSlot(ctx, phase0.Slot(uint64(latest.Epoch)*slotsPerEpoch))
+	require.NoError(t, err)
+	require.Equal(t, latest.CurrentVersion, forkVersion)
+}
@@ -26,19 +26,31 @@ type genesisJSON struct {
 }
 
 // Genesis provides the genesis information of the chain.
+// The returned genesis is a copy, so callers are free to modify it.
 func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
-	s.genesisMutex.RLock()
-	if s.genesis != nil {
-		defer s.genesisMutex.RUnlock()
-		return s.genesis, nil
+	genesis, err := s.cachedGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return genesis.Copy(), nil
+}
+
+// cachedGenesis provides the genesis information, fetching it if not already cached.
+// The returned genesis is shared between callers and must not be modified.
+func (s *Service) cachedGenesis(ctx context.Context) (*api.Genesis, error) {
+	if value, exists := s.cached(genesisCacheName); exists {
+		if genesis, isType := value.(*api.Genesis); isType {
+			return genesis, nil
+		}
 	}
-	s.genesisMutex.RUnlock()
 
 	s.genesisMutex.Lock()
 	defer s.genesisMutex.Unlock()
-	if s.genesis != nil {
-		// Someone else fetched this whilst we were waiting for the lock.
-		return s.genesis, nil
+	if value, exists := s.cached(genesisCacheName); exists {
+		if genesis, isType := value.(*api.Genesis); isType {
+			// Someone else fetched this whilst we were waiting for the lock.
+			return genesis, nil
+		}
 	}
 
 	// Up to us to fetch the information.
@@ -54,6 +66,7 @@ func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
 	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse genesis")
 	}
-	s.genesis = resp.Data
-	return s.genesis, nil
+	s.cache.Set(s.cacheKey(genesisCacheName), resp.Data)
+
+	return resp.Data, nil
 }
@@ -22,7 +22,7 @@ import (
 
 // GenesisTime provides the genesis time of the chain.
 func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
-	genesis, err := s.Genesis(ctx)
+	genesis, err := s.cachedGenesis(ctx)
 	if err != nil {
 		return time.Time{}, errors.Wrap(err, "failed to obtain genesis")
 	}
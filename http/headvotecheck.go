@@ -0,0 +1,130 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HeadVoteCheckMode defines the action taken when attestation data votes for a
+// head that is unknown or older than expected.
+type HeadVoteCheckMode int
+
+const (
+	// HeadVoteCheckNone does not check the head vote of attestation data.
+	HeadVoteCheckNone HeadVoteCheckMode = iota
+	// HeadVoteCheckWarn logs a warning for attestation data that fails the check,
+	// but still returns it.
+	HeadVoteCheckWarn
+	// HeadVoteCheckReject returns ErrHeadVoteMismatch for attestation data that
+	// fails the check.
+	HeadVoteCheckReject
+)
+
+// headVoteRetention is the number of slots beyond the maximum head age for which
+// heads are retained, to allow for attestation data requested for earlier slots.
+const headVoteRetention = phase0.Slot(64)
+
+// ErrHeadVoteMismatch is returned by AttestationData when the data votes for a head
+// that has not been seen on the events stream, or that is too old for the slot.
+// This is commonly a sign of a desynced node.
+var ErrHeadVoteMismatch = errors.New("attestation data head vote mismatch")
+
+// headVotes tracks recent heads from the events stream.
+type headVotes struct {
+	maxAge phase0.Slot
+
+	mu      sync.RWMutex
+	heads   map[phase0.Root]phase0.Slot
+	latest  phase0.Slot
+	started bool
+}
+
+// newHeadVotes creates a tracker for heads up to the given age.
+func newHeadVotes(maxAge phase0.Slot) *headVotes {
+	return &headVotes{
+		maxAge: maxAge,
+		heads:  make(map[phase0.Root]phase0.Slot),
+	}
+}
+
+// handle records a head event.
+func (h *headVotes) handle(event *apiv1.Event) {
+	head, isHead := event.Data.(*apiv1.HeadEvent)
+	if !isHead {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.heads[head.Block] = head.Slot
+	if !h.started || head.Slot > h.latest {
+		h.latest = head.Slot
+	}
+	h.started = true
+
+	// Prune heads that are too old to be of use.
+	if h.latest > h.maxAge+headVoteRetention {
+		cutoff := h.latest - h.maxAge - headVoteRetention
+		for root, slot := range h.heads {
+			if slot < cutoff {
+				delete(h.heads, root)
+			}
+		}
+	}
+}
+
+// check returns ErrHeadVoteMismatch if the attestation data votes for an unknown
+// head, or for a head more than the maximum age before the slot of the data.
+// Data is not checked until the first head has been seen.
+func (h *headVotes) check(data *phase0.AttestationData) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if !h.started {
+		return nil
+	}
+	slot, exists := h.heads[data.BeaconBlockRoot]
+	if !exists {
+		return errors.Wrapf(ErrHeadVoteMismatch, "head %#x not seen", data.BeaconBlockRoot)
+	}
+	if data.Slot > slot+h.maxAge {
+		return errors.Wrapf(ErrHeadVoteMismatch, "head at slot %d too old for slot %d", slot, data.Slot)
+	}
+
+	return nil
+}
+
+// checkHeadVote checks the head vote of attestation data, if configured to do so.
+func (s *Service) checkHeadVote(data *phase0.AttestationData) error {
+	if s.headVoteCheckMode == HeadVoteCheckNone || s.headVotes == nil {
+		return nil
+	}
+
+	err := s.headVotes.check(data)
+	if err == nil {
+		return nil
+	}
+	if s.headVoteCheckMode == HeadVoteCheckReject {
+		return err
+	}
+	s.log.Warn().Uint64("slot", uint64(data.Slot)).Err(err).Msg("Attestation data failed head vote check")
+
+	return nil
+}
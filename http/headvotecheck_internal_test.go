@@ -0,0 +1,100 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func headVoteEvent(slot phase0.Slot, root byte) *apiv1.Event {
+	return &apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:  slot,
+			Block: phase0.Root{root},
+		},
+	}
+}
+
+func TestHeadVoteCheck(t *testing.T) {
+	s := &Service{
+		log:               zerolog.Nop(),
+		headVoteCheckMode: HeadVoteCheckReject,
+		headVotes:         newHeadVotes(2),
+	}
+
+	// Nothing is checked before the first head is seen.
+	require.NoError(t, s.checkHeadVote(&phase0.AttestationData{Slot: 10, BeaconBlockRoot: phase0.Root{0x01}}))
+
+	s.headVotes.handle(headVoteEvent(100, 0x01))
+	s.headVotes.handle(headVoteEvent(101, 0x02))
+	// Events for other topics are ignored.
+	s.headVotes.handle(&apiv1.Event{Topic: "block", Data: &apiv1.BlockEvent{Slot: 102, Block: phase0.Root{0x03}}})
+
+	tests := []struct {
+		name string
+		data *phase0.AttestationData
+		err  string
+	}{
+		{
+			name: "Current",
+			data: &phase0.AttestationData{Slot: 101, BeaconBlockRoot: phase0.Root{0x02}},
+		},
+		{
+			name: "Recent",
+			data: &phase0.AttestationData{Slot: 102, BeaconBlockRoot: phase0.Root{0x01}},
+		},
+		{
+			name: "Old",
+			data: &phase0.AttestationData{Slot: 103, BeaconBlockRoot: phase0.Root{0x01}},
+			err:  "head at slot 100 too old for slot 103: attestation data head vote mismatch",
+		},
+		{
+			name: "Unknown",
+			data: &phase0.AttestationData{Slot: 102, BeaconBlockRoot: phase0.Root{0x03}},
+			err:  "head 0x0300000000000000000000000000000000000000000000000000000000000000 not seen: attestation data head vote mismatch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := s.checkHeadVote(test.data)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.True(t, errors.Is(err, ErrHeadVoteMismatch))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// Warn mode returns the data regardless.
+	s.headVoteCheckMode = HeadVoteCheckWarn
+	require.NoError(t, s.checkHeadVote(&phase0.AttestationData{Slot: 102, BeaconBlockRoot: phase0.Root{0x03}}))
+}
+
+func TestHeadVotesPrune(t *testing.T) {
+	h := newHeadVotes(2)
+	h.handle(headVoteEvent(1, 0x01))
+	h.handle(headVoteEvent(100, 0x02))
+	require.Len(t, h.heads, 1)
+	require.NoError(t, h.check(&phase0.AttestationData{Slot: 100, BeaconBlockRoot: phase0.Root{0x02}}))
+	require.Error(t, h.check(&phase0.AttestationData{Slot: 100, BeaconBlockRoot: phase0.Root{0x01}}))
+}
@@ -16,14 +16,15 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
-	"net/url"
 	"strings"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
 )
@@ -47,47 +48,77 @@ func (e Error) Error() string {
 	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
 }
 
-// get sends an HTTP get request and returns the body.
+// get sends an HTTP get request for JSON and returns the body.
+// Endpoints that return versioned data in binary formats should use getVersioned instead.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
 func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
-	log.Trace().Msg("GET request")
-
-	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	res, err := s.get2(ctx, endpoint, "application/json")
 	if err != nil {
-		return nil, errors.Wrap(err, "invalid endpoint")
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	if !s.isJSON(res) {
+		// The server chose a format that this endpoint cannot decode.
+		return nil, fmt.Errorf("unsupported response content type %s", res.contentType)
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
-	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
-	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to create GET request")
+	return bytes.NewReader(res.body), nil
+}
+
+// httpResponse is the response to an HTTP request, along with its relevant headers.
+type httpResponse struct {
+	statusCode       int
+	contentType      string
+	consensusVersion spec.DataVersion
+	headers          http.Header
+	body             []byte
+}
+
+// versionFromHeaders returns the consensus version stated in the response headers, and
+// false if the headers do not state a version.  An error is returned if the headers state
+// a version that is not known.
+func (r *httpResponse) versionFromHeaders() (spec.DataVersion, bool, error) {
+	header := r.headers.Get("Eth-Consensus-Version")
+	if header == "" {
+		return 0, false, nil
+	}
+
+	var version spec.DataVersion
+	if err := version.UnmarshalJSON([]byte(fmt.Sprintf("%q", header))); err != nil {
+		return 0, true, errors.Wrap(err, "unsupported consensus version")
 	}
-	req.Header.Set("Accept", "application/json")
-	resp, err := s.client.Do(req)
+
+	return version, true, nil
+}
+
+// get2 sends an HTTP get request with the given accept header and returns the response.
+// If the response from the server is a 404 this will return nil for both the response and the error.
+func (s *Service) get2(ctx context.Context, endpoint string, accept string) (*httpResponse, error) {
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	log.Trace().Msg("GET request")
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeoutFor(endpoint))
+	resp, err := s.execute(opCtx, &Request{
+		Method:   http.MethodGet,
+		Endpoint: endpoint,
+		Headers:  http.Header{"Accept": []string{accept}},
+	})
+	cancel()
 	if err != nil {
-		cancel()
 		return nil, errors.Wrap(err, "failed to call GET endpoint")
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
 		// Nothing found.  This is not an error, so we return nil on both counts.
-		cancel()
 		return nil, nil
 	}
-
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to read GET response")
-	}
+	data := resp.Body
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
-		cancel()
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("GET failed")
 		return nil, Error{
 			Method:     http.MethodGet,
@@ -96,57 +127,181 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 			Data:       data,
 		}
 	}
-	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("GET response")
+	res := &httpResponse{
+		statusCode:  resp.StatusCode,
+		contentType: strings.TrimSpace(strings.Split(resp.Headers.Get("Content-Type"), ";")[0]),
+		headers:     resp.Headers,
+		body:        data,
+	}
+	if version, stated, err := res.versionFromHeaders(); err != nil {
+		// Most endpoints do not need the version, so this is not an error here; those that
+		// do will fail when decoding the response.
+		log.Debug().Err(err).Msg("Response has unknown consensus version")
+	} else if stated {
+		res.consensusVersion = version
+		s.observeConsensusVersion(ctx, version)
+	}
 
-	return bytes.NewReader(data), nil
+	if res.contentType != codecs.JSON.ContentType() {
+		log.Trace().Int("bytes", len(data)).Msg("GET response")
+	} else if e := log.Trace(); e.Enabled() {
+		e.Str("response", string(data)).Msg("GET response")
+	}
+
+	return res, nil
 }
 
-// post sends an HTTP post request and returns the body.
-func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
-	if e := log.Trace(); e.Enabled() {
-		bodyBytes, err := io.ReadAll(body)
+// versionedResponse is the versioned data returned by an endpoint, along with the codec
+// required to decode it.
+type versionedResponse struct {
+	version spec.DataVersion
+	codec   codecs.Codec
+	data    []byte
+}
+
+// getVersioned sends an HTTP get request to an endpoint that returns versioned data,
+// accepting the preferred wire format of the service, and returns the data in whichever
+// format the server chose.
+// If the response from the server is a 404 this will return nil for both the response and the error.
+func (s *Service) getVersioned(ctx context.Context, endpoint string) (*versionedResponse, error) {
+	httpResp, err := s.get2(ctx, endpoint, s.preferredAccept())
+	if err != nil {
+		return nil, err
+	}
+	if httpResp == nil {
+		return nil, nil
+	}
+
+	if !s.isJSON(httpResp) {
+		_, stated, err := httpResp.versionFromHeaders()
 		if err != nil {
-			return nil, errors.New("failed to read request body")
+			return nil, err
 		}
-		body = bytes.NewReader(bodyBytes)
+		if stated {
+			codec, err := s.codecFor(httpResp)
+			if err != nil {
+				return nil, errors.Wrap(err, "unsupported response content type")
+			}
 
-		e.Str("body", string(bodyBytes)).Msg("POST request")
+			return &versionedResponse{
+				version: httpResp.consensusVersion,
+				codec:   codec,
+				data:    httpResp.body,
+			}, nil
+		}
+
+		// Cannot decode binary formats without knowing the version; fall back to JSON.
+		httpResp, err = s.get2(ctx, endpoint, codecs.JSON.ContentType())
+		if err != nil {
+			return nil, err
+		}
+		if httpResp == nil {
+			return nil, nil
+		}
+		if !s.isJSON(httpResp) {
+			return nil, fmt.Errorf("unsupported response content type %s", httpResp.contentType)
+		}
 	}
 
-	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid endpoint")
+	var resp versionedResponseJSON
+	if err := json.Unmarshal(httpResp.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+
+	return &versionedResponse{
+		version: resp.Version,
+		codec:   codecs.JSON,
+		data:    resp.Data,
+	}, nil
+}
+
+// versionedResponseJSON is the JSON response from an endpoint that returns versioned data.
+// The data is decoded separately once the version is known.
+type versionedResponseJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// preferredAccept returns the accept header for endpoints that can return the preferred
+// wire format of the service, falling back to JSON.
+func (s *Service) preferredAccept() string {
+	if s.codec == nil || s.codec.ContentType() == codecs.JSON.ContentType() {
+		return codecs.JSON.ContentType()
+	}
+
+	return codecs.FormatAccept([]*codecs.MediaRange{
+		{ContentType: s.codec.ContentType(), Quality: 1},
+		{ContentType: codecs.JSON.ContentType(), Quality: 0.9},
+	})
+}
+
+// isJSON returns true if the response should be decoded as JSON.  This is the case if
+// the server chose JSON, or chose a content type that no codec handles, as some servers
+// do not state a content type for JSON responses.
+func (s *Service) isJSON(httpResp *httpResponse) bool {
+	if httpResp.contentType == codecs.JSON.ContentType() {
+		return true
+	}
+	_, err := s.codecFor(httpResp)
+
+	return err != nil
+}
+
+// codecFor returns the codec for the content type of the given response.
+func (s *Service) codecFor(httpResp *httpResponse) (codecs.Codec, error) {
+	if s.codec != nil && s.codec.ContentType() == httpResp.contentType {
+		return s.codec, nil
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
-	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url.String(), body)
+	return codecs.ForContentType(httpResp.contentType)
+}
+
+// post sends an HTTP post request and returns the body.
+func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+	res, err := s.post2(ctx, endpoint, body, nil)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to create POST request")
+		return nil, err
 	}
-	req.Header.Set("Content-type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	resp, err := s.client.Do(req)
+
+	return bytes.NewReader(res.body), nil
+}
+
+// post2 sends an HTTP post request with the given additional headers and returns the response.
+func (s *Service) post2(ctx context.Context, endpoint string, body io.Reader, headers map[string]string) (*httpResponse, error) {
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	bodyBytes, err := io.ReadAll(body)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to call POST endpoint")
+		return nil, errors.New("failed to read request body")
+	}
+	if e := log.Trace(); e.Enabled() {
+		e.Str("body", string(bodyBytes)).Msg("POST request")
 	}
-	defer resp.Body.Close()
 
-	data, err := io.ReadAll(resp.Body)
+	reqHeaders := http.Header{
+		"Content-Type": []string{"application/json"},
+		"Accept":       []string{"application/json"},
+	}
+	for k, v := range headers {
+		reqHeaders.Set(k, v)
+	}
+	opCtx, cancel := context.WithTimeout(ctx, s.timeoutFor(endpoint))
+	resp, err := s.execute(opCtx, &Request{
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		Headers:  reqHeaders,
+		Body:     bodyBytes,
+	})
+	cancel()
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to read POST response")
+		return nil, errors.Wrap(err, "failed to call POST endpoint")
 	}
+	data := resp.Body
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("POST failed")
-		cancel()
 		return nil, Error{
 			Method:     http.MethodPost,
 			StatusCode: resp.StatusCode,
@@ -154,11 +309,17 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 			Data:       data,
 		}
 	}
-	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("POST response")
+	if e := log.Trace(); e.Enabled() {
+		e.Str("response", string(data)).Msg("POST response")
+	}
 
-	return bytes.NewReader(data), nil
+	return &httpResponse{
+		statusCode:  resp.StatusCode,
+		contentType: strings.TrimSpace(strings.Split(resp.Headers.Get("Content-Type"), ";")[0]),
+		headers:     resp.Headers,
+		body:        data,
+	}, nil
 }
 
 // responseMetadata returns metadata related to responses.
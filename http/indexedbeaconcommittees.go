@@ -0,0 +1,72 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// IndexedBeaconCommittees fetches the beacon committees matching the filter at the given state.
+func (s *Service) IndexedBeaconCommittees(ctx context.Context,
+	stateID string,
+	filter *api.BeaconCommitteesFilter,
+) (
+	*api.BeaconCommittees,
+	error,
+) {
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees%s", stateID, beaconCommitteesQuery(filter))
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon committees")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain beacon committees")
+	}
+
+	var resp beaconCommitteesJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon committees")
+	}
+
+	return api.NewBeaconCommittees(resp.Data), nil
+}
+
+// beaconCommitteesQuery returns the query string for a beacon committees filter.
+func beaconCommitteesQuery(filter *api.BeaconCommitteesFilter) string {
+	if filter == nil {
+		return ""
+	}
+
+	params := make([]string, 0, 3)
+	if filter.Epoch != nil {
+		params = append(params, fmt.Sprintf("epoch=%d", *filter.Epoch))
+	}
+	if filter.Index != nil {
+		params = append(params, fmt.Sprintf("index=%d", *filter.Index))
+	}
+	if filter.Slot != nil {
+		params = append(params, fmt.Sprintf("slot=%d", *filter.Slot))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+
+	return "?" + strings.Join(params, "&")
+}
@@ -0,0 +1,58 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconCommitteesQuery(t *testing.T) {
+	epoch := phase0.Epoch(10)
+	index := phase0.CommitteeIndex(2)
+	slot := phase0.Slot(325)
+
+	tests := []struct {
+		name     string
+		filter   *api.BeaconCommitteesFilter
+		expected string
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name:   "Empty",
+			filter: &api.BeaconCommitteesFilter{},
+		},
+		{
+			name:     "Epoch",
+			filter:   &api.BeaconCommitteesFilter{Epoch: &epoch},
+			expected: "?epoch=10",
+		},
+		{
+			name:     "All",
+			filter:   &api.BeaconCommitteesFilter{Epoch: &epoch, Index: &index, Slot: &slot},
+			expected: "?epoch=10&index=2&slot=325",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, beaconCommitteesQuery(test.filter))
+		})
+	}
+}
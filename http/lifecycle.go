@@ -0,0 +1,90 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ErrServiceClosed is returned by calls made after the service has been closed.
+var ErrServiceClosed = errors.New("service closed")
+
+// track registers a background goroutine or in-flight request with the service,
+// so that Close() waits for it to finish.  It returns false if the service is
+// closed, in which case the work should not be started.
+// Each successful call must be matched by a call to untrack().
+func (s *Service) track() bool {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	s.active.Add(1)
+
+	return true
+}
+
+// untrack marks work registered with track() as finished.
+func (s *Service) untrack() {
+	s.active.Done()
+}
+
+// lifecycleContext returns a context that is cancelled when either the supplied
+// context is done or the service is closed.
+func (s *Service) lifecycleContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-s.closing:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// Close shuts down the service.  It stops background tasks, cancels in-flight
+// requests, closes event streams and waits for running event handlers to return.
+// If the supplied context is done before this completes an error is returned,
+// although shutdown continues in the background.
+// Calls made after the service is closed return ErrServiceClosed.
+func (s *Service) Close(ctx context.Context) error {
+	s.lifecycleMu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.closing)
+	}
+	s.lifecycleMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.active.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		if s.client != nil {
+			s.client.CloseIdleConnections()
+		}
+		s.log.Trace().Msg("Service closed")
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "service did not close in time")
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		// Hold the request until it is cancelled.
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Minute,
+		closing: make(chan struct{}),
+	}
+	require.NoError(t, s.periodicClearStaticValues(ctx))
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.Raw(ctx, http.MethodGet, "/eth/v1/slow", nil, nil, "")
+		errCh <- err
+	}()
+	<-started
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.Close(closeCtx))
+
+	// The in-flight request should have been cancelled.
+	select {
+	case err := <-errCh:
+		require.Error(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "in-flight request not cancelled")
+	}
+
+	// Calls after close should be rejected.
+	_, err = s.Raw(ctx, http.MethodGet, "/eth/v1/slow", nil, nil, "")
+	require.True(t, errors.Is(err, ErrServiceClosed))
+	require.True(t, errors.Is(s.Events(ctx, []string{"head"}, nil), ErrServiceClosed))
+	require.True(t, errors.Is(s.periodicClearStaticValues(ctx), ErrServiceClosed))
+
+	// Closing again is a no-op.
+	require.NoError(t, s.Close(closeCtx))
+}
+
+func TestCloseTimeout(t *testing.T) {
+	s := &Service{
+		log:     zerolog.Nop(),
+		closing: make(chan struct{}),
+	}
+
+	// Simulate work that does not finish.
+	require.True(t, s.track())
+	defer s.untrack()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.EqualError(t, s.Close(ctx), "service did not close in time: context deadline exceeded")
+}
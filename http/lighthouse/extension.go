@@ -0,0 +1,56 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lighthouse provides access to the Lighthouse-specific extensions of the beacon node API.
+//
+// Importing this package registers the extension with the http client, after which it can be
+// obtained with:
+//
+//	extensions, err := client.(eth2client.ExtensionsProvider).Extensions(ctx)
+//	...
+//	provider, isProvider := extensions[lighthouse.ExtensionName].(lighthouse.ValidatorInclusionProvider)
+package lighthouse
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
+)
+
+// ExtensionName is the name under which the extension is registered.
+const ExtensionName = "lighthouse"
+
+func init() {
+	http.RegisterExtension(ExtensionName, New)
+}
+
+// Extension provides access to Lighthouse-specific endpoints.
+type Extension struct {
+	caller http.ExtensionCaller
+}
+
+// New creates the extension, returning nil if the node is not Lighthouse.
+func New(ctx context.Context, caller http.ExtensionCaller) (interface{}, error) {
+	nodeClient, err := caller.NodeClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain node client")
+	}
+	if nodeClient != "lighthouse" {
+		return nil, nil
+	}
+
+	return &Extension{
+		caller: caller,
+	}, nil
+}
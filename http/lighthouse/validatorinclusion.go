@@ -0,0 +1,102 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lighthouse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ValidatorInclusionProvider is the interface for providing validator inclusion information.
+type ValidatorInclusionProvider interface {
+	// GlobalValidatorInclusion provides global validator inclusion information for the given epoch.
+	GlobalValidatorInclusion(ctx context.Context, epoch phase0.Epoch) (*GlobalValidatorInclusion, error)
+
+	// ValidatorInclusion provides validator inclusion information for the given epoch and validator.
+	// validatorID can be a validator index or public key.
+	ValidatorInclusion(ctx context.Context, epoch phase0.Epoch, validatorID string) (*ValidatorInclusion, error)
+}
+
+// GlobalValidatorInclusion contains global inclusion information for an epoch.
+type GlobalValidatorInclusion struct {
+	CurrentEpochActiveGwei           phase0.Gwei `json:"current_epoch_active_gwei"`
+	PreviousEpochActiveGwei          phase0.Gwei `json:"previous_epoch_active_gwei"`
+	CurrentEpochTargetAttestingGwei  phase0.Gwei `json:"current_epoch_target_attesting_gwei"`
+	PreviousEpochTargetAttestingGwei phase0.Gwei `json:"previous_epoch_target_attesting_gwei"`
+	PreviousEpochHeadAttestingGwei   phase0.Gwei `json:"previous_epoch_head_attesting_gwei"`
+}
+
+// ValidatorInclusion contains inclusion information for a validator at an epoch.
+type ValidatorInclusion struct {
+	IsSlashed                        bool        `json:"is_slashed"`
+	IsWithdrawableInCurrentEpoch     bool        `json:"is_withdrawable_in_current_epoch"`
+	IsActiveUnslashedInCurrentEpoch  bool        `json:"is_active_unslashed_in_current_epoch"`
+	IsActiveUnslashedInPreviousEpoch bool        `json:"is_active_unslashed_in_previous_epoch"`
+	CurrentEpochEffectiveBalanceGwei phase0.Gwei `json:"current_epoch_effective_balance_gwei"`
+	IsCurrentEpochTargetAttester     bool        `json:"is_current_epoch_target_attester"`
+	IsPreviousEpochTargetAttester    bool        `json:"is_previous_epoch_target_attester"`
+	IsPreviousEpochHeadAttester      bool        `json:"is_previous_epoch_head_attester"`
+}
+
+type globalValidatorInclusionJSON struct {
+	Data *GlobalValidatorInclusion `json:"data"`
+}
+
+type validatorInclusionJSON struct {
+	Data *ValidatorInclusion `json:"data"`
+}
+
+// GlobalValidatorInclusion provides global validator inclusion information for the given epoch.
+func (e *Extension) GlobalValidatorInclusion(ctx context.Context, epoch phase0.Epoch) (*GlobalValidatorInclusion, error) {
+	respBodyReader, err := e.caller.Get(ctx, fmt.Sprintf("/lighthouse/validator_inclusion/%d/global", epoch))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request global validator inclusion")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var resp globalValidatorInclusionJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse global validator inclusion")
+	}
+
+	return resp.Data, nil
+}
+
+// ValidatorInclusion provides validator inclusion information for the given epoch and validator.
+func (e *Extension) ValidatorInclusion(ctx context.Context, epoch phase0.Epoch, validatorID string) (*ValidatorInclusion, error) {
+	if validatorID == "" {
+		return nil, errors.New("no validator ID specified")
+	}
+
+	respBodyReader, err := e.caller.Get(ctx, fmt.Sprintf("/lighthouse/validator_inclusion/%d/%s", epoch, validatorID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator inclusion")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var resp validatorInclusionJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator inclusion")
+	}
+
+	return resp.Data, nil
+}
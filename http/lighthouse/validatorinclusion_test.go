@@ -0,0 +1,93 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lighthouse_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/http/lighthouse"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testCaller is a caller that returns fixed responses.
+type testCaller struct {
+	nodeClient string
+	responses  map[string]string
+}
+
+func (c *testCaller) Get(_ context.Context, endpoint string) (io.Reader, error) {
+	resp, exists := c.responses[endpoint]
+	if !exists {
+		return nil, nil
+	}
+	return strings.NewReader(resp), nil
+}
+
+func (c *testCaller) Post(_ context.Context, _ string, _ io.Reader) (io.Reader, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *testCaller) NodeClient(_ context.Context) (string, error) {
+	return c.nodeClient, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	extension, err := lighthouse.New(ctx, &testCaller{nodeClient: "teku"})
+	require.NoError(t, err)
+	require.Nil(t, extension)
+
+	extension, err = lighthouse.New(ctx, &testCaller{nodeClient: "lighthouse"})
+	require.NoError(t, err)
+	require.Implements(t, (*lighthouse.ValidatorInclusionProvider)(nil), extension)
+}
+
+func TestValidatorInclusion(t *testing.T) {
+	ctx := context.Background()
+
+	caller := &testCaller{
+		nodeClient: "lighthouse",
+		responses: map[string]string{
+			"/lighthouse/validator_inclusion/10/global": `{"data":{"current_epoch_active_gwei":642688000000000,"previous_epoch_active_gwei":642688000000000,"current_epoch_target_attesting_gwei":366208000000000,"previous_epoch_target_attesting_gwei":1000000000,"previous_epoch_head_attesting_gwei":1000000000}}`,
+			"/lighthouse/validator_inclusion/10/42":     `{"data":{"is_slashed":false,"is_withdrawable_in_current_epoch":false,"is_active_unslashed_in_current_epoch":true,"is_active_unslashed_in_previous_epoch":true,"current_epoch_effective_balance_gwei":32000000000,"is_current_epoch_target_attester":false,"is_previous_epoch_target_attester":true,"is_previous_epoch_head_attester":false}}`,
+		},
+	}
+	extension, err := lighthouse.New(ctx, caller)
+	require.NoError(t, err)
+	provider := extension.(lighthouse.ValidatorInclusionProvider)
+
+	global, err := provider.GlobalValidatorInclusion(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(642688000000000), global.CurrentEpochActiveGwei)
+	require.Equal(t, phase0.Gwei(1000000000), global.PreviousEpochHeadAttestingGwei)
+
+	inclusion, err := provider.ValidatorInclusion(ctx, 10, "42")
+	require.NoError(t, err)
+	require.True(t, inclusion.IsActiveUnslashedInCurrentEpoch)
+	require.True(t, inclusion.IsPreviousEpochTargetAttester)
+	require.Equal(t, phase0.Gwei(32000000000), inclusion.CurrentEpochEffectiveBalanceGwei)
+
+	missing, err := provider.ValidatorInclusion(ctx, 11, "42")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	_, err = provider.ValidatorInclusion(ctx, 10, "")
+	require.EqualError(t, err, "no validator ID specified")
+}
@@ -0,0 +1,78 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// ErrRefusedInLightMode is returned by calls that would download a beacon state or
+// block body when the service is in light mode.
+var ErrRefusedInLightMode = errors.New("endpoint refused in light mode")
+
+// lightModeRefused are the classes of endpoints refused in light mode.
+var lightModeRefused = []Endpoint{
+	EndpointStatesV1,
+	EndpointStatesV2,
+	EndpointBlocksV1,
+	EndpointBlocksV2,
+	EndpointBlindedBlocks,
+}
+
+// NewLight creates a new Ethereum 2 client service in light mode, for bandwidth-constrained
+// consumers.  It is the same as New() with WithLightMode(), but the returned service is
+// restricted to the cheap providers in eth2client.LightService.
+func NewLight(ctx context.Context, params ...Parameter) (eth2client.LightService, error) {
+	params = append([]Parameter{WithLightMode()}, params...)
+	service, err := New(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.(*Service), nil
+}
+
+// refusedInLightMode returns true if the given request is refused because the
+// service is in light mode.  Only downloads are refused; submissions to the
+// same endpoints are permitted.
+func (s *Service) refusedInLightMode(method string, endpoint string) bool {
+	if !s.lightMode || method != http.MethodGet {
+		return false
+	}
+	path := endpoint
+	if idx := strings.Index(path, "?"); idx != -1 {
+		path = path[:idx]
+	}
+	if strings.HasSuffix(path, "/root") {
+		// Block roots are cheap.
+		return false
+	}
+	for _, allowed := range s.lightModeAllowed {
+		if strings.HasPrefix(path, string(allowed)) {
+			return false
+		}
+	}
+	for _, refused := range lightModeRefused {
+		if strings.HasPrefix(path, string(refused)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,132 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefusedInLightMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []Endpoint
+		method   string
+		endpoint string
+		refused  bool
+	}{
+		{
+			name:     "Header",
+			method:   http.MethodGet,
+			endpoint: "/eth/v1/beacon/headers/head",
+		},
+		{
+			name:     "Duties",
+			method:   http.MethodPost,
+			endpoint: "/eth/v1/validator/duties/attester/1",
+		},
+		{
+			name:     "State",
+			method:   http.MethodGet,
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			refused:  true,
+		},
+		{
+			name:     "Block",
+			method:   http.MethodGet,
+			endpoint: "/eth/v2/beacon/blocks/head",
+			refused:  true,
+		},
+		{
+			name:     "BlindedBlock",
+			method:   http.MethodGet,
+			endpoint: "/eth/v1/beacon/blinded_blocks/head",
+			refused:  true,
+		},
+		{
+			name:     "BlockRoot",
+			method:   http.MethodGet,
+			endpoint: "/eth/v1/beacon/blocks/head/root",
+		},
+		{
+			name:     "BlockSubmission",
+			method:   http.MethodPost,
+			endpoint: "/eth/v1/beacon/blocks",
+		},
+		{
+			name:     "Allowed",
+			allowed:  []Endpoint{EndpointBlocksV2},
+			method:   http.MethodGet,
+			endpoint: "/eth/v2/beacon/blocks/head",
+		},
+		{
+			name:     "OtherAllowed",
+			allowed:  []Endpoint{EndpointBlocksV2},
+			method:   http.MethodGet,
+			endpoint: "/eth/v2/debug/beacon/states/head?x=1",
+			refused:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				lightMode:        true,
+				lightModeAllowed: test.allowed,
+			}
+			require.Equal(t, test.refused, s.refusedInLightMode(test.method, test.endpoint))
+		})
+	}
+
+	// Nothing is refused outside of light mode.
+	require.False(t, (&Service{}).refusedInLightMode(http.MethodGet, "/eth/v2/debug/beacon/states/head"))
+}
+
+func TestLightMode(t *testing.T) {
+	ctx := context.Background()
+
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:                   zerolog.Nop(),
+		base:                  base,
+		address:               server.URL,
+		client:                server.Client(),
+		timeout:               time.Second,
+		supportsV2BeaconState: true,
+		lightMode:             true,
+	}
+
+	_, err = s.BeaconState(ctx, "head")
+	require.True(t, errors.Is(err, ErrRefusedInLightMode))
+	require.False(t, requested)
+
+	require.NoError(t, s.checkAPIVersioning(ctx))
+	require.True(t, s.supportsV2BeaconBlocks)
+	require.False(t, requested)
+}
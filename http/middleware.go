@@ -0,0 +1,194 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Request is a request to a beacon node endpoint, as seen by middleware.
+type Request struct {
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Endpoint is the path of the request relative to the node's address, including any query.
+	Endpoint string `json:"endpoint"`
+	// Headers are the headers of the request.
+	Headers http.Header `json:"headers,omitempty"`
+	// Body is the body of the request, if any.
+	Body []byte `json:"body,omitempty"`
+}
+
+// Response is a response from a beacon node endpoint, as seen by middleware.
+// Responses with any status code are passed through the middleware chain; interpretation
+// of the status code happens after the chain has returned.
+type Response struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"status_code"`
+	// Headers are the headers of the response.
+	Headers http.Header `json:"headers,omitempty"`
+	// Body is the body of the response.
+	Body []byte `json:"body,omitempty"`
+}
+
+// CallFunc carries out a request.
+type CallFunc func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a CallFunc to add behaviour before or after the request is carried out,
+// or to carry it out itself.  Middleware applies to all requests to the node except for the
+// long-lived events stream.
+type Middleware func(next CallFunc) CallFunc
+
+// chain builds the call function for the service from its middleware.
+// The first middleware supplied is the outermost.
+func chain(call CallFunc, middlewares []Middleware) CallFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		call = middlewares[i](call)
+	}
+
+	return call
+}
+
+// execute carries out a request through the middleware chain.
+func (s *Service) execute(ctx context.Context, req *Request) (*Response, error) {
+	if s.refusedInLightMode(req.Method, req.Endpoint) {
+		return nil, errors.Wrapf(ErrRefusedInLightMode, "%s %s", req.Method, req.Endpoint)
+	}
+
+	// Requests are cancelled if the service is closed whilst they are in flight.
+	if !s.track() {
+		return nil, ErrServiceClosed
+	}
+	defer s.untrack()
+	ctx, cancel := s.lifecycleContext(ctx)
+	defer cancel()
+
+	if s.call == nil {
+		return s.do(ctx, req)
+	}
+
+	return s.call(ctx, req)
+}
+
+// do carries out a request against the node.
+func (s *Service) do(ctx context.Context, req *Request) (*Response, error) {
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), req.Endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, url.String(), body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	for k, v := range req.Headers {
+		httpReq.Header[k] = v
+	}
+
+	httpResp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	data, err := readResponseBody(httpResp, s.maxResponseSizeFor(req.Endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		StatusCode: httpResp.StatusCode,
+		Headers:    httpResp.Header,
+		Body:       data,
+	}, nil
+}
+
+// LoggingMiddleware logs each request and its outcome to the supplied logger at debug level.
+func LoggingMiddleware(log zerolog.Logger) Middleware {
+	return func(next CallFunc) CallFunc {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			started := time.Now()
+			resp, err := next(ctx, req)
+			e := log.Debug().Str("method", req.Method).Str("endpoint", req.Endpoint).Dur("elapsed", time.Since(started))
+			if err != nil {
+				e.Err(err).Msg("Request failed")
+			} else {
+				e.Int("status_code", resp.StatusCode).Int("bytes", len(resp.Body)).Msg("Request complete")
+			}
+
+			return resp, err
+		}
+	}
+}
+
+// Recording is a request and the response to it, captured by a Recorder.
+type Recording struct {
+	Request  *Request
+	Response *Response
+	Err      error
+}
+
+// Recorder captures requests and responses in memory.
+type Recorder struct {
+	mu         sync.Mutex
+	recordings []*Recording
+}
+
+// NewRecorder creates a new recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Middleware returns middleware that captures requests and responses in to the recorder.
+func (r *Recorder) Middleware() Middleware {
+	return func(next CallFunc) CallFunc {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			resp, err := next(ctx, req)
+			r.mu.Lock()
+			r.recordings = append(r.recordings, &Recording{
+				Request:  req,
+				Response: resp,
+				Err:      err,
+			})
+			r.mu.Unlock()
+
+			return resp, err
+		}
+	}
+}
+
+// Recordings returns the recordings captured so far, in the order the requests completed.
+func (r *Recorder) Recordings() []*Recording {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*Recording, len(r.recordings))
+	copy(res, r.recordings)
+
+	return res
+}
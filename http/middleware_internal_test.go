@@ -0,0 +1,131 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":"` + r.Header.Get("X-Order") + `"}`))
+	}))
+	defer server.Close()
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	// Middleware that appends its name to a header, to confirm ordering.
+	tag := func(name string) Middleware {
+		return func(next CallFunc) CallFunc {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				req.Headers.Set("X-Order", req.Headers.Get("X-Order")+name)
+				return next(ctx, req)
+			}
+		}
+	}
+	// Middleware that serves a fixed response for one endpoint without calling the node.
+	shortCircuit := func(next CallFunc) CallFunc {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			if req.Endpoint == "/cached" {
+				return &Response{StatusCode: http.StatusOK, Headers: http.Header{}, Body: []byte(`{"data":"cached"}`)}, nil
+			}
+			if req.Endpoint == "/chaos" {
+				return nil, errors.New("chaos")
+			}
+			return next(ctx, req)
+		}
+	}
+
+	// Logging is disabled globally for tests; enable it to check the logging middleware.
+	level := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	defer zerolog.SetGlobalLevel(level)
+
+	recorder := NewRecorder()
+	logs := &bytes.Buffer{}
+	parameters, err := parseAndCheckParameters(
+		WithAddress(server.URL),
+		WithMiddleware(LoggingMiddleware(zerolog.New(logs))),
+		WithMiddleware(recorder.Middleware()),
+		WithMiddleware(tag("a")),
+		WithMiddleware(tag("b")),
+		WithMiddleware(shortCircuit),
+	)
+	require.NoError(t, err)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+	s.call = chain(s.do, parameters.middlewares)
+
+	res, err := s.get2(ctx, "/test", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"ab"}`, string(res.body))
+	require.Equal(t, "application/json", res.contentType)
+
+	res, err = s.get2(ctx, "/cached", "application/json")
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"cached"}`, string(res.body))
+
+	res, err = s.get2(ctx, "/missing", "application/json")
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = s.get2(ctx, "/chaos", "application/json")
+	require.EqualError(t, err, "failed to call GET endpoint: chaos")
+
+	_, err = s.post(ctx, "/test", bytes.NewBufferString(`{}`))
+	require.NoError(t, err)
+
+	require.Equal(t, 3, hits)
+
+	recordings := recorder.Recordings()
+	require.Len(t, recordings, 5)
+	require.Equal(t, "/test", recordings[0].Request.Endpoint)
+	require.Equal(t, http.StatusOK, recordings[0].Response.StatusCode)
+	require.Equal(t, http.StatusNotFound, recordings[2].Response.StatusCode)
+	require.EqualError(t, recordings[3].Err, "chaos")
+	require.Equal(t, http.MethodPost, recordings[4].Request.Method)
+	require.Equal(t, []byte(`{}`), recordings[4].Request.Body)
+
+	require.Contains(t, logs.String(), `"endpoint":"/cached"`)
+	require.Contains(t, logs.String(), `"error":"chaos"`)
+}
+
+func TestMiddlewareNil(t *testing.T) {
+	_, err := parseAndCheckParameters(WithAddress("localhost:1"), WithMiddleware(nil))
+	require.EqualError(t, err, "nil middleware specified")
+}
@@ -0,0 +1,92 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// NodePeersPage obtains a page of the peers of the node.
+// The returned flag is true if there are further peers after the page.
+func (s *Service) NodePeersPage(ctx context.Context, opts *api.PageOptions) ([]*apiv1.Peer, bool, error) {
+	respBody, err := s.getStream(ctx, "/eth/v1/node/peers")
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to request peers")
+	}
+	if respBody == nil {
+		return nil, false, errors.New("failed to obtain peers")
+	}
+	defer respBody.Close()
+
+	peers := make([]*apiv1.Peer, 0)
+	more, err := decodeDataEntries(respBody, "data", opts, func(decoder *json.Decoder) error {
+		var peer apiv1.Peer
+		if err := decoder.Decode(&peer); err != nil {
+			return err
+		}
+		peers = append(peers, &peer)
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to parse peers")
+	}
+
+	return peers, more, nil
+}
+
+// NodePeersPages iterates over the peers of the node, calling the supplied function
+// with successive pages of up to pageSize peers.
+// Only a single page of peers is held in memory at any time.
+func (s *Service) NodePeersPages(ctx context.Context, pageSize uint64, fn func([]*apiv1.Peer) error) error {
+	respBody, err := s.getStream(ctx, "/eth/v1/node/peers")
+	if err != nil {
+		return errors.Wrap(err, "failed to request peers")
+	}
+	if respBody == nil {
+		return errors.New("failed to obtain peers")
+	}
+	defer respBody.Close()
+
+	page := make([]*apiv1.Peer, 0, pageSize)
+	err = iteratePages(ctx, respBody, "data", pageSize,
+		func(decoder *json.Decoder) error {
+			var peer apiv1.Peer
+			if err := decoder.Decode(&peer); err != nil {
+				return err
+			}
+			page = append(page, &peer)
+
+			return nil
+		},
+		func() error {
+			if err := fn(page); err != nil {
+				return err
+			}
+			page = make([]*apiv1.Peer, 0, pageSize)
+
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to iterate over peers")
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/pkg/errors"
+)
+
+// getStream carries out a GET request for JSON against the node, returning the body
+// of the response to be decoded as it is read rather than buffering it in full as get
+// does.  Because of this the request does not pass through the service's middleware.
+// The timeout for the endpoint covers reading the body, and the maximum response size
+// is enforced as the body is read.  The caller must close the returned body.
+func (s *Service) getStream(ctx context.Context, endpoint string) (io.ReadCloser, error) {
+	if s.refusedInLightMode(http.MethodGet, endpoint) {
+		return nil, errors.Wrapf(ErrRefusedInLightMode, "%s %s", http.MethodGet, endpoint)
+	}
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	log.Trace().Msg("GET stream request")
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	// The request remains in flight until the body is closed.
+	if !s.track() {
+		return nil, ErrServiceClosed
+	}
+	ctx, lifecycleCancel := s.lifecycleContext(ctx)
+	ctx, timeoutCancel := context.WithTimeout(ctx, s.timeoutFor(endpoint))
+	release := func() {
+		timeoutCancel()
+		lifecycleCancel()
+		s.untrack()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		release()
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	httpReq.Header.Set("Accept", codecs.JSON.ContentType())
+	httpResp, err := s.client.Do(httpReq)
+	if err != nil {
+		release()
+		return nil, errors.Wrap(err, "failed to call GET endpoint")
+	}
+	body := &streamedBody{
+		body:    httpResp.Body,
+		reader:  httpResp.Body,
+		release: release,
+	}
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		// Nothing found.  This is not an error, so we return nil on both counts.
+		_ = body.Close()
+		return nil, nil
+	}
+
+	limit := s.maxResponseSizeFor(endpoint)
+	if httpResp.StatusCode/100 != 2 {
+		data, err := readResponseBody(httpResp, limit)
+		_ = body.Close()
+		if err != nil {
+			return nil, err
+		}
+		log.Trace().Int("status_code", httpResp.StatusCode).Str("data", string(data)).Msg("GET stream failed")
+		return nil, Error{
+			Method:     http.MethodGet,
+			StatusCode: httpResp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       data,
+		}
+	}
+
+	res := &httpResponse{
+		statusCode:  httpResp.StatusCode,
+		contentType: strings.TrimSpace(strings.Split(httpResp.Header.Get("Content-Type"), ";")[0]),
+		headers:     httpResp.Header,
+	}
+	if !s.isJSON(res) {
+		// The server chose a format that this endpoint cannot decode.
+		_ = body.Close()
+		return nil, fmt.Errorf("unsupported response content type %s", res.contentType)
+	}
+
+	if limit > 0 {
+		if httpResp.ContentLength > limit {
+			_ = body.Close()
+			return nil, errors.Wrapf(ErrResponseTooLarge, "response of %d bytes greater than maximum of %d", httpResp.ContentLength, limit)
+		}
+		body.reader = &limitedReader{reader: httpResp.Body, remaining: limit, limit: limit}
+	}
+
+	return body, nil
+}
+
+// streamedBody is the body of a streamed response.  Closing it releases the request.
+type streamedBody struct {
+	body    io.Closer
+	reader  io.Reader
+	release func()
+}
+
+// Read implements io.Reader.
+func (b *streamedBody) Read(p []byte) (int, error) {
+	return b.reader.Read(p)
+}
+
+// Close implements io.Closer.
+func (b *streamedBody) Close() error {
+	err := b.body.Close()
+	b.release()
+
+	return err
+}
+
+// limitedReader reads from the underlying reader, failing once more than the limit
+// has been read.
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+	limit     int64
+}
+
+// Read implements io.Reader.
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if r.remaining < 0 {
+		return 0, errors.Wrapf(ErrResponseTooLarge, "response greater than maximum of %d bytes", r.limit)
+	}
+	// Allow one byte more than the limit to be read, to detect oversized responses.
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return 0, errors.Wrapf(ErrResponseTooLarge, "response greater than maximum of %d bytes", r.limit)
+	}
+
+	return n, err
+}
+
+// decodeDataEntries decodes the entries of the named array of a response one at a
+// time, passing each to the supplied function.  Entries before the offset of the
+// page options are skipped without being decoded, and decoding stops once the
+// limit has been reached, so that only the requested entries are held in memory.
+// Returns true if there are further entries after the last one passed.
+func decodeDataEntries(reader io.Reader, key string, opts *api.PageOptions, fn func(*json.Decoder) error) (bool, error) {
+	if opts == nil {
+		opts = &api.PageOptions{}
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return false, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return false, errors.Wrap(err, "invalid JSON")
+		}
+		if name, isString := token.(string); !isString || name != key {
+			// Skip the value of any other field.
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return false, errors.Wrap(err, "invalid JSON")
+			}
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return false, err
+		}
+		for i := uint64(0); decoder.More(); i++ {
+			if opts.Limit > 0 && i >= opts.Offset+opts.Limit {
+				return true, nil
+			}
+			if i < opts.Offset {
+				var skip json.RawMessage
+				if err := decoder.Decode(&skip); err != nil {
+					return false, errors.Wrap(err, "invalid JSON")
+				}
+				continue
+			}
+			if err := fn(decoder); err != nil {
+				return false, err
+			}
+		}
+
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%s not returned", key)
+}
+
+// expectDelim consumes the next token of the decoder, returning an error if it is
+// not the given delimiter.
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, found %v", delim, token)
+	}
+
+	return nil
+}
+
+// iteratePages calls the supplied function with successive pages of up to pageSize
+// entries as they are decoded from the named array of a response.
+func iteratePages(ctx context.Context, reader io.Reader, key string, pageSize uint64, decodeEntry func(*json.Decoder) error, flush func() error) error {
+	if pageSize == 0 {
+		return errors.New("page size must be greater than 0")
+	}
+
+	pending := uint64(0)
+	_, err := decodeDataEntries(reader, key, nil, func(decoder *json.Decoder) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := decodeEntry(decoder); err != nil {
+			return err
+		}
+		pending++
+		if pending == pageSize {
+			pending = 0
+			return flush()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,372 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttestationPool returns an attestation pool response with the given number
+// of attestations for slot 5, with committee indices matching their positions.
+func testAttestationPool(count int) string {
+	entries := make([]string, count)
+	for i := range entries {
+		entries[i] = fmt.Sprintf(`{"aggregation_bits":"0x01","data":{"slot":"5","index":"%d","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}`, i)
+	}
+
+	return fmt.Sprintf(`{"execution_optimistic":false,"data":[%s]}`, strings.Join(entries, ","))
+}
+
+func testPaginationService(t *testing.T, response string) *Service {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+}
+
+func TestAttestationPoolPage(t *testing.T) {
+	ctx := context.Background()
+	s := testPaginationService(t, testAttestationPool(10))
+
+	tests := []struct {
+		name    string
+		opts    *api.PageOptions
+		indices []phase0.CommitteeIndex
+		more    bool
+	}{
+		{
+			name:    "Nil",
+			indices: []phase0.CommitteeIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name:    "Limit",
+			opts:    &api.PageOptions{Limit: 3},
+			indices: []phase0.CommitteeIndex{0, 1, 2},
+			more:    true,
+		},
+		{
+			name:    "OffsetAndLimit",
+			opts:    &api.PageOptions{Offset: 4, Limit: 3},
+			indices: []phase0.CommitteeIndex{4, 5, 6},
+			more:    true,
+		},
+		{
+			name:    "FinalPage",
+			opts:    &api.PageOptions{Offset: 7, Limit: 3},
+			indices: []phase0.CommitteeIndex{7, 8, 9},
+		},
+		{
+			name:    "OffsetOnly",
+			opts:    &api.PageOptions{Offset: 8},
+			indices: []phase0.CommitteeIndex{8, 9},
+		},
+		{
+			name:    "OffsetBeyondEnd",
+			opts:    &api.PageOptions{Offset: 20, Limit: 3},
+			indices: []phase0.CommitteeIndex{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attestations, more, err := s.AttestationPoolPage(ctx, 5, test.opts)
+			require.NoError(t, err)
+			require.Equal(t, test.more, more)
+			indices := make([]phase0.CommitteeIndex, len(attestations))
+			for i := range attestations {
+				indices[i] = attestations[i].Data.Index
+			}
+			require.Equal(t, test.indices, indices)
+		})
+	}
+}
+
+func TestAttestationPoolPageErrors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		response string
+		err      string
+	}{
+		{
+			name:     "DataMissing",
+			response: `{"execution_optimistic":false}`,
+			err:      "failed to parse attestation pool: data not returned",
+		},
+		{
+			name:     "DataNull",
+			response: `{"data":null}`,
+			err:      "failed to parse attestation pool: expected [, found <nil>",
+		},
+		{
+			name:     "NotObject",
+			response: `[]`,
+			err:      "failed to parse attestation pool: expected {, found [",
+		},
+		{
+			name:     "WrongSlot",
+			response: strings.ReplaceAll(testAttestationPool(2), `"slot":"5"`, `"slot":"6"`),
+			err:      "failed to parse attestation pool: attestation pool entry not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testPaginationService(t, test.response)
+			_, _, err := s.AttestationPoolPage(ctx, 5, nil)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestAttestationPoolPages(t *testing.T) {
+	ctx := context.Background()
+	s := testPaginationService(t, testAttestationPool(10))
+
+	sizes := make([]int, 0)
+	total := 0
+	err := s.AttestationPoolPages(ctx, 5, 4, func(page []*phase0.Attestation) error {
+		sizes = append(sizes, len(page))
+		for i := range page {
+			require.Equal(t, phase0.CommitteeIndex(total), page[i].Data.Index)
+			total++
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 4, 2}, sizes)
+
+	err = s.AttestationPoolPages(ctx, 5, 0, func(page []*phase0.Attestation) error { return nil })
+	require.EqualError(t, err, "failed to iterate over attestation pool: page size must be greater than 0")
+
+	calls := 0
+	err = s.AttestationPoolPages(ctx, 5, 4, func(page []*phase0.Attestation) error {
+		calls++
+		return fmt.Errorf("stop")
+	})
+	require.EqualError(t, err, "failed to iterate over attestation pool: stop")
+	require.Equal(t, 1, calls)
+}
+
+func TestAttestationPoolPageStreamed(t *testing.T) {
+	ctx := context.Background()
+
+	// The server sends the start of the pool and then stalls, so the page can only be
+	// returned if the response is decoded as it is read.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		pool := testAttestationPool(3)
+		_, _ = w.Write([]byte(pool[:len(pool)-2]))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: 2 * time.Second,
+	}
+
+	started := time.Now()
+	attestations, more, err := s.AttestationPoolPage(ctx, 5, &api.PageOptions{Limit: 2})
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Len(t, attestations, 2)
+	require.Less(t, int64(time.Since(started)), int64(time.Second))
+}
+
+func TestAttestationPoolPageMaxResponseSize(t *testing.T) {
+	ctx := context.Background()
+	pool := testAttestationPool(10)
+
+	// A chunked response, so that the size is only known as the body is read.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(pool[:len(pool)/2]))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(pool[len(pool)/2:]))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:             zerolog.Nop(),
+		base:            base,
+		address:         server.URL,
+		client:          server.Client(),
+		timeout:         time.Second,
+		maxResponseSize: int64(len(pool) / 2),
+	}
+
+	// The first page is within the limit.
+	attestations, more, err := s.AttestationPoolPage(ctx, 5, &api.PageOptions{Limit: 2})
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Len(t, attestations, 2)
+
+	// The full pool is not.
+	_, _, err = s.AttestationPoolPage(ctx, 5, nil)
+	require.True(t, errors.Is(err, ErrResponseTooLarge))
+}
+
+func TestAttestationPoolPageStatus(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		status int
+		err    string
+	}{
+		{
+			name:   "NotFound",
+			status: http.StatusNotFound,
+			err:    "failed to obtain attestation pool",
+		},
+		{
+			name:   "ServerError",
+			status: http.StatusInternalServerError,
+			err:    "failed to request attestation pool: GET failed with status 500: {\"code\":500}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(`{"code":500}`))
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:     zerolog.Nop(),
+				base:    base,
+				address: server.URL,
+				client:  server.Client(),
+				timeout: time.Second,
+			}
+
+			_, _, err = s.AttestationPoolPage(ctx, 5, nil)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+// testPeers returns a peers response with the given number of peers, with peer IDs
+// matching their positions.
+func testPeers(count int) string {
+	entries := make([]string, count)
+	for i := range entries {
+		entries[i] = fmt.Sprintf(`{"peer_id":"peer%d","last_seen_p2p_address":"/ip4/10.0.0.1/tcp/9000","state":"connected","direction":"inbound"}`, i)
+	}
+
+	return fmt.Sprintf(`{"data":[%s],"meta":{"count":"%d"}}`, strings.Join(entries, ","), count)
+}
+
+func TestNodePeersPage(t *testing.T) {
+	ctx := context.Background()
+	s := testPaginationService(t, testPeers(5))
+
+	peers, more, err := s.NodePeersPage(ctx, &api.PageOptions{Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Len(t, peers, 2)
+	require.Equal(t, "peer1", peers[0].PeerID)
+	require.Equal(t, "peer2", peers[1].PeerID)
+
+	sizes := make([]int, 0)
+	err = s.NodePeersPages(ctx, 2, func(page []*apiv1.Peer) error {
+		sizes = append(sizes, len(page))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 2, 1}, sizes)
+}
+
+// testForkChoice returns a fork choice response with the given number of nodes, with
+// slots matching their positions.
+func testForkChoice(count int) string {
+	root := "0x0000000000000000000000000000000000000000000000000000000000000000"
+	entries := make([]string, count)
+	for i := range entries {
+		entries[i] = fmt.Sprintf(`{"slot":"%d","block_root":"%s","parent_root":"%s","justified_epoch":"0","finalized_epoch":"0","weight":"0","validity":"valid","execution_block_hash":"%s","extra_data":{}}`, i, root, root, root)
+	}
+	checkpoint := fmt.Sprintf(`{"epoch":"0","root":"%s"}`, root)
+
+	return fmt.Sprintf(`{"justified_checkpoint":%s,"finalized_checkpoint":%s,"fork_choice_nodes":[%s],"extra_data":{}}`, checkpoint, checkpoint, strings.Join(entries, ","))
+}
+
+func TestForkChoiceNodesPage(t *testing.T) {
+	ctx := context.Background()
+	s := testPaginationService(t, testForkChoice(5))
+
+	nodes, more, err := s.ForkChoiceNodesPage(ctx, &api.PageOptions{Offset: 3})
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Len(t, nodes, 2)
+	require.Equal(t, phase0.Slot(3), nodes[0].Slot)
+	require.Equal(t, phase0.Slot(4), nodes[1].Slot)
+
+	sizes := make([]int, 0)
+	err = s.ForkChoiceNodesPages(ctx, 3, func(page []*apiv1.ForkChoiceNode) error {
+		sizes = append(sizes, len(page))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 2}, sizes)
+
+	s = testPaginationService(t, `{"extra_data":{}}`)
+	_, _, err = s.ForkChoiceNodesPage(ctx, nil)
+	require.EqualError(t, err, "failed to parse fork choice: fork_choice_nodes not returned")
+}
@@ -14,18 +14,45 @@
 package http
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel        zerolog.Level
-	address         string
-	timeout         time.Duration
-	indexChunkSize  int
-	pubKeyChunkSize int
+	logLevel           zerolog.Level
+	address            string
+	timeout            time.Duration
+	timeouts           map[Endpoint]time.Duration
+	indexChunkSize     int
+	pubKeyChunkSize    int
+	staleEventsTimeout time.Duration
+	staleEventsHandler StaleEventsHandlerFunc
+	eventsWebSocket    string
+	codec              codecs.Codec
+	middlewares        []Middleware
+	slashingProtector  SlashingProtector
+	rejectWhenSyncing  bool
+	maxSyncDistance    phase0.Slot
+	maxResponseSize    int64
+	maxResponseSizes   map[Endpoint]int64
+	unixSocket         string
+	proxy              string
+	headVoteCheckMode  HeadVoteCheckMode
+	maxHeadVoteAge     phase0.Slot
+	lightMode          bool
+	lightModeAllowed   []Endpoint
+	minPeers           uint64
+	dependentRootCheck bool
+	dependentRetries   int
+	forkHandler        ForkTransitionHandlerFunc
+	cache              cache.Cache
 }
 
 // Parameter is the interface for service parameters.
@@ -60,6 +87,18 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithTimeouts sets custom maximum durations for requests to classes of endpoints.
+// Endpoints that do not match any of the supplied classes use the value supplied
+// by WithTimeout.
+func WithTimeouts(timeouts map[Endpoint]time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeouts = make(map[Endpoint]time.Duration, len(timeouts))
+		for endpoint, timeout := range timeouts {
+			p.timeouts[endpoint] = timeout
+		}
+	})
+}
+
 // WithIndexChunkSize sets the maximum number of indices to send for individual validator requests.
 func WithIndexChunkSize(indexChunkSize int) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -74,6 +113,162 @@ func WithPubKeyChunkSize(pubKeyChunkSize int) Parameter {
 	})
 }
 
+// WithStaleEventsTimeout sets the maximum duration an events stream can go without
+// any activity before it is considered stale and reconnected.  A value of 0 disables
+// staleness detection.
+func WithStaleEventsTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.staleEventsTimeout = timeout
+	})
+}
+
+// WithStaleEventsHandler sets a handler that is called when an events stream is found to be stale.
+func WithStaleEventsHandler(handler StaleEventsHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.staleEventsHandler = handler
+	})
+}
+
+// WithForkTransitionHandler sets a handler that is called when responses from the node
+// start to carry a later consensus version than previously seen.  Fork-dependent cached
+// values are cleared before the handler is called.
+func WithForkTransitionHandler(handler ForkTransitionHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.forkHandler = handler
+	})
+}
+
+// WithEventsWebSocket sets the address of a WebSocket endpoint, provided by the node or a
+// sidecar, to use for event subscriptions in preference to the server-sent events stream.
+// The address must use the ws or wss scheme.  If a connection to the WebSocket endpoint
+// cannot be established the server-sent events stream is used instead.
+func WithEventsWebSocket(address string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsWebSocket = address
+	})
+}
+
+// WithCodec sets the preferred wire format for responses from endpoints that support
+// alternatives to JSON.  JSON is always accepted as a fallback.  Defaults to SSZ.
+func WithCodec(codec codecs.Codec) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.codec = codec
+	})
+}
+
+// WithMiddleware adds middleware to the requests made by the service.  This can be supplied
+// multiple times, in which case the first middleware supplied is the outermost.
+func WithMiddleware(middleware Middleware) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.middlewares = append(p.middlewares, middleware)
+	})
+}
+
+// WithSlashingProtector sets a slashing protector that is consulted before proposals
+// and attestations are submitted; if it returns an error the submission is refused.
+func WithSlashingProtector(protector SlashingProtector) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slashingProtector = protector
+	})
+}
+
+// WithMaxResponseSize sets the maximum size in bytes of responses from the endpoint.
+// Requests with larger responses fail with ErrResponseTooLarge.  The default is no limit.
+func WithMaxResponseSize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseSize = size
+	})
+}
+
+// WithMaxResponseSizes sets custom maximum response sizes for classes of endpoints.
+// Endpoints that do not match any of the supplied classes use the value supplied
+// by WithMaxResponseSize.  A size of 0 means no limit for the class.
+func WithMaxResponseSizes(sizes map[Endpoint]int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseSizes = make(map[Endpoint]int64, len(sizes))
+		for endpoint, size := range sizes {
+			p.maxResponseSizes[endpoint] = size
+		}
+	})
+}
+
+// WithCache sets the cache in which the service holds values fetched from the node that
+// rarely change: the genesis, spec, deposit contract and fork schedule.  The cache can be
+// shared between services, as its keys are qualified by the node.  Values are held as the
+// types of this module, so an implementation that holds them outside of the process must
+// return them as the same types.  The default is an unbounded in-memory cache.
+func WithCache(cache cache.Cache) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.cache = cache
+	})
+}
+
+// WithRejectWhenSyncing causes calls that require a synced node, such as duties,
+// attestation data and proposals, to fail with ErrNodeSyncing if the node's sync
+// distance is greater than the given distance.
+func WithRejectWhenSyncing(distance phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rejectWhenSyncing = true
+		p.maxSyncDistance = distance
+	})
+}
+
+// WithMinPeers sets the minimum number of connected peers for ChainReadiness to consider
+// the node ready.  Defaults to 1.
+func WithMinPeers(peers uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.minPeers = peers
+	})
+}
+
+// WithUnixSocket connects to the node through the unix domain socket at the given path,
+// rather than over TCP.  The host in the address is then only used for the HTTP Host
+// header.  An address of the form unix:///path/to/socket has the same effect.
+func WithUnixSocket(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.unixSocket = path
+	})
+}
+
+// WithProxy connects to the node through the proxy at the given URL.  Proxies with
+// socks5, socks5h, http and https schemes are supported.
+func WithProxy(proxy string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proxy = proxy
+	})
+}
+
+// WithHeadVoteCheck checks the beacon block root in attestation data against the
+// heads seen on the events stream, flagging data that votes for a head that has not
+// been seen or that is more than the given number of slots older than the data.
+// The mode defines if such data is logged or rejected with ErrHeadVoteMismatch.
+func WithHeadVoteCheck(mode HeadVoteCheckMode, maxAge phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.headVoteCheckMode = mode
+		p.maxHeadVoteAge = maxAge
+	})
+}
+
+// WithProposerDutiesDependentRootCheck checks the dependent root returned with proposer
+// duties against the root of the block at the dependent slot, refetching the duties up to
+// the given number of times on mismatch before failing with ErrDependentRootMismatch.
+func WithProposerDutiesDependentRootCheck(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dependentRootCheck = true
+		p.dependentRetries = retries
+	})
+}
+
+// WithLightMode refuses calls that download beacon states or block bodies, failing them
+// with ErrRefusedInLightMode, for consumers that only require cheap data such as headers,
+// finality and duties.  Classes of endpoints can be permitted regardless by supplying them.
+func WithLightMode(allowed ...Endpoint) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.lightMode = true
+		p.lightModeAllowed = allowed
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -81,6 +276,8 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		timeout:         2 * time.Second,
 		indexChunkSize:  -1,
 		pubKeyChunkSize: -1,
+		codec:           codecs.SSZ,
+		minPeers:        1,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -94,12 +291,72 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	for endpoint, timeout := range parameters.timeouts {
+		if !strings.HasPrefix(string(endpoint), "/") {
+			return nil, fmt.Errorf("invalid endpoint %s for custom timeout", endpoint)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("no timeout specified for endpoint %s", endpoint)
+		}
+	}
+	if parameters.maxResponseSize < 0 {
+		return nil, errors.New("invalid maximum response size")
+	}
+	for endpoint, size := range parameters.maxResponseSizes {
+		if !strings.HasPrefix(string(endpoint), "/") {
+			return nil, fmt.Errorf("invalid endpoint %s for custom maximum response size", endpoint)
+		}
+		if size < 0 {
+			return nil, fmt.Errorf("invalid maximum response size for endpoint %s", endpoint)
+		}
+	}
 	if parameters.indexChunkSize == 0 {
 		return nil, errors.New("no index chunk size specified")
 	}
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.staleEventsTimeout < 0 {
+		return nil, errors.New("invalid stale events timeout")
+	}
+	if parameters.eventsWebSocket != "" &&
+		!strings.HasPrefix(parameters.eventsWebSocket, "ws://") &&
+		!strings.HasPrefix(parameters.eventsWebSocket, "wss://") {
+		return nil, errors.New("invalid events websocket address")
+	}
+	if strings.HasPrefix(parameters.address, unixAddressPrefix) {
+		if parameters.unixSocket != "" {
+			return nil, errors.New("unix socket supplied in both address and parameter")
+		}
+		parameters.unixSocket = strings.TrimPrefix(parameters.address, unixAddressPrefix)
+	}
+	if parameters.unixSocket != "" && parameters.proxy != "" {
+		return nil, errors.New("cannot use both a unix socket and a proxy")
+	}
+	if parameters.proxy != "" {
+		if _, err := parseProxy(parameters.proxy); err != nil {
+			return nil, err
+		}
+	}
+	if parameters.dependentRetries < 0 {
+		return nil, errors.New("proposer duties dependent root retries cannot be negative")
+	}
+	if parameters.headVoteCheckMode < HeadVoteCheckNone || parameters.headVoteCheckMode > HeadVoteCheckReject {
+		return nil, errors.New("invalid head vote check mode")
+	}
+	for _, endpoint := range parameters.lightModeAllowed {
+		if !strings.HasPrefix(string(endpoint), "/") {
+			return nil, fmt.Errorf("invalid endpoint %s for light mode", endpoint)
+		}
+	}
+	if parameters.codec == nil {
+		return nil, errors.New("no codec specified")
+	}
+	for _, middleware := range parameters.middlewares {
+		if middleware == nil {
+			return nil, errors.New("nil middleware specified")
+		}
+	}
 
 	return &parameters, nil
 }
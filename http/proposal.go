@@ -0,0 +1,247 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// proposalJSON is the response from the v3 block production endpoint.
+// The data is decoded separately once the version and blinding are known.
+type proposalJSON struct {
+	Version                 spec.DataVersion `json:"version"`
+	ExecutionPayloadBlinded *bool            `json:"execution_payload_blinded"`
+	ExecutionPayloadValue   string           `json:"execution_payload_value"`
+	ConsensusBlockValue     string           `json:"consensus_block_value"`
+	Data                    json.RawMessage  `json:"data"`
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Service) Proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
+	// Graffiti should be 32 bytes.
+	fixedGraffiti := [32]byte{}
+	copy(fixedGraffiti[:], graffiti)
+
+	url := fmt.Sprintf("/eth/v3/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, fixedGraffiti)
+	httpResp, err := s.get2(ctx, url, s.preferredAccept())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request proposal")
+	}
+	if httpResp == nil {
+		return nil, errors.New("failed to obtain proposal")
+	}
+
+	var resp proposalJSON
+	codec := codecs.JSON
+	var data []byte
+	if s.isJSON(httpResp) {
+		if err := json.NewDecoder(bytes.NewReader(httpResp.body)).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse proposal")
+		}
+		data = resp.Data
+	} else {
+		// Binary formats carry only the block, so metadata must come from the headers.
+		_, stated, err := httpResp.versionFromHeaders()
+		if err != nil {
+			return nil, err
+		}
+		if !stated {
+			return nil, errors.New("proposal does not state its version")
+		}
+		codec, err = s.codecFor(httpResp)
+		if err != nil {
+			return nil, errors.Wrap(err, "unsupported response content type")
+		}
+		data = httpResp.body
+	}
+
+	res, err := proposalFromResponse(httpResp, &resp, codec, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if err := checkProposal(res, slot, randaoReveal, fixedGraffiti); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// proposalFromResponse builds a proposal from the response.  Metadata is taken from the
+// response headers where present, falling back to the response body.  The block is
+// decoded from the supplied data with the supplied codec.
+func proposalFromResponse(httpResp *httpResponse,
+	resp *proposalJSON,
+	codec codecs.Codec,
+	data []byte,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	res := &api.VersionedProposal{
+		Version: resp.Version,
+	}
+	version, stated, err := httpResp.versionFromHeaders()
+	if err != nil {
+		return nil, err
+	}
+	if stated {
+		res.Version = version
+	}
+
+	switch {
+	case httpResp.headers.Get("Eth-Execution-Payload-Blinded") != "":
+		res.ExecutionPayloadBlinded = strings.EqualFold(httpResp.headers.Get("Eth-Execution-Payload-Blinded"), "true")
+	case resp.ExecutionPayloadBlinded != nil:
+		res.ExecutionPayloadBlinded = *resp.ExecutionPayloadBlinded
+	default:
+		return nil, errors.New("proposal does not state if it is blinded")
+	}
+
+	res.ExecutionPayloadValue, err = proposalValue(httpResp.headers.Get("Eth-Execution-Payload-Value"), resp.ExecutionPayloadValue)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid execution payload value")
+	}
+	res.ConsensusBlockValue, err = proposalValue(httpResp.headers.Get("Eth-Consensus-Block-Value"), resp.ConsensusBlockValue)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid consensus block value")
+	}
+
+	switch res.Version {
+	case spec.DataVersionPhase0:
+		if res.ExecutionPayloadBlinded {
+			return nil, errors.New("phase 0 proposal cannot be blinded")
+		}
+		res.Phase0 = &phase0.BeaconBlock{}
+		err = codec.Unmarshal(data, res.Phase0)
+	case spec.DataVersionAltair:
+		if res.ExecutionPayloadBlinded {
+			return nil, errors.New("altair proposal cannot be blinded")
+		}
+		res.Altair = &altair.BeaconBlock{}
+		err = codec.Unmarshal(data, res.Altair)
+	case spec.DataVersionBellatrix:
+		if res.ExecutionPayloadBlinded {
+			res.BellatrixBlinded = &apiv1bellatrix.BlindedBeaconBlock{}
+			err = codec.Unmarshal(data, res.BellatrixBlinded)
+		} else {
+			res.Bellatrix = &bellatrix.BeaconBlock{}
+			err = codec.Unmarshal(data, res.Bellatrix)
+		}
+	case spec.DataVersionCapella:
+		if res.ExecutionPayloadBlinded {
+			res.CapellaBlinded = &apiv1capella.BlindedBeaconBlock{}
+			err = codec.Unmarshal(data, res.CapellaBlinded)
+		} else {
+			res.Capella = &capella.BeaconBlock{}
+			err = codec.Unmarshal(data, res.Capella)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", res.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s proposal", res.Version))
+	}
+
+	return res, nil
+}
+
+// proposalValue parses a proposal value in Wei, preferring the header value if present.
+// A missing value is treated as 0, as not all beacon nodes provide it.
+func proposalValue(header string, body string) (*big.Int, error) {
+	input := header
+	if input == "" {
+		input = body
+	}
+	if input == "" {
+		return big.NewInt(0), nil
+	}
+	value, success := new(big.Int).SetString(input, 10)
+	if !success {
+		return nil, fmt.Errorf("failed to parse value %s", input)
+	}
+
+	return value, nil
+}
+
+// checkProposal ensures that the proposal matches the request.
+func checkProposal(proposal *api.VersionedProposal,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti [32]byte,
+) error {
+	proposalSlot, err := proposal.Slot()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain proposal slot")
+	}
+
+	var proposalRANDAOReveal phase0.BLSSignature
+	var proposalGraffiti [32]byte
+	switch {
+	case proposal.Phase0 != nil && proposal.Phase0.Body != nil:
+		proposalRANDAOReveal, proposalGraffiti = proposal.Phase0.Body.RANDAOReveal, proposal.Phase0.Body.Graffiti
+	case proposal.Altair != nil && proposal.Altair.Body != nil:
+		proposalRANDAOReveal, proposalGraffiti = proposal.Altair.Body.RANDAOReveal, proposal.Altair.Body.Graffiti
+	case proposal.Bellatrix != nil && proposal.Bellatrix.Body != nil:
+		proposalRANDAOReveal, proposalGraffiti = proposal.Bellatrix.Body.RANDAOReveal, proposal.Bellatrix.Body.Graffiti
+	case proposal.BellatrixBlinded != nil && proposal.BellatrixBlinded.Body != nil:
+		proposalRANDAOReveal, proposalGraffiti = proposal.BellatrixBlinded.Body.RANDAOReveal, proposal.BellatrixBlinded.Body.Graffiti
+	case proposal.Capella != nil && proposal.Capella.Body != nil:
+		proposalRANDAOReveal, proposalGraffiti = proposal.Capella.Body.RANDAOReveal, proposal.Capella.Body.Graffiti
+	case proposal.CapellaBlinded != nil && proposal.CapellaBlinded.Body != nil:
+		proposalRANDAOReveal, proposalGraffiti = proposal.CapellaBlinded.Body.RANDAOReveal, proposal.CapellaBlinded.Body.Graffiti
+	default:
+		return errors.New("proposal has no body")
+	}
+
+	if proposalSlot != slot {
+		return errors.New("proposal not for requested slot")
+	}
+	if !bytes.Equal(proposalRANDAOReveal[:], randaoReveal[:]) {
+		return errors.New("proposal has incorrect RANDAO reveal")
+	}
+	if !bytes.Equal(proposalGraffiti[:], graffiti[:]) {
+		return errors.New("proposal has incorrect graffiti")
+	}
+
+	return nil
+}
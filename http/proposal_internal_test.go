@@ -0,0 +1,230 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testAltairBlock(t *testing.T) json.RawMessage {
+	block := &altair.BeaconBlock{
+		Slot: 12345,
+		Body: &altair.BeaconBlockBody{
+			RANDAOReveal:      phase0.BLSSignature{0x01},
+			ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			Graffiti:          [32]byte{0x02},
+			ProposerSlashings: []*phase0.ProposerSlashing{},
+			AttesterSlashings: []*phase0.AttesterSlashing{},
+			Attestations:      []*phase0.Attestation{},
+			Deposits:          []*phase0.Deposit{},
+			VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+		},
+	}
+	data, err := json.Marshal(block)
+	require.NoError(t, err)
+	return data
+}
+
+func testBellatrixBlindedBlock(t *testing.T) json.RawMessage {
+	block := &apiv1bellatrix.BlindedBeaconBlock{
+		Slot: 12345,
+		Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+			RANDAOReveal:      phase0.BLSSignature{0x01},
+			ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			Graffiti:          [32]byte{0x02},
+			ProposerSlashings: []*phase0.ProposerSlashing{},
+			AttesterSlashings: []*phase0.AttesterSlashing{},
+			Attestations:      []*phase0.Attestation{},
+			Deposits:          []*phase0.Deposit{},
+			VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{
+				BaseFeePerGas: [32]byte{0x01},
+				ExtraData:     []byte{},
+			},
+		},
+	}
+	data, err := json.Marshal(block)
+	require.NoError(t, err)
+	return data
+}
+
+func TestProposalFromResponse(t *testing.T) {
+	blinded := true
+	unblinded := false
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		resp           *proposalJSON
+		err            string
+		version        spec.DataVersion
+		blinded        bool
+		executionValue *big.Int
+		consensusValue *big.Int
+	}{
+		{
+			name: "BodyMetadata",
+			resp: &proposalJSON{
+				Version:                 spec.DataVersionAltair,
+				ExecutionPayloadBlinded: &unblinded,
+				ExecutionPayloadValue:   "0",
+				ConsensusBlockValue:     "12345678901234567890",
+				Data:                    testAltairBlock(t),
+			},
+			version:        spec.DataVersionAltair,
+			executionValue: big.NewInt(0),
+			consensusValue: new(big.Int).SetUint64(12345678901234567890),
+		},
+		{
+			name: "HeaderMetadata",
+			headers: map[string]string{
+				"Eth-Consensus-Version":         "bellatrix",
+				"Eth-Execution-Payload-Blinded": "true",
+				"Eth-Execution-Payload-Value":   "100",
+				"Eth-Consensus-Block-Value":     "200",
+			},
+			resp: &proposalJSON{
+				Data: testBellatrixBlindedBlock(t),
+			},
+			version:        spec.DataVersionBellatrix,
+			blinded:        true,
+			executionValue: big.NewInt(100),
+			consensusValue: big.NewInt(200),
+		},
+		{
+			name: "MissingValues",
+			resp: &proposalJSON{
+				Version:                 spec.DataVersionBellatrix,
+				ExecutionPayloadBlinded: &blinded,
+				Data:                    testBellatrixBlindedBlock(t),
+			},
+			version:        spec.DataVersionBellatrix,
+			blinded:        true,
+			executionValue: big.NewInt(0),
+			consensusValue: big.NewInt(0),
+		},
+		{
+			name: "BlindedMissing",
+			resp: &proposalJSON{
+				Version: spec.DataVersionAltair,
+				Data:    testAltairBlock(t),
+			},
+			err: "proposal does not state if it is blinded",
+		},
+		{
+			name: "ValueInvalid",
+			resp: &proposalJSON{
+				Version:                 spec.DataVersionAltair,
+				ExecutionPayloadBlinded: &unblinded,
+				ExecutionPayloadValue:   "0x10",
+				Data:                    testAltairBlock(t),
+			},
+			err: "invalid execution payload value: failed to parse value 0x10",
+		},
+		{
+			name: "AltairBlinded",
+			resp: &proposalJSON{
+				Version:                 spec.DataVersionAltair,
+				ExecutionPayloadBlinded: &blinded,
+				Data:                    testAltairBlock(t),
+			},
+			err: "altair proposal cannot be blinded",
+		},
+		{
+			name: "DataInvalid",
+			resp: &proposalJSON{
+				Version:                 spec.DataVersionBellatrix,
+				ExecutionPayloadBlinded: &unblinded,
+				Data:                    testBellatrixBlindedBlock(t),
+			},
+			err: "failed to parse bellatrix proposal: invalid JSON: execution payload missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpResp := &httpResponse{
+				headers: http.Header{},
+			}
+			for k, v := range test.headers {
+				httpResp.headers.Set(k, v)
+			}
+			if version := httpResp.headers.Get("Eth-Consensus-Version"); version != "" {
+				require.NoError(t, httpResp.consensusVersion.UnmarshalJSON([]byte(`"`+version+`"`)))
+			}
+
+			res, err := proposalFromResponse(httpResp, test.resp, codecs.JSON, test.resp.Data)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.version, res.Version)
+			require.Equal(t, test.blinded, res.ExecutionPayloadBlinded)
+			require.Equal(t, 0, test.executionValue.Cmp(res.ExecutionPayloadValue))
+			require.Equal(t, 0, test.consensusValue.Cmp(res.ConsensusBlockValue))
+
+			require.NoError(t, checkProposal(res, 12345, phase0.BLSSignature{0x01}, [32]byte{0x02}))
+			require.EqualError(t, checkProposal(res, 12346, phase0.BLSSignature{0x01}, [32]byte{0x02}), "proposal not for requested slot")
+			require.EqualError(t, checkProposal(res, 12345, phase0.BLSSignature{0x03}, [32]byte{0x02}), "proposal has incorrect RANDAO reveal")
+			require.EqualError(t, checkProposal(res, 12345, phase0.BLSSignature{0x01}, [32]byte{0x03}), "proposal has incorrect graffiti")
+		})
+	}
+}
+
+func TestProposalFromResponseSSZ(t *testing.T) {
+	block := &altair.BeaconBlock{}
+	require.NoError(t, json.Unmarshal(testAltairBlock(t), block))
+	data, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	httpResp := &httpResponse{
+		contentType:      codecs.SSZ.ContentType(),
+		consensusVersion: spec.DataVersionAltair,
+		headers: http.Header{
+			"Eth-Consensus-Version":         []string{"altair"},
+			"Eth-Execution-Payload-Blinded": []string{"false"},
+			"Eth-Consensus-Block-Value":     []string{"12"},
+		},
+		body: data,
+	}
+
+	res, err := proposalFromResponse(httpResp, &proposalJSON{}, codecs.SSZ, httpResp.body)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionAltair, res.Version)
+	require.False(t, res.ExecutionPayloadBlinded)
+	require.Equal(t, big.NewInt(12), res.ConsensusBlockValue)
+	require.Equal(t, block, res.Altair)
+
+	_, err = proposalFromResponse(httpResp, &proposalJSON{}, codecs.SSZ, data[:10])
+	require.Error(t, err)
+}
@@ -14,40 +14,59 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
+// ErrDependentRootMismatch is returned when proposer duties are checked against their
+// dependent root and the node continues to return duties for a different dependent root.
+var ErrDependentRootMismatch = errors.New("proposer duties dependent root mismatch")
+
 type proposerDutiesJSON struct {
-	Data []*api.ProposerDuty `json:"data"`
+	DependentRoot string              `json:"dependent_root"`
+	Data          []*api.ProposerDuty `json:"data"`
 }
 
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validators is empty all duties are returned, otherwise only matching duties are returned.
 func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ProposerDuty, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to request proposer duties")
-	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain proposer duties")
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
 	}
 
-	var resp proposerDutiesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
-		return nil, errors.Wrap(err, "failed to parse proposer duties response")
-	}
-
-	// Validate the duties.
 	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
 	}
+
+	var resp *proposerDutiesJSON
+	for attempt := 0; ; attempt++ {
+		resp, err = s.proposerDuties(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if !s.dependentRootCheck {
+			break
+		}
+		err = s.checkDependentRoot(ctx, epoch, slotsPerEpoch, resp.DependentRoot)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, ErrDependentRootMismatch) || attempt >= s.dependentRetries {
+			return nil, err
+		}
+		s.log.Debug().Uint64("epoch", uint64(epoch)).Err(err).Msg("Proposer duties dependent root mismatch; refetching")
+	}
+
+	// Validate the duties.
 	startSlot := phase0.Slot(uint64(epoch) * slotsPerEpoch)
 	endSlot := phase0.Slot(uint64(epoch)*slotsPerEpoch + slotsPerEpoch - 1)
 	for _, duty := range resp.Data {
@@ -75,3 +94,86 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, valida
 
 	return duties, nil
 }
+
+// proposerDuties fetches the proposer duties for the given epoch.
+func (s *Service) proposerDuties(ctx context.Context, epoch phase0.Epoch) (*proposerDutiesJSON, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request proposer duties")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain proposer duties")
+	}
+
+	var resp proposerDutiesJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposer duties response")
+	}
+
+	return &resp, nil
+}
+
+// checkDependentRoot returns ErrDependentRootMismatch if the dependent root returned
+// with proposer duties for the epoch is not the root of the block at the dependent slot,
+// being the last slot of the previous epoch.  If that slot is empty the most recent
+// block before it is used, up to an epoch earlier.
+func (s *Service) checkDependentRoot(ctx context.Context,
+	epoch phase0.Epoch,
+	slotsPerEpoch uint64,
+	dependentRoot string,
+) error {
+	if dependentRoot == "" {
+		// Node does not supply a dependent root, so nothing to check against.
+		return nil
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(dependentRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid dependent root")
+	}
+	if len(root) != phase0.RootLength {
+		return errors.New("incorrect length for dependent root")
+	}
+
+	if epoch == 0 {
+		// The dependent root is the genesis block root.
+		return s.checkDependentRootAt(ctx, "genesis", root)
+	}
+
+	dependentSlot := phase0.Slot(uint64(epoch)*slotsPerEpoch - 1)
+	for i := uint64(0); i < slotsPerEpoch && uint64(dependentSlot) >= i; i++ {
+		header, err := s.BeaconBlockHeader(ctx, fmt.Sprintf("%d", dependentSlot-phase0.Slot(i)))
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain block header at dependent slot")
+		}
+		if header == nil {
+			// Empty slot; try the one before.
+			continue
+		}
+
+		return compareDependentRoot(header, root)
+	}
+
+	return errors.New("no block found for dependent slot")
+}
+
+// checkDependentRootAt checks the dependent root against the root of the given block.
+func (s *Service) checkDependentRootAt(ctx context.Context, blockID string, root []byte) error {
+	header, err := s.BeaconBlockHeader(ctx, blockID)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block header at dependent slot")
+	}
+	if header == nil {
+		return errors.New("no block found for dependent slot")
+	}
+
+	return compareDependentRoot(header, root)
+}
+
+// compareDependentRoot compares the dependent root against the root of the header.
+func compareDependentRoot(header *api.BeaconBlockHeader, root []byte) error {
+	if !bytes.Equal(header.Root[:], root) {
+		return errors.Wrapf(ErrDependentRootMismatch, "duties have dependent root %#x but dependent block has root %#x", root, header.Root)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerDutiesDependentRoot(t *testing.T) {
+	ctx := context.Background()
+
+	goodRoot := fmt.Sprintf("%#x", strings.Repeat("\x01", 32))
+	badRoot := fmt.Sprintf("%#x", strings.Repeat("\x02", 32))
+	header := fmt.Sprintf(`{"data":{"root":"%s","canonical":true,"header":{"message":{"slot":"62","proposer_index":"1","parent_root":"%s","state_root":"%s","body_root":"%s"},"signature":"%#x"}}}`,
+		goodRoot, badRoot, badRoot, badRoot, strings.Repeat("\x03", 96))
+
+	tests := []struct {
+		name     string
+		roots    []string
+		check    bool
+		retries  int
+		requests int
+		err      string
+	}{
+		{
+			name:     "Unchecked",
+			roots:    []string{badRoot},
+			requests: 1,
+		},
+		{
+			name:     "Good",
+			roots:    []string{goodRoot},
+			check:    true,
+			requests: 1,
+		},
+		{
+			name:     "Missing",
+			roots:    []string{""},
+			check:    true,
+			requests: 1,
+		},
+		{
+			name:     "Retried",
+			roots:    []string{badRoot, goodRoot},
+			check:    true,
+			retries:  1,
+			requests: 2,
+		},
+		{
+			name:     "RetriesExhausted",
+			roots:    []string{badRoot, badRoot, goodRoot},
+			check:    true,
+			retries:  1,
+			requests: 2,
+			err:      fmt.Sprintf("duties have dependent root %s but dependent block has root %s: proposer duties dependent root mismatch", badRoot, goodRoot),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/eth/v1/config/spec":
+					fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32"}}`)
+				case "/eth/v1/validator/duties/proposer/2":
+					root := test.roots[requests]
+					requests++
+					if root == "" {
+						fmt.Fprint(w, `{"data":[]}`)
+					} else {
+						fmt.Fprintf(w, `{"dependent_root":"%s","data":[]}`, root)
+					}
+				case "/eth/v1/beacon/headers/62":
+					fmt.Fprint(w, header)
+				default:
+					// Includes slot 63, which is empty.
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				cache:              testValueCache(),
+				log:                zerolog.Nop(),
+				base:               base,
+				address:            server.URL,
+				client:             server.Client(),
+				timeout:            time.Second,
+				dependentRootCheck: test.check,
+				dependentRetries:   test.retries,
+			}
+
+			_, err = s.ProposerDuties(ctx, 2, nil)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.True(t, errors.Is(err, ErrDependentRootMismatch))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.requests, requests)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Raw carries out a request against an arbitrary endpoint of the node, returning the
+// raw response.  This allows access to endpoints that are new or specific to a node
+// implementation, and so do not yet have their own call.
+//
+// The request passes through the service's middleware, and is subject to its timeouts
+// and response size limits.  If supplied, the body is sent with a JSON content type.
+// An accept header of "" defaults to JSON.  Responses with a status code outside of
+// the 2xx range, including 404, are returned as an Error.
+func (s *Service) Raw(ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	body []byte,
+	accept string,
+) (
+	*Response,
+	error,
+) {
+	if method == "" {
+		return nil, errors.New("no method specified")
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.New("path must start with /")
+	}
+	if strings.Contains(path, "?") {
+		return nil, errors.New("path must not contain a query; supply it separately")
+	}
+
+	endpoint := path
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+	if accept == "" {
+		accept = "application/json"
+	}
+	headers := http.Header{"Accept": []string{accept}}
+	if body != nil {
+		headers.Set("Content-Type", "application/json")
+	}
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	log.Trace().Str("method", method).Msg("Raw request")
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeoutFor(endpoint))
+	defer cancel()
+	resp, err := s.execute(opCtx, &Request{
+		Method:   method,
+		Endpoint: endpoint,
+		Headers:  headers,
+		Body:     body,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call endpoint")
+	}
+
+	if resp.StatusCode/100 != 2 {
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(resp.Body)).Msg("Raw request failed")
+		return nil, Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       resp.Body,
+		}
+	}
+	log.Trace().Int("bytes", len(resp.Body)).Msg("Raw response")
+
+	return resp, nil
+}
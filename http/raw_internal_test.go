@@ -0,0 +1,89 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaw(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/custom":
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", r.Header.Get("Accept"))
+			w.Header().Set("X-Method", r.Method)
+			w.Header().Set("X-Request-Content-Type", r.Header.Get("Content-Type"))
+			_, _ = w.Write([]byte(r.URL.RawQuery + "|" + string(body)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+
+	resp, err := s.Raw(ctx, http.MethodGet, "/eth/v1/custom", url.Values{"id": []string{"1", "2"}}, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Headers.Get("Content-Type"))
+	require.Equal(t, "", resp.Headers.Get("X-Request-Content-Type"))
+	require.Equal(t, "id=1&id=2|", string(resp.Body))
+
+	resp, err = s.Raw(ctx, http.MethodPost, "/eth/v1/custom", nil, []byte(`{"a":1}`), "application/octet-stream")
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPost, resp.Headers.Get("X-Method"))
+	require.Equal(t, "application/octet-stream", resp.Headers.Get("Content-Type"))
+	require.Equal(t, "application/json", resp.Headers.Get("X-Request-Content-Type"))
+	require.Equal(t, `|{"a":1}`, string(resp.Body))
+
+	_, err = s.Raw(ctx, http.MethodGet, "/eth/v1/missing", nil, nil, "")
+	var httpErr Error
+	require.True(t, errors.As(err, &httpErr))
+	require.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+	require.Equal(t, `{"code":404,"message":"not found"}`, string(httpErr.Data))
+}
+
+func TestRawErrors(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{}
+
+	_, err := s.Raw(ctx, "", "/eth/v1/custom", nil, nil, "")
+	require.EqualError(t, err, "no method specified")
+	_, err = s.Raw(ctx, http.MethodGet, "eth/v1/custom", nil, nil, "")
+	require.EqualError(t, err, "path must start with /")
+	_, err = s.Raw(ctx, http.MethodGet, "/eth/v1/custom?id=1", nil, nil, "")
+	require.EqualError(t, err, "path must not contain a query; supply it separately")
+}
@@ -0,0 +1,70 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrResponseTooLarge is returned when a response is larger than the maximum size
+// set by WithMaxResponseSize or WithMaxResponseSizes.
+var ErrResponseTooLarge = errors.New("response too large")
+
+// maxResponseSizeFor returns the maximum response size for the given endpoint.
+// If more than one custom size matches the endpoint then the one with the
+// longest prefix is used.  If no custom size matches the endpoint then the
+// service-wide size is used.
+func (s *Service) maxResponseSizeFor(endpoint string) int64 {
+	size := s.maxResponseSize
+	matched := -1
+	for prefix, prefixSize := range s.maxResponseSizes {
+		if len(prefix) > matched && strings.HasPrefix(endpoint, string(prefix)) {
+			size = prefixSize
+			matched = len(prefix)
+		}
+	}
+
+	return size
+}
+
+// readResponseBody reads the body of the response, failing without reading the
+// remainder of the body if it is larger than the given limit.  A limit of 0 means
+// no limit.
+func readResponseBody(resp *http.Response, limit int64) ([]byte, error) {
+	if limit == 0 {
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read response")
+		}
+
+		return data, nil
+	}
+
+	if resp.ContentLength > limit {
+		return nil, errors.Wrapf(ErrResponseTooLarge, "response of %d bytes greater than maximum of %d", resp.ContentLength, limit)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	if int64(len(data)) > limit {
+		return nil, errors.Wrapf(ErrResponseTooLarge, "response greater than maximum of %d bytes", limit)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxResponseSizeFor(t *testing.T) {
+	s := &Service{
+		maxResponseSize: 100,
+		maxResponseSizes: map[Endpoint]int64{
+			EndpointStatesV2:                0,
+			"/eth/v2/debug":                 1000,
+			"/eth/v1/validator/duties/sync": 50,
+			EndpointDuties:                  200,
+		},
+	}
+
+	require.Equal(t, int64(100), s.maxResponseSizeFor("/eth/v1/node/version"))
+	require.Equal(t, int64(0), s.maxResponseSizeFor("/eth/v2/debug/beacon/states/head"))
+	require.Equal(t, int64(1000), s.maxResponseSizeFor("/eth/v2/debug/fork_choice"))
+	require.Equal(t, int64(200), s.maxResponseSizeFor("/eth/v1/validator/duties/attester/1"))
+	require.Equal(t, int64(50), s.maxResponseSizeFor("/eth/v1/validator/duties/sync/1"))
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	ctx := context.Background()
+
+	body := strings.Repeat("x", 100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/chunked" {
+			// Flushing before writing the body removes the content length.
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		limit    int64
+		err      string
+	}{
+		{
+			name:     "NoLimit",
+			endpoint: "/sized",
+		},
+		{
+			name:     "AtLimit",
+			endpoint: "/sized",
+			limit:    100,
+		},
+		{
+			name:     "OverLimit",
+			endpoint: "/sized",
+			limit:    99,
+			err:      "response of 100 bytes greater than maximum of 99: response too large",
+		},
+		{
+			name:     "ChunkedAtLimit",
+			endpoint: "/chunked",
+			limit:    100,
+		},
+		{
+			name:     "ChunkedOverLimit",
+			endpoint: "/chunked",
+			limit:    99,
+			err:      "response greater than maximum of 99 bytes: response too large",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:             zerolog.Nop(),
+				base:            base,
+				address:         server.URL,
+				client:          server.Client(),
+				timeout:         time.Second,
+				maxResponseSize: test.limit,
+			}
+			resp, err := s.do(ctx, &Request{
+				Method:   http.MethodGet,
+				Endpoint: test.endpoint,
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.True(t, errors.Is(err, ErrResponseTooLarge))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, body, string(resp.Body))
+		})
+	}
+}
+
+func TestMaxResponseSizeParameters(t *testing.T) {
+	_, err := parseAndCheckParameters(WithAddress("http://localhost"), WithMaxResponseSize(-1))
+	require.EqualError(t, err, "invalid maximum response size")
+
+	_, err = parseAndCheckParameters(WithAddress("http://localhost"), WithMaxResponseSizes(map[Endpoint]int64{"eth": 1}))
+	require.EqualError(t, err, "invalid endpoint eth for custom maximum response size")
+
+	_, err = parseAndCheckParameters(WithAddress("http://localhost"), WithMaxResponseSizes(map[Endpoint]int64{EndpointDuties: -1}))
+	require.EqualError(t, err, "invalid maximum response size for endpoint /eth/v1/validator/duties")
+}
+
+func TestMaxResponseSizesCopied(t *testing.T) {
+	sizes := map[Endpoint]int64{EndpointDuties: 1}
+	parameters, err := parseAndCheckParameters(WithAddress("http://localhost"), WithMaxResponseSizes(sizes))
+	require.NoError(t, err)
+
+	// Changes to the caller's map after creation do not affect the parameters.
+	sizes[EndpointDuties] = 2
+	sizes["/eth/v1/node"] = 3
+	require.Equal(t, map[Endpoint]int64{EndpointDuties: 1}, parameters.maxResponseSizes)
+}
@@ -16,7 +16,6 @@ package http
 import (
 	"context"
 	"fmt"
-	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -24,7 +23,9 @@ import (
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
-	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -32,28 +33,37 @@ import (
 )
 
 // Service is an Ethereum 2 client service.
+// It is safe for concurrent use; values returned to callers are copies, and may be modified freely.
 type Service struct {
 	// log is a service-wide logger.
 	log zerolog.Logger
 
-	base    *url.URL
-	address string
-	client  *http.Client
-	timeout time.Duration
+	base     *url.URL
+	address  string
+	client   *http.Client
+	timeout  time.Duration
+	timeouts map[Endpoint]time.Duration
+
+	// Maximum response sizes; 0 for no limit.
+	maxResponseSize  int64
+	maxResponseSizes map[Endpoint]int64
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
-	genesis              *api.Genesis
-	genesisMutex         sync.RWMutex
-	spec                 map[string]interface{}
-	specMutex            sync.RWMutex
-	depositContract      *api.DepositContract
-	depositContractMutex sync.RWMutex
-	forkSchedule         []*phase0.Fork
-	forkScheduleMutex    sync.RWMutex
+	// cache holds the genesis, spec, deposit contract and fork schedule; the mutexes
+	// ensure that each is fetched only once when not present.
+	cache                cache.Cache
+	genesisMutex         sync.Mutex
+	specMutex            sync.Mutex
+	depositContractMutex sync.Mutex
+	forkScheduleMutex    sync.Mutex
 	nodeVersion          string
 	nodeVersionMutex     sync.RWMutex
 
+	// Implementation-specific extensions.
+	extensions      map[string]interface{}
+	extensionsMutex sync.RWMutex
+
 	// API support.
 	supportsV2BeaconBlocks    bool
 	supportsV2BeaconState     bool
@@ -62,6 +72,58 @@ type Service struct {
 	// User-specified chunk sizes.
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
+
+	// Events stream staleness detection.
+	staleEventsTimeout time.Duration
+	staleEventsHandler StaleEventsHandlerFunc
+
+	// Optional WebSocket endpoint for events.
+	eventsWebSocket string
+
+	// Preferred wire format for responses.
+	codec codecs.Codec
+
+	// call carries out requests, through any middleware.
+	call CallFunc
+
+	// Optional slashing protection for submissions.
+	slashingProtector SlashingProtector
+
+	// Optional unix socket or proxy through which to connect.
+	unixSocket string
+	proxyURL   *url.URL
+
+	// Optional rejection of calls requiring a synced node.
+	rejectWhenSyncing bool
+	maxSyncDistance   phase0.Slot
+
+	// Minimum connected peers for the node to be ready.
+	minPeers uint64
+
+	// Optional check of proposer duties against the dependent root.
+	dependentRootCheck bool
+	dependentRetries   int
+
+	// Optional check of attestation data head votes against recent heads.
+	headVoteCheckMode HeadVoteCheckMode
+	headVotes         *headVotes
+
+	// Latest consensus version seen in responses, and handler for changes.
+	consensusVersionMu   sync.Mutex
+	consensusVersion     spec.DataVersion
+	consensusVersionSeen bool
+	forkHandler          ForkTransitionHandlerFunc
+
+	// Optional refusal of state and block body downloads.
+	lightMode        bool
+	lightModeAllowed []Endpoint
+
+	// Lifecycle; closing is closed when the service is closed, and active
+	// tracks background goroutines and in-flight requests.
+	lifecycleMu sync.Mutex
+	closed      bool
+	closing     chan struct{}
+	active      sync.WaitGroup
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -77,22 +139,11 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
-	client := &http.Client{
-		Timeout: parameters.timeout,
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   parameters.timeout,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxConnsPerHost:     64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     600 * time.Second,
-		},
-	}
-
 	address := parameters.address
+	if strings.HasPrefix(address, unixAddressPrefix) {
+		// The socket path is not a host, so use a placeholder for the URL.
+		address = "http://localhost"
+	}
 	if !strings.HasPrefix(address, "http") {
 		address = fmt.Sprintf("http://%s", address)
 	}
@@ -103,16 +154,62 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid URL")
 	}
+	valueCache := parameters.cache
+	if valueCache == nil {
+		valueCache, err = cache.New()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create cache")
+		}
+	}
+	var proxyURL *url.URL
+	if parameters.proxy != "" {
+		proxyURL, err = parseProxy(parameters.proxy)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	s := &Service{
 		log:                 log,
 		base:                base,
 		address:             parameters.address,
-		client:              client,
+		unixSocket:          parameters.unixSocket,
+		proxyURL:            proxyURL,
 		timeout:             parameters.timeout,
+		timeouts:            parameters.timeouts,
+		maxResponseSize:     parameters.maxResponseSize,
+		maxResponseSizes:    parameters.maxResponseSizes,
 		userIndexChunkSize:  parameters.indexChunkSize,
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
+		staleEventsTimeout:  parameters.staleEventsTimeout,
+		staleEventsHandler:  parameters.staleEventsHandler,
+		eventsWebSocket:     parameters.eventsWebSocket,
+		codec:               parameters.codec,
+		slashingProtector:   parameters.slashingProtector,
+		rejectWhenSyncing:   parameters.rejectWhenSyncing,
+		maxSyncDistance:     parameters.maxSyncDistance,
+		minPeers:            parameters.minPeers,
+		dependentRootCheck:  parameters.dependentRootCheck,
+		dependentRetries:    parameters.dependentRetries,
+		headVoteCheckMode:   parameters.headVoteCheckMode,
+		forkHandler:         parameters.forkHandler,
+		lightMode:           parameters.lightMode,
+		lightModeAllowed:    parameters.lightModeAllowed,
+		cache:               valueCache,
+		closing:             make(chan struct{}),
+	}
+	transport := s.newTransport(parameters.timeout, 30*time.Second)
+	transport.MaxIdleConns = 64
+	transport.MaxConnsPerHost = 64
+	transport.MaxIdleConnsPerHost = 64
+	transport.IdleConnTimeout = 600 * time.Second
+	s.client = &http.Client{
+		// Individual requests are bounded by their own context, so the client
+		// timeout is only a backstop for the longest permitted request.
+		Timeout:   maxTimeout(parameters.timeout, parameters.timeouts),
+		Transport: transport,
 	}
+	s.call = chain(s.do, parameters.middlewares)
 
 	// Fetch static values to confirm the connection is good.
 	if err := s.fetchStaticValues(ctx); err != nil {
@@ -124,16 +221,43 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		return nil, errors.Wrap(err, "failed to set update ticker")
 	}
 
+	// Set the current consensus version, so that historical data fetched when
+	// checking API versioning is not taken as the current version.
+	seeded := true
+	if err := s.seedConsensusVersion(ctx); err != nil {
+		log.Debug().Err(err).Msg("Failed to seed consensus version")
+		seeded = false
+	}
+
 	// Handle flags for API versioning.
 	if err := s.checkAPIVersioning(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to check API versioning")
 	}
+	if !seeded {
+		// Forget any version seen when checking API versioning, as it was for historical data.
+		s.consensusVersionMu.Lock()
+		s.consensusVersionSeen = false
+		s.consensusVersionMu.Unlock()
+	}
+
+	// Track heads for checking attestation data, if required.
+	if s.headVoteCheckMode != HeadVoteCheckNone {
+		s.headVotes = newHeadVotes(parameters.maxHeadVoteAge)
+		if err := s.Events(ctx, []string{"head"}, s.headVotes.handle); err != nil {
+			return nil, errors.Wrap(err, "failed to track heads")
+		}
+	}
 
 	// Close the service on context done.
 	go func(s *Service) {
-		<-ctx.Done()
-		log.Trace().Msg("Context done; closing connection")
-		s.close()
+		select {
+		case <-ctx.Done():
+			log.Trace().Msg("Context done; closing connection")
+			if err := s.Close(context.Background()); err != nil {
+				log.Warn().Err(err).Msg("Failed to close service")
+			}
+		case <-s.closing:
+		}
 	}(s)
 
 	return s, nil
@@ -142,16 +266,16 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 // fetchStaticValues fetches values that never change.
 // This caches the values, avoiding future API calls.
 func (s *Service) fetchStaticValues(ctx context.Context) error {
-	if _, err := s.Genesis(ctx); err != nil {
+	if _, err := s.cachedGenesis(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch genesis")
 	}
-	if _, err := s.Spec(ctx); err != nil {
+	if _, err := s.cachedSpec(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch spec")
 	}
-	if _, err := s.DepositContract(ctx); err != nil {
+	if _, err := s.cachedDepositContract(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch deposit contract")
 	}
-	if _, err := s.ForkSchedule(ctx); err != nil {
+	if _, err := s.cachedForkSchedule(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch fork schedule")
 	}
 	if _, err := s.NodeVersion(ctx); err != nil {
@@ -164,29 +288,29 @@ func (s *Service) fetchStaticValues(ctx context.Context) error {
 // periodicClearStaticValues periodically sets static values to nil so they are
 // refetched the next time they are required.
 func (s *Service) periodicClearStaticValues(ctx context.Context) error {
+	if !s.track() {
+		return ErrServiceClosed
+	}
 	go func(s *Service, ctx context.Context) {
+		defer s.untrack()
 		// Refreah every 5 minutes.
 		refreshTicker := time.NewTicker(5 * time.Minute)
+		defer refreshTicker.Stop()
 		for {
 			select {
 			case <-refreshTicker.C:
-				s.genesisMutex.Lock()
-				s.genesis = nil
-				s.genesisMutex.Unlock()
-				s.specMutex.Lock()
-				s.spec = nil
-				s.specMutex.Unlock()
-				s.depositContractMutex.Lock()
-				s.depositContract = nil
-				s.depositContractMutex.Unlock()
-				s.forkScheduleMutex.Lock()
-				s.forkSchedule = nil
-				s.forkScheduleMutex.Unlock()
+				s.uncache(genesisCacheName, specCacheName, depositContractCacheName, forkScheduleCacheName)
 				s.nodeVersionMutex.Lock()
 				s.nodeVersion = ""
 				s.nodeVersionMutex.Unlock()
+				// Node client may have changed, so extensions need to be recreated.
+				s.extensionsMutex.Lock()
+				s.extensions = nil
+				s.extensionsMutex.Unlock()
 			case <-ctx.Done():
 				return
+			case <-s.closing:
+				return
 			}
 		}
 	}(s, ctx)
@@ -196,6 +320,14 @@ func (s *Service) periodicClearStaticValues(ctx context.Context) error {
 // checkAPIVersioning checks the versions of some APIs and sets
 // internal flags appropriately.
 func (s *Service) checkAPIVersioning(ctx context.Context) error {
+	if s.refusedInLightMode(http.MethodGet, "/eth/v2/beacon/blocks/0") {
+		// Blocks cannot be fetched, so assume that current APIs are present.
+		s.supportsV2BeaconBlocks = true
+		s.supportsV2BeaconState = true
+		s.supportsV2ValidatorBlocks = true
+		return nil
+	}
+
 	// Start by setting the API v2 flag for blocks and fetching block 0.
 	s.supportsV2BeaconBlocks = true
 	_, err := s.SignedBeaconBlock(ctx, "0")
@@ -221,7 +353,3 @@ func (s *Service) Name() string {
 func (s *Service) Address() string {
 	return s.address
 }
-
-// close closes the service, freeing up resources.
-func (s *Service) close() {
-}
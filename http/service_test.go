@@ -62,6 +62,24 @@ func TestService(t *testing.T) {
 			},
 			err: "invalid URL: parse \"http://\\x01\": net/url: invalid control character in URL",
 		},
+		{
+			name: "TimeoutsEndpointInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithTimeouts(map[v1.Endpoint]time.Duration{"eth/v1/events": time.Second}),
+			},
+			err: "problem with parameters: invalid endpoint eth/v1/events for custom timeout",
+		},
+		{
+			name: "TimeoutsTimeoutZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithTimeouts(map[v1.Endpoint]time.Duration{v1.EndpointDuties: 0}),
+			},
+			err: "problem with parameters: no timeout specified for endpoint /eth/v1/validator/duties",
+		},
 		{
 			name: "IndexChunkSizeZero",
 			parameters: []v1.Parameter{
@@ -113,10 +131,13 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.PagedAttestationPoolProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.AttesterAssignmentsProvider)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconBlockHeadersRangeProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockProposalProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
@@ -131,10 +152,16 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
+	assert.Implements(t, (*client.ForkVersionsProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.IndexedBeaconCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*client.PagedForkChoiceNodesProvider)(nil), s)
+	assert.Implements(t, (*client.PagedNodePeersProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ProposalProvider)(nil), s)
+	assert.Implements(t, (*client.SignedBeaconBlocksProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
@@ -142,11 +169,15 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ValidatedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.ValidatedBlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.ValidatorBalanceHistoryProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 
 	// Non-standard extensions.
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.ExtensionsProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
 }
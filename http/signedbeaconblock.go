@@ -14,11 +14,9 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
@@ -32,18 +30,6 @@ type phase0SignedBeaconBlockJSON struct {
 	Data *phase0.SignedBeaconBlock `json:"data"`
 }
 
-type altairSignedBeaconBlockJSON struct {
-	Data *altair.SignedBeaconBlock `json:"data"`
-}
-
-type bellatrixSignedBeaconBlockJSON struct {
-	Data *bellatrix.SignedBeaconBlock `json:"data"`
-}
-
-type capellaSignedBeaconBlockJSON struct {
-	Data *capella.SignedBeaconBlock `json:"data"`
-}
-
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
 // N.B if a signed beacon block for the block ID is not available this will return nil without an error.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
@@ -74,53 +60,39 @@ func (s *Service) signedBeaconBlockV1(ctx context.Context, blockID string) (*spe
 	}, nil
 }
 
-// signedBeaconBlockV2 fetches a signed beacon block from the V2 endpoint.
+// signedBeaconBlockV2 fetches a signed beacon block from the V2 endpoint, preferring the
+// service's codec if the server supports it.
 func (s *Service) signedBeaconBlockV2(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
+	resp, err := s.getVersioned(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request signed beacon block")
 	}
-	if respBodyReader == nil {
+	if resp == nil {
 		return nil, nil
 	}
 
-	var dataBodyReader bytes.Buffer
-	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
-	var metadata responseMetadata
-	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
-		return nil, errors.Wrap(err, "failed to parse response")
-	}
 	res := &spec.VersionedSignedBeaconBlock{
-		Version: metadata.Version,
+		Version: resp.version,
 	}
 
-	switch metadata.Version {
+	switch resp.version {
 	case spec.DataVersionPhase0:
-		var resp phase0SignedBeaconBlockJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse phase 0 signed beacon block")
-		}
-		res.Phase0 = resp.Data
+		res.Phase0 = &phase0.SignedBeaconBlock{}
+		err = resp.codec.Unmarshal(resp.data, res.Phase0)
 	case spec.DataVersionAltair:
-		var resp altairSignedBeaconBlockJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse altair signed beacon block")
-		}
-		res.Altair = resp.Data
+		res.Altair = &altair.SignedBeaconBlock{}
+		err = resp.codec.Unmarshal(resp.data, res.Altair)
 	case spec.DataVersionBellatrix:
-		var resp bellatrixSignedBeaconBlockJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse bellatrix signed beacon block")
-		}
-		res.Bellatrix = resp.Data
+		res.Bellatrix = &bellatrix.SignedBeaconBlock{}
+		err = resp.codec.Unmarshal(resp.data, res.Bellatrix)
 	case spec.DataVersionCapella:
-		var resp capellaSignedBeaconBlockJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse capella signed beacon block")
-		}
-		res.Capella = resp.Data
+		res.Capella = &capella.SignedBeaconBlock{}
+		err = resp.codec.Unmarshal(resp.data, res.Capella)
 	default:
-		return nil, fmt.Errorf("unhandled block version %s", metadata.Version)
+		return nil, fmt.Errorf("unhandled block version %s", resp.version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s signed beacon block", resp.version))
 	}
 
 	return res, nil
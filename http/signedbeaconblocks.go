@@ -0,0 +1,99 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// signedBeaconBlocksParallelism is the maximum number of concurrent requests made when fetching multiple blocks.
+const signedBeaconBlocksParallelism = 16
+
+// SignedBeaconBlocks fetches signed beacon blocks given their roots.
+// Blocks that are not available are not present in the returned map.
+func (s *Service) SignedBeaconBlocks(ctx context.Context, roots []phase0.Root) (map[phase0.Root]*spec.VersionedSignedBeaconBlock, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := make(map[phase0.Root]*spec.VersionedSignedBeaconBlock, len(roots))
+	var resMu sync.Mutex
+	var firstErr error
+
+	// Fetch each root only once, even if it is requested multiple times.
+	uniqueRoots := make([]phase0.Root, 0, len(roots))
+	seen := make(map[phase0.Root]struct{}, len(roots))
+	for _, root := range roots {
+		if _, exists := seen[root]; exists {
+			continue
+		}
+		seen[root] = struct{}{}
+		uniqueRoots = append(uniqueRoots, root)
+	}
+
+	sem := make(chan struct{}, signedBeaconBlocksParallelism)
+	var wg sync.WaitGroup
+	for _, root := range uniqueRoots {
+		resMu.Lock()
+		failed := firstErr != nil
+		resMu.Unlock()
+		if failed {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(root phase0.Root) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			block, err := s.signedBeaconBlockByRoot(ctx, root)
+
+			resMu.Lock()
+			defer resMu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = errors.Wrap(err, fmt.Sprintf("failed to obtain block %#x", root))
+					cancel()
+				}
+				return
+			}
+			if block != nil {
+				res[root] = block
+			}
+		}(root)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return res, nil
+}
+
+// signedBeaconBlockByRoot fetches a single signed beacon block, preferring the service's codec if the server supports it.
+func (s *Service) signedBeaconBlockByRoot(ctx context.Context, root phase0.Root) (*spec.VersionedSignedBeaconBlock, error) {
+	blockID := fmt.Sprintf("%#x", root)
+	if !s.supportsV2BeaconBlocks {
+		return s.signedBeaconBlockV1(ctx, blockID)
+	}
+
+	return s.signedBeaconBlockV2(ctx, blockID)
+}
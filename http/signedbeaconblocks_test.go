@@ -0,0 +1,75 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBeaconBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	headRoot, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+	genesisRoot, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "0")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		roots []phase0.Root
+		found int
+	}{
+		{
+			name:  "Empty",
+			roots: []phase0.Root{},
+		},
+		{
+			name:  "Good",
+			roots: []phase0.Root{*headRoot, *genesisRoot},
+			found: 2,
+		},
+		{
+			name:  "Duplicate",
+			roots: []phase0.Root{*headRoot, *headRoot},
+			found: 1,
+		},
+		{
+			name:  "Unknown",
+			roots: []phase0.Root{*headRoot, {0x01}},
+			found: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.SignedBeaconBlocksProvider).SignedBeaconBlocks(ctx, test.roots)
+			require.NoError(t, err)
+			require.Len(t, res, test.found)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SlashingProtector is consulted before signed proposals and attestations are
+// submitted, and can refuse submission of any that could be slashable.
+type SlashingProtector interface {
+	// CheckProposal returns an error if the proposal with the given header must not
+	// be submitted.  The header has the same root as the proposed block.
+	CheckProposal(ctx context.Context, header *phase0.BeaconBlockHeader) error
+	// CheckAttestations returns an error if the attestations must not be submitted.
+	CheckAttestations(ctx context.Context, attestations []*phase0.Attestation) error
+}
+
+// checkBlock consults the slashing protector, if present, about a proposal.  It
+// fails closed: if the header of the block cannot be obtained it is refused.
+func (s *Service) checkBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	if s.slashingProtector == nil {
+		return nil
+	}
+	header, err := blockHeader(block)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block header for slashing protection")
+	}
+
+	return s.checkProposal(ctx, header)
+}
+
+// checkBlindedBlock consults the slashing protector, if present, about a blinded
+// proposal.  It fails closed: if the header of the block cannot be obtained it is
+// refused.
+func (s *Service) checkBlindedBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	if s.slashingProtector == nil {
+		return nil
+	}
+	header, err := blindedBlockHeader(block)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain blinded block header for slashing protection")
+	}
+
+	return s.checkProposal(ctx, header)
+}
+
+// checkProposal consults the slashing protector about a proposal header.
+func (s *Service) checkProposal(ctx context.Context, header *phase0.BeaconBlockHeader) error {
+	if err := s.slashingProtector.CheckProposal(ctx, header); err != nil {
+		return errors.Wrap(err, "proposal refused by slashing protection")
+	}
+
+	return nil
+}
+
+// checkAttestations consults the slashing protector, if present, about attestations.
+func (s *Service) checkAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
+	if s.slashingProtector == nil {
+		return nil
+	}
+	for _, attestation := range attestations {
+		if attestation == nil || attestation.Data == nil {
+			return errors.New("attestation without data refused by slashing protection")
+		}
+	}
+	if err := s.slashingProtector.CheckAttestations(ctx, attestations); err != nil {
+		return errors.Wrap(err, "attestations refused by slashing protection")
+	}
+
+	return nil
+}
+
+// blockHeader returns the header for a signed beacon block.
+func blockHeader(block *spec.VersionedSignedBeaconBlock) (*phase0.BeaconBlockHeader, error) {
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, err
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := block.StateRoot()
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := block.BodyRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &phase0.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		BodyRoot:      bodyRoot,
+	}, nil
+}
+
+// blindedBlockHeader returns the header for a signed blinded beacon block.
+func blindedBlockHeader(block *api.VersionedSignedBlindedBeaconBlock) (*phase0.BeaconBlockHeader, error) {
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, err
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := block.StateRoot()
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := block.BodyRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &phase0.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		BodyRoot:      bodyRoot,
+	}, nil
+}
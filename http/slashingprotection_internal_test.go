@@ -0,0 +1,125 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type testSlashingProtector struct {
+	err          error
+	headers      []*phase0.BeaconBlockHeader
+	attestations []*phase0.Attestation
+}
+
+func (p *testSlashingProtector) CheckProposal(_ context.Context, header *phase0.BeaconBlockHeader) error {
+	p.headers = append(p.headers, header)
+	return p.err
+}
+
+func (p *testSlashingProtector) CheckAttestations(_ context.Context, attestations []*phase0.Attestation) error {
+	p.attestations = append(p.attestations, attestations...)
+	return p.err
+}
+
+func TestSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	protector := &testSlashingProtector{}
+	s := &Service{
+		log:               zerolog.Nop(),
+		base:              base,
+		address:           server.URL,
+		client:            server.Client(),
+		timeout:           time.Second,
+		slashingProtector: protector,
+	}
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:          1,
+				ProposerIndex: 2,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				},
+			},
+		},
+	}
+	blockRoot, err := block.Root()
+	require.NoError(t, err)
+	attestations := []*phase0.Attestation{
+		{
+			Data: &phase0.AttestationData{
+				Slot:   1,
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+	}
+
+	// Refused submissions do not reach the node.
+	protector.err = errors.New("slashable")
+	require.EqualError(t, s.SubmitBeaconBlock(ctx, block), "proposal refused by slashing protection: slashable")
+	_, err = s.SubmitBeaconBlockWithValidation(ctx, block, 0)
+	require.EqualError(t, err, "proposal refused by slashing protection: slashable")
+	require.EqualError(t, s.SubmitAttestations(ctx, attestations), "attestations refused by slashing protection: slashable")
+	require.Equal(t, 0, requests)
+
+	// The header supplied to the protector has the same root as the block.
+	require.Len(t, protector.headers, 2)
+	require.Equal(t, phase0.ValidatorIndex(2), protector.headers[0].ProposerIndex)
+	headerRoot, err := protector.headers[0].HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, blockRoot, phase0.Root(headerRoot))
+	require.Equal(t, attestations, protector.attestations)
+
+	// Blocks for which a header cannot be obtained are refused.
+	blindedBlock := &api.VersionedSignedBlindedBeaconBlock{
+		Version:   spec.DataVersionBellatrix,
+		Bellatrix: &apiv1bellatrix.SignedBlindedBeaconBlock{},
+	}
+	require.EqualError(t, s.SubmitBlindedBeaconBlock(ctx, blindedBlock), "failed to obtain blinded block header for slashing protection: no bellatrix block: data missing")
+	require.EqualError(t, s.SubmitAttestations(ctx, []*phase0.Attestation{{}}), "attestation without data refused by slashing protection")
+	require.Equal(t, 0, requests)
+
+	// Accepted submissions reach the node.
+	protector.err = nil
+	require.NoError(t, s.SubmitBeaconBlock(ctx, block))
+	require.NoError(t, s.SubmitAttestations(ctx, attestations))
+	require.Equal(t, 2, requests)
+}
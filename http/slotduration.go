@@ -20,10 +20,5 @@ import (
 
 // SlotDuration provides the duration of a slot for the chain.
 func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
-	spec, err := s.Spec(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	return spec["SECONDS_PER_SLOT"].(time.Duration), nil
+	return s.SpecDuration(ctx, "SECONDS_PER_SLOT")
 }
@@ -19,10 +19,5 @@ import (
 
 // SlotsPerEpoch provides the number of slots per epoch for the chain.
 func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
-	spec, err := s.Spec(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	return spec["SLOTS_PER_EPOCH"].(uint64), nil
+	return s.SpecUint64(ctx, "SLOTS_PER_EPOCH")
 }
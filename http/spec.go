@@ -30,19 +30,41 @@ type specJSON struct {
 }
 
 // Spec provides the spec information of the chain.
+// The returned map is a copy, so callers are free to modify it.
 func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
-	s.specMutex.RLock()
-	if s.spec != nil {
-		defer s.specMutex.RUnlock()
-		return s.spec, nil
+	spec, err := s.cachedSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]interface{}, len(spec))
+	for k, v := range spec {
+		if byteVal, isBytes := v.([]byte); isBytes {
+			// Byte slices would otherwise be shared with the cached spec.
+			v = append([]byte{}, byteVal...)
+		}
+		res[k] = v
+	}
+
+	return res, nil
+}
+
+// cachedSpec provides the spec information of the chain, fetching it if not already cached.
+// The returned map is shared between callers and must not be modified.
+func (s *Service) cachedSpec(ctx context.Context) (map[string]interface{}, error) {
+	if value, exists := s.cached(specCacheName); exists {
+		if spec, isType := value.(map[string]interface{}); isType {
+			return spec, nil
+		}
 	}
-	s.specMutex.RUnlock()
 
 	s.specMutex.Lock()
 	defer s.specMutex.Unlock()
-	if s.spec != nil {
-		// Someone else fetched this whilst we were waiting for the lock.
-		return s.spec, nil
+	if value, exists := s.cached(specCacheName); exists {
+		if spec, isType := value.(map[string]interface{}); isType {
+			// Someone else fetched this whilst we were waiting for the lock.
+			return spec, nil
+		}
 	}
 
 	// Up to us to fetch the information.
@@ -138,6 +160,7 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
 	}
 
-	s.spec = config
-	return s.spec, nil
+	s.cache.Set(s.cacheKey(specCacheName), config)
+
+	return config, nil
 }
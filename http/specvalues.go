@@ -0,0 +1,144 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ErrSpecValueMissing is returned when a spec value is not supplied by the node.
+var ErrSpecValueMissing = errors.New("spec value missing")
+
+// ErrSpecValueWrongType is returned when a spec value cannot be converted to the requested type.
+var ErrSpecValueWrongType = errors.New("spec value of wrong type")
+
+// SpecValueError is the error returned when a spec value cannot be provided.
+// It wraps one of ErrSpecValueMissing or ErrSpecValueWrongType.
+type SpecValueError struct {
+	Name string
+	// Value is the value held in the spec, if present.
+	Value interface{}
+	Err   error
+}
+
+func (e *SpecValueError) Error() string {
+	if e.Value == nil {
+		return fmt.Sprintf("%s: %v", e.Name, e.Err)
+	}
+
+	return fmt.Sprintf("%s: %v (%T)", e.Name, e.Err, e.Value)
+}
+
+// Unwrap returns the underlying error.
+func (e *SpecValueError) Unwrap() error {
+	return e.Err
+}
+
+// SpecUint64 provides the named spec value as an integer.
+func (s *Service) SpecUint64(ctx context.Context, name string) (uint64, error) {
+	value, err := s.specValue(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	res, isUint64 := value.(uint64)
+	if !isUint64 {
+		return 0, &SpecValueError{Name: name, Value: value, Err: ErrSpecValueWrongType}
+	}
+
+	return res, nil
+}
+
+// SpecDuration provides the named spec value as a duration.
+func (s *Service) SpecDuration(ctx context.Context, name string) (time.Duration, error) {
+	value, err := s.specValue(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case uint64:
+		// Zero durations are parsed as integers.
+		if v == 0 {
+			return 0, nil
+		}
+	}
+
+	return 0, &SpecValueError{Name: name, Value: value, Err: ErrSpecValueWrongType}
+}
+
+// SpecBytes provides the named spec value as a byte slice.
+// The returned slice is a copy, so callers are free to modify it.
+func (s *Service) SpecBytes(ctx context.Context, name string) ([]byte, error) {
+	value, err := s.specValue(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		res := make([]byte, len(v))
+		copy(res, v)
+		return res, nil
+	case phase0.DomainType:
+		return v[:], nil
+	case phase0.Version:
+		return v[:], nil
+	}
+
+	return nil, &SpecValueError{Name: name, Value: value, Err: ErrSpecValueWrongType}
+}
+
+// SpecVersion provides the named spec value as a fork version.
+func (s *Service) SpecVersion(ctx context.Context, name string) (phase0.Version, error) {
+	value, err := s.specValue(ctx, name)
+	if err != nil {
+		return phase0.Version{}, err
+	}
+
+	switch v := value.(type) {
+	case phase0.Version:
+		return v, nil
+	case []byte:
+		if len(v) == phase0.ForkVersionLength {
+			var res phase0.Version
+			copy(res[:], v)
+			return res, nil
+		}
+	}
+
+	return phase0.Version{}, &SpecValueError{Name: name, Value: value, Err: ErrSpecValueWrongType}
+}
+
+// specValue provides the named spec value.
+func (s *Service) specValue(ctx context.Context, name string) (interface{}, error) {
+	spec, err := s.cachedSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, exists := spec[name]
+	if !exists {
+		return nil, &SpecValueError{Name: name, Err: ErrSpecValueMissing}
+	}
+
+	return value, nil
+}
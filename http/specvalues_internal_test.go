@@ -0,0 +1,116 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecValues(t *testing.T) {
+	ctx := context.Background()
+	s := testSpecService(t, map[string]interface{}{
+		"SLOTS_PER_EPOCH":      uint64(32),
+		"SECONDS_PER_SLOT":     12 * time.Second,
+		"SECONDS_PER_NOTHING":  uint64(0),
+		"DEPOSIT_CONTRACT":     []byte{0x01, 0x02},
+		"DOMAIN_BEACON_SLOTS":  phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		"ALTAIR_FORK_VERSION":  phase0.Version{0x01, 0x00, 0x00, 0x00},
+		"UNKNOWN_FORK_VERSION": []byte{0x02, 0x00, 0x00, 0x00},
+	})
+
+	slotsPerEpoch, err := s.SpecUint64(ctx, "SLOTS_PER_EPOCH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), slotsPerEpoch)
+
+	slotDuration, err := s.SpecDuration(ctx, "SECONDS_PER_SLOT")
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, slotDuration)
+	zeroDuration, err := s.SpecDuration(ctx, "SECONDS_PER_NOTHING")
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), zeroDuration)
+
+	bytes, err := s.SpecBytes(ctx, "DEPOSIT_CONTRACT")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, bytes)
+	// Returned bytes are a copy.
+	bytes[0] = 0xff
+	bytes, err = s.SpecBytes(ctx, "DEPOSIT_CONTRACT")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, bytes)
+	bytes, err = s.SpecBytes(ctx, "DOMAIN_BEACON_SLOTS")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x00, 0x00, 0x00}, bytes)
+
+	version, err := s.SpecVersion(ctx, "ALTAIR_FORK_VERSION")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x01, 0x00, 0x00, 0x00}, version)
+	version, err = s.SpecVersion(ctx, "UNKNOWN_FORK_VERSION")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x02, 0x00, 0x00, 0x00}, version)
+}
+
+func TestSpecValueErrors(t *testing.T) {
+	ctx := context.Background()
+	s := testSpecService(t, map[string]interface{}{
+		"SLOTS_PER_EPOCH":        uint64(32),
+		"CONFIG_NAME":            "mainnet",
+		"TRUNCATED_FORK_VERSION": []byte{0x02},
+	})
+
+	_, err := s.SpecUint64(ctx, "MISSING")
+	require.True(t, errors.Is(err, ErrSpecValueMissing))
+	require.EqualError(t, err, "MISSING: spec value missing")
+
+	_, err = s.SpecUint64(ctx, "CONFIG_NAME")
+	require.True(t, errors.Is(err, ErrSpecValueWrongType))
+	require.EqualError(t, err, "CONFIG_NAME: spec value of wrong type (string)")
+	var specErr *SpecValueError
+	require.True(t, errors.As(err, &specErr))
+	require.Equal(t, "CONFIG_NAME", specErr.Name)
+	require.Equal(t, "mainnet", specErr.Value)
+
+	_, err = s.SpecDuration(ctx, "SLOTS_PER_EPOCH")
+	require.True(t, errors.Is(err, ErrSpecValueWrongType))
+
+	_, err = s.SpecBytes(ctx, "SLOTS_PER_EPOCH")
+	require.True(t, errors.Is(err, ErrSpecValueWrongType))
+
+	_, err = s.SpecVersion(ctx, "TRUNCATED_FORK_VERSION")
+	require.True(t, errors.Is(err, ErrSpecValueWrongType))
+}
+
+// testSpecService returns a service with the given spec in its cache.
+func testSpecService(t *testing.T, spec map[string]interface{}) *Service {
+	t.Helper()
+
+	base, err := url.Parse("http://localhost/")
+	require.NoError(t, err)
+	valueCache, err := cache.New()
+	require.NoError(t, err)
+	s := &Service{
+		base:  base,
+		cache: valueCache,
+	}
+	s.cache.Set(s.cacheKey(specCacheName), spec)
+
+	return s
+}
@@ -24,6 +24,10 @@ import (
 
 // SubmitAttestations submits attestations.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
+	if err := s.checkAttestations(ctx, attestations); err != nil {
+		return err
+	}
+
 	specJSON, err := json.Marshal(attestations)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
@@ -30,6 +30,9 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSi
 	if block == nil {
 		return errors.New("no block supplied")
 	}
+	if err := s.checkBlock(ctx, block); err != nil {
+		return err
+	}
 
 	switch block.Version {
 	case spec.DataVersionPhase0:
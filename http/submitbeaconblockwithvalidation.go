@@ -0,0 +1,177 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitBeaconBlockWithValidation submits a beacon block, requesting that the node
+// carry out the given validation before broadcasting it.
+func (s *Service) SubmitBeaconBlockWithValidation(ctx context.Context,
+	block *spec.VersionedSignedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	var specJSON []byte
+	var err error
+
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+	if err := s.checkBlock(ctx, block); err != nil {
+		return nil, err
+	}
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		specJSON, err = json.Marshal(block.Phase0)
+	case spec.DataVersionAltair:
+		specJSON, err = json.Marshal(block.Altair)
+	case spec.DataVersionBellatrix:
+		specJSON, err = json.Marshal(block.Bellatrix)
+	case spec.DataVersionCapella:
+		specJSON, err = json.Marshal(block.Capella)
+	default:
+		err = errors.New("unknown block version")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	receipt, err := s.submitWithValidation(ctx, "/eth/v2/beacon/blocks", block.Version, validation, specJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit beacon block")
+	}
+
+	return receipt, nil
+}
+
+// SubmitBlindedBeaconBlockWithValidation submits a blinded beacon block, requesting that
+// the node carry out the given validation before broadcasting it.
+func (s *Service) SubmitBlindedBeaconBlockWithValidation(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	var specJSON []byte
+	var err error
+
+	if block == nil {
+		return nil, errors.New("no blinded block supplied")
+	}
+	if err := s.checkBlindedBlock(ctx, block); err != nil {
+		return nil, err
+	}
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		err = errors.New("blinded phase0 blocks not supported")
+	case spec.DataVersionAltair:
+		err = errors.New("blinded altair blocks not supported")
+	case spec.DataVersionBellatrix:
+		specJSON, err = json.Marshal(block.Bellatrix)
+	case spec.DataVersionCapella:
+		specJSON, err = json.Marshal(block.Capella)
+	default:
+		err = errors.New("unknown block version")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	receipt, err := s.submitWithValidation(ctx, "/eth/v2/beacon/blinded_blocks", block.Version, validation, specJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit blinded beacon block")
+	}
+
+	return receipt, nil
+}
+
+// submissionErrorJSON is the error body returned by a node for a submission.
+type submissionErrorJSON struct {
+	Message string `json:"message"`
+}
+
+// submitWithValidation posts a block to the given endpoint with broadcast validation, and
+// turns the node's response in to a receipt.
+//
+// The node returns 200 if the block was broadcast and imported, 202 if the block was
+// broadcast but failed to import, and 400 if the block failed validation and was not
+// broadcast.  All of these result in a receipt; any other response is an error.
+func (s *Service) submitWithValidation(ctx context.Context,
+	endpoint string,
+	version spec.DataVersion,
+	validation apiv1.BroadcastValidation,
+	body []byte,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	if validation.String() == "unknown" {
+		return nil, errors.New("unknown broadcast validation")
+	}
+
+	receipt := &apiv1.SubmissionReceipt{
+		BroadcastValidation: validation,
+	}
+	res, err := s.post2(ctx,
+		fmt.Sprintf("%s?broadcast_validation=%s", endpoint, validation.String()),
+		bytes.NewBuffer(body),
+		map[string]string{"Eth-Consensus-Version": version.String()},
+	)
+	if err != nil {
+		var apiErr Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+			return nil, err
+		}
+		receipt.StatusCode = apiErr.StatusCode
+		receipt.Message = submissionMessage(apiErr.Data)
+
+		return receipt, nil
+	}
+
+	receipt.StatusCode = res.statusCode
+	receipt.Broadcast = true
+	receipt.Imported = res.statusCode == http.StatusOK
+	receipt.Message = submissionMessage(res.body)
+
+	return receipt, nil
+}
+
+// submissionMessage obtains the message from a submission response body.
+func submissionMessage(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+	resp := &submissionErrorJSON{}
+	if err := json.Unmarshal(data, resp); err != nil || resp.Message == "" {
+		return string(data)
+	}
+
+	return resp.Message
+}
@@ -0,0 +1,149 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBeaconBlockWithValidation(t *testing.T) {
+	ctx := context.Background()
+
+	var statusCode int
+	var body string
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: server.URL,
+		client:  server.Client(),
+		timeout: time.Second,
+	}
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: 1,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		block      *spec.VersionedSignedBeaconBlock
+		validation apiv1.BroadcastValidation
+		statusCode int
+		body       string
+		expected   *apiv1.SubmissionReceipt
+		err        string
+	}{
+		{
+			name:       "Nil",
+			validation: apiv1.BroadcastValidationGossip,
+			err:        "no block supplied",
+		},
+		{
+			name:       "UnknownValidation",
+			block:      block,
+			validation: apiv1.BroadcastValidation(-1),
+			err:        "failed to submit beacon block: unknown broadcast validation",
+		},
+		{
+			name:       "Imported",
+			block:      block,
+			validation: apiv1.BroadcastValidationConsensus,
+			statusCode: http.StatusOK,
+			expected: &apiv1.SubmissionReceipt{
+				BroadcastValidation: apiv1.BroadcastValidationConsensus,
+				Broadcast:           true,
+				Imported:            true,
+				StatusCode:          http.StatusOK,
+			},
+		},
+		{
+			name:       "NotImported",
+			block:      block,
+			validation: apiv1.BroadcastValidationGossip,
+			statusCode: http.StatusAccepted,
+			body:       `{"code":202,"message":"block failed integration"}`,
+			expected: &apiv1.SubmissionReceipt{
+				BroadcastValidation: apiv1.BroadcastValidationGossip,
+				Broadcast:           true,
+				StatusCode:          http.StatusAccepted,
+				Message:             "block failed integration",
+			},
+		},
+		{
+			name:       "Rejected",
+			block:      block,
+			validation: apiv1.BroadcastValidationConsensusAndEquivocation,
+			statusCode: http.StatusBadRequest,
+			body:       "equivocation",
+			expected: &apiv1.SubmissionReceipt{
+				BroadcastValidation: apiv1.BroadcastValidationConsensusAndEquivocation,
+				StatusCode:          http.StatusBadRequest,
+				Message:             "equivocation",
+			},
+		},
+		{
+			name:       "ServerError",
+			block:      block,
+			validation: apiv1.BroadcastValidationGossip,
+			statusCode: http.StatusInternalServerError,
+			body:       "internal error",
+			err:        "failed to submit beacon block: POST failed with status 500: internal error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			statusCode = test.statusCode
+			body = test.body
+			receipt, err := s.SubmitBeaconBlockWithValidation(ctx, test.block, test.validation)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, receipt)
+			require.Equal(t, "/eth/v2/beacon/blocks", request.URL.Path)
+			require.Equal(t, test.validation.String(), request.URL.Query().Get("broadcast_validation"))
+			require.Equal(t, "phase0", request.Header.Get("Eth-Consensus-Version"))
+		})
+	}
+}
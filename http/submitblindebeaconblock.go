@@ -31,6 +31,9 @@ func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Versi
 	if block == nil {
 		return errors.New("no blinded block supplied")
 	}
+	if err := s.checkBlindedBlock(ctx, block); err != nil {
+		return err
+	}
 
 	switch block.Version {
 	case spec.DataVersionPhase0:
@@ -0,0 +1,114 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// headSubscriptionBuffer is the number of head events that can be queued
+// for a slow consumer before the event stream blocks.
+const headSubscriptionBuffer = 16
+
+// headSubscription filters head events so that consumers receive each head
+// root once, with slots that never decrease.
+type headSubscription struct {
+	mu       sync.Mutex
+	ctx      context.Context
+	ch       chan *apiv1.HeadEvent
+	closed   bool
+	started  bool
+	lastSlot phase0.Slot
+	lastRoot phase0.Root
+}
+
+func newHeadSubscription(ctx context.Context) *headSubscription {
+	return &headSubscription{
+		ctx: ctx,
+		ch:  make(chan *apiv1.HeadEvent, headSubscriptionBuffer),
+	}
+}
+
+// handle is the event handler for the subscription.
+func (h *headSubscription) handle(event *apiv1.Event) {
+	if event == nil || event.Data == nil {
+		return
+	}
+	head, isHead := event.Data.(*apiv1.HeadEvent)
+	if !isHead {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	if h.started {
+		if head.Block == h.lastRoot {
+			// Duplicate.
+			return
+		}
+		if head.Slot < h.lastSlot {
+			// Out of order.
+			return
+		}
+	}
+
+	select {
+	case h.ch <- head:
+		h.started = true
+		h.lastSlot = head.Slot
+		h.lastRoot = head.Block
+	case <-h.ctx.Done():
+	}
+}
+
+// close closes the subscription channel.
+func (h *headSubscription) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.closed {
+		h.closed = true
+		close(h.ch)
+	}
+}
+
+// SubscribeHeads provides a channel of head events.  Duplicate head roots
+// are dropped, and slots on the channel never decrease, although multiple
+// heads may be supplied for the same slot in the case of a reorg.
+// The channel is closed when the context is cancelled or the service is closed.
+func (s *Service) SubscribeHeads(ctx context.Context) (<-chan *apiv1.HeadEvent, error) {
+	ctx, cancel := s.lifecycleContext(ctx)
+	sub := newHeadSubscription(ctx)
+	if err := s.Events(ctx, []string{"head"}, sub.handle); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to subscribe to head events")
+	}
+
+	go func() {
+		<-ctx.Done()
+		sub.close()
+		cancel()
+	}()
+
+	return sub.ch, nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func headEvent(slot phase0.Slot, root byte) *apiv1.Event {
+	return &apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:  slot,
+			Block: phase0.Root{root},
+		},
+	}
+}
+
+func TestHeadSubscription(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := newHeadSubscription(ctx)
+
+	sub.handle(nil)
+	sub.handle(&apiv1.Event{Topic: "block", Data: &apiv1.BlockEvent{}})
+	sub.handle(headEvent(10, 0x01))
+	// Duplicate root.
+	sub.handle(headEvent(10, 0x01))
+	// Reorg at the same slot.
+	sub.handle(headEvent(10, 0x02))
+	// Earlier slot.
+	sub.handle(headEvent(9, 0x03))
+	sub.handle(headEvent(11, 0x04))
+
+	cancel()
+	sub.close()
+	// Events after close are ignored.
+	sub.handle(headEvent(12, 0x05))
+
+	received := make([]*apiv1.HeadEvent, 0)
+	for head := range sub.ch {
+		received = append(received, head)
+	}
+	require.Len(t, received, 3)
+	require.Equal(t, phase0.Root{0x01}, received[0].Block)
+	require.Equal(t, phase0.Root{0x02}, received[1].Block)
+	require.Equal(t, phase0.Root{0x04}, received[2].Block)
+}
+
+func TestHeadSubscriptionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := newHeadSubscription(ctx)
+
+	// Fill the buffer so that the next send would block.
+	for i := 0; i < headSubscriptionBuffer; i++ {
+		sub.handle(headEvent(phase0.Slot(i), byte(i+1)))
+	}
+	cancel()
+	// Must not block once the context is cancelled.
+	sub.handle(headEvent(phase0.Slot(headSubscriptionBuffer), 0xff))
+	sub.close()
+
+	count := 0
+	for range sub.ch {
+		count++
+	}
+	require.Equal(t, headSubscriptionBuffer, count)
+}
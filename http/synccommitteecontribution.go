@@ -36,6 +36,10 @@ func (s *Service) SyncCommitteeContribution(ctx context.Context,
 	*altair.SyncCommitteeContribution,
 	error,
 ) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("/eth/v1/validator/sync_committee_contribution?slot=%d&subcommittee_index=%d&beacon_block_root=%#x", slot, subcommitteeIndex, beaconBlockRoot)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
@@ -30,6 +30,10 @@ type syncCommitteeDutiesJSON struct {
 
 // SyncCommitteeDuties obtains sync committee duties.
 func (s *Service) SyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.SyncCommitteeDuty, error) {
+	if err := s.checkSynced(ctx); err != nil {
+		return nil, err
+	}
+
 	var reqBodyReader bytes.Buffer
 	if _, err := reqBodyReader.WriteString(`[`); err != nil {
 		return nil, errors.Wrap(err, "failed to write validator index array start")
@@ -0,0 +1,45 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNodeSyncing is returned by calls that require a synced node when the node is
+// further behind than the distance given by WithRejectWhenSyncing.
+var ErrNodeSyncing = errors.New("node is syncing")
+
+// checkSynced returns ErrNodeSyncing if the service rejects calls when syncing and the
+// sync distance of the node is beyond the permitted distance.
+func (s *Service) checkSynced(ctx context.Context) error {
+	if !s.rejectWhenSyncing {
+		return nil
+	}
+
+	syncState, err := s.NodeSyncing(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain sync state")
+	}
+	if syncState == nil {
+		return errors.New("no sync state returned")
+	}
+	if syncState.SyncDistance > s.maxSyncDistance {
+		return errors.Wrapf(ErrNodeSyncing, "sync distance %d greater than %d", syncState.SyncDistance, s.maxSyncDistance)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectWhenSyncing(t *testing.T) {
+	ctx := context.Background()
+
+	syncDistance := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/node/syncing":
+			_, _ = fmt.Fprintf(w, `{"data":{"head_slot":"100","sync_distance":"%d","is_syncing":%t}}`, syncDistance, syncDistance > 0)
+		case "/eth/v1/validator/attestation_data":
+			_, _ = w.Write([]byte(`{"data":{"slot":"1","index":"2","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	newService := func(params ...Parameter) *Service {
+		parameters, err := parseAndCheckParameters(append([]Parameter{WithAddress(server.URL)}, params...)...)
+		require.NoError(t, err)
+		return &Service{
+			log:               zerolog.Nop(),
+			base:              base,
+			address:           server.URL,
+			client:            server.Client(),
+			timeout:           time.Second,
+			rejectWhenSyncing: parameters.rejectWhenSyncing,
+			maxSyncDistance:   parameters.maxSyncDistance,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		params       []Parameter
+		syncDistance int
+		err          string
+	}{
+		{
+			name:         "NotGated",
+			syncDistance: 50,
+		},
+		{
+			name:   "Synced",
+			params: []Parameter{WithRejectWhenSyncing(2)},
+		},
+		{
+			name:         "WithinDistance",
+			params:       []Parameter{WithRejectWhenSyncing(2)},
+			syncDistance: 2,
+		},
+		{
+			name:         "BeyondDistance",
+			params:       []Parameter{WithRejectWhenSyncing(2)},
+			syncDistance: 3,
+			err:          "sync distance 3 greater than 2: node is syncing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			syncDistance = test.syncDistance
+			s := newService(test.params...)
+			attestationData, err := s.AttestationData(ctx, 1, 2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.True(t, errors.Is(err, ErrNodeSyncing))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(1), attestationData.Slot)
+		})
+	}
+}
@@ -15,15 +15,9 @@ package http
 
 import (
 	"context"
-
-	"github.com/pkg/errors"
 )
 
 // TargetAggregatorsPerCommittee provides the target aggregators per committee of the chain.
 func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
-	spec, err := s.Spec(ctx)
-	if err != nil {
-		return 0, errors.Wrap(err, "failed to obtain spec")
-	}
-	return spec["TARGET_AGGREGATORS_PER_COMMITTEE"].(uint64), nil
+	return s.SpecUint64(ctx, "TARGET_AGGREGATORS_PER_COMMITTEE")
 }
@@ -0,0 +1,69 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"strings"
+	"time"
+)
+
+// Endpoint is the path prefix of a class of endpoints, for example
+// "/eth/v1/validator/duties".  Any request whose path starts with the prefix
+// is considered a member of the class.
+type Endpoint string
+
+const (
+	// EndpointDuties is the class of validator duty endpoints.
+	EndpointDuties Endpoint = "/eth/v1/validator/duties"
+	// EndpointStatesV1 is the class of V1 beacon state download endpoints.
+	EndpointStatesV1 Endpoint = "/eth/v1/debug/beacon/states"
+	// EndpointStatesV2 is the class of V2 beacon state download endpoints.
+	EndpointStatesV2 Endpoint = "/eth/v2/debug/beacon/states"
+	// EndpointEvents is the event stream endpoint.
+	EndpointEvents Endpoint = "/eth/v1/events"
+	// EndpointBlocksV1 is the class of V1 beacon block endpoints.
+	EndpointBlocksV1 Endpoint = "/eth/v1/beacon/blocks"
+	// EndpointBlocksV2 is the class of V2 beacon block endpoints.
+	EndpointBlocksV2 Endpoint = "/eth/v2/beacon/blocks"
+	// EndpointBlindedBlocks is the class of blinded beacon block endpoints.
+	EndpointBlindedBlocks Endpoint = "/eth/v1/beacon/blinded_blocks"
+)
+
+// timeoutFor returns the timeout for the given endpoint.
+// If more than one custom timeout matches the endpoint then the one
+// with the longest prefix is used.  If no custom timeout matches the
+// endpoint then the service-wide timeout is used.
+func (s *Service) timeoutFor(endpoint string) time.Duration {
+	timeout := s.timeout
+	matched := -1
+	for prefix, prefixTimeout := range s.timeouts {
+		if len(prefix) > matched && strings.HasPrefix(endpoint, string(prefix)) {
+			timeout = prefixTimeout
+			matched = len(prefix)
+		}
+	}
+
+	return timeout
+}
+
+// maxTimeout returns the largest of the service-wide and custom timeouts.
+func maxTimeout(timeout time.Duration, timeouts map[Endpoint]time.Duration) time.Duration {
+	for _, endpointTimeout := range timeouts {
+		if endpointTimeout > timeout {
+			timeout = endpointTimeout
+		}
+	}
+
+	return timeout
+}
@@ -0,0 +1,87 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutFor(t *testing.T) {
+	s := &Service{
+		timeout: 2 * time.Second,
+		timeouts: map[Endpoint]time.Duration{
+			EndpointDuties:                      time.Second,
+			EndpointStatesV2:                    time.Minute,
+			"/eth/v1/validator/duties/proposer": 500 * time.Millisecond,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		timeout  time.Duration
+	}{
+		{
+			name:     "Default",
+			endpoint: "/eth/v1/beacon/genesis",
+			timeout:  2 * time.Second,
+		},
+		{
+			name:     "Duties",
+			endpoint: "/eth/v1/validator/duties/attester/10",
+			timeout:  time.Second,
+		},
+		{
+			name:     "LongestPrefix",
+			endpoint: "/eth/v1/validator/duties/proposer/10",
+			timeout:  500 * time.Millisecond,
+		},
+		{
+			name:     "State",
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			timeout:  time.Minute,
+		},
+		{
+			name:     "StateV1",
+			endpoint: "/eth/v1/debug/beacon/states/head",
+			timeout:  2 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.timeout, s.timeoutFor(test.endpoint))
+		})
+	}
+}
+
+func TestMaxTimeout(t *testing.T) {
+	require.Equal(t, 2*time.Second, maxTimeout(2*time.Second, nil))
+	require.Equal(t, time.Minute, maxTimeout(2*time.Second, map[Endpoint]time.Duration{
+		EndpointDuties:   time.Second,
+		EndpointStatesV2: time.Minute,
+	}))
+}
+
+func TestTimeoutsParameterCopied(t *testing.T) {
+	timeouts := map[Endpoint]time.Duration{EndpointDuties: time.Second}
+	parameters, err := parseAndCheckParameters(WithAddress("http://localhost"), WithTimeouts(timeouts))
+	require.NoError(t, err)
+
+	timeouts[EndpointDuties] = time.Minute
+	require.Equal(t, time.Second, parameters.timeouts[EndpointDuties])
+}
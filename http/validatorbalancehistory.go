@@ -0,0 +1,122 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// validatorBalanceHistoryParallelism is the maximum number of concurrent requests made when fetching balance history.
+// This is kept low as each request can require the node to regenerate a historical state.
+const validatorBalanceHistoryParallelism = 4
+
+// ValidatorBalanceHistory provides the balances of a validator at the start of every step epochs from
+// fromEpoch to toEpoch inclusive.
+// Epochs whose states the node cannot supply, for example because it is not an archive node, are returned
+// as unavailable rather than causing an error.  Epochs before the validator was known to the chain are
+// omitted.
+func (s *Service) ValidatorBalanceHistory(ctx context.Context,
+	index phase0.ValidatorIndex,
+	fromEpoch phase0.Epoch,
+	toEpoch phase0.Epoch,
+	step uint64,
+) (
+	*api.ValidatorBalanceHistory,
+	error,
+) {
+	if step == 0 {
+		return nil, errors.New("step must be greater than 0")
+	}
+	if toEpoch < fromEpoch {
+		return nil, errors.New("to epoch cannot be before from epoch")
+	}
+
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	epochs := make([]phase0.Epoch, 0, uint64(toEpoch-fromEpoch)/step+1)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch += phase0.Epoch(step) {
+		epochs = append(epochs, epoch)
+		if uint64(toEpoch-epoch) < step {
+			break
+		}
+	}
+
+	type sample struct {
+		balance   phase0.Gwei
+		present   bool
+		available bool
+	}
+	samples := make([]sample, len(epochs))
+
+	sem := make(chan struct{}, validatorBalanceHistoryParallelism)
+	var wg sync.WaitGroup
+	for i := range epochs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// The state at the first slot of the epoch is the one most likely to be retained by the node.
+			slot := phase0.Slot(uint64(epochs[i]) * slotsPerEpoch)
+			balances, err := s.ValidatorBalances(ctx, fmt.Sprintf("%d", slot), []phase0.ValidatorIndex{index})
+			if err != nil {
+				s.log.Trace().Uint64("epoch", uint64(epochs[i])).Err(err).Msg("State not available")
+				return
+			}
+			// Each goroutine writes to a distinct index so no lock is required.
+			samples[i].available = true
+			samples[i].balance, samples[i].present = balances[index]
+		}(i)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	res := &api.ValidatorBalanceHistory{
+		Index:       index,
+		Epochs:      make([]phase0.Epoch, 0, len(epochs)),
+		Balances:    make([]phase0.Gwei, 0, len(epochs)),
+		Unavailable: make([]phase0.Epoch, 0),
+	}
+	for i, sample := range samples {
+		switch {
+		case !sample.available:
+			res.Unavailable = append(res.Unavailable, epochs[i])
+		case sample.present:
+			res.Epochs = append(res.Epochs, epochs[i])
+			res.Balances = append(res.Balances, sample.balance)
+		}
+	}
+	if len(res.Unavailable) == len(epochs) {
+		return nil, errors.New("no states available for the requested epochs")
+	}
+
+	return res, nil
+}
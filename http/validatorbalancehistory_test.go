@@ -0,0 +1,86 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorBalanceHistory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		fromEpoch phase0.Epoch
+		toEpoch   phase0.Epoch
+		step      uint64
+		samples   int
+		err       string
+	}{
+		{
+			name:      "StepZero",
+			fromEpoch: 1,
+			toEpoch:   2,
+			err:       "step must be greater than 0",
+		},
+		{
+			name:      "EpochsReversed",
+			fromEpoch: 2,
+			toEpoch:   1,
+			step:      1,
+			err:       "to epoch cannot be before from epoch",
+		},
+		{
+			name:      "Single",
+			fromEpoch: 0,
+			toEpoch:   0,
+			step:      1,
+			samples:   1,
+		},
+		{
+			name:      "Stepped",
+			fromEpoch: 0,
+			toEpoch:   4,
+			step:      2,
+			samples:   3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.ValidatorBalanceHistoryProvider).ValidatorBalanceHistory(ctx, 0, test.fromEpoch, test.toEpoch, test.step)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.Balances, len(res.Epochs))
+			require.Equal(t, test.samples, len(res.Epochs)+len(res.Unavailable))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"fmt"
+)
+
+// Names of the values held in the service's cache.
+const (
+	genesisCacheName         = "genesis"
+	specCacheName            = "spec"
+	depositContractCacheName = "deposit_contract"
+	forkScheduleCacheName    = "fork_schedule"
+)
+
+// cacheKey returns the key under which the named value is held in the service's cache.
+// Keys are qualified by the node, so that a cache can be shared between services.
+func (s *Service) cacheKey(name string) string {
+	return fmt.Sprintf("%s%s#%s", s.base.Host, s.base.Path, name)
+}
+
+// cached returns the named value from the service's cache, and true if it was present.
+func (s *Service) cached(name string) (interface{}, bool) {
+	return s.cache.Get(s.cacheKey(name))
+}
+
+// uncache removes the named values from the service's cache, so that they are
+// refetched the next time they are required.
+func (s *Service) uncache(names ...string) {
+	for _, name := range names {
+		s.cache.Delete(s.cacheKey(name))
+	}
+}
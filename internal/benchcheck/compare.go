@@ -0,0 +1,139 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Results are the metrics of benchmarks, keyed by benchmark and then by unit.
+type Results map[string]map[string]float64
+
+// Regression is a metric of a benchmark that has regressed from its baseline.
+type Regression struct {
+	Benchmark string
+	Metric    string
+	Baseline  float64
+	Current   float64
+}
+
+// String returns a string version of the structure.
+func (r *Regression) String() string {
+	return fmt.Sprintf("%s: %s increased from %.0f to %.0f (%+.1f%%)",
+		r.Benchmark,
+		r.Metric,
+		r.Baseline,
+		r.Current,
+		100*(r.Current-r.Baseline)/r.Baseline,
+	)
+}
+
+// Parse parses the output of "go test -bench".  Lines that are not benchmark
+// results are ignored.  If a benchmark appears more than once the lowest value of
+// each metric is kept.
+func Parse(input io.Reader) (Results, error) {
+	results := make(Results)
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.ParseUint(fields[1], 10, 64); err != nil {
+			// Not a result line, for example the name of a failing benchmark.
+			continue
+		}
+		name := benchmarkName(fields[0])
+		if _, exists := results[name]; !exists {
+			results[name] = make(map[string]float64)
+		}
+		// Remaining fields are value/unit pairs.
+		for i := 2; i+1 < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value for %s %s", name, fields[i+1])
+			}
+			if existing, exists := results[name][fields[i+1]]; !exists || value < existing {
+				results[name][fields[i+1]] = value
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read benchmark output")
+	}
+
+	return results, nil
+}
+
+// benchmarkName strips the GOMAXPROCS suffix from a benchmark name, so that
+// results from machines with differing numbers of processors can be compared.
+func benchmarkName(name string) string {
+	i := strings.LastIndex(name, "-")
+	if i == -1 {
+		return name
+	}
+	if _, err := strconv.Atoi(name[i+1:]); err != nil {
+		return name
+	}
+
+	return name[:i]
+}
+
+// Compare compares current results against a baseline, returning the metrics
+// that have increased by more than the threshold.  Benchmarks or metrics that are
+// not present in both sets of results are ignored.
+func Compare(baseline Results, current Results, threshold float64, metrics []string) []*Regression {
+	regressions := make([]*Regression, 0)
+	for name, currentMetrics := range current {
+		baselineMetrics, exists := baseline[name]
+		if !exists {
+			continue
+		}
+		for _, metric := range metrics {
+			baselineValue, exists := baselineMetrics[metric]
+			if !exists {
+				continue
+			}
+			currentValue, exists := currentMetrics[metric]
+			if !exists {
+				continue
+			}
+			if currentValue > baselineValue*(1+threshold) {
+				regressions = append(regressions, &Regression{
+					Benchmark: name,
+					Metric:    metric,
+					Baseline:  baselineValue,
+					Current:   currentValue,
+				})
+			}
+		}
+	}
+
+	sort.Slice(regressions, func(i int, j int) bool {
+		if regressions[i].Benchmark != regressions[j].Benchmark {
+			return regressions[i].Benchmark < regressions[j].Benchmark
+		}
+
+		return regressions[i].Metric < regressions[j].Metric
+	})
+
+	return regressions
+}
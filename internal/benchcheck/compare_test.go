@@ -0,0 +1,116 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const baselineOutput = `goos: linux
+goarch: amd64
+pkg: github.com/attestantio/go-eth2-client/spec/capella
+BenchmarkBeaconStateMarshalSSZ-8     	      10	 139946068 ns/op	141762560 B/op	       1 allocs/op
+BenchmarkBeaconStateUnmarshalSSZ-8   	      10	 170428412 ns/op	188787872 B/op	 2000023 allocs/op
+BenchmarkBeaconStateUnmarshalSSZ-8   	      10	 160428412 ns/op	188787872 B/op	 2000023 allocs/op
+PASS
+ok  	github.com/attestantio/go-eth2-client/spec/capella	17.774s
+`
+
+func TestParse(t *testing.T) {
+	results, err := Parse(strings.NewReader(baselineOutput))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, float64(1), results["BenchmarkBeaconStateMarshalSSZ"]["allocs/op"])
+	require.Equal(t, float64(160428412), results["BenchmarkBeaconStateUnmarshalSSZ"]["ns/op"])
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("BenchmarkTest-8  10  bad ns/op\n"))
+	require.EqualError(t, err, `invalid value for BenchmarkTest ns/op: strconv.ParseFloat: parsing "bad": invalid syntax`)
+}
+
+func TestBenchmarkName(t *testing.T) {
+	require.Equal(t, "BenchmarkTest", benchmarkName("BenchmarkTest-16"))
+	require.Equal(t, "BenchmarkTest", benchmarkName("BenchmarkTest"))
+	require.Equal(t, "BenchmarkTest/sub-case", benchmarkName("BenchmarkTest/sub-case"))
+}
+
+func TestCompare(t *testing.T) {
+	baseline := Results{
+		"BenchmarkA": {"ns/op": 100, "allocs/op": 10},
+		"BenchmarkB": {"ns/op": 100, "allocs/op": 10},
+	}
+
+	tests := []struct {
+		name        string
+		current     Results
+		metrics     []string
+		regressions []string
+	}{
+		{
+			name: "Unchanged",
+			current: Results{
+				"BenchmarkA": {"ns/op": 100, "allocs/op": 10},
+			},
+			metrics: []string{"ns/op", "allocs/op"},
+		},
+		{
+			name: "WithinThreshold",
+			current: Results{
+				"BenchmarkA": {"ns/op": 110, "allocs/op": 11},
+			},
+			metrics: []string{"ns/op", "allocs/op"},
+		},
+		{
+			name: "Regressed",
+			current: Results{
+				"BenchmarkA": {"ns/op": 200, "allocs/op": 12},
+				"BenchmarkB": {"ns/op": 50, "allocs/op": 20},
+			},
+			metrics: []string{"ns/op", "allocs/op"},
+			regressions: []string{
+				"BenchmarkA: allocs/op increased from 10 to 12 (+20.0%)",
+				"BenchmarkA: ns/op increased from 100 to 200 (+100.0%)",
+				"BenchmarkB: allocs/op increased from 10 to 20 (+100.0%)",
+			},
+		},
+		{
+			name: "UncheckedMetric",
+			current: Results{
+				"BenchmarkA": {"ns/op": 200, "allocs/op": 10},
+			},
+			metrics: []string{"allocs/op"},
+		},
+		{
+			name: "NewBenchmark",
+			current: Results{
+				"BenchmarkC": {"ns/op": 200, "allocs/op": 10},
+			},
+			metrics: []string{"ns/op", "allocs/op"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			regressions := Compare(baseline, test.current, 0.1, test.metrics)
+			require.Len(t, regressions, len(test.regressions))
+			for i := range regressions {
+				require.Equal(t, test.regressions[i], regressions[i].String())
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command benchcheck compares the output of "go test -bench" against a stored
+// baseline, and fails if any benchmark has regressed beyond a threshold.
+//
+// Baselines are the saved output of a benchmark run, for example:
+//
+//	go test ./spec/capella ./api/v1 -run '^$' -bench . -benchmem > baseline.txt
+//
+// and later runs are checked with:
+//
+//	go test ./spec/capella ./api/v1 -run '^$' -bench . -benchmem | go run ./internal/benchcheck -baseline baseline.txt
+//
+// Timings depend on the machine running the benchmarks, so by default only
+// allocation metrics are checked; timings can be added with -metrics when the
+// baseline was generated on the same machine.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	baselineFile := flag.String("baseline", "", "path to the baseline benchmark output")
+	currentFile := flag.String("current", "", "path to the current benchmark output (default stdin)")
+	threshold := flag.Float64("threshold", 0.1, "permitted fractional increase of a metric over its baseline")
+	metrics := flag.String("metrics", "B/op,allocs/op", "comma-separated list of metrics to check")
+	flag.Parse()
+
+	regressions, err := run(*baselineFile, *currentFile, *threshold, strings.Split(*metrics, ","))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	for _, regression := range regressions {
+		fmt.Println(regression)
+	}
+	if len(regressions) > 0 {
+		os.Exit(1)
+	}
+}
+
+func run(baselineFile string, currentFile string, threshold float64, metrics []string) ([]*Regression, error) {
+	if baselineFile == "" {
+		return nil, errors.New("no baseline specified")
+	}
+
+	baselineInput, err := os.Open(baselineFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open baseline")
+	}
+	defer baselineInput.Close()
+	baseline, err := Parse(baselineInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse baseline")
+	}
+
+	var currentInput io.Reader = os.Stdin
+	if currentFile != "" {
+		file, err := os.Open(currentFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open current results")
+		}
+		defer file.Close()
+		currentInput = file
+	}
+	current, err := Parse(currentInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse current results")
+	}
+
+	return Compare(baseline, current, threshold, metrics), nil
+}
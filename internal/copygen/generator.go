@@ -0,0 +1,328 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// outputFile is the name of the generated file in each package.
+const outputFile = "copy.go"
+
+// loadPackage parses and type-checks the package in the given directory,
+// ignoring tests and any previously generated copy methods.
+func loadPackage(dir string) (*types.Package, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != outputFile
+	}, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse package")
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected 1 package, found %d", len(pkgs))
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+
+	config := &types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+	}
+	pkg, err := config.Check(dir, fset, files, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to type-check package")
+	}
+
+	return pkg, nil
+}
+
+// generator generates the copy methods of a single package.
+type generator struct {
+	pkg *types.Package
+	// copyable are the structures in the package for which methods are generated.
+	copyable map[*types.TypeName]bool
+	imports  map[string]string
+	buf      strings.Builder
+}
+
+// Generate returns the formatted source of the copy methods for the package.
+func Generate(pkg *types.Package) ([]byte, error) {
+	g := &generator{
+		pkg:      pkg,
+		copyable: make(map[*types.TypeName]bool),
+		imports:  make(map[string]string),
+	}
+
+	names := pkg.Scope().Names()
+	sort.Strings(names)
+	objs := make([]*types.TypeName, 0)
+	for _, name := range names {
+		obj, isTypeName := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !isTypeName || !obj.Exported() || obj.IsAlias() {
+			continue
+		}
+		if structure, isStruct := obj.Type().Underlying().(*types.Struct); isStruct && allExported(structure) {
+			g.copyable[obj] = true
+			objs = append(objs, obj)
+		}
+	}
+
+	var body strings.Builder
+	for _, obj := range objs {
+		method, err := g.method(obj)
+		if err != nil {
+			return nil, errors.Wrap(err, obj.Name())
+		}
+		body.WriteString(method)
+	}
+
+	g.printf("// Code generated by copygen. DO NOT EDIT.\n")
+	g.printf("package %s\n\n", pkg.Name())
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		g.printf("import (\n")
+		for _, path := range paths {
+			g.printf("\t%q\n", path)
+		}
+		g.printf(")\n\n")
+	}
+	g.buf.WriteString(body.String())
+
+	src, err := format.Source([]byte(g.buf.String()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to format generated source")
+	}
+
+	return src, nil
+}
+
+// allExported returns true if all fields of the structure are exported.
+func allExported(structure *types.Struct) bool {
+	for i := 0; i < structure.NumFields(); i++ {
+		if !structure.Field(i).Exported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// method returns the copy method for the structure.
+func (g *generator) method(obj *types.TypeName) (string, error) {
+	structure := obj.Type().Underlying().(*types.Struct)
+	receiver := strings.ToLower(obj.Name()[:1])
+	m := &method{
+		g:        g,
+		receiver: receiver,
+	}
+
+	shallow := make([]string, 0)
+	for i := 0; i < structure.NumFields(); i++ {
+		field := structure.Field(i)
+		if _, isInterface := field.Type().Underlying().(*types.Interface); isInterface {
+			shallow = append(shallow, field.Name())
+		}
+		if err := m.copy("res."+field.Name(), receiver+"."+field.Name(), field.Type(), 1); err != nil {
+			return "", errors.Wrap(err, field.Name())
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("// Copy returns a deep copy of the structure.\n")
+	if len(shallow) > 0 {
+		fmt.Fprintf(&b, "// %s %s copied shallowly.\n", strings.Join(shallow, ", "), plural(len(shallow), "is", "are"))
+	}
+	fmt.Fprintf(&b, "func (%s *%s) Copy() *%s {\n", receiver, obj.Name(), obj.Name())
+	fmt.Fprintf(&b, "if %s == nil {\nreturn nil\n}\n", receiver)
+	fmt.Fprintf(&b, "res := *%s\n", receiver)
+	b.WriteString(m.buf.String())
+	b.WriteString("\nreturn &res\n}\n\n")
+
+	return b.String(), nil
+}
+
+func plural(n int, singular string, multiple string) string {
+	if n == 1 {
+		return singular
+	}
+
+	return multiple
+}
+
+// method generates the body of a single copy method.
+type method struct {
+	g        *generator
+	receiver string
+	buf      strings.Builder
+}
+
+func (m *method) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&m.buf, format, args...)
+}
+
+// index returns the name of the loop variable at the given depth, avoiding the receiver.
+func (m *method) index(depth int) string {
+	names := []string{"i", "j", "k", "l", "n"}
+	for _, name := range names {
+		if name == m.receiver {
+			continue
+		}
+		if depth == 1 {
+			return name
+		}
+		depth--
+	}
+
+	return fmt.Sprintf("i%d", depth)
+}
+
+// copy writes the statements to deep copy src to dst, where dst already holds
+// a shallow copy of src.
+func (m *method) copy(dst string, src string, t types.Type, depth int) error {
+	if !m.g.isDeep(t) {
+		return nil
+	}
+
+	switch underlying := t.Underlying().(type) {
+	case *types.Pointer:
+		return m.copyPointer(dst, src, underlying.Elem())
+	case *types.Slice:
+		m.printf("if %s != nil {\n", src)
+		m.printf("%s = append(%s[:0:0], %s...)\n", dst, src, src)
+		if m.g.isDeep(underlying.Elem()) {
+			index := m.index(depth)
+			m.printf("for %s := range %s {\n", index, src)
+			if err := m.copy(fmt.Sprintf("%s[%s]", dst, index), fmt.Sprintf("%s[%s]", src, index), underlying.Elem(), depth+1); err != nil {
+				return err
+			}
+			m.printf("}\n")
+		}
+		m.printf("}\n")
+	case *types.Array:
+		index := m.index(depth)
+		m.printf("for %s := range %s {\n", index, src)
+		if err := m.copy(fmt.Sprintf("%s[%s]", dst, index), fmt.Sprintf("%s[%s]", src, index), underlying.Elem(), depth+1); err != nil {
+			return err
+		}
+		m.printf("}\n")
+	case *types.Map:
+		m.printf("if %s != nil {\n", src)
+		m.printf("%s = make(%s, len(%s))\n", dst, m.g.typeString(t), src)
+		m.printf("for key, value := range %s {\n", src)
+		if m.g.isDeep(underlying.Elem()) {
+			m.printf("copied := value\n")
+			if err := m.copy("copied", "value", underlying.Elem(), depth+1); err != nil {
+				return err
+			}
+			m.printf("%s[key] = copied\n", dst)
+		} else {
+			m.printf("%s[key] = value\n", dst)
+		}
+		m.printf("}\n}\n")
+	case *types.Struct:
+		m.printf("%s = *%s.Copy()\n", dst, src)
+	default:
+		return fmt.Errorf("unsupported type %s", m.g.typeString(t))
+	}
+
+	return nil
+}
+
+// copyPointer writes the statements to deep copy the pointer src to dst.
+func (m *method) copyPointer(dst string, src string, elem types.Type) error {
+	if named, isNamed := elem.(*types.Named); isNamed && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "math/big" && named.Obj().Name() == "Int" {
+		m.g.imports["math/big"] = "big"
+		m.printf("if %s != nil {\n%s = new(big.Int).Set(%s)\n}\n", src, dst, src)
+		return nil
+	}
+	if m.g.hasCopy(elem) {
+		m.printf("%s = %s.Copy()\n", dst, src)
+		return nil
+	}
+	if !m.g.isDeep(elem) {
+		if _, isStruct := elem.Underlying().(*types.Struct); !isStruct {
+			m.printf("if %s != nil {\ncopied := *%s\n%s = &copied\n}\n", src, src, dst)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no copy method for %s", m.g.typeString(elem))
+}
+
+// isDeep returns true if a value of the type shares memory with its copy.
+func (g *generator) isDeep(t types.Type) bool {
+	switch underlying := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map:
+		return true
+	case *types.Array:
+		return g.isDeep(underlying.Elem())
+	case *types.Struct:
+		// Structures without a copy method, such as time.Time, are treated as values.
+		return g.hasCopy(t)
+	default:
+		// Basic types, and interfaces which are copied shallowly.
+		return false
+	}
+}
+
+// hasCopy returns true if the type has a Copy() method, or will have one generated.
+func (g *generator) hasCopy(t types.Type) bool {
+	named, isNamed := t.(*types.Named)
+	if !isNamed {
+		return false
+	}
+	if g.copyable[named.Obj()] {
+		return true
+	}
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, named.Obj().Pkg(), "Copy")
+	_, isFunc := obj.(*types.Func)
+
+	return isFunc
+}
+
+// typeString returns the type as it is written in the package, recording any imports required.
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg == g.pkg {
+			return ""
+		}
+		g.imports[pkg.Path()] = pkg.Name()
+		return pkg.Name()
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMatchesExisting(t *testing.T) {
+	dirs := []string{
+		filepath.Join("..", "..", "spec"),
+		filepath.Join("..", "..", "spec", "phase0"),
+		filepath.Join("..", "..", "spec", "altair"),
+		filepath.Join("..", "..", "spec", "bellatrix"),
+		filepath.Join("..", "..", "spec", "capella"),
+		filepath.Join("..", "..", "api"),
+		filepath.Join("..", "..", "api", "v1"),
+		filepath.Join("..", "..", "api", "v1", "bellatrix"),
+		filepath.Join("..", "..", "api", "v1", "capella"),
+	}
+
+	for _, dir := range dirs {
+		t.Run(dir, func(t *testing.T) {
+			pkg, err := loadPackage(dir)
+			require.NoError(t, err)
+
+			src, err := Generate(pkg)
+			require.NoError(t, err)
+
+			expected, err := os.ReadFile(filepath.Join(dir, outputFile))
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(src), "generated copy methods out of date; run go generate")
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command copygen generates Copy() methods that carry out deep copies of the
+// exported structures in a package.
+//
+// The methods are written to copy.go in the package directory.  Packages must be
+// generated in dependency order, so that the structures of imported packages
+// already have their Copy() methods.
+//
+// Usage:
+//
+//	go run ./internal/copygen <package directory>...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	flag.Parse()
+
+	for _, dir := range flag.Args() {
+		if err := run(dir); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", dir, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func run(dir string) error {
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		return err
+	}
+
+	src, err := Generate(pkg)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate copy methods")
+	}
+	if err := os.WriteFile(filepath.Join(dir, outputFile), src, 0o644); err != nil {
+		return errors.Wrap(err, "failed to write generated file")
+	}
+
+	return nil
+}
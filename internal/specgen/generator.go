@@ -0,0 +1,415 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Field kinds.
+const (
+	kindUint          = "uint"
+	kindBytes         = "bytes"
+	kindFixedBytes    = "fixed_bytes"
+	kindContainer     = "container"
+	kindContainerList = "container_list"
+)
+
+// modulePath is the path of the module containing the spec packages.
+const modulePath = "github.com/attestantio/go-eth2-client/spec/"
+
+// generator generates the source of a single container.
+type generator struct {
+	schema    *Schema
+	container *Container
+	receiver  string
+	buf       strings.Builder
+}
+
+// Generate returns the formatted source of the file for the container.
+func Generate(schema *Schema, container *Container) ([]byte, error) {
+	if err := checkContainer(container); err != nil {
+		return nil, errors.Wrap(err, container.Name)
+	}
+
+	g := &generator{
+		schema:    schema,
+		container: container,
+		receiver:  strings.ToLower(container.Name[:1]),
+	}
+	g.header()
+	g.imports()
+	g.types()
+	g.marshalJSON()
+	g.unmarshalJSON()
+	g.marshalYAML()
+	g.strings()
+
+	src, err := format.Source([]byte(g.buf.String()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to format generated source")
+	}
+
+	return src, nil
+}
+
+// checkContainer checks that a container definition is complete.
+func checkContainer(container *Container) error {
+	if container.Name == "" {
+		return errors.New("no name")
+	}
+	if len(container.Fields) == 0 {
+		return errors.New("no fields")
+	}
+	for _, field := range container.Fields {
+		if field.Name == "" || field.Type == "" {
+			return errors.New("field without name or type")
+		}
+		switch field.Kind {
+		case kindUint, kindContainer:
+		case kindFixedBytes:
+			if field.Size == 0 {
+				return fmt.Errorf("%s: no size", field.Name)
+			}
+		case kindBytes, kindContainerList:
+			if field.Max == 0 {
+				return fmt.Errorf("%s: no max", field.Name)
+			}
+		default:
+			return fmt.Errorf("%s: unknown kind %q", field.Name, field.Kind)
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) header() {
+	g.printf(`// Copyright © %d Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package %s
+
+`, g.schema.CopyrightYear, g.schema.Package)
+}
+
+func (g *generator) imports() {
+	std := map[string]bool{
+		"bytes":         true,
+		"encoding/json": true,
+		"fmt":           true,
+	}
+	external := map[string]bool{
+		"github.com/goccy/go-yaml": true,
+		"github.com/pkg/errors":    true,
+	}
+	for _, field := range g.container.Fields {
+		switch field.Kind {
+		case kindUint:
+			std["strconv"] = true
+		case kindBytes, kindFixedBytes:
+			std["encoding/hex"] = true
+			std["strings"] = true
+		}
+		for _, name := range []string{field.Type, field.SizeConstant} {
+			if i := strings.Index(name, "."); i != -1 {
+				pkg := strings.TrimLeft(name[:i], "[]*")
+				if pkg != g.schema.Package {
+					external[modulePath+pkg] = true
+				}
+			}
+		}
+	}
+
+	g.printf("import (\n")
+	for _, path := range sortedKeys(std) {
+		g.printf("\t%q\n", path)
+	}
+	g.printf("\n")
+	for _, path := range sortedKeys(external) {
+		g.printf("\t%q\n", path)
+	}
+	g.printf(")\n\n")
+}
+
+func (g *generator) types() {
+	name := g.container.Name
+	g.printf("// %s %s\n", name, g.container.Description)
+	g.printf("type %s struct {\n", name)
+	for _, field := range g.container.Fields {
+		switch field.Kind {
+		case kindFixedBytes:
+			g.printf("\t%s %s `ssz-size:\"%d\"`\n", field.Name, field.Type, field.Size)
+		case kindBytes:
+			g.printf("\t%s %s `ssz-max:\"%d\"`\n", field.Name, field.Type, field.Max)
+		case kindContainer:
+			g.printf("\t%s *%s\n", field.Name, field.Type)
+		case kindContainerList:
+			g.printf("\t%s []*%s `ssz-max:\"%d\"`\n", field.Name, field.Type, field.Max)
+		default:
+			g.printf("\t%s %s\n", field.Name, field.Type)
+		}
+	}
+	g.printf("}\n\n")
+
+	for _, encoding := range []string{"JSON", "YAML"} {
+		g.printf("// %s%s is an internal representation of the struct.\n", lowerFirst(name), encoding)
+		g.printf("type %s%s struct {\n", lowerFirst(name), encoding)
+		for _, field := range g.container.Fields {
+			var fieldType string
+			switch field.Kind {
+			case kindUint:
+				fieldType = "string"
+				if encoding == "YAML" {
+					fieldType = "uint64"
+				}
+			case kindContainer:
+				fieldType = "*" + field.Type
+			case kindContainerList:
+				fieldType = "[]*" + field.Type
+			default:
+				fieldType = "string"
+			}
+			g.printf("\t%s %s `%s:\"%s\"`\n", field.Name, fieldType, strings.ToLower(encoding), specName(field))
+		}
+		g.printf("}\n\n")
+	}
+}
+
+func (g *generator) marshalJSON() {
+	g.printf("// MarshalJSON implements json.Marshaler.\n")
+	g.printf("func (%s *%s) MarshalJSON() ([]byte, error) {\n", g.receiver, g.container.Name)
+	g.printf("\treturn json.Marshal(&%sJSON{\n", lowerFirst(g.container.Name))
+	for _, field := range g.container.Fields {
+		switch field.Kind {
+		case kindUint:
+			g.printf("\t\t%s: fmt.Sprintf(\"%%d\", %s.%s),\n", field.Name, g.receiver, field.Name)
+		case kindBytes, kindFixedBytes:
+			g.printf("\t\t%s: fmt.Sprintf(\"%%#x\", %s.%s),\n", field.Name, g.receiver, field.Name)
+		default:
+			g.printf("\t\t%s: %s.%s,\n", field.Name, g.receiver, field.Name)
+		}
+	}
+	g.printf("\t})\n}\n\n")
+}
+
+func (g *generator) unmarshalJSON() {
+	name := g.container.Name
+	g.printf(`// UnmarshalJSON implements json.Unmarshaler.
+func (%[1]s *%[2]s) UnmarshalJSON(input []byte) error {
+	var data %[3]sJSON
+	err := json.Unmarshal(input, &data)
+	if err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return %[1]s.unpack(&data)
+}
+
+func (%[1]s *%[2]s) unpack(data *%[3]sJSON) error {
+`, g.receiver, name, lowerFirst(name))
+
+	for i, field := range g.container.Fields {
+		if i > 0 {
+			g.printf("\n")
+		}
+		desc := strings.ReplaceAll(specName(field), "_", " ")
+		variable := lowerFirst(field.Name)
+		if token.IsKeyword(variable) {
+			variable += "Value"
+		}
+		switch field.Kind {
+		case kindUint:
+			g.printf(`	if data.%[1]s == "" {
+		return errors.New("%[2]s missing")
+	}
+	%[3]s, err := strconv.ParseUint(data.%[1]s, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for %[2]s")
+	}
+`, field.Name, desc, variable)
+			if field.Type == "uint64" {
+				g.printf("\t%s.%s = %s\n", g.receiver, field.Name, variable)
+			} else {
+				g.printf("\t%s.%s = %s(%s)\n", g.receiver, field.Name, field.Type, variable)
+			}
+		case kindBytes, kindFixedBytes:
+			g.printf(`	if data.%[1]s == "" {
+		return errors.New("%[2]s missing")
+	}
+	%[3]s, err := hex.DecodeString(strings.TrimPrefix(data.%[1]s, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for %[2]s")
+	}
+`, field.Name, desc, variable)
+			if field.Kind == kindBytes {
+				g.printf(`	if len(%[1]s) > %[2]d {
+		return errors.New("%[3]s too long")
+	}
+	%[4]s.%[5]s = %[1]s
+`, variable, field.Max, desc, g.receiver, field.Name)
+			} else {
+				size := field.SizeConstant
+				if size == "" {
+					size = fmt.Sprintf("%d", field.Size)
+				}
+				g.printf(`	if len(%[1]s) != %[2]s {
+		return errors.New("incorrect length for %[3]s")
+	}
+	copy(%[4]s.%[5]s[:], %[1]s)
+`, variable, size, desc, g.receiver, field.Name)
+			}
+		case kindContainer:
+			g.printf(`	if data.%[1]s == nil {
+		return errors.New("%[2]s missing")
+	}
+	%[3]s.%[1]s = data.%[1]s
+`, field.Name, desc, g.receiver)
+		case kindContainerList:
+			g.printf(`	if data.%[1]s == nil {
+		return errors.New("%[2]s missing")
+	}
+	if len(data.%[1]s) > %[4]d {
+		return errors.New("%[2]s too long")
+	}
+	for i := range data.%[1]s {
+		if data.%[1]s[i] == nil {
+			return fmt.Errorf("%[2]s entry %%d missing", i)
+		}
+	}
+	%[3]s.%[1]s = data.%[1]s
+`, field.Name, desc, g.receiver, field.Max)
+		}
+	}
+	g.printf("\n\treturn nil\n}\n\n")
+}
+
+func (g *generator) marshalYAML() {
+	name := g.container.Name
+	g.printf("// MarshalYAML implements yaml.Marshaler.\n")
+	g.printf("func (%s *%s) MarshalYAML() ([]byte, error) {\n", g.receiver, name)
+	g.printf("\tyamlBytes, err := yaml.MarshalWithOptions(&%sYAML{\n", lowerFirst(name))
+	for _, field := range g.container.Fields {
+		switch field.Kind {
+		case kindUint:
+			if field.Type == "uint64" {
+				g.printf("\t\t%s: %s.%s,\n", field.Name, g.receiver, field.Name)
+			} else {
+				g.printf("\t\t%s: uint64(%s.%s),\n", field.Name, g.receiver, field.Name)
+			}
+		case kindBytes, kindFixedBytes:
+			g.printf("\t\t%s: fmt.Sprintf(\"%%#x\", %s.%s),\n", field.Name, g.receiver, field.Name)
+		default:
+			g.printf("\t\t%s: %s.%s,\n", field.Name, g.receiver, field.Name)
+		}
+	}
+	g.printf(`	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`+"`\"`"+`), []byte(`+"`'`"+`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (%[1]s *%[2]s) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data %[3]sJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return err
+	}
+	return %[1]s.unpack(&data)
+}
+
+`, g.receiver, name, lowerFirst(name))
+}
+
+func (g *generator) strings() {
+	g.printf(`// String returns a string version of the structure.
+func (%[1]s *%[2]s) String() string {
+	if %[1]s == nil {
+		return ""
+	}
+	data, err := yaml.Marshal(%[1]s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %%v", err)
+	}
+	return string(data)
+}
+`, g.receiver, g.container.Name)
+}
+
+// specName returns the name of the field in the spec.
+func specName(field *Field) string {
+	if field.SpecName != "" {
+		return field.SpecName
+	}
+
+	var res strings.Builder
+	runes := []rune(field.Name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			res.WriteRune('_')
+		}
+		res.WriteRune(unicode.ToLower(r))
+	}
+
+	return res.String()
+}
+
+// lowerFirst returns the name with its leading capitals in lower case, as used for
+// unexported names and local variables.
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
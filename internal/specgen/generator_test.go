@@ -0,0 +1,117 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMatchesExisting(t *testing.T) {
+	schema, err := loadSchema(filepath.Join("testdata", "withdrawal.yaml"))
+	require.NoError(t, err)
+	require.Len(t, schema.Containers, 1)
+
+	src, err := Generate(schema, schema.Containers[0])
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join("..", "..", "spec", "capella", "withdrawal.go"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(src))
+}
+
+func TestGenerateAllKinds(t *testing.T) {
+	schema, err := loadSchema(filepath.Join("testdata", "all.yaml"))
+	require.NoError(t, err)
+	require.Len(t, schema.Containers, 1)
+
+	src, err := Generate(schema, schema.Containers[0])
+	require.NoError(t, err)
+	require.Contains(t, string(src), "\"github.com/attestantio/go-eth2-client/spec/phase0\"")
+	require.Contains(t, string(src), "ExtraData    []byte      `ssz-max:\"32\"`")
+	require.Contains(t, string(src), "Attestations []*phase0.Attestation `ssz-max:\"128\"`")
+	require.Contains(t, string(src), "t.Count = count")
+	require.Contains(t, string(src), "Count:        t.Count,")
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	schema := &Schema{
+		Package:       "capella",
+		CopyrightYear: 2022,
+	}
+
+	tests := []struct {
+		name      string
+		container *Container
+		err       string
+	}{
+		{
+			name:      "NoFields",
+			container: &Container{Name: "Test"},
+			err:       "Test: no fields",
+		},
+		{
+			name: "UnknownKind",
+			container: &Container{
+				Name:   "Test",
+				Fields: []*Field{{Name: "Value", Type: "uint64", Kind: "bitlist"}},
+			},
+			err: `Test: Value: unknown kind "bitlist"`,
+		},
+		{
+			name: "FixedBytesNoSize",
+			container: &Container{
+				Name:   "Test",
+				Fields: []*Field{{Name: "Root", Type: "phase0.Root", Kind: "fixed_bytes"}},
+			},
+			err: "Test: Root: no size",
+		},
+		{
+			name: "ListNoMax",
+			container: &Container{
+				Name:   "Test",
+				Fields: []*Field{{Name: "Items", Type: "phase0.Attestation", Kind: "container_list"}},
+			},
+			err: "Test: Items: no max",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Generate(schema, test.container)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestSpecName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Index", expected: "index"},
+		{name: "ValidatorIndex", expected: "validator_index"},
+		{name: "BLSToExecutionChanges", expected: "bls_to_execution_changes"},
+		{name: "ParentRoot", expected: "parent_root"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, specName(&Field{Name: test.name}))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command specgen generates the Go definitions of spec containers, along with
+// their JSON and YAML encodings, from a container schema.
+//
+// The SSZ encoding of the generated containers is provided by sszgen as usual.
+//
+// Usage:
+//
+//	go run ./internal/specgen -schema <schema.yaml> -out spec/<package>
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+func main() {
+	schemaFile := flag.String("schema", "", "path to the container schema")
+	outDir := flag.String("out", ".", "directory in which to write the generated files")
+	flag.Parse()
+
+	if err := run(*schemaFile, *outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaFile string, outDir string) error {
+	if schemaFile == "" {
+		return errors.New("no schema specified")
+	}
+
+	schema, err := loadSchema(schemaFile)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range schema.Containers {
+		src, err := Generate(schema, container)
+		if err != nil {
+			return errors.Wrap(err, "failed to generate container")
+		}
+		path := filepath.Join(outDir, fmt.Sprintf("%s.go", strings.ToLower(container.Name)))
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			return errors.Wrap(err, "failed to write generated file")
+		}
+	}
+
+	return nil
+}
+
+// loadSchema loads a schema from the given file.
+func loadSchema(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read schema")
+	}
+
+	var schema Schema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, errors.Wrap(err, "failed to parse schema")
+	}
+	if schema.Package == "" {
+		return nil, errors.New("schema has no package")
+	}
+	if schema.CopyrightYear == 0 {
+		return nil, errors.New("schema has no copyright year")
+	}
+
+	return &schema, nil
+}
@@ -0,0 +1,57 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// Schema is the intermediate definition of the containers of a spec package,
+// maintained alongside the upstream consensus specs.
+type Schema struct {
+	// Package is the name of the Go package, for example "capella".
+	Package string `yaml:"package"`
+	// CopyrightYear is the year stated in the license header of generated files.
+	CopyrightYear int `yaml:"copyright_year"`
+	// Containers are the containers to generate.
+	Containers []*Container `yaml:"containers"`
+}
+
+// Container is the definition of a single SSZ container.
+type Container struct {
+	// Name is the Go name of the container.
+	Name string `yaml:"name"`
+	// Description completes the doc comment of the container, following its name.
+	Description string `yaml:"description"`
+	// Fields are the fields of the container, in spec order.
+	Fields []*Field `yaml:"fields"`
+}
+
+// Field is the definition of a single field of a container.
+type Field struct {
+	// Name is the Go name of the field.
+	Name string `yaml:"name"`
+	// SpecName is the name of the field in the spec; if not supplied it is derived
+	// from the Go name.
+	SpecName string `yaml:"spec_name"`
+	// Type is the Go type of the field, for example "phase0.Gwei".  For containers
+	// and lists of containers this is the type of the container.
+	Type string `yaml:"type"`
+	// Kind is the encoding of the field: one of "uint", "bytes", "fixed_bytes",
+	// "container" or "container_list".
+	Kind string `yaml:"kind"`
+	// Size is the length of a fixed_bytes field.
+	Size int `yaml:"size"`
+	// SizeConstant is an optional constant holding the length of a fixed_bytes field,
+	// used in preference to the size when checking decoded values.
+	SizeConstant string `yaml:"size_constant"`
+	// Max is the maximum length of a bytes or container_list field.
+	Max int `yaml:"max"`
+}
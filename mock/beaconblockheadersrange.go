@@ -0,0 +1,37 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeadersRange provides the block headers for a range of slots.
+func (s *Service) BeaconBlockHeadersRange(ctx context.Context, startSlot spec.Slot, count uint64) ([]*api.BeaconBlockHeader, error) {
+	res := make([]*api.BeaconBlockHeader, count)
+	for i := range res {
+		res[i] = &api.BeaconBlockHeader{
+			Header: &spec.SignedBeaconBlockHeader{
+				Message: &spec.BeaconBlockHeader{
+					Slot: startSlot + spec.Slot(i),
+				},
+			},
+		}
+	}
+
+	return res, nil
+}
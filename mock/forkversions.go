@@ -0,0 +1,61 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ForkAtEpoch provides the fork in effect at the given epoch, according to the fork schedule.
+func (s *Service) ForkAtEpoch(ctx context.Context, epoch phase0.Epoch) (*phase0.Fork, error) {
+	forkSchedule, err := s.ForkSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(forkSchedule) - 1; i >= 0; i-- {
+		if forkSchedule[i].Epoch <= epoch {
+			return forkSchedule[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no fork found for epoch %d", epoch)
+}
+
+// ForkVersionAtSlot provides the fork version in effect at the given slot, according to the fork schedule.
+func (s *Service) ForkVersionAtSlot(ctx context.Context, slot phase0.Slot) (phase0.Version, error) {
+	fork, err := s.ForkAtEpoch(ctx, phase0.Epoch(slot/32))
+	if err != nil {
+		return phase0.Version{}, err
+	}
+	return fork.CurrentVersion, nil
+}
+
+// DataVersionAtSlot provides the data version in effect at the given slot, according to the fork schedule.
+// The mock treats each entry in its fork schedule as the next data version.
+func (s *Service) DataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	forkSchedule, err := s.ForkSchedule(ctx)
+	if err != nil {
+		return spec.DataVersionPhase0, err
+	}
+	epoch := phase0.Epoch(slot / 32)
+	for i := len(forkSchedule) - 1; i >= 0; i-- {
+		if forkSchedule[i].Epoch <= epoch {
+			return spec.DataVersion(i), nil
+		}
+	}
+	return spec.DataVersionPhase0, fmt.Errorf("no fork found for epoch %d", epoch)
+}
@@ -0,0 +1,39 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// IndexedBeaconCommittees fetches the beacon committees matching the filter at the given state.
+func (s *Service) IndexedBeaconCommittees(ctx context.Context,
+	stateID string,
+	filter *api.BeaconCommitteesFilter,
+) (
+	*api.BeaconCommittees,
+	error,
+) {
+	res := make([]*api.BeaconCommittee, 5)
+	for i := 0; i < 5; i++ {
+		res[i] = &api.BeaconCommittee{
+			Index: phase0.CommitteeIndex(i),
+		}
+	}
+
+	return api.NewBeaconCommittees(res), nil
+}
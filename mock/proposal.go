@@ -0,0 +1,37 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Proposal fetches a proposal for signing.
+func (s *Service) Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error) {
+	block, err := s.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.VersionedProposal{
+		Version:               block.Version,
+		ExecutionPayloadValue: big.NewInt(0),
+		ConsensusBlockValue:   big.NewInt(0),
+		Phase0:                block.Phase0,
+	}, nil
+}
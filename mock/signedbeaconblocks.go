@@ -0,0 +1,38 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignedBeaconBlocks fetches signed beacon blocks given their roots.
+func (s *Service) SignedBeaconBlocks(ctx context.Context, roots []phase0.Root) (map[phase0.Root]*spec.VersionedSignedBeaconBlock, error) {
+	res := make(map[phase0.Root]*spec.VersionedSignedBeaconBlock, len(roots))
+	for _, root := range roots {
+		block, err := s.SignedBeaconBlock(ctx, fmt.Sprintf("%#x", root))
+		if err != nil {
+			return nil, err
+		}
+		if block != nil {
+			res[root] = block
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ValidatorBalanceHistory provides the balances of a validator at the start of every step epochs from
+// fromEpoch to toEpoch inclusive.
+func (s *Service) ValidatorBalanceHistory(ctx context.Context,
+	index spec.ValidatorIndex,
+	fromEpoch spec.Epoch,
+	toEpoch spec.Epoch,
+	step uint64,
+) (
+	*api.ValidatorBalanceHistory,
+	error,
+) {
+	res := &api.ValidatorBalanceHistory{
+		Index:       index,
+		Epochs:      make([]spec.Epoch, 0),
+		Balances:    make([]spec.Gwei, 0),
+		Unavailable: make([]spec.Epoch, 0),
+	}
+	if step == 0 {
+		return res, nil
+	}
+	for epoch := fromEpoch; epoch <= toEpoch; epoch += spec.Epoch(step) {
+		res.Epochs = append(res.Epochs, epoch)
+		res.Balances = append(res.Balances, 32000000000)
+		if uint64(toEpoch-epoch) < step {
+			break
+		}
+	}
+
+	return res, nil
+}
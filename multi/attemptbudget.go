@@ -0,0 +1,50 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+)
+
+// attemptContext returns the context for an attempt to call a provider, given the
+// number of providers remaining to be tried including this one.
+func (s *Service) attemptContext(ctx context.Context, remaining int) (context.Context, context.CancelFunc) {
+	timeout := s.attemptTimeout(ctx, remaining, time.Now())
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// attemptTimeout returns the time allowed for an attempt to call a provider, or 0
+// if the attempt is limited only by the parent context.
+func (s *Service) attemptTimeout(ctx context.Context, remaining int, now time.Time) time.Duration {
+	s.configMu.RLock()
+	timeout := s.providerTimeout
+	deadlineSharing := s.deadlineSharing
+	s.configMu.RUnlock()
+
+	if deadlineSharing && remaining > 1 {
+		if deadline, exists := ctx.Deadline(); exists {
+			share := deadline.Sub(now) / time.Duration(remaining)
+			if share > 0 && (timeout == 0 || share < timeout) {
+				timeout = share
+			}
+		}
+	}
+
+	return timeout
+}
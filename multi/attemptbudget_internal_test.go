@@ -0,0 +1,135 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingClient is a client whose genesis time call blocks until its context is done.
+type blockingClient struct {
+	*mock.Service
+}
+
+func (c *blockingClient) GenesisTime(ctx context.Context) (time.Time, error) {
+	<-ctx.Done()
+	return time.Time{}, ctx.Err()
+}
+
+func TestAttemptTimeout(t *testing.T) {
+	now := time.Now()
+	deadlineCtx, cancel := context.WithDeadline(context.Background(), now.Add(3*time.Second))
+	defer cancel()
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		providerTimeout time.Duration
+		deadlineSharing bool
+		remaining       int
+		expected        time.Duration
+	}{
+		{
+			name:      "Default",
+			ctx:       deadlineCtx,
+			remaining: 3,
+		},
+		{
+			name:            "ProviderTimeout",
+			ctx:             context.Background(),
+			providerTimeout: time.Second,
+			remaining:       3,
+			expected:        time.Second,
+		},
+		{
+			name:            "SharingNoDeadline",
+			ctx:             context.Background(),
+			deadlineSharing: true,
+			remaining:       3,
+		},
+		{
+			name:            "Sharing",
+			ctx:             deadlineCtx,
+			deadlineSharing: true,
+			remaining:       3,
+			expected:        time.Second,
+		},
+		{
+			name:            "SharingLastProvider",
+			ctx:             deadlineCtx,
+			deadlineSharing: true,
+			remaining:       1,
+		},
+		{
+			name:            "SharingCappedByProviderTimeout",
+			ctx:             deadlineCtx,
+			providerTimeout: 500 * time.Millisecond,
+			deadlineSharing: true,
+			remaining:       3,
+			expected:        500 * time.Millisecond,
+		},
+		{
+			name:            "ProviderTimeoutCappedBySharing",
+			ctx:             deadlineCtx,
+			providerTimeout: 2 * time.Second,
+			deadlineSharing: true,
+			remaining:       2,
+			expected:        1500 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				providerTimeout: test.providerTimeout,
+				deadlineSharing: test.deadlineSharing,
+			}
+			require.Equal(t, test.expected, s.attemptTimeout(test.ctx, test.remaining, now))
+		})
+	}
+}
+
+func TestDeadlineSharingFailover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			&blockingClient{Service: client1},
+			client2,
+		}),
+		WithDeadlineSharing(true),
+	)
+	require.NoError(t, err)
+
+	callCtx, callCancel := context.WithTimeout(ctx, 2*time.Second)
+	defer callCancel()
+	started := time.Now()
+	_, err = s.(consensusclient.GenesisTimeProvider).GenesisTime(callCtx)
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), 2*time.Second)
+}
@@ -21,6 +21,7 @@ import (
 )
 
 // AttestationData fetches the attestation data for the given slot and committee index.
+// How the data is obtained from the providers depends on the attestation data policy.
 func (s *Service) AttestationData(ctx context.Context,
 	slot phase0.Slot,
 	committeeIndex phase0.CommitteeIndex,
@@ -28,7 +29,14 @@ func (s *Service) AttestationData(ctx context.Context,
 	*phase0.AttestationData,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	s.configMu.RLock()
+	policy := s.attestationDataPolicy
+	s.configMu.RUnlock()
+	if policy != AttestationDataPolicyFirst {
+		return s.consistentAttestationData(ctx, slot, committeeIndex)
+	}
+
+	res, err := s.doCall(ctx, "AttestationData", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		attestationData, err := client.(consensusclient.AttestationDataProvider).AttestationData(ctx, slot, committeeIndex)
 		if err != nil {
 			return nil, err
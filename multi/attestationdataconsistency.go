@@ -0,0 +1,164 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationDataPolicy defines how attestation data is obtained from multiple providers.
+type AttestationDataPolicy int
+
+const (
+	// AttestationDataPolicyFirst uses the attestation data from the first provider that
+	// returns it, without comparing it against other providers.
+	AttestationDataPolicyFirst AttestationDataPolicy = iota
+	// AttestationDataPolicyMajority fetches attestation data from all active providers and,
+	// if they diverge, uses the data returned by the most providers.  Ties are broken by
+	// the highest target epoch.
+	AttestationDataPolicyMajority
+	// AttestationDataPolicyHighestTarget fetches attestation data from all active providers
+	// and, if they diverge, uses the data with the highest target epoch.
+	AttestationDataPolicyHighestTarget
+)
+
+// attestationDataKey is the part of attestation data that is compared across providers.
+type attestationDataKey struct {
+	beaconBlockRoot phase0.Root
+	sourceEpoch     phase0.Epoch
+	sourceRoot      phase0.Root
+	targetEpoch     phase0.Epoch
+	targetRoot      phase0.Root
+}
+
+// attestationDataResponse is the attestation data returned by a provider.
+type attestationDataResponse struct {
+	provider string
+	data     *phase0.AttestationData
+	key      attestationDataKey
+}
+
+// consistentAttestationData fetches attestation data from all active providers, and
+// resolves any divergence between them according to the attestation data policy.
+func (s *Service) consistentAttestationData(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	*phase0.AttestationData,
+	error,
+) {
+	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, len(s.activeClients))
+	copy(clients, s.activeClients)
+	s.clientsMu.RUnlock()
+	clients = s.routedClients("AttestationData", clients)
+
+	// Fetch from all providers concurrently, retaining provider order in the responses.
+	responses := make([]*attestationDataResponse, len(clients))
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			provider, isProvider := client.(consensusclient.AttestationDataProvider)
+			if !isProvider {
+				return
+			}
+			// Providers are called concurrently, so each has the full deadline.
+			attemptCtx, cancel := s.attemptContext(ctx, 1)
+			defer cancel()
+			data, err := provider.AttestationData(attemptCtx, slot, committeeIndex)
+			if err != nil {
+				s.log.Debug().Str("provider", client.Address()).Err(err).Msg("Failed to obtain attestation data")
+				return
+			}
+			if data == nil || data.Source == nil || data.Target == nil {
+				return
+			}
+			responses[i] = &attestationDataResponse{
+				provider: client.Address(),
+				data:     data,
+				key: attestationDataKey{
+					beaconBlockRoot: data.BeaconBlockRoot,
+					sourceEpoch:     data.Source.Epoch,
+					sourceRoot:      data.Source.Root,
+					targetEpoch:     data.Target.Epoch,
+					targetRoot:      data.Target.Root,
+				},
+			}
+		}(i, clients[i])
+	}
+	wg.Wait()
+
+	valid := make([]*attestationDataResponse, 0, len(responses))
+	for _, response := range responses {
+		if response != nil {
+			valid = append(valid, response)
+		}
+	}
+	if len(valid) == 0 {
+		return nil, errors.New("no providers returned attestation data")
+	}
+
+	counts := make(map[attestationDataKey]int)
+	for _, response := range valid {
+		counts[response.key]++
+	}
+	if len(counts) == 1 {
+		return valid[0].data, nil
+	}
+
+	s.configMu.RLock()
+	policy := s.attestationDataPolicy
+	s.configMu.RUnlock()
+	selected := selectAttestationData(policy, valid, counts)
+	e := s.log.Warn().Uint64("slot", uint64(slot)).Str("selected", selected.provider)
+	for _, response := range valid {
+		e = e.Str(response.provider, response.data.String())
+	}
+	e.Msg("Providers returned divergent attestation data")
+	incAttestationDataDivergencesMetric(ctx)
+
+	return selected.data, nil
+}
+
+// selectAttestationData selects the attestation data to use from divergent responses.
+// Responses are in provider order, and earlier responses are preferred when otherwise equal.
+func selectAttestationData(policy AttestationDataPolicy,
+	responses []*attestationDataResponse,
+	counts map[attestationDataKey]int,
+) *attestationDataResponse {
+	selected := responses[0]
+	for _, response := range responses[1:] {
+		if policy == AttestationDataPolicyMajority {
+			if counts[response.key] < counts[selected.key] {
+				continue
+			}
+			if counts[response.key] > counts[selected.key] {
+				selected = response
+				continue
+			}
+		}
+		if response.key.targetEpoch > selected.key.targetEpoch {
+			selected = response
+		}
+	}
+
+	return selected
+}
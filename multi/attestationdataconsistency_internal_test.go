@@ -0,0 +1,160 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// attestationDataClient is a mock client that returns fixed attestation data.
+type attestationDataClient struct {
+	*mock.Service
+	data *phase0.AttestationData
+}
+
+func (c *attestationDataClient) AttestationData(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.CommitteeIndex,
+) (
+	*phase0.AttestationData,
+	error,
+) {
+	if c.data == nil {
+		return nil, errors.New("failed")
+	}
+	return c.data, nil
+}
+
+func testAttestationData(targetEpoch phase0.Epoch, head byte) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            100,
+		BeaconBlockRoot: phase0.Root{head},
+		Source:          &phase0.Checkpoint{Epoch: 1},
+		Target:          &phase0.Checkpoint{Epoch: targetEpoch, Root: phase0.Root{byte(targetEpoch)}},
+	}
+}
+
+func TestAttestationDataPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	newClient := func(name string, data *phase0.AttestationData) consensusclient.Service {
+		client, err := mock.New(ctx, mock.WithName(name))
+		require.NoError(t, err)
+		return &attestationDataClient{Service: client, data: data}
+	}
+
+	minority := testAttestationData(3, 0x01)
+	majority := testAttestationData(2, 0x02)
+
+	tests := []struct {
+		name     string
+		policy   AttestationDataPolicy
+		data     []*phase0.AttestationData
+		expected *phase0.AttestationData
+		err      string
+	}{
+		{
+			name:     "FirstDivergent",
+			policy:   AttestationDataPolicyFirst,
+			data:     []*phase0.AttestationData{minority, majority, majority},
+			expected: minority,
+		},
+		{
+			name:     "MajorityAgreed",
+			policy:   AttestationDataPolicyMajority,
+			data:     []*phase0.AttestationData{majority, majority},
+			expected: majority,
+		},
+		{
+			name:     "MajorityDivergent",
+			policy:   AttestationDataPolicyMajority,
+			data:     []*phase0.AttestationData{minority, majority, majority},
+			expected: majority,
+		},
+		{
+			name:     "MajorityTie",
+			policy:   AttestationDataPolicyMajority,
+			data:     []*phase0.AttestationData{majority, minority},
+			expected: minority,
+		},
+		{
+			name:     "MajorityFailures",
+			policy:   AttestationDataPolicyMajority,
+			data:     []*phase0.AttestationData{nil, minority, nil},
+			expected: minority,
+		},
+		{
+			name:   "MajorityAllFail",
+			policy: AttestationDataPolicyMajority,
+			data:   []*phase0.AttestationData{nil, nil},
+			err:    "no providers returned attestation data",
+		},
+		{
+			name:     "HighestTargetDivergent",
+			policy:   AttestationDataPolicyHighestTarget,
+			data:     []*phase0.AttestationData{majority, majority, minority},
+			expected: minority,
+		},
+		{
+			name:     "HighestTargetSameTarget",
+			policy:   AttestationDataPolicyHighestTarget,
+			data:     []*phase0.AttestationData{testAttestationData(2, 0x03), majority},
+			expected: testAttestationData(2, 0x03),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]consensusclient.Service, len(test.data))
+			for i := range test.data {
+				clients[i] = newClient(string(rune('a'+i)), test.data[i])
+			}
+			s, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients(clients),
+				WithAttestationDataPolicy(test.policy),
+			)
+			require.NoError(t, err)
+
+			data, err := s.(consensusclient.AttestationDataProvider).AttestationData(ctx, 100, 0)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, data)
+		})
+	}
+}
+
+func TestAttestationDataPolicyInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	_, err = New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client}),
+		WithAttestationDataPolicy(AttestationDataPolicy(5)),
+	)
+	require.EqualError(t, err, "problem with parameters: invalid attestation data policy")
+}
@@ -22,7 +22,7 @@ import (
 
 // AttestationPool obtains the attestation pool for a given slot.
 func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "AttestationPool", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		attestationPool, err := client.(consensusclient.AttestationPoolProvider).AttestationPool(ctx, slot)
 		if err != nil {
 			return nil, err
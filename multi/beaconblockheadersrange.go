@@ -0,0 +1,47 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeadersRange provides the block headers for a range of slots.
+// The returned slice has an entry for each slot in the range in order; slots without a block have a nil entry.
+func (s *Service) BeaconBlockHeadersRange(ctx context.Context,
+	startSlot phase0.Slot,
+	count uint64,
+) (
+	[]*apiv1.BeaconBlockHeader,
+	error,
+) {
+	res, err := s.doCall(ctx, "BeaconBlockHeadersRange", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		headers, err := client.(consensusclient.BeaconBlockHeadersRangeProvider).BeaconBlockHeadersRange(ctx, startSlot, count)
+		if err != nil {
+			return nil, err
+		}
+		return headers, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*apiv1.BeaconBlockHeader), nil
+}
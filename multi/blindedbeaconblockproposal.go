@@ -30,7 +30,7 @@ func (s *Service) BlindedBeaconBlockProposal(ctx context.Context,
 	*api.VersionedBlindedBeaconBlock,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BlindedBeaconBlockProposal", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.BlindedBeaconBlockProposalProvider).BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
 		if err != nil {
 			return nil, err
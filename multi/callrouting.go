@@ -0,0 +1,76 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// callRoute defines the calls for which a provider can be used.  Calls are identified
+// by the name of the method, for example "Proposal" or "BeaconState".
+type callRoute struct {
+	// allowed, if not empty, are the only calls for which the provider is used.
+	allowed map[string]bool
+	// denied are the calls for which the provider is never used.
+	denied map[string]bool
+}
+
+// permits returns true if the route permits the call.
+func (r *callRoute) permits(name string) bool {
+	if r.denied[name] {
+		return false
+	}
+	if len(r.allowed) > 0 && !r.allowed[name] {
+		return false
+	}
+
+	return true
+}
+
+// routedClients returns the clients that are permitted to be used for the call, in order.
+func (s *Service) routedClients(name string, clients []consensusclient.Service) []consensusclient.Service {
+	s.configMu.RLock()
+	routes := s.callRoutes
+	s.configMu.RUnlock()
+
+	if len(routes) == 0 {
+		return clients
+	}
+
+	res := make([]consensusclient.Service, 0, len(clients))
+	for _, client := range clients {
+		route, exists := routes[client.Address()]
+		if exists && !route.permits(name) {
+			continue
+		}
+		res = append(res, client)
+	}
+
+	return res
+}
+
+// routedAddress returns the address of the first active client that is permitted to be
+// used for the call, or "none" if there is no such client.
+func (s *Service) routedAddress(name string) string {
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	routed := s.routedClients(name, activeClients)
+	if len(routed) == 0 {
+		return "none"
+	}
+
+	return routed[0].Address()
+}
@@ -0,0 +1,97 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallRouting(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	_, err = New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1}),
+		WithDeniedCalls("", "Proposal"),
+	)
+	require.EqualError(t, err, "problem with parameters: call routes specified without an address")
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		WithDeniedCalls("mock 1", "Proposal", "Events"),
+		WithAllowedCalls("mock 2", "Proposal", "BeaconState"),
+		WithDeniedCalls("mock 2", "BeaconState"),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	call := func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		return client.Address(), nil
+	}
+
+	// Denied on the first client, so served by the second.
+	res, err := multi.doCall(ctx, "Proposal", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+
+	// Not allowed on the second client, so served by the first.
+	res, err = multi.doCall(ctx, "Validators", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+
+	// Allowed and denied on the second client, so denied.
+	res, err = multi.doCall(ctx, "BeaconState", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+
+	// Not permitted on any client.
+	require.NoError(t, multi.Reconfigure(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		WithDeniedCalls("mock 1", "Proposal"),
+		WithDeniedCalls("mock 2", "Proposal"),
+	))
+	_, err = multi.doCall(ctx, "Proposal", call, nil)
+	require.EqualError(t, err, "no active clients permitted to make call Proposal")
+
+	// Events are forwarded from the first client permitted to provide them.
+	require.Equal(t, "mock 1", multi.routedAddress("Events"))
+	require.NoError(t, multi.Reconfigure(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		WithDeniedCalls("mock 1", "Events"),
+	))
+	require.Equal(t, "mock 2", multi.routedAddress("Events"))
+}
@@ -15,10 +15,12 @@ package multi
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -44,8 +46,8 @@ func (s *Service) monitor(ctx context.Context) {
 // recheck checks clients to update their state.
 func (s *Service) recheck(ctx context.Context) {
 	// Fetch all clients.
-	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
 	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
 	clients = append(clients, s.activeClients...)
 	clients = append(clients, s.inactiveClients...)
 	s.clientsMu.RUnlock()
@@ -55,20 +57,21 @@ func (s *Service) recheck(ctx context.Context) {
 		if ping(ctx, client) {
 			s.activateClient(ctx, client)
 		} else {
-			s.deactivateClient(ctx, client)
+			s.deactivateClient(ctx, client, errors.New("provider not ready"))
 		}
 	}
 }
 
 // deactivateClient deactivates a client, moving it to the inactive list if not currently on it.
-func (s *Service) deactivateClient(ctx context.Context, client consensusclient.Service) {
+func (s *Service) deactivateClient(ctx context.Context, client consensusclient.Service, reason error) {
 	log := zerolog.Ctx(ctx)
 
 	s.clientsMu.Lock()
-	defer s.clientsMu.Unlock()
 
+	// The lists are rebuilt rather than appended to, as callers may hold copies of the old lists.
 	activeClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
-	inactiveClients := s.inactiveClients
+	inactiveClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	inactiveClients = append(inactiveClients, s.inactiveClients...)
 	for _, activeClient := range s.activeClients {
 		if activeClient == client {
 			inactiveClients = append(inactiveClients, activeClient)
@@ -77,7 +80,8 @@ func (s *Service) deactivateClient(ctx context.Context, client consensusclient.S
 			activeClients = append(activeClients, activeClient)
 		}
 	}
-	if len(inactiveClients) != len(s.inactiveClients) {
+	deactivated := len(inactiveClients) != len(s.inactiveClients)
+	if deactivated {
 		log.Trace().Str("client", client.Address()).Int("active", len(activeClients)).Int("inactive", len(inactiveClients)).Msg("Client deactivated")
 	}
 
@@ -85,6 +89,11 @@ func (s *Service) deactivateClient(ctx context.Context, client consensusclient.S
 	setProvidersMetric(ctx, "active", len(s.activeClients))
 	s.inactiveClients = inactiveClients
 	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
+	s.clientsMu.Unlock()
+
+	if deactivated {
+		s.notifyProviderFailed(ctx, client, reason)
+	}
 }
 
 // activateClient activates a client, moving it to the active list if not currently on it.
@@ -92,9 +101,10 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 	log := zerolog.Ctx(ctx)
 
 	s.clientsMu.Lock()
-	defer s.clientsMu.Unlock()
 
-	activeClients := s.activeClients
+	// The lists are rebuilt rather than appended to, as callers may hold copies of the old lists.
+	activeClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	activeClients = append(activeClients, s.activeClients...)
 	inactiveClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
 	for _, inactiveClient := range s.inactiveClients {
 		if inactiveClient == client {
@@ -104,7 +114,8 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 			inactiveClients = append(inactiveClients, inactiveClient)
 		}
 	}
-	if len(inactiveClients) != len(s.inactiveClients) {
+	activated := len(inactiveClients) != len(s.inactiveClients)
+	if activated {
 		log.Trace().Str("client", client.Address()).Int("active", len(activeClients)).Int("inactive", len(inactiveClients)).Msg("Client activated")
 	}
 
@@ -112,6 +123,11 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 	setProvidersMetric(ctx, "active", len(s.activeClients))
 	s.inactiveClients = inactiveClients
 	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
+	s.clientsMu.Unlock()
+
+	if activated {
+		s.notifyProviderRecovered(ctx, client)
+	}
 }
 
 // ping pings a client, returning true if it is ready to serve requests and
@@ -144,10 +160,19 @@ type callFunc func(ctx context.Context, client consensusclient.Service) (interfa
 type errHandlerFunc func(ctx context.Context, client consensusclient.Service, err error) (bool, error)
 
 // doCall carries out a call on the active clients in turn until one succeeds.
-func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
+// name is the name of the call, used to report failovers.
+func (s *Service) doCall(ctx context.Context, name string, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
 	log := s.log.With().Logger()
 	ctx = log.WithContext(ctx)
 
+	// Calls are cancelled if the service is closed whilst they are in flight.
+	if !s.track() {
+		return nil, ErrServiceClosed
+	}
+	defer s.untrack()
+	ctx, cancel := s.lifecycleContext(ctx)
+	defer cancel()
+
 	// Grab local copy of active clients in case it is updated whilst we are using it.
 	s.clientsMu.RLock()
 	activeClients := s.activeClients
@@ -164,11 +189,31 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 	if len(activeClients) == 0 {
 		return nil, errors.New("no active clients to which to make call")
 	}
+	s.configMu.RLock()
+	sticky := s.sticky
+	readYourWritesWindow := s.readYourWritesWindow
+	s.configMu.RUnlock()
+	if sticky {
+		activeClients = s.stickyOrder(activeClients)
+	}
+	// Submissions are identified by their name.
+	submission := strings.HasPrefix(name, "Submit")
+	if readYourWritesWindow > 0 && !submission {
+		activeClients = s.writerOrder(activeClients)
+	}
+	activeClients = s.routedClients(name, activeClients)
+	if len(activeClients) == 0 {
+		return nil, fmt.Errorf("no active clients permitted to make call %s", name)
+	}
 
 	var err error
 	var res interface{}
-	for _, client := range activeClients {
-		res, err = call(ctx, client)
+	// failed is the first client that failed the call, if any.
+	var failed consensusclient.Service
+	for i, client := range activeClients {
+		attemptCtx, cancel := s.attemptContext(ctx, len(activeClients)-i)
+		res, err = call(attemptCtx, client)
+		cancel()
 		if err != nil {
 			failover := true
 			if errHandler != nil {
@@ -178,7 +223,14 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 			if failover {
 				log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Deactivating client on error")
 				// Failed with this client; try the next.
-				s.deactivateClient(ctx, client)
+				s.deactivateClient(ctx, client, err)
+				if sticky {
+					s.unpin(client)
+				}
+				s.forgetWriter(client)
+				if failed == nil {
+					failed = client
+				}
 				continue
 			}
 
@@ -190,26 +242,30 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 			err = errors.New("empty response")
 			continue
 		}
+		if sticky {
+			s.pin(ctx, client)
+		}
+		if readYourWritesWindow > 0 && submission {
+			s.recordWriter(client, readYourWritesWindow)
+		}
+		if failed != nil {
+			s.notifyFailover(ctx, name, failed, client)
+		}
 		return res, nil
 	}
 	return nil, err
 }
 
 // providerInfo returns information on the provider.
-// Currently this just returns the name of the service (lighthouse/teku/etc.).
+// Currently this just returns the implementation of the service (lighthouse/teku/etc.).
 func (s *Service) providerInfo(ctx context.Context, provider consensusclient.Service) string {
 	providerName := "<unknown>"
 	nodeVersionProvider, isNodeVersionProvider := provider.(consensusclient.NodeVersionProvider)
 	if isNodeVersionProvider {
 		nodeVersion, err := nodeVersionProvider.NodeVersion(ctx)
 		if err == nil {
-			switch {
-			case strings.Contains(strings.ToLower(nodeVersion), "lighthouse"):
-				providerName = "lighthouse"
-			case strings.Contains(strings.ToLower(nodeVersion), "prysm"):
-				providerName = "prysm"
-			case strings.Contains(strings.ToLower(nodeVersion), "teku"):
-				providerName = "teku"
+			if implementation := apiv1.ParseNodeVersion(nodeVersion).Implementation; implementation != apiv1.NodeImplementationUnknown {
+				providerName = implementation
 			}
 		}
 	}
@@ -15,6 +15,7 @@ package multi
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 
@@ -58,7 +59,7 @@ func TestDeactivateMulti(t *testing.T) {
 		go func() {
 			<-starter
 			defer wg.Done()
-			multi.deactivateClient(ctx, erroringClient1)
+			multi.deactivateClient(ctx, erroringClient1, errors.New("test"))
 		}()
 	}
 	close(starter)
@@ -95,8 +96,8 @@ func TestActivateMulti(t *testing.T) {
 	require.NoError(t, err)
 	multi := s.(*Service)
 
-	multi.deactivateClient(ctx, erroringClient1)
-	multi.deactivateClient(ctx, erroringClient2)
+	multi.deactivateClient(ctx, erroringClient1, errors.New("test"))
+	multi.deactivateClient(ctx, erroringClient2, errors.New("test"))
 
 	var wg sync.WaitGroup
 	starter := make(chan interface{})
@@ -135,7 +136,7 @@ func TestRecheck(t *testing.T) {
 	_, err = s.(consensusclient.GenesisProvider).Genesis(ctx)
 	require.NoError(t, err)
 
-	multi.deactivateClient(ctx, consensusClient)
+	multi.deactivateClient(ctx, consensusClient, errors.New("test"))
 
 	_, err = s.(consensusclient.GenesisProvider).Genesis(ctx)
 	// Should re-activate in recheck so not return an error.
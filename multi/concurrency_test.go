@@ -0,0 +1,102 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConcurrentCalls exercises concurrent calls whilst clients fail and are
+// reactivated; it is most useful when run with the race detector.
+func TestConcurrentCalls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	erroringClient1, err := testclients.NewErroring(ctx, 0.2, client1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	erroringClient2, err := testclients.NewErroring(ctx, 0.2, client2)
+	require.NoError(t, err)
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			erroringClient1,
+			erroringClient2,
+			client3,
+		}),
+		multi.WithStickiness(true),
+	)
+	require.NoError(t, err)
+
+	calls := []func() error{
+		func() error {
+			_, err := s.(consensusclient.AttesterDutiesProvider).AttesterDuties(ctx, 1, []phase0.ValidatorIndex{1, 2})
+			return err
+		},
+		func() error {
+			_, err := s.(consensusclient.ProposerDutiesProvider).ProposerDuties(ctx, 1, nil)
+			return err
+		},
+		func() error {
+			_, err := s.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+			return err
+		},
+		func() error {
+			_, err := s.(consensusclient.SpecProvider).Spec(ctx)
+			return err
+		},
+		func() error {
+			return s.(consensusclient.EventsProvider).Events(ctx, []string{"head"}, func(*apiv1.Event) {})
+		},
+		func() error {
+			_ = s.Address()
+			return nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16*len(calls))
+	for i := 0; i < 16; i++ {
+		for _, call := range calls {
+			wg.Add(1)
+			go func(call func() error) {
+				defer wg.Done()
+				errs <- call()
+			}(call)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
@@ -32,14 +32,26 @@ func (s *Service) Events(ctx context.Context,
 	// #nosec G404
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Logger()
 
+	if !s.track() {
+		return ErrServiceClosed
+	}
+	defer s.untrack()
+	// Streams are stopped when the service is closed.  The context is released
+	// when either the caller's context is done or the service is closed.
+	ctx, _ = s.lifecycleContext(ctx)
+
 	// Because events are streams we treat them differently from all other calls.
 	// We listen to all active clients, and only pass along events from the currently active provider.
 
 	// Grab local copy of both active and inactive clients in case it is updated whilst we are using it.
 	s.clientsMu.RLock()
 	activeClients := s.activeClients
-	inactiveClients := s.inactiveClients
+	// Inactive clients are appended to below, so take a copy to avoid writing to the shared list.
+	inactiveClients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	inactiveClients = append(inactiveClients, s.inactiveClients...)
 	s.clientsMu.RUnlock()
+	activeClients = s.routedClients("Events", activeClients)
+	inactiveClients = s.routedClients("Events", inactiveClients)
 
 	// Call all active clients immediately.
 	for _, client := range activeClients {
@@ -64,7 +76,11 @@ func (s *Service) Events(ctx context.Context,
 			address: inactiveClient.Address(),
 			handler: handler,
 		}
+		if !s.track() {
+			return ErrServiceClosed
+		}
 		go func(c consensusclient.Service, ah *activeHandler) {
+			defer s.untrack()
 			for {
 				provider, isProvider := c.(consensusclient.NodeSyncingProvider)
 				if !isProvider {
@@ -84,7 +100,11 @@ func (s *Service) Events(ctx context.Context,
 					// Return either way.
 					return
 				}
-				time.Sleep(5 * time.Second)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(5 * time.Second):
+				}
 			}
 		}(inactiveClient, ah)
 	}
@@ -104,7 +124,7 @@ func (h *activeHandler) handleEvent(event *api.Event) {
 	// We only forward events from the currently active provider.  If we did not do this then we could end up with
 	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
 	// block end up with an earlier block.
-	if h.s.Address() == h.address {
+	if h.s.routedAddress("Events") == h.address {
 		h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Forwarding due to primary active address")
 		h.handler(event)
 	}
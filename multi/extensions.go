@@ -0,0 +1,44 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// Extensions provides the implementation-specific extensions supported by the node, keyed by name.
+// N.B. extensions are obtained from the currently active client, and calls made through them
+// are not subject to failover.
+func (s *Service) Extensions(ctx context.Context) (map[string]interface{}, error) {
+	res, err := s.doCall(ctx, "Extensions", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		provider, isProvider := client.(consensusclient.ExtensionsProvider)
+		if !isProvider {
+			return map[string]interface{}{}, nil
+		}
+		extensions, err := provider.Extensions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return extensions, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(map[string]interface{}), nil
+}
@@ -23,7 +23,7 @@ import (
 
 // FarFutureEpoch provides the far future epoch of the chain.
 func (s *Service) FarFutureEpoch(ctx context.Context) (phase0.Epoch, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "FarFutureEpoch", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		epoch, err := client.(consensusclient.FarFutureEpochProvider).FarFutureEpoch(ctx)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,83 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkAtEpoch provides the fork in effect at the given epoch, according to the fork schedule.
+func (s *Service) ForkAtEpoch(ctx context.Context, epoch phase0.Epoch) (*phase0.Fork, error) {
+	res, err := s.doCall(ctx, "ForkAtEpoch", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		provider, isProvider := client.(consensusclient.ForkVersionsProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide fork versions")
+		}
+		fork, err := provider.ForkAtEpoch(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		return fork, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*phase0.Fork), nil
+}
+
+// ForkVersionAtSlot provides the fork version in effect at the given slot, according to the fork schedule.
+func (s *Service) ForkVersionAtSlot(ctx context.Context, slot phase0.Slot) (phase0.Version, error) {
+	res, err := s.doCall(ctx, "ForkVersionAtSlot", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		provider, isProvider := client.(consensusclient.ForkVersionsProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide fork versions")
+		}
+		version, err := provider.ForkVersionAtSlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		return version, nil
+	}, nil)
+	if err != nil {
+		return phase0.Version{}, err
+	}
+	return res.(phase0.Version), nil
+}
+
+// DataVersionAtSlot provides the data version in effect at the given slot, according to the fork schedule.
+func (s *Service) DataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	res, err := s.doCall(ctx, "DataVersionAtSlot", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		provider, isProvider := client.(consensusclient.ForkVersionsProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide fork versions")
+		}
+		dataVersion, err := provider.DataVersionAtSlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		return dataVersion, nil
+	}, nil)
+	if err != nil {
+		return spec.DataVersionPhase0, err
+	}
+	return res.(spec.DataVersion), nil
+}
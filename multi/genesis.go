@@ -22,7 +22,7 @@ import (
 
 // Genesis provides the genesis for the chain.
 func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "Genesis", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		genesis, err := client.(consensusclient.GenesisProvider).Genesis(ctx)
 		if err != nil {
 			return nil, err
@@ -23,7 +23,7 @@ import (
 
 // GenesisTime provides the genesis time of the chain.
 func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "GenesisTime", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		genesisTime, err := client.(consensusclient.GenesisTimeProvider).GenesisTime(ctx)
 		if err != nil {
 			return nil, err
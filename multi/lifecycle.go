@@ -0,0 +1,116 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// ErrServiceClosed is returned by calls made after the service has been closed.
+var ErrServiceClosed = errors.New("service closed")
+
+// track registers a background goroutine or in-flight call with the service,
+// so that Close() waits for it to finish.  It returns false if the service is
+// closed, in which case the work should not be started.
+// Each successful call must be matched by a call to untrack().
+func (s *Service) track() bool {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	s.active.Add(1)
+
+	return true
+}
+
+// untrack marks work registered with track() as finished.
+func (s *Service) untrack() {
+	s.active.Done()
+}
+
+// lifecycleContext returns a context that is cancelled when either the supplied
+// context is done or the service is closed.
+func (s *Service) lifecycleContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-s.closing:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// closeOwned closes the given client if it was created by the service.
+// Clients supplied by the caller are left for the caller to close.
+func (s *Service) closeOwned(ctx context.Context, client consensusclient.Service) {
+	s.clientsMu.Lock()
+	owned := s.owned[client]
+	delete(s.owned, client)
+	s.clientsMu.Unlock()
+	if !owned {
+		return
+	}
+
+	if closer, isCloser := client.(consensusclient.Closer); isCloser {
+		if err := closer.Close(ctx); err != nil {
+			s.log.Warn().Str("provider", client.Address()).Err(err).Msg("Failed to close provider")
+		}
+	}
+}
+
+// Close shuts down the service.  It stops monitoring providers, cancels
+// in-flight calls and event streams, waits for them to finish, and then
+// closes the providers the service created from addresses.
+// If the supplied context is done before this completes an error is returned.
+// Calls made after the service is closed return ErrServiceClosed.
+func (s *Service) Close(ctx context.Context) error {
+	s.lifecycleMu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.closing)
+	}
+	s.lifecycleMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.active.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "service did not close in time")
+	}
+
+	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	clients = append(clients, s.activeClients...)
+	clients = append(clients, s.inactiveClients...)
+	s.clientsMu.RUnlock()
+	for _, client := range clients {
+		s.closeOwned(ctx, client)
+	}
+	s.log.Trace().Msg("Service closed")
+
+	return nil
+}
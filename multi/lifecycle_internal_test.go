@@ -0,0 +1,79 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// closableClient is a mock client that records being closed.
+type closableClient struct {
+	*mock.Service
+	closed bool
+}
+
+func (c *closableClient) Close(_ context.Context) error {
+	c.closed = true
+	return nil
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1 := &closableClient{Service: mock1}
+	mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2 := &closableClient{Service: mock2}
+
+	service, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1, client2}),
+	)
+	require.NoError(t, err)
+	s := service.(*Service)
+	// Treat the second client as if it had been created from an address.
+	s.clientsMu.Lock()
+	s.owned[client2] = true
+	s.clientsMu.Unlock()
+
+	_, err = s.Genesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.Events(ctx, []string{"head"}, func(*apiv1.Event) {}))
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.Close(closeCtx))
+
+	// Only the client owned by the service is closed.
+	require.False(t, client1.closed)
+	require.True(t, client2.closed)
+
+	_, err = s.Genesis(ctx)
+	require.True(t, errors.Is(err, ErrServiceClosed))
+	require.True(t, errors.Is(s.Events(ctx, []string{"head"}, nil), ErrServiceClosed))
+
+	// Closing again is a no-op.
+	require.NoError(t, s.Close(closeCtx))
+}
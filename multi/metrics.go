@@ -22,8 +22,9 @@ import (
 )
 
 var (
-	providersMetric      *prometheus.GaugeVec
-	providerActiveMetric *prometheus.GaugeVec
+	providersMetric                  *prometheus.GaugeVec
+	providerActiveMetric             *prometheus.GaugeVec
+	attestationDataDivergencesMetric prometheus.Counter
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -60,6 +61,15 @@ func registerPrometheusMetrics(ctx context.Context) error {
 	if err := prometheus.Register(providerActiveMetric); err != nil {
 		return errors.Wrap(err, "failed to register provider_state")
 	}
+	attestationDataDivergencesMetric = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "attestation_data_divergences_total",
+		Help:      "Number of times providers returned divergent attestation data",
+	})
+	if err := prometheus.Register(attestationDataDivergencesMetric); err != nil {
+		return errors.Wrap(err, "failed to register attestation_data_divergences_total")
+	}
 
 	return nil
 }
@@ -79,3 +89,9 @@ func setProvidersMetric(ctx context.Context, state string, count int) {
 		providersMetric.WithLabelValues(state).Set(float64(count))
 	}
 }
+
+func incAttestationDataDivergencesMetric(ctx context.Context) {
+	if attestationDataDivergencesMetric != nil {
+		attestationDataDivergencesMetric.Inc()
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// Observer is notified of changes to the state of the providers of the multi client,
+// for example to alert when redundancy is degraded.
+// Observers are called synchronously, so should return promptly.
+type Observer interface {
+	// OnProviderFailed is called when a provider is removed from the active providers.
+	// err is the reason for the failure.
+	OnProviderFailed(ctx context.Context, provider consensusclient.Service, err error)
+	// OnProviderRecovered is called when a previously failed provider becomes active again.
+	OnProviderRecovered(ctx context.Context, provider consensusclient.Service)
+	// OnFailover is called when a provider failed a call, and the call was instead
+	// served by another provider.  Providers that return an empty response are not
+	// considered to have failed.
+	OnFailover(ctx context.Context, call string, from consensusclient.Service, to consensusclient.Service)
+}
+
+// notifyProviderFailed notifies observers that a provider has failed.
+func (s *Service) notifyProviderFailed(ctx context.Context, provider consensusclient.Service, err error) {
+	for _, observer := range s.currentObservers() {
+		observer.OnProviderFailed(ctx, provider, err)
+	}
+}
+
+// notifyProviderRecovered notifies observers that a provider has recovered.
+func (s *Service) notifyProviderRecovered(ctx context.Context, provider consensusclient.Service) {
+	for _, observer := range s.currentObservers() {
+		observer.OnProviderRecovered(ctx, provider)
+	}
+}
+
+// notifyFailover notifies observers that a call failed over between providers.
+func (s *Service) notifyFailover(ctx context.Context, call string, from consensusclient.Service, to consensusclient.Service) {
+	for _, observer := range s.currentObservers() {
+		observer.OnFailover(ctx, call, from, to)
+	}
+}
+
+// currentObservers returns the observers, which can change on reconfiguration.
+func (s *Service) currentObservers() []Observer {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+
+	return s.observers
+}
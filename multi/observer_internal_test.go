@@ -0,0 +1,121 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingObserver struct {
+	mu        sync.Mutex
+	events    []string
+	lastError error
+}
+
+func (o *recordingObserver) OnProviderFailed(_ context.Context, provider consensusclient.Service, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, "failed "+provider.Address())
+	o.lastError = err
+}
+
+func (o *recordingObserver) OnProviderRecovered(_ context.Context, provider consensusclient.Service) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, "recovered "+provider.Address())
+}
+
+func (o *recordingObserver) OnFailover(_ context.Context, call string, from consensusclient.Service, to consensusclient.Service) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, "failover "+call+" "+from.Address()+" "+to.Address())
+}
+
+func TestObserver(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	observer := &recordingObserver{}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		WithObserver(observer),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	callErr := errors.New("call failed")
+	res, err := multi.doCall(ctx, "Test", func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		if client == client1 {
+			return nil, callErr
+		}
+		return client.Address(), nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+
+	// Recheck reactivates the failed client.
+	multi.recheck(ctx)
+
+	// An empty response is not a failover.
+	res, err = multi.doCall(ctx, "Empty", func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		if client == client1 {
+			return nil, nil
+		}
+		return client.Address(), nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+
+	// Deactivating an inactive client does not notify.
+	multi.deactivateClient(ctx, client1, errors.New("test"))
+	multi.deactivateClient(ctx, client1, errors.New("test"))
+
+	require.Equal(t, []string{
+		"failed mock 1",
+		"failover Test mock 1 mock 2",
+		"recovered mock 1",
+		"failed mock 1",
+	}, observer.events)
+	require.Equal(t, "test", observer.lastError.Error())
+}
+
+func TestObserverNil(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	_, err = New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1}),
+		WithObserver(nil),
+	)
+	require.EqualError(t, err, "problem with parameters: nil observer specified")
+}
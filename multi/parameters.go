@@ -28,6 +28,17 @@ type parameters struct {
 	clients   []consensusclient.Service
 	addresses []string
 	timeout   time.Duration
+	sticky    bool
+	observers []Observer
+
+	attestationDataPolicy AttestationDataPolicy
+
+	providerTimeout time.Duration
+	deadlineSharing bool
+
+	readYourWritesWindow time.Duration
+
+	callRoutes map[string]*callRoute
 }
 
 // Parameter is the interface for service parameters.
@@ -76,6 +87,101 @@ func WithAddresses(addresses []string) Parameter {
 	})
 }
 
+// WithStickiness pins calls to the client that served the first call of each epoch,
+// until the end of the epoch or until the client fails.  This ensures that related
+// calls, for example fetching duties, obtaining attestation data and submitting
+// attestations, are served by a node with a consistent view of the chain.
+func WithStickiness(sticky bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.sticky = sticky
+	})
+}
+
+// WithObserver adds an observer to be notified of provider failures, recoveries and failovers.
+// This can be supplied multiple times to add multiple observers.
+func WithObserver(observer Observer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.observers = append(p.observers, observer)
+	})
+}
+
+// WithAttestationDataPolicy sets the policy for obtaining attestation data.  Policies other
+// than AttestationDataPolicyFirst fetch attestation data from all active providers and compare
+// it, protecting against attesting with a provider that is following a minority fork.
+func WithAttestationDataPolicy(policy AttestationDataPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.attestationDataPolicy = policy
+	})
+}
+
+// WithProviderTimeout sets the maximum time that a single provider has to respond to
+// a call before the call fails over to the next provider.  The default is no limit
+// beyond that of the context supplied to the call.
+func WithProviderTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.providerTimeout = timeout
+	})
+}
+
+// WithDeadlineSharing shares the time remaining before the deadline of the context
+// supplied to a call equally between the providers yet to be tried, so that a slow
+// provider cannot use up the entire deadline before the call fails over.
+func WithDeadlineSharing(deadlineSharing bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.deadlineSharing = deadlineSharing
+	})
+}
+
+// WithReadYourWrites routes calls made within the given window after a submission
+// to the client that accepted the submission, so that objects just submitted can
+// be read back before they have propagated to the other clients.
+func WithReadYourWrites(window time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.readYourWritesWindow = window
+	})
+}
+
+// WithAllowedCalls restricts the provider at the given address to the given calls, so it
+// is not used for any other call.  Calls are identified by the name of the method, for
+// example "Proposal" or "BeaconState".  This can be supplied multiple times.
+func WithAllowedCalls(address string, calls ...string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		route := p.callRoute(address)
+		for _, call := range calls {
+			route.allowed[call] = true
+		}
+	})
+}
+
+// WithDeniedCalls ensures that the provider at the given address is never used for the
+// given calls, for example to avoid fetching proposals from an archive node.  Calls are
+// identified by the name of the method.  This can be supplied multiple times.
+func WithDeniedCalls(address string, calls ...string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		route := p.callRoute(address)
+		for _, call := range calls {
+			route.denied[call] = true
+		}
+	})
+}
+
+// callRoute returns the call route for the given address, creating it if required.
+func (p *parameters) callRoute(address string) *callRoute {
+	if p.callRoutes == nil {
+		p.callRoutes = make(map[string]*callRoute)
+	}
+	route, exists := p.callRoutes[address]
+	if !exists {
+		route = &callRoute{
+			allowed: make(map[string]bool),
+			denied:  make(map[string]bool),
+		}
+		p.callRoutes[address] = route
+	}
+
+	return route
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -94,6 +200,23 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if len(parameters.clients)+len(parameters.addresses) == 0 {
 		return nil, errors.New("no Ethereum 2 clients specified")
 	}
+	if parameters.attestationDataPolicy < AttestationDataPolicyFirst ||
+		parameters.attestationDataPolicy > AttestationDataPolicyHighestTarget {
+		return nil, errors.New("invalid attestation data policy")
+	}
+	if parameters.providerTimeout < 0 {
+		return nil, errors.New("provider timeout cannot be negative")
+	}
+	for address := range parameters.callRoutes {
+		if address == "" {
+			return nil, errors.New("call routes specified without an address")
+		}
+	}
+	for _, observer := range parameters.observers {
+		if observer == nil {
+			return nil, errors.New("nil observer specified")
+		}
+	}
 
 	return &parameters, nil
 }
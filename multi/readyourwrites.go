@@ -0,0 +1,57 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// recordWriter records the client that accepted a submission, so that calls within
+// the window are routed to it.
+func (s *Service) recordWriter(client consensusclient.Service, window time.Duration) {
+	s.writerMu.Lock()
+	defer s.writerMu.Unlock()
+
+	s.writer = client
+	s.writerUntil = time.Now().Add(window)
+}
+
+// forgetWriter forgets the client that accepted a submission, if it is the given client.
+func (s *Service) forgetWriter(client consensusclient.Service) {
+	s.writerMu.Lock()
+	defer s.writerMu.Unlock()
+
+	if s.writer == client {
+		s.writer = nil
+		s.writerUntil = time.Time{}
+	}
+}
+
+// writerOrder returns the active clients with the client that accepted the most
+// recent submission first, if the submission was within the read-your-writes window
+// and the client is still active.
+func (s *Service) writerOrder(activeClients []consensusclient.Service) []consensusclient.Service {
+	s.writerMu.Lock()
+	writer := s.writer
+	expired := !time.Now().Before(s.writerUntil)
+	s.writerMu.Unlock()
+
+	if writer == nil || expired {
+		return activeClients
+	}
+
+	return orderFirst(activeClients, writer)
+}
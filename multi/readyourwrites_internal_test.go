@@ -0,0 +1,124 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadYourWrites(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		WithReadYourWrites(time.Minute),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	// Call returns the address of the client that served it, failing for any in the failing set
+	// and returning an empty response for any in the empty set.
+	failing := make(map[string]bool)
+	empty := make(map[string]bool)
+	call := func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		if failing[client.Address()] {
+			return nil, errors.New("failed")
+		}
+		if empty[client.Address()] {
+			return nil, nil
+		}
+		return client.Address(), nil
+	}
+
+	// Reads go to the first client in the absence of a submission.
+	res, err := multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+
+	// Submission is accepted by the second client.
+	empty["mock 1"] = true
+	res, err = multi.doCall(ctx, "SubmitTest", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+	require.Equal(t, client2, multi.writer)
+
+	// Reads follow the submission, even though the first client is available.
+	delete(empty, "mock 1")
+	res, err = multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+
+	// Once the window expires the usual order applies.
+	multi.writerUntil = time.Now().Add(-time.Second)
+	res, err = multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+
+	// Failure of the writer forgets it.
+	multi.recordWriter(client2, time.Minute)
+	failing["mock 2"] = true
+	res, err = multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+	require.Nil(t, multi.writer)
+}
+
+func TestReadYourWritesDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	call := func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		return client.Address(), nil
+	}
+
+	multi.recordWriter(client2, time.Minute)
+	res, err := multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+
+	_, err = multi.doCall(ctx, "SubmitTest", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, client2, multi.writer)
+}
@@ -0,0 +1,173 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
+)
+
+// Reconfigure updates the configuration of the service without recreating it.
+// The parameters are those accepted by New(), and replace the existing configuration
+// in full.  Providers present both before and after reconfiguration, matched by
+// address, are retained along with their state, so only newly supplied providers
+// are connected.  Providers created from addresses are recreated if the timeout
+// changes.  The log level and monitor cannot be changed.
+//
+// If the new configuration has no active providers it is rejected, and the existing
+// configuration is retained.
+func (s *Service) Reconfigure(ctx context.Context, params ...Parameter) error {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return errors.Wrap(err, "problem with parameters")
+	}
+
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	// Reconfigurations are serialised, so that each starts from the result of the last.
+	s.reconfigureMu.Lock()
+	defer s.reconfigureMu.Unlock()
+
+	s.configMu.RLock()
+	timeoutChanged := parameters.timeout != s.timeout
+	s.configMu.RUnlock()
+
+	// Grab the current clients.
+	s.clientsMu.RLock()
+	existing := make(map[string]consensusclient.Service, len(s.activeClients)+len(s.inactiveClients))
+	for _, client := range s.activeClients {
+		existing[client.Address()] = client
+	}
+	for _, client := range s.inactiveClients {
+		existing[client.Address()] = client
+	}
+	outdated := make(map[consensusclient.Service]bool)
+	if timeoutChanged {
+		for client := range s.owned {
+			outdated[client] = true
+		}
+	}
+	s.clientsMu.RUnlock()
+
+	// Build the new set of clients, in order, finding the state of those that are new.
+	clients := make([]consensusclient.Service, 0, len(parameters.clients)+len(parameters.addresses))
+	included := make(map[consensusclient.Service]bool, len(parameters.clients)+len(parameters.addresses))
+	newClientsActive := make(map[consensusclient.Service]bool)
+	include := func(client consensusclient.Service, isNew bool) {
+		if included[client] {
+			return
+		}
+		included[client] = true
+		clients = append(clients, client)
+		if isNew {
+			active := ping(ctx, client)
+			state := "inactive"
+			if active {
+				state = "active"
+			}
+			setProviderActiveMetric(ctx, client.Address(), state)
+			newClientsActive[client] = active
+		}
+	}
+
+	for _, client := range parameters.clients {
+		existingClient, exists := existing[client.Address()]
+		if exists {
+			client = existingClient
+		}
+		include(client, !exists)
+	}
+	created := make([]consensusclient.Service, 0, len(parameters.addresses))
+	for _, address := range parameters.addresses {
+		client, exists := existing[address]
+		if !exists || outdated[client] {
+			// Providers are created with the context of the service, as they outlive this call.
+			client, err = http.New(s.ctx,
+				http.WithLogLevel(parameters.logLevel),
+				http.WithTimeout(parameters.timeout),
+				http.WithAddress(address),
+			)
+			if err != nil {
+				log.Error().Str("provider", address).Msg("Provider not present; dropping from rotation")
+				continue
+			}
+			s.clientsMu.Lock()
+			s.owned[client] = true
+			s.clientsMu.Unlock()
+			created = append(created, client)
+			exists = false
+		}
+		include(client, !exists)
+	}
+
+	// Merge with the current state of retained clients, which can have changed since
+	// they were obtained above.
+	s.clientsMu.Lock()
+	currentlyActive := make(map[consensusclient.Service]bool, len(s.activeClients))
+	for _, client := range s.activeClients {
+		currentlyActive[client] = true
+	}
+	activeClients := make([]consensusclient.Service, 0, len(clients))
+	inactiveClients := make([]consensusclient.Service, 0, len(clients))
+	for _, client := range clients {
+		active, isNew := newClientsActive[client]
+		if !isNew {
+			active = currentlyActive[client]
+		}
+		if active {
+			activeClients = append(activeClients, client)
+		} else {
+			inactiveClients = append(inactiveClients, client)
+		}
+	}
+	if len(activeClients) == 0 {
+		s.clientsMu.Unlock()
+		for _, client := range created {
+			s.closeOwned(ctx, client)
+		}
+		return errors.New("no providers active, cannot reconfigure")
+	}
+	s.activeClients = activeClients
+	s.inactiveClients = inactiveClients
+	s.clientsMu.Unlock()
+	log.Trace().Int("active", len(activeClients)).Int("inactive", len(inactiveClients)).Msg("Reconfigured providers")
+	setProvidersMetric(ctx, "active", len(activeClients))
+	setProvidersMetric(ctx, "inactive", len(inactiveClients))
+
+	// Ensure that removed clients are no longer pinned.
+	for _, client := range existing {
+		if !included[client] {
+			s.unpin(client)
+			s.forgetWriter(client)
+			s.closeOwned(ctx, client)
+		}
+	}
+
+	s.configMu.Lock()
+	s.timeout = parameters.timeout
+	s.sticky = parameters.sticky
+	s.observers = parameters.observers
+	s.attestationDataPolicy = parameters.attestationDataPolicy
+	s.providerTimeout = parameters.providerTimeout
+	s.deadlineSharing = parameters.deadlineSharing
+	s.readYourWritesWindow = parameters.readYourWritesWindow
+	s.callRoutes = parameters.callRoutes
+	s.configMu.Unlock()
+
+	return nil
+}
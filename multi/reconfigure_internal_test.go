@@ -0,0 +1,265 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// unsyncedClient is a client that cannot provide its sync state, so is never active.
+type unsyncedClient struct {
+	address string
+}
+
+func (c *unsyncedClient) Name() string {
+	return "unsynced"
+}
+
+func (c *unsyncedClient) Address() string {
+	return c.address
+}
+
+func TestReconfigure(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+	unsynced := &unsyncedClient{address: "unsynced"}
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1, client2, unsynced}),
+	)
+	require.NoError(t, err)
+	service := s.(*Service)
+
+	// Bad parameters are rejected.
+	require.EqualError(t, service.Reconfigure(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1}),
+		WithProviderTimeout(-time.Second),
+	), "problem with parameters: provider timeout cannot be negative")
+
+	// A configuration without active providers is rejected.
+	require.EqualError(t, service.Reconfigure(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{unsynced}),
+	), "no providers active, cannot reconfigure")
+	require.Equal(t, []consensusclient.Service{client1, client2}, service.activeClients)
+
+	// Replace client 1 with client 3; client 2 is supplied as a new instance with the
+	// same address, so the existing instance should be retained.
+	client2Replacement, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	require.NoError(t, service.Reconfigure(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client2Replacement, client3, unsynced}),
+		WithStickiness(true),
+		WithProviderTimeout(time.Second),
+		WithDeadlineSharing(true),
+		WithAttestationDataPolicy(AttestationDataPolicyMajority),
+	))
+	require.Len(t, service.activeClients, 2)
+	require.True(t, service.activeClients[0] == client2)
+	require.True(t, service.activeClients[1] == client3)
+	require.Equal(t, []consensusclient.Service{unsynced}, service.inactiveClients)
+	require.True(t, service.sticky)
+	require.Equal(t, time.Second, service.providerTimeout)
+	require.True(t, service.deadlineSharing)
+	require.Equal(t, AttestationDataPolicyMajority, service.attestationDataPolicy)
+	require.Equal(t, "mock 2", service.Address())
+}
+
+func TestReconfigureConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1}),
+	)
+	require.NoError(t, err)
+	service := s.(*Service)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := service.AttestationData(ctx, 1, 0)
+			require.NoError(t, err)
+		}()
+		go func(i int) {
+			defer wg.Done()
+			clients := []consensusclient.Service{client1}
+			if i%2 == 0 {
+				clients = []consensusclient.Service{client2, client1}
+			}
+			require.NoError(t, service.Reconfigure(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients(clients),
+				WithStickiness(i%2 == 0),
+				WithProviderTimeout(time.Duration(i)*time.Second),
+			))
+		}(i)
+	}
+	wg.Wait()
+}
+
+// newBeaconNodeServer creates a server providing enough of the beacon node API for an
+// HTTP client to be created and report itself as synced.
+func newBeaconNodeServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"%#x","genesis_fork_version":"0x00000000"}}`, make([]byte, 32))
+		case "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12"}}`)
+		case "/eth/v1/config/deposit_contract":
+			fmt.Fprintf(w, `{"data":{"chain_id":"1","address":"%#x"}}`, make([]byte, 20))
+		case "/eth/v1/config/fork_schedule":
+			fmt.Fprint(w, `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`)
+		case "/eth/v1/node/version":
+			fmt.Fprint(w, `{"data":{"version":"test/v1.0.0"}}`)
+		case "/eth/v1/node/syncing":
+			fmt.Fprint(w, `{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestReconfigureAddresses(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server1 := newBeaconNodeServer(t)
+	server2 := newBeaconNodeServer(t)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithAddresses([]string{server1.URL}),
+	)
+	require.NoError(t, err)
+	service := s.(*Service)
+	defer func() {
+		require.NoError(t, service.Close(context.Background()))
+	}()
+	original := service.activeClients[0]
+
+	// Providers created by reconfiguration outlive the context of the call.
+	reconfigureCtx, reconfigureCancel := context.WithTimeout(ctx, 5*time.Second)
+	require.NoError(t, service.Reconfigure(reconfigureCtx,
+		WithLogLevel(zerolog.Disabled),
+		WithAddresses([]string{server1.URL, server2.URL}),
+	))
+	reconfigureCancel()
+	require.Len(t, service.activeClients, 2)
+	require.True(t, service.activeClients[0] == original)
+	time.Sleep(100 * time.Millisecond)
+	_, err = service.activeClients[1].(consensusclient.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+
+	// A change of timeout recreates providers created from addresses.
+	require.NoError(t, service.Reconfigure(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithAddresses([]string{server1.URL}),
+		WithTimeout(time.Minute),
+	))
+	require.Len(t, service.activeClients, 1)
+	require.False(t, service.activeClients[0] == original)
+	require.Equal(t, time.Minute, service.timeout)
+}
+
+func TestReconfigureKeepsState(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1, client2}),
+	)
+	require.NoError(t, err)
+	service := s.(*Service)
+
+	// A client deactivated while reconfiguration is in progress stays deactivated.
+	slow := &slowPingClient{
+		Service: client1,
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	done := make(chan error)
+	go func() {
+		done <- service.Reconfigure(ctx,
+			WithLogLevel(zerolog.Disabled),
+			WithClients([]consensusclient.Service{client1, client2, slow}),
+		)
+	}()
+	<-slow.started
+	service.deactivateClient(ctx, client2, errors.New("test"))
+	close(slow.release)
+	require.NoError(t, <-done)
+
+	require.Equal(t, []consensusclient.Service{client1}, service.activeClients)
+	require.Equal(t, []consensusclient.Service{client2, slow}, service.inactiveClients)
+}
+
+// slowPingClient is a client with a distinct address that waits to be released
+// before reporting its sync state, and is never synced.
+type slowPingClient struct {
+	*mock.Service
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *slowPingClient) Address() string {
+	return "slow"
+}
+
+func (c *slowPingClient) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
+	close(c.started)
+	<-c.release
+	return nil, errors.New("not synced")
+}
@@ -16,6 +16,7 @@ package multi
 import (
 	"context"
 	"sync"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
@@ -25,12 +26,61 @@ import (
 )
 
 // Service handles multiple Ethereum 2 clients.
+// It is safe for concurrent use.
 type Service struct {
 	log zerolog.Logger
 
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
 	inactiveClients []consensusclient.Service
+
+	// reconfigureMu serialises calls to Reconfigure().
+	reconfigureMu sync.Mutex
+	// configMu protects the configuration below, which can be changed by Reconfigure().
+	configMu sync.RWMutex
+	// timeout is the timeout for providers created from addresses.
+	timeout time.Duration
+	// sticky pins calls to a single client for the duration of an epoch.
+	sticky    bool
+	observers []Observer
+
+	attestationDataPolicy AttestationDataPolicy
+
+	// providerTimeout and deadlineSharing limit the time given to each provider for a call.
+	providerTimeout time.Duration
+	deadlineSharing bool
+
+	// readYourWritesWindow is the time after a submission for which reads are routed
+	// to the client that accepted the submission.
+	readYourWritesWindow time.Duration
+
+	// callRoutes are the calls permitted for each provider, by address.
+	callRoutes map[string]*callRoute
+
+	pinMu         sync.Mutex
+	pinned        consensusclient.Service
+	pinnedUntil   time.Time
+	genesisTime   time.Time
+	epochDuration time.Duration
+
+	writerMu    sync.Mutex
+	writer      consensusclient.Service
+	writerUntil time.Time
+
+	// owned holds the clients created by the service from addresses, which are
+	// closed by the service.  It is protected by clientsMu.
+	owned map[consensusclient.Service]bool
+
+	// ctx is the context supplied on creation, which bounds the lifetime of
+	// providers created from addresses.
+	ctx context.Context
+
+	// Lifecycle; closing is closed when the service is closed, and active
+	// tracks background goroutines and in-flight calls.
+	lifecycleMu sync.Mutex
+	closed      bool
+	closing     chan struct{}
+	active      sync.WaitGroup
 }
 
 // New creates a new Ethereum 2 client with multiple endpoints.
@@ -59,6 +109,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	// Check the state of each client and put it in an active or inactive list, accordingly.
 	activeClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	inactiveClients := make([]consensusclient.Service, 0, len(parameters.clients))
+	owned := make(map[consensusclient.Service]bool, len(parameters.addresses))
 	for _, client := range parameters.clients {
 		if ping(ctx, client) {
 			activeClients = append(activeClients, client)
@@ -76,6 +127,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 			log.Error().Str("provider", address).Msg("Provider not present; dropping from rotation")
 			continue
 		}
+		owned[client] = true
 		if ping(ctx, client) {
 			activeClients = append(activeClients, client)
 			setProviderActiveMetric(ctx, client.Address(), "active")
@@ -95,10 +147,28 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 		log:             log,
 		activeClients:   activeClients,
 		inactiveClients: inactiveClients,
+		timeout:         parameters.timeout,
+		sticky:          parameters.sticky,
+		observers:       parameters.observers,
+
+		attestationDataPolicy: parameters.attestationDataPolicy,
+		providerTimeout:       parameters.providerTimeout,
+		deadlineSharing:       parameters.deadlineSharing,
+		readYourWritesWindow:  parameters.readYourWritesWindow,
+		callRoutes:            parameters.callRoutes,
+		owned:                 owned,
+		ctx:                   ctx,
+		closing:               make(chan struct{}),
 	}
 
 	// Kick off monitor.
-	go s.monitor(ctx)
+	s.active.Add(1)
+	go func() {
+		defer s.untrack()
+		ctx, cancel := s.lifecycleContext(ctx)
+		defer cancel()
+		s.monitor(ctx)
+	}()
 
 	return s, nil
 }
@@ -106,6 +106,7 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.AttesterAssignmentsProvider)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockProposalProvider)(nil), s)
@@ -120,10 +121,13 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
+	assert.Implements(t, (*client.ForkVersionsProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.IndexedBeaconCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ProposalProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
@@ -131,6 +135,9 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ValidatedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.ValidatedBlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.ValidatorBalanceHistoryProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
@@ -28,7 +28,7 @@ func (s *Service) SignedBeaconBlock(ctx context.Context,
 	*spec.VersionedSignedBeaconBlock,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "SignedBeaconBlock", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, blockID)
 		if err != nil {
 			return nil, err
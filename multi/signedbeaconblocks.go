@@ -0,0 +1,46 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignedBeaconBlocks fetches signed beacon blocks given their roots.
+// Blocks that are not available are not present in the returned map.
+func (s *Service) SignedBeaconBlocks(ctx context.Context,
+	roots []phase0.Root,
+) (
+	map[phase0.Root]*spec.VersionedSignedBeaconBlock,
+	error,
+) {
+	res, err := s.doCall(ctx, "SignedBeaconBlocks", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		blocks, err := client.(consensusclient.SignedBeaconBlocksProvider).SignedBeaconBlocks(ctx, roots)
+		if err != nil {
+			return nil, err
+		}
+		return blocks, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(map[phase0.Root]*spec.VersionedSignedBeaconBlock), nil
+}
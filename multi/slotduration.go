@@ -23,7 +23,7 @@ import (
 
 // SlotDuration provides the duration of a slot of the chain.
 func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "SlotDuration", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		duration, err := client.(consensusclient.SlotDurationProvider).SlotDuration(ctx)
 		if err != nil {
 			return nil, err
@@ -22,7 +22,7 @@ import (
 
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconStateRoot", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		stateRoot, err := client.(consensusclient.BeaconStateRootProvider).BeaconStateRoot(ctx, stateID)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,148 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// stickyOrder returns the active clients with the pinned client first, if there is a
+// pinned client, its pin has not expired, and it is still active.
+func (s *Service) stickyOrder(activeClients []consensusclient.Service) []consensusclient.Service {
+	s.pinMu.Lock()
+	pinned := s.pinned
+	expired := !s.pinnedUntil.IsZero() && !time.Now().Before(s.pinnedUntil)
+	s.pinMu.Unlock()
+
+	if pinned == nil || expired {
+		return activeClients
+	}
+
+	return orderFirst(activeClients, pinned)
+}
+
+// orderFirst returns the active clients with the given client first, if it is active.
+func orderFirst(activeClients []consensusclient.Service, first consensusclient.Service) []consensusclient.Service {
+	if activeClients[0] == first {
+		return activeClients
+	}
+
+	ordered := make([]consensusclient.Service, 0, len(activeClients))
+	for _, client := range activeClients {
+		if client == first {
+			ordered = append(ordered, client)
+			break
+		}
+	}
+	if len(ordered) == 0 {
+		// Client is no longer active.
+		return activeClients
+	}
+	for _, client := range activeClients {
+		if client != first {
+			ordered = append(ordered, client)
+		}
+	}
+
+	return ordered
+}
+
+// pin pins the client that served a call, if it is not already pinned.
+// The pin lasts until the end of the current epoch, or until the client fails.
+func (s *Service) pin(ctx context.Context, client consensusclient.Service) {
+	now := time.Now()
+	s.pinMu.Lock()
+	pinned := s.pinned == client && (s.pinnedUntil.IsZero() || now.Before(s.pinnedUntil))
+	s.pinMu.Unlock()
+	if pinned {
+		return
+	}
+
+	// Obtain the end of the epoch without holding the lock, as it can call the client.
+	until := s.epochEnd(ctx, client, now)
+
+	s.pinMu.Lock()
+	s.pinned = client
+	s.pinnedUntil = until
+	s.pinMu.Unlock()
+	zerolog.Ctx(ctx).Trace().Str("client", client.Name()).Str("address", client.Address()).Time("until", until).Msg("Pinned client")
+}
+
+// unpin removes the pin from the client, if it is pinned.
+func (s *Service) unpin(client consensusclient.Service) {
+	s.pinMu.Lock()
+	defer s.pinMu.Unlock()
+
+	if s.pinned == client {
+		s.pinned = nil
+		s.pinnedUntil = time.Time{}
+	}
+}
+
+// epochEnd returns the end of the epoch containing the given time.  Chain timing is
+// obtained from the client on first use; if it is unavailable this returns the zero
+// time, and pins last until the pinned client fails.
+func (s *Service) epochEnd(ctx context.Context, client consensusclient.Service, now time.Time) time.Time {
+	s.pinMu.Lock()
+	genesisTime := s.genesisTime
+	epochDuration := s.epochDuration
+	s.pinMu.Unlock()
+
+	if epochDuration == 0 {
+		var err error
+		genesisTime, epochDuration, err = chainTiming(ctx, client)
+		if err != nil {
+			return time.Time{}
+		}
+		s.pinMu.Lock()
+		s.genesisTime = genesisTime
+		s.epochDuration = epochDuration
+		s.pinMu.Unlock()
+	}
+	if epochDuration == 0 || now.Before(genesisTime) {
+		return time.Time{}
+	}
+
+	epochs := now.Sub(genesisTime) / epochDuration
+	return genesisTime.Add((epochs + 1) * epochDuration)
+}
+
+// chainTiming obtains the genesis time and epoch duration from the client.
+func chainTiming(ctx context.Context, client consensusclient.Service) (time.Time, time.Duration, error) {
+	genesisTimeProvider, isGenesisTimeProvider := client.(consensusclient.GenesisTimeProvider)
+	slotDurationProvider, isSlotDurationProvider := client.(consensusclient.SlotDurationProvider)
+	slotsPerEpochProvider, isSlotsPerEpochProvider := client.(consensusclient.SlotsPerEpochProvider)
+	if !isGenesisTimeProvider || !isSlotDurationProvider || !isSlotsPerEpochProvider {
+		return time.Time{}, 0, errors.New("client does not provide chain timing")
+	}
+	genesisTime, err := genesisTimeProvider.GenesisTime(ctx)
+	if err != nil {
+		return time.Time{}, 0, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := slotDurationProvider.SlotDuration(ctx)
+	if err != nil {
+		return time.Time{}, 0, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return time.Time{}, 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	return genesisTime, slotDuration * time.Duration(slotsPerEpoch), nil
+}
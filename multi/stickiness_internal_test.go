@@ -0,0 +1,164 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStickiness(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+		WithStickiness(true),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	// Call returns the address of the client that served it, failing for any in the failing set.
+	failing := make(map[string]bool)
+	call := func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		if failing[client.Address()] {
+			return nil, errors.New("failed")
+		}
+		return client.Address(), nil
+	}
+
+	// First call pins the first client.
+	res, err := multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+	require.Equal(t, client1, multi.pinned)
+	genesisTime, err := client1.GenesisTime(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisTime.Add(32*12*time.Second), multi.pinnedUntil)
+
+	// Pin the second client; calls should go to it ahead of the first.
+	multi.pin(ctx, client2)
+	res, err = multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+
+	// Once the pin expires the usual order applies, and the client that served the call is pinned.
+	multi.pinnedUntil = time.Now().Add(-time.Second)
+	res, err = multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 1", res)
+	require.Equal(t, client1, multi.pinned)
+
+	// Failure of the pinned client moves the pin.
+	failing["mock 1"] = true
+	res, err = multi.doCall(ctx, "Test", call, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+	require.Equal(t, client2, multi.pinned)
+	require.Len(t, multi.activeClients, 1)
+}
+
+func TestStickinessDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+		}),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	_, err = multi.doCall(ctx, "Test", func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		return client.Address(), nil
+	}, nil)
+	require.NoError(t, err)
+	require.Nil(t, multi.pinned)
+}
+
+type slowTimingClient struct {
+	*mock.Service
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *slowTimingClient) GenesisTime(ctx context.Context) (time.Time, error) {
+	close(c.started)
+	<-c.release
+	return c.Service.GenesisTime(ctx)
+}
+
+func TestStickinessPinDoesNotBlock(t *testing.T) {
+	ctx := context.Background()
+
+	mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1 := &slowTimingClient{
+		Service: mock1,
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+		}),
+		WithStickiness(true),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	pinned := make(chan struct{})
+	go func() {
+		multi.pin(ctx, client1)
+		close(pinned)
+	}()
+	<-client1.started
+
+	// Ordering clients must not wait for the pin to obtain chain timing.
+	ordered := make(chan struct{})
+	go func() {
+		multi.stickyOrder([]consensusclient.Service{client1})
+		close(ordered)
+	}()
+	select {
+	case <-ordered:
+	case <-time.After(time.Second):
+		require.Fail(t, "ordering blocked by pin")
+	}
+
+	close(client1.release)
+	<-pinned
+	require.Equal(t, consensusclient.Service(client1), multi.pinned)
+}
@@ -0,0 +1,67 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SubmitBeaconBlockWithValidation submits a beacon block, requesting that the node
+// carry out the given validation before broadcasting it.
+func (s *Service) SubmitBeaconBlockWithValidation(ctx context.Context,
+	block *spec.VersionedSignedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	res, err := s.doCall(ctx, "SubmitBeaconBlockWithValidation", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		receipt, err := client.(consensusclient.ValidatedBeaconBlockSubmitter).SubmitBeaconBlockWithValidation(ctx, block, validation)
+		if err != nil {
+			return nil, err
+		}
+		return receipt, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*apiv1.SubmissionReceipt), nil
+}
+
+// SubmitBlindedBeaconBlockWithValidation submits a blinded beacon block, requesting that
+// the node carry out the given validation before broadcasting it.
+func (s *Service) SubmitBlindedBeaconBlockWithValidation(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	res, err := s.doCall(ctx, "SubmitBlindedBeaconBlockWithValidation", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		receipt, err := client.(consensusclient.ValidatedBlindedBeaconBlockSubmitter).SubmitBlindedBeaconBlockWithValidation(ctx, block, validation)
+		if err != nil {
+			return nil, err
+		}
+		return receipt, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*apiv1.SubmissionReceipt), nil
+}
@@ -23,7 +23,7 @@ import (
 
 // SyncCommittee fetches the sync committee for the given state.
 func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncCommittee, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "SyncCommittee", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.SyncCommitteesProvider).SyncCommittee(ctx, stateID)
 		if err != nil {
 			return nil, err
@@ -41,7 +41,7 @@ func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncC
 
 // SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
 func (s *Service) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*api.SyncCommittee, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "SyncCommitteeAtEpoch", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.SyncCommitteesProvider).SyncCommitteeAtEpoch(ctx, stateID, epoch)
 		if err != nil {
 			return nil, err
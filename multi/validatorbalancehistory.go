@@ -0,0 +1,49 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ValidatorBalanceHistory provides the balances of a validator at the start of every step epochs from
+// fromEpoch to toEpoch inclusive.
+func (s *Service) ValidatorBalanceHistory(ctx context.Context,
+	index phase0.ValidatorIndex,
+	fromEpoch phase0.Epoch,
+	toEpoch phase0.Epoch,
+	step uint64,
+) (
+	*apiv1.ValidatorBalanceHistory,
+	error,
+) {
+	res, err := s.doCall(ctx, "ValidatorBalanceHistory", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		history, err := client.(consensusclient.ValidatorBalanceHistoryProvider).ValidatorBalanceHistory(ctx, index, fromEpoch, toEpoch, step)
+		if err != nil {
+			return nil, err
+		}
+		return history, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*apiv1.ValidatorBalanceHistory), nil
+}
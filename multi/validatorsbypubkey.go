@@ -32,7 +32,7 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context,
 	map[phase0.ValidatorIndex]*api.Validator,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "ValidatorsByPubKey", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.ValidatorsProvider).ValidatorsByPubKey(ctx, stateID, validatorPubKeys)
 		if err != nil {
 			return nil, err
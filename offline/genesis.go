@@ -0,0 +1,137 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// loadGenesis loads the genesis state from the given file.
+func (s *Service) loadGenesis(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read file")
+	}
+
+	version, err := s.versionAtSlot(0)
+	if err != nil {
+		return err
+	}
+	state := &spec.VersionedBeaconState{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		state.Phase0 = &phase0.BeaconState{}
+		err = state.Phase0.UnmarshalSSZ(data)
+	case spec.DataVersionAltair:
+		state.Altair = &altair.BeaconState{}
+		err = state.Altair.UnmarshalSSZ(data)
+	case spec.DataVersionBellatrix:
+		state.Bellatrix = &bellatrix.BeaconState{}
+		err = state.Bellatrix.UnmarshalSSZ(data)
+	case spec.DataVersionCapella:
+		state.Capella = &capella.BeaconState{}
+		err = state.Capella.UnmarshalSSZ(data)
+	default:
+		return fmt.Errorf("unsupported genesis version %s", version)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode %s genesis state", version)
+	}
+	if err := state.Validate(); err != nil {
+		return errors.Wrap(err, "invalid genesis state")
+	}
+
+	genesis := &apiv1.Genesis{}
+	switch version {
+	case spec.DataVersionPhase0:
+		genesis.GenesisTime = time.Unix(int64(state.Phase0.GenesisTime), 0)
+		genesis.GenesisValidatorsRoot = state.Phase0.GenesisValidatorsRoot
+		genesis.GenesisForkVersion = state.Phase0.Fork.CurrentVersion
+	case spec.DataVersionAltair:
+		genesis.GenesisTime = time.Unix(int64(state.Altair.GenesisTime), 0)
+		genesis.GenesisValidatorsRoot = state.Altair.GenesisValidatorsRoot
+		genesis.GenesisForkVersion = state.Altair.Fork.CurrentVersion
+	case spec.DataVersionBellatrix:
+		genesis.GenesisTime = time.Unix(int64(state.Bellatrix.GenesisTime), 0)
+		genesis.GenesisValidatorsRoot = state.Bellatrix.GenesisValidatorsRoot
+		genesis.GenesisForkVersion = state.Bellatrix.Fork.CurrentVersion
+	case spec.DataVersionCapella:
+		genesis.GenesisTime = time.Unix(int64(state.Capella.GenesisTime), 0)
+		genesis.GenesisValidatorsRoot = state.Capella.GenesisValidatorsRoot
+		genesis.GenesisForkVersion = state.Capella.Fork.CurrentVersion
+	}
+	// The configured genesis fork version takes precedence, as the genesis state of a chain
+	// that starts after phase 0 carries the version of its starting fork.
+	if tmp, exists := s.spec["GENESIS_FORK_VERSION"]; exists {
+		if forkVersion, isVersion := tmp.(phase0.Version); isVersion {
+			genesis.GenesisForkVersion = forkVersion
+		}
+	}
+
+	s.genesisState = state
+	s.genesis = genesis
+
+	return nil
+}
+
+// Genesis provides the genesis information of the chain.
+func (s *Service) Genesis(_ context.Context) (*apiv1.Genesis, error) {
+	return s.genesis, nil
+}
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Service) GenesisTime(_ context.Context) (time.Time, error) {
+	return s.genesis.GenesisTime, nil
+}
+
+// GenesisValidatorsRoot provides the genesis validators root of the chain.
+func (s *Service) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
+	return s.genesis.GenesisValidatorsRoot[:], nil
+}
+
+// BeaconState fetches a beacon state given a state ID.
+// Only the genesis state is available offline.
+func (s *Service) BeaconState(_ context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	if stateID != "genesis" && stateID != "0" {
+		return nil, fmt.Errorf("state %s not available offline", stateID)
+	}
+
+	return s.genesisState, nil
+}
+
+// NodeVersion returns a free-text string with the node version.
+func (s *Service) NodeVersion(_ context.Context) (string, error) {
+	return "offline", nil
+}
+
+// NodeSyncing provides the syncing information for the node.
+// The node is always synced, with its head at the highest available block.
+func (s *Service) NodeSyncing(_ context.Context) (*apiv1.SyncState, error) {
+	return &apiv1.SyncState{
+		HeadSlot: s.headSlot,
+	}, nil
+}
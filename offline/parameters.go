@@ -0,0 +1,110 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel  zerolog.Level
+	name      string
+	config    string
+	presets   []string
+	genesis   string
+	blocksDir string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithName sets the name for the module.
+func WithName(name string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.name = name
+	})
+}
+
+// WithConfig sets the path to the chain configuration file, as found in the config.yaml
+// of a network definition.  If the file contains PRESET_BASE for a well-known preset
+// then the main preset values, such as SLOTS_PER_EPOCH, are provided automatically.
+func WithConfig(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.config = path
+	})
+}
+
+// WithPresets sets the paths to preset files, as found in the presets directory of the
+// consensus specification.  Values in the chain configuration take precedence.
+func WithPresets(paths []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.presets = append([]string{}, paths...)
+	})
+}
+
+// WithGenesis sets the path to the SSZ-encoded genesis state.
+func WithGenesis(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesis = path
+	})
+}
+
+// WithBlocksDir sets the directory containing SSZ-encoded signed beacon blocks, one per
+// file with a .ssz extension.  This is optional; without it the service provides no blocks.
+func WithBlocksDir(dir string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blocksDir = dir
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		name:     "offline",
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.name == "" {
+		return nil, errors.New("no name specified")
+	}
+	if parameters.config == "" {
+		return nil, errors.New("no config specified")
+	}
+	if parameters.genesis == "" {
+		return nil, errors.New("no genesis specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+// presets are the values of the well-known presets, keyed by PRESET_BASE.
+// Only values used to interpret the chain are included; other preset values
+// can be supplied with WithPresets.
+var presets = map[string]map[string]string{
+	"mainnet": {
+		"SLOTS_PER_EPOCH":                  "32",
+		"MIN_SEED_LOOKAHEAD":               "1",
+		"MAX_SEED_LOOKAHEAD":               "4",
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":    "64",
+		"SLOTS_PER_HISTORICAL_ROOT":        "8192",
+		"EPOCHS_PER_HISTORICAL_VECTOR":     "65536",
+		"EPOCHS_PER_SLASHINGS_VECTOR":      "8192",
+		"MAX_COMMITTEES_PER_SLOT":          "64",
+		"TARGET_COMMITTEE_SIZE":            "128",
+		"MAX_VALIDATORS_PER_COMMITTEE":     "2048",
+		"SHUFFLE_ROUND_COUNT":              "90",
+		"MAX_EFFECTIVE_BALANCE":            "32000000000",
+		"EFFECTIVE_BALANCE_INCREMENT":      "1000000000",
+		"SYNC_COMMITTEE_SIZE":              "512",
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": "256",
+	},
+	"minimal": {
+		"SLOTS_PER_EPOCH":                  "8",
+		"MIN_SEED_LOOKAHEAD":               "1",
+		"MAX_SEED_LOOKAHEAD":               "4",
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":    "4",
+		"SLOTS_PER_HISTORICAL_ROOT":        "64",
+		"EPOCHS_PER_HISTORICAL_VECTOR":     "64",
+		"EPOCHS_PER_SLASHINGS_VECTOR":      "64",
+		"MAX_COMMITTEES_PER_SLOT":          "4",
+		"TARGET_COMMITTEE_SIZE":            "4",
+		"MAX_VALIDATORS_PER_COMMITTEE":     "2048",
+		"SHUFFLE_ROUND_COUNT":              "10",
+		"MAX_EFFECTIVE_BALANCE":            "32000000000",
+		"EFFECTIVE_BALANCE_INCREMENT":      "1000000000",
+		"SYNC_COMMITTEE_SIZE":              "32",
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": "8",
+	},
+}
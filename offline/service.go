@@ -0,0 +1,149 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package offline provides an Ethereum 2 client service backed entirely by local files,
+// for deterministic testing and air-gapped analysis.  It implements the read-only provider
+// interfaces that can be answered from a chain configuration, a genesis state and a set of
+// blocks.
+package offline
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is an Ethereum 2 client service, providing data from local files.
+type Service struct {
+	name string
+
+	spec         map[string]interface{}
+	forkEpochs   []*forkEpoch
+	genesis      *apiv1.Genesis
+	genesisState *spec.VersionedBeaconState
+
+	// blocks are the blocks by root, with indices by slot.
+	blocks       map[phase0.Root]*spec.VersionedSignedBeaconBlock
+	blocksBySlot map[phase0.Slot]phase0.Root
+	headSlot     phase0.Slot
+}
+
+// forkEpoch is the epoch at which a data version comes in to effect.
+type forkEpoch struct {
+	version spec.DataVersion
+	epoch   phase0.Epoch
+}
+
+// log is a service-wide logger.
+var log zerolog.Logger
+
+// New creates a new Ethereum 2 client service from local files.
+// All files are read and decoded on creation, so any problems with them are reported here.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "client").Str("impl", "offline").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		name:         parameters.name,
+		blocks:       make(map[phase0.Root]*spec.VersionedSignedBeaconBlock),
+		blocksBySlot: make(map[phase0.Slot]phase0.Root),
+	}
+
+	if err := s.loadConfig(parameters.config, parameters.presets); err != nil {
+		return nil, errors.Wrap(err, "failed to load config")
+	}
+	if err := s.loadGenesis(parameters.genesis); err != nil {
+		return nil, errors.Wrap(err, "failed to load genesis")
+	}
+	if parameters.blocksDir != "" {
+		if err := s.loadBlocks(parameters.blocksDir); err != nil {
+			return nil, errors.Wrap(err, "failed to load blocks")
+		}
+	}
+	log.Trace().Int("blocks", len(s.blocks)).Uint64("head_slot", uint64(s.headSlot)).Msg("Loaded offline data")
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "offline"
+}
+
+// Address provides the address of the service.
+func (s *Service) Address() string {
+	return s.name
+}
+
+// loadBlocks loads the signed beacon blocks in the given directory.
+func (s *Service) loadBlocks(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to read directory")
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".ssz") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return errors.Wrap(err, "failed to read block")
+		}
+		block, err := s.decodeSignedBeaconBlock(data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode block %s", name)
+		}
+		root, err := block.Root()
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain root of block %s", name)
+		}
+		slot, err := block.Slot()
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain slot of block %s", name)
+		}
+		if existing, exists := s.blocksBySlot[slot]; exists && existing != root {
+			return fmt.Errorf("multiple blocks for slot %d", slot)
+		}
+		s.blocks[root] = block
+		s.blocksBySlot[slot] = root
+		if slot > s.headSlot {
+			s.headSlot = slot
+		}
+	}
+
+	return nil
+}
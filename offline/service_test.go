@@ -0,0 +1,346 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/offline"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `# Test configuration.
+PRESET_BASE: 'minimal'
+CONFIG_NAME: "test"
+SLOTS_PER_EPOCH: 8
+SECONDS_PER_SLOT: 6
+GENESIS_FORK_VERSION: 0x00000001
+ALTAIR_FORK_VERSION: 0x01000001
+ALTAIR_FORK_EPOCH: 2
+BELLATRIX_FORK_EPOCH: 18446744073709551615
+`
+
+func testGenesisState() *phase0.BeaconState {
+	return &phase0.BeaconState{
+		GenesisTime:                 1606824023,
+		GenesisValidatorsRoot:       phase0.Root{0x01},
+		Fork:                        &phase0.Fork{},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, 8192),
+		StateRoots:                  make([]phase0.Root, 8192),
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           []byte{0x00},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+}
+
+func testPhase0Block(slot phase0.Slot) *phase0.SignedBeaconBlock {
+	return &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: phase0.ValidatorIndex(slot) + 100,
+			ParentRoot:    phase0.Root{byte(slot)},
+			StateRoot:     phase0.Root{0x02},
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			},
+		},
+		Signature: phase0.BLSSignature{byte(slot)},
+	}
+}
+
+func testAltairBlock(slot phase0.Slot) *altair.SignedBeaconBlock {
+	return &altair.SignedBeaconBlock{
+		Message: &altair.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: phase0.ValidatorIndex(slot) + 100,
+			ParentRoot:    phase0.Root{byte(slot)},
+			StateRoot:     phase0.Root{0x03},
+			Body: &altair.BeaconBlockBody{
+				ETH1Data:      &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				SyncAggregate: &altair.SyncAggregate{SyncCommitteeBits: make([]byte, 64)},
+			},
+		},
+		Signature: phase0.BLSSignature{byte(slot)},
+	}
+}
+
+type marshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+func writeSSZ(t *testing.T, path string, item marshaler) {
+	data, err := item.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+// testFiles writes a configuration, genesis state and blocks to a temporary directory,
+// returning the paths.
+func testFiles(t *testing.T) (string, string, string) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(testConfig), 0o600))
+	genesisPath := filepath.Join(dir, "genesis.ssz")
+	writeSSZ(t, genesisPath, testGenesisState())
+
+	blocksDir := filepath.Join(dir, "blocks")
+	require.NoError(t, os.Mkdir(blocksDir, 0o700))
+	writeSSZ(t, filepath.Join(blocksDir, "1.ssz"), testPhase0Block(1))
+	writeSSZ(t, filepath.Join(blocksDir, "2.ssz"), testPhase0Block(2))
+	writeSSZ(t, filepath.Join(blocksDir, "17.ssz"), testAltairBlock(17))
+	require.NoError(t, os.WriteFile(filepath.Join(blocksDir, "README"), []byte("ignored"), 0o600))
+
+	return configPath, genesisPath, blocksDir
+}
+
+func TestInterfaces(t *testing.T) {
+	configPath, genesisPath, _ := testFiles(t)
+	s, err := offline.New(context.Background(),
+		offline.WithConfig(configPath),
+		offline.WithGenesis(genesisPath),
+	)
+	require.NoError(t, err)
+
+	assert.Implements(t, (*consensusclient.BeaconBlockHeadersProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.BeaconBlockRootProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.BeaconStateProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.FarFutureEpochProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.GenesisProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.GenesisTimeProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.GenesisValidatorsRootProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.NodeVersionProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.Service)(nil), s)
+	assert.Implements(t, (*consensusclient.SignedBeaconBlockProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.SlotDurationProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.SlotsPerEpochProvider)(nil), s)
+	assert.Implements(t, (*consensusclient.SpecProvider)(nil), s)
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	configPath, genesisPath, blocksDir := testFiles(t)
+	dir := t.TempDir()
+	badPath := filepath.Join(dir, "bad.ssz")
+	require.NoError(t, os.WriteFile(badPath, []byte{0x01, 0x02}, 0o600))
+	dupDir := filepath.Join(dir, "dup")
+	require.NoError(t, os.Mkdir(dupDir, 0o700))
+	writeSSZ(t, filepath.Join(dupDir, "a.ssz"), testPhase0Block(1))
+	dup := testPhase0Block(1)
+	dup.Message.ProposerIndex = 1
+	writeSSZ(t, filepath.Join(dupDir, "b.ssz"), dup)
+
+	tests := []struct {
+		name   string
+		params []offline.Parameter
+		err    string
+	}{
+		{
+			name:   "ConfigMissing",
+			params: []offline.Parameter{offline.WithGenesis(genesisPath)},
+			err:    "problem with parameters: no config specified",
+		},
+		{
+			name:   "GenesisMissing",
+			params: []offline.Parameter{offline.WithConfig(configPath)},
+			err:    "problem with parameters: no genesis specified",
+		},
+		{
+			name:   "NameEmpty",
+			params: []offline.Parameter{offline.WithName(""), offline.WithConfig(configPath), offline.WithGenesis(genesisPath)},
+			err:    "problem with parameters: no name specified",
+		},
+		{
+			name:   "ConfigNotFound",
+			params: []offline.Parameter{offline.WithConfig(filepath.Join(dir, "missing.yaml")), offline.WithGenesis(genesisPath)},
+			err:    "failed to load config: failed to read file",
+		},
+		{
+			name:   "PresetNotFound",
+			params: []offline.Parameter{offline.WithConfig(configPath), offline.WithPresets([]string{filepath.Join(dir, "missing.yaml")}), offline.WithGenesis(genesisPath)},
+			err:    "failed to load config: failed to load preset",
+		},
+		{
+			name:   "GenesisBad",
+			params: []offline.Parameter{offline.WithConfig(configPath), offline.WithGenesis(badPath)},
+			err:    "failed to load genesis: failed to decode phase0 genesis state",
+		},
+		{
+			name:   "BlocksDuplicateSlot",
+			params: []offline.Parameter{offline.WithConfig(configPath), offline.WithGenesis(genesisPath), offline.WithBlocksDir(dupDir)},
+			err:    "failed to load blocks: multiple blocks for slot 1",
+		},
+		{
+			name:   "Good",
+			params: []offline.Parameter{offline.WithConfig(configPath), offline.WithGenesis(genesisPath), offline.WithBlocksDir(blocksDir)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := offline.New(ctx, test.params...)
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPresets(t *testing.T) {
+	ctx := context.Background()
+	_, genesisPath, _ := testFiles(t)
+	dir := t.TempDir()
+
+	tests := []struct {
+		name          string
+		config        string
+		preset        string
+		slotsPerEpoch uint64
+		err           string
+	}{
+		{
+			name:   "Missing",
+			config: "PRESET_BASE: 'custom'\n",
+			err:    "SLOTS_PER_EPOCH not found in config",
+		},
+		{
+			name:          "PresetBase",
+			config:        "PRESET_BASE: 'mainnet'\n",
+			slotsPerEpoch: 32,
+		},
+		{
+			name:          "PresetFile",
+			config:        "PRESET_BASE: 'custom'\n",
+			preset:        "SLOTS_PER_EPOCH: 4\n",
+			slotsPerEpoch: 4,
+		},
+		{
+			name:          "ConfigOverrides",
+			config:        "PRESET_BASE: 'mainnet'\nSLOTS_PER_EPOCH: 16\n",
+			preset:        "SLOTS_PER_EPOCH: 4\n",
+			slotsPerEpoch: 16,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configPath := filepath.Join(dir, test.name+"-config.yaml")
+			require.NoError(t, os.WriteFile(configPath, []byte(test.config), 0o600))
+			params := []offline.Parameter{offline.WithConfig(configPath), offline.WithGenesis(genesisPath)}
+			if test.preset != "" {
+				presetPath := filepath.Join(dir, test.name+"-preset.yaml")
+				require.NoError(t, os.WriteFile(presetPath, []byte(test.preset), 0o600))
+				params = append(params, offline.WithPresets([]string{presetPath}))
+			}
+			s, err := offline.New(ctx, params...)
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.slotsPerEpoch, slotsPerEpoch)
+		})
+	}
+}
+
+func TestProviders(t *testing.T) {
+	ctx := context.Background()
+	configPath, genesisPath, blocksDir := testFiles(t)
+	s, err := offline.New(ctx,
+		offline.WithName("fixtures"),
+		offline.WithConfig(configPath),
+		offline.WithGenesis(genesisPath),
+		offline.WithBlocksDir(blocksDir),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fixtures", s.Address())
+
+	specData, err := s.Spec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "test", specData["CONFIG_NAME"])
+	require.Equal(t, phase0.Version{0x01, 0x00, 0x00, 0x01}, specData["ALTAIR_FORK_VERSION"])
+	slotDuration, err := s.SlotDuration(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 6*time.Second, slotDuration)
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), slotsPerEpoch)
+
+	genesis, err := s.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1606824023, 0), genesis.GenesisTime)
+	require.Equal(t, phase0.Root{0x01}, genesis.GenesisValidatorsRoot)
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x01}, genesis.GenesisForkVersion)
+
+	state, err := s.BeaconState(ctx, "genesis")
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionPhase0, state.Version)
+	_, err = s.BeaconState(ctx, "head")
+	require.EqualError(t, err, "state head not available offline")
+
+	syncState, err := s.NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(17), syncState.HeadSlot)
+
+	block, err := s.SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionAltair, block.Version)
+	block, err = s.SignedBeaconBlock(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionPhase0, block.Version)
+	require.Equal(t, phase0.Slot(2), block.Phase0.Message.Slot)
+	block, err = s.SignedBeaconBlock(ctx, "3")
+	require.NoError(t, err)
+	require.Nil(t, block)
+	_, err = s.SignedBeaconBlock(ctx, "finalized")
+	require.EqualError(t, err, "block ID finalized not available offline")
+
+	expectedRoot, err := testPhase0Block(1).Message.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := s.BeaconBlockRoot(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expectedRoot), *root)
+	block, err = s.SignedBeaconBlock(ctx, root.String())
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(1), block.Phase0.Message.Slot)
+
+	header, err := s.BeaconBlockHeader(ctx, "17")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(17), header.Header.Message.Slot)
+	require.Equal(t, phase0.ValidatorIndex(117), header.Header.Message.ProposerIndex)
+	require.Equal(t, phase0.Root{0x03}, header.Header.Message.StateRoot)
+	headerRoot, err := header.Header.Message.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, header.Root, phase0.Root(headerRoot))
+}
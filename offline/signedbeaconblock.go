@@ -0,0 +1,172 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// signedBlockSlotOffset is the offset of the slot in an SSZ-encoded signed beacon block,
+// after the message offset and the signature.  It is the same for all versions.
+const signedBlockSlotOffset = 4 + 96
+
+// decodeSignedBeaconBlock decodes an SSZ-encoded signed beacon block, using its slot
+// to select the version.
+func (s *Service) decodeSignedBeaconBlock(data []byte) (*spec.VersionedSignedBeaconBlock, error) {
+	if len(data) < signedBlockSlotOffset+8 {
+		return nil, errors.New("block data too short")
+	}
+	slot := phase0.Slot(binary.LittleEndian.Uint64(data[signedBlockSlotOffset : signedBlockSlotOffset+8]))
+	version, err := s.versionAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		block.Phase0 = &phase0.SignedBeaconBlock{}
+		err = block.Phase0.UnmarshalSSZ(data)
+	case spec.DataVersionAltair:
+		block.Altair = &altair.SignedBeaconBlock{}
+		err = block.Altair.UnmarshalSSZ(data)
+	case spec.DataVersionBellatrix:
+		block.Bellatrix = &bellatrix.SignedBeaconBlock{}
+		err = block.Bellatrix.UnmarshalSSZ(data)
+	case spec.DataVersionCapella:
+		block.Capella = &capella.SignedBeaconBlock{}
+		err = block.Capella.UnmarshalSSZ(data)
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s block", version)
+	}
+
+	return block, nil
+}
+
+// blockRoot returns the root of the block with the given ID, or false if it is not present.
+// Supported block IDs are "head", "genesis", a slot or a block root.
+func (s *Service) blockRoot(blockID string) (phase0.Root, bool, error) {
+	var slot phase0.Slot
+	switch {
+	case blockID == "head":
+		slot = s.headSlot
+	case blockID == "genesis":
+		slot = 0
+	case strings.HasPrefix(blockID, "0x"):
+		data, err := hex.DecodeString(strings.TrimPrefix(blockID, "0x"))
+		if err != nil || len(data) != phase0.RootLength {
+			return phase0.Root{}, false, fmt.Errorf("invalid block ID %s", blockID)
+		}
+		var root phase0.Root
+		copy(root[:], data)
+		_, exists := s.blocks[root]
+		return root, exists, nil
+	default:
+		tmp, err := strconv.ParseUint(blockID, 10, 64)
+		if err != nil {
+			return phase0.Root{}, false, fmt.Errorf("block ID %s not available offline", blockID)
+		}
+		slot = phase0.Slot(tmp)
+	}
+
+	root, exists := s.blocksBySlot[slot]
+	return root, exists, nil
+}
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID.
+// If the block is not available this returns nil for both the block and the error.
+func (s *Service) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	root, exists, err := s.blockRoot(blockID)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return s.blocks[root], nil
+}
+
+// BeaconBlockRoot fetches a block's root given a block ID.
+// If the block is not available this returns nil for both the root and the error.
+func (s *Service) BeaconBlockRoot(_ context.Context, blockID string) (*phase0.Root, error) {
+	root, exists, err := s.blockRoot(blockID)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return &root, nil
+}
+
+// BeaconBlockHeader provides the block header of a given block ID.
+// If the block is not available this returns nil for both the header and the error.
+func (s *Service) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	root, exists, err := s.blockRoot(blockID)
+	if err != nil || !exists {
+		return nil, err
+	}
+	block := s.blocks[root]
+
+	header := &phase0.SignedBeaconBlockHeader{
+		Message: &phase0.BeaconBlockHeader{},
+	}
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		header.Message.Slot = block.Phase0.Message.Slot
+		header.Message.ProposerIndex = block.Phase0.Message.ProposerIndex
+		header.Signature = block.Phase0.Signature
+	case spec.DataVersionAltair:
+		header.Message.Slot = block.Altair.Message.Slot
+		header.Message.ProposerIndex = block.Altair.Message.ProposerIndex
+		header.Signature = block.Altair.Signature
+	case spec.DataVersionBellatrix:
+		header.Message.Slot = block.Bellatrix.Message.Slot
+		header.Message.ProposerIndex = block.Bellatrix.Message.ProposerIndex
+		header.Signature = block.Bellatrix.Signature
+	case spec.DataVersionCapella:
+		header.Message.Slot = block.Capella.Message.Slot
+		header.Message.ProposerIndex = block.Capella.Message.ProposerIndex
+		header.Signature = block.Capella.Signature
+	}
+	if header.Message.ParentRoot, err = block.ParentRoot(); err != nil {
+		return nil, err
+	}
+	if header.Message.StateRoot, err = block.StateRoot(); err != nil {
+		return nil, err
+	}
+	if header.Message.BodyRoot, err = block.BodyRoot(); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.BeaconBlockHeader{
+		Root:      root,
+		Canonical: true,
+		Header:    header,
+	}, nil
+}
@@ -0,0 +1,241 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// loadConfig loads the chain configuration from the given file, along with preset values
+// from the given preset files and the well-known preset named by PRESET_BASE.
+func (s *Service) loadConfig(path string, presetPaths []string) error {
+	values, err := readConfig(path)
+	if err != nil {
+		return err
+	}
+	for _, presetPath := range presetPaths {
+		presetValues, err := readConfig(presetPath)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load preset %s", presetPath)
+		}
+		addMissing(values, presetValues)
+	}
+	if preset, exists := presets[values["PRESET_BASE"]]; exists {
+		addMissing(values, preset)
+	}
+
+	s.spec = make(map[string]interface{}, len(values))
+	for k, v := range values {
+		s.spec[k] = specValue(k, v)
+	}
+
+	s.forkEpochs = []*forkEpoch{{version: spec.DataVersionPhase0}}
+	for _, fork := range []struct {
+		key     string
+		version spec.DataVersion
+	}{
+		{key: "ALTAIR_FORK_EPOCH", version: spec.DataVersionAltair},
+		{key: "BELLATRIX_FORK_EPOCH", version: spec.DataVersionBellatrix},
+		{key: "CAPELLA_FORK_EPOCH", version: spec.DataVersionCapella},
+	} {
+		tmp, exists := s.spec[fork.key]
+		if !exists {
+			break
+		}
+		epoch, isEpoch := tmp.(uint64)
+		if !isEpoch {
+			return fmt.Errorf("%s of unexpected type", fork.key)
+		}
+		s.forkEpochs = append(s.forkEpochs, &forkEpoch{version: fork.version, epoch: phase0.Epoch(epoch)})
+	}
+
+	return nil
+}
+
+// readConfig reads and parses the configuration in the given file.
+func readConfig(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+
+	return parseConfig(data)
+}
+
+// addMissing adds the values to the configuration where they are not already present.
+func addMissing(values map[string]string, additional map[string]string) {
+	for k, v := range additional {
+		if _, exists := values[k]; !exists {
+			values[k] = v
+		}
+	}
+}
+
+// parseConfig parses the flat key/value YAML of a chain configuration.
+// A general YAML parser is not used, as it would interpret values such as fork versions
+// as hex integers, losing their width.
+func parseConfig(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if idx := strings.Index(text, "#"); idx != -1 {
+			text = text[:idx]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		parts := strings.SplitN(text, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid config at line %d", line)
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.Trim(strings.TrimSpace(parts[1]), `'"`)
+		if key == "" {
+			return nil, fmt.Errorf("missing key at line %d", line)
+		}
+		values[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+
+	return values, nil
+}
+
+// specValue converts a configuration value to the type used by the spec provider.
+// This follows the conversion carried out by the HTTP client.
+func specValue(k string, v string) interface{} {
+	// Handle domains.
+	if strings.HasPrefix(k, "DOMAIN_") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			var domainType phase0.DomainType
+			copy(domainType[:], byteVal)
+			return domainType
+		}
+	}
+
+	// Handle fork versions.
+	if strings.HasSuffix(k, "_FORK_VERSION") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			var version phase0.Version
+			copy(version[:], byteVal)
+			return version
+		}
+	}
+
+	// Handle hex strings.
+	if strings.HasPrefix(v, "0x") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			return byteVal
+		}
+	}
+
+	// Handle times.
+	if strings.HasSuffix(k, "_TIME") {
+		intVal, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && intVal != 0 {
+			return time.Unix(intVal, 0)
+		}
+	}
+
+	// Handle durations.
+	if strings.HasPrefix(k, "SECONDS_PER_") || k == "GENESIS_DELAY" {
+		intVal, err := strconv.ParseUint(v, 10, 64)
+		if err == nil && intVal != 0 {
+			return time.Duration(intVal) * time.Second
+		}
+	}
+
+	// Handle integers.
+	intVal, err := strconv.ParseUint(v, 10, 64)
+	if err == nil {
+		return intVal
+	}
+
+	// Assume string.
+	return v
+}
+
+// versionAtSlot returns the data version in effect at the given slot.
+func (s *Service) versionAtSlot(slot phase0.Slot) (spec.DataVersion, error) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(context.Background())
+	if err != nil {
+		return spec.DataVersionPhase0, err
+	}
+	epoch := phase0.Epoch(uint64(slot) / slotsPerEpoch)
+
+	version := spec.DataVersionPhase0
+	for _, fork := range s.forkEpochs {
+		if fork.epoch <= epoch {
+			version = fork.version
+		}
+	}
+
+	return version, nil
+}
+
+// Spec provides the spec information of the chain.
+func (s *Service) Spec(_ context.Context) (map[string]interface{}, error) {
+	return s.spec, nil
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (s *Service) SlotDuration(_ context.Context) (time.Duration, error) {
+	tmp, exists := s.spec["SECONDS_PER_SLOT"]
+	if !exists {
+		return 0, errors.New("SECONDS_PER_SLOT not found in config")
+	}
+	slotDuration, isDuration := tmp.(time.Duration)
+	if !isDuration {
+		return 0, errors.New("SECONDS_PER_SLOT of unexpected type")
+	}
+
+	return slotDuration, nil
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+func (s *Service) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	tmp, exists := s.spec["SLOTS_PER_EPOCH"]
+	if !exists {
+		return 0, errors.New("SLOTS_PER_EPOCH not found in config")
+	}
+	slotsPerEpoch, isUint := tmp.(uint64)
+	if !isUint || slotsPerEpoch == 0 {
+		return 0, errors.New("SLOTS_PER_EPOCH of unexpected type")
+	}
+
+	return slotsPerEpoch, nil
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Service) FarFutureEpoch(_ context.Context) (phase0.Epoch, error) {
+	return phase0.Epoch(0xffffffffffffffff), nil
+}
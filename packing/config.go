@@ -0,0 +1,78 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packing
+
+import (
+	"fmt"
+)
+
+// Config contains the spec values required to pack attestations and operations.
+// The maximum numbers of operations other than attestations are 0 if not present in
+// the spec, in which case no operations of that type are packed.
+type Config struct {
+	MaxAttestations          uint64
+	MaxProposerSlashings     uint64
+	MaxAttesterSlashings     uint64
+	MaxVoluntaryExits        uint64
+	MaxBLSToExecutionChanges uint64
+}
+
+// NewConfig creates a configuration from a spec, as returned by a SpecProvider.
+func NewConfig(spec map[string]interface{}) (*Config, error) {
+	config := &Config{}
+
+	uintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "MAX_ATTESTATIONS", value: &config.MaxAttestations},
+	}
+	for _, uintValue := range uintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", uintValue.key)
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		if val == 0 {
+			return nil, fmt.Errorf("%s must be greater than 0", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+
+	optionalUintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "MAX_PROPOSER_SLASHINGS", value: &config.MaxProposerSlashings},
+		{key: "MAX_ATTESTER_SLASHINGS", value: &config.MaxAttesterSlashings},
+		{key: "MAX_VOLUNTARY_EXITS", value: &config.MaxVoluntaryExits},
+		{key: "MAX_BLS_TO_EXECUTION_CHANGES", value: &config.MaxBLSToExecutionChanges},
+	}
+	for _, uintValue := range optionalUintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			continue
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+
+	return config, nil
+}
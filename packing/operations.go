@@ -0,0 +1,221 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packing
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Operations are the operations in a block body other than attestations and deposits.
+type Operations struct {
+	ProposerSlashings     []*phase0.ProposerSlashing
+	AttesterSlashings     []*phase0.AttesterSlashing
+	VoluntaryExits        []*phase0.SignedVoluntaryExit
+	BLSToExecutionChanges []*capella.SignedBLSToExecutionChange
+}
+
+// PackOperations selects the operations to include in a block body from the supplied
+// candidates, such that the block remains valid:
+//
+//   - proposer slashings are slashable, with at most one per proposer, ordered by proposer index
+//   - attester slashings are slashable, and each slashes at least one validator not slashed by
+//     an operation before it, ordered by the number of validators newly slashed
+//   - voluntary exits are for validators not slashed in the block, with at most one per
+//     validator, ordered by validator index
+//   - BLS to execution changes are at most one per validator, ordered by validator index
+//
+// Each type of operation is limited to its maximum number in the configuration.
+// Operations are not checked against the beacon state, so callers should remove any
+// whose validators have already been slashed or exited, or have already changed their
+// withdrawal credentials, before packing.
+func PackOperations(config *Config, candidates *Operations) (*Operations, error) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if candidates == nil {
+		return nil, errors.New("no operations supplied")
+	}
+
+	slashed := make(map[phase0.ValidatorIndex]bool)
+	res := &Operations{}
+	res.ProposerSlashings = packProposerSlashings(config.MaxProposerSlashings, candidates.ProposerSlashings, slashed)
+	res.AttesterSlashings = packAttesterSlashings(config.MaxAttesterSlashings, candidates.AttesterSlashings, slashed)
+	res.VoluntaryExits = packVoluntaryExits(config.MaxVoluntaryExits, candidates.VoluntaryExits, slashed)
+	res.BLSToExecutionChanges = packBLSToExecutionChanges(config.MaxBLSToExecutionChanges, candidates.BLSToExecutionChanges)
+
+	return res, nil
+}
+
+// packProposerSlashings packs proposer slashings, adding the slashed proposers to slashed.
+func packProposerSlashings(max uint64,
+	candidates []*phase0.ProposerSlashing,
+	slashed map[phase0.ValidatorIndex]bool,
+) []*phase0.ProposerSlashing {
+	res := make([]*phase0.ProposerSlashing, 0)
+	for _, candidate := range candidates {
+		if candidate == nil || !phase0.IsSlashableBlockProposal(candidate.SignedHeader1, candidate.SignedHeader2) {
+			continue
+		}
+		if slashed[candidate.SignedHeader1.Message.ProposerIndex] {
+			continue
+		}
+		slashed[candidate.SignedHeader1.Message.ProposerIndex] = true
+		res = append(res, candidate)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].SignedHeader1.Message.ProposerIndex < res[j].SignedHeader1.Message.ProposerIndex
+	})
+	if uint64(len(res)) > max {
+		for _, dropped := range res[max:] {
+			delete(slashed, dropped.SignedHeader1.Message.ProposerIndex)
+		}
+		res = res[:max]
+	}
+
+	return res
+}
+
+// packAttesterSlashings packs attester slashings, adding the slashed attesters to slashed.
+// Selection is greedy, taking the slashing that slashes the most validators not already
+// slashed at each step.
+func packAttesterSlashings(max uint64,
+	candidates []*phase0.AttesterSlashing,
+	slashed map[phase0.ValidatorIndex]bool,
+) []*phase0.AttesterSlashing {
+	type attesterSlashing struct {
+		slashing *phase0.AttesterSlashing
+		indices  []phase0.ValidatorIndex
+		selected bool
+	}
+	pool := make([]*attesterSlashing, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate == nil || candidate.Attestation1 == nil || candidate.Attestation2 == nil ||
+			!phase0.IsSlashableAttestationData(candidate.Attestation1.Data, candidate.Attestation2.Data) {
+			continue
+		}
+		pool = append(pool, &attesterSlashing{
+			slashing: candidate,
+			indices:  slashableIndices(candidate),
+		})
+	}
+
+	res := make([]*phase0.AttesterSlashing, 0)
+	for uint64(len(res)) < max {
+		var best *attesterSlashing
+		bestCount := 0
+		for _, candidate := range pool {
+			if candidate.selected {
+				continue
+			}
+			count := 0
+			for _, index := range candidate.indices {
+				if !slashed[index] {
+					count++
+				}
+			}
+			if count > bestCount {
+				best = candidate
+				bestCount = count
+			}
+		}
+		if best == nil {
+			// Nothing left that slashes a new validator.
+			break
+		}
+
+		best.selected = true
+		for _, index := range best.indices {
+			slashed[index] = true
+		}
+		res = append(res, best.slashing)
+	}
+
+	return res
+}
+
+// slashableIndices returns the indices of the validators in both attestations of the slashing.
+func slashableIndices(slashing *phase0.AttesterSlashing) []phase0.ValidatorIndex {
+	attesters := make(map[uint64]bool, len(slashing.Attestation1.AttestingIndices))
+	for _, index := range slashing.Attestation1.AttestingIndices {
+		attesters[index] = true
+	}
+	res := make([]phase0.ValidatorIndex, 0)
+	for _, index := range slashing.Attestation2.AttestingIndices {
+		if attesters[index] {
+			res = append(res, phase0.ValidatorIndex(index))
+			delete(attesters, index)
+		}
+	}
+
+	return res
+}
+
+// packVoluntaryExits packs voluntary exits for validators that have not been slashed.
+func packVoluntaryExits(max uint64,
+	candidates []*phase0.SignedVoluntaryExit,
+	slashed map[phase0.ValidatorIndex]bool,
+) []*phase0.SignedVoluntaryExit {
+	exiting := make(map[phase0.ValidatorIndex]bool)
+	res := make([]*phase0.SignedVoluntaryExit, 0)
+	for _, candidate := range candidates {
+		if candidate == nil || candidate.Message == nil {
+			continue
+		}
+		index := candidate.Message.ValidatorIndex
+		if slashed[index] || exiting[index] {
+			continue
+		}
+		exiting[index] = true
+		res = append(res, candidate)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Message.ValidatorIndex < res[j].Message.ValidatorIndex
+	})
+	if uint64(len(res)) > max {
+		res = res[:max]
+	}
+
+	return res
+}
+
+// packBLSToExecutionChanges packs BLS to execution changes.
+func packBLSToExecutionChanges(max uint64,
+	candidates []*capella.SignedBLSToExecutionChange,
+) []*capella.SignedBLSToExecutionChange {
+	changing := make(map[phase0.ValidatorIndex]bool)
+	res := make([]*capella.SignedBLSToExecutionChange, 0)
+	for _, candidate := range candidates {
+		if candidate == nil || candidate.Message == nil {
+			continue
+		}
+		index := candidate.Message.ValidatorIndex
+		if changing[index] {
+			continue
+		}
+		changing[index] = true
+		res = append(res, candidate)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Message.ValidatorIndex < res[j].Message.ValidatorIndex
+	})
+	if uint64(len(res)) > max {
+		res = res[:max]
+	}
+
+	return res
+}
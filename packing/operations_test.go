@@ -0,0 +1,253 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packing_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/packing"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func proposerSlashing(index phase0.ValidatorIndex) *phase0.ProposerSlashing {
+	header := func(root byte) *phase0.SignedBeaconBlockHeader {
+		return &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          1,
+				ProposerIndex: index,
+				BodyRoot:      phase0.Root{root},
+			},
+		}
+	}
+	return &phase0.ProposerSlashing{
+		SignedHeader1: header(1),
+		SignedHeader2: header(2),
+	}
+}
+
+func attesterSlashing(indices1 []uint64, indices2 []uint64) *phase0.AttesterSlashing {
+	attestation := func(root byte, indices []uint64) *phase0.IndexedAttestation {
+		return &phase0.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &phase0.AttestationData{
+				Slot:            1,
+				BeaconBlockRoot: phase0.Root{root},
+				Source:          &phase0.Checkpoint{},
+				Target:          &phase0.Checkpoint{Epoch: 1},
+			},
+		}
+	}
+	return &phase0.AttesterSlashing{
+		Attestation1: attestation(1, indices1),
+		Attestation2: attestation(2, indices2),
+	}
+}
+
+func voluntaryExit(index phase0.ValidatorIndex) *phase0.SignedVoluntaryExit {
+	return &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{ValidatorIndex: index},
+	}
+}
+
+func blsToExecutionChange(index phase0.ValidatorIndex) *capella.SignedBLSToExecutionChange {
+	return &capella.SignedBLSToExecutionChange{
+		Message: &capella.BLSToExecutionChange{ValidatorIndex: index},
+	}
+}
+
+func TestNewConfigOperations(t *testing.T) {
+	config, err := packing.NewConfig(map[string]interface{}{"MAX_ATTESTATIONS": uint64(128)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), config.MaxProposerSlashings)
+
+	_, err = packing.NewConfig(map[string]interface{}{
+		"MAX_ATTESTATIONS":       uint64(128),
+		"MAX_PROPOSER_SLASHINGS": "16",
+	})
+	require.EqualError(t, err, "MAX_PROPOSER_SLASHINGS of unexpected type")
+
+	config, err = packing.NewConfig(map[string]interface{}{
+		"MAX_ATTESTATIONS":             uint64(128),
+		"MAX_PROPOSER_SLASHINGS":       uint64(16),
+		"MAX_ATTESTER_SLASHINGS":       uint64(2),
+		"MAX_VOLUNTARY_EXITS":          uint64(16),
+		"MAX_BLS_TO_EXECUTION_CHANGES": uint64(16),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), config.MaxProposerSlashings)
+	require.Equal(t, uint64(2), config.MaxAttesterSlashings)
+	require.Equal(t, uint64(16), config.MaxVoluntaryExits)
+	require.Equal(t, uint64(16), config.MaxBLSToExecutionChanges)
+}
+
+func TestPackOperations(t *testing.T) {
+	config := &packing.Config{
+		MaxAttestations:          128,
+		MaxProposerSlashings:     2,
+		MaxAttesterSlashings:     2,
+		MaxVoluntaryExits:        3,
+		MaxBLSToExecutionChanges: 2,
+	}
+
+	unslashableProposer := proposerSlashing(9)
+	unslashableProposer.SignedHeader2 = unslashableProposer.SignedHeader1
+	unslashableAttester := attesterSlashing([]uint64{10}, []uint64{10})
+	unslashableAttester.Attestation2.Data = unslashableAttester.Attestation1.Data
+	// A surround vote with the surrounded attestation first is not a valid slashing.
+	misorderedAttester := attesterSlashing([]uint64{12}, []uint64{12})
+	misorderedAttester.Attestation1.Data.Source.Epoch = 2
+	misorderedAttester.Attestation1.Data.Target.Epoch = 3
+	misorderedAttester.Attestation2.Data.Source.Epoch = 1
+	misorderedAttester.Attestation2.Data.Target.Epoch = 4
+
+	proposer5 := proposerSlashing(5)
+	proposer3 := proposerSlashing(3)
+	proposer4 := proposerSlashing(4)
+	attesterSmall := attesterSlashing([]uint64{1, 2}, []uint64{2})
+	attesterLarge := attesterSlashing([]uint64{1, 2, 3, 7}, []uint64{1, 2, 3, 7, 8})
+	attesterCovered := attesterSlashing([]uint64{1, 7}, []uint64{1, 7})
+	attesterOther := attesterSlashing([]uint64{11}, []uint64{11})
+
+	tests := []struct {
+		name       string
+		config     *packing.Config
+		candidates *packing.Operations
+		expected   *packing.Operations
+		err        string
+	}{
+		{
+			name:       "ConfigMissing",
+			candidates: &packing.Operations{},
+			err:        "no config supplied",
+		},
+		{
+			name:   "OperationsMissing",
+			config: config,
+			err:    "no operations supplied",
+		},
+		{
+			name:       "Empty",
+			config:     config,
+			candidates: &packing.Operations{},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{},
+				AttesterSlashings:     []*phase0.AttesterSlashing{},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+		{
+			name:   "NoneAllowed",
+			config: &packing.Config{MaxAttestations: 128},
+			candidates: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{proposer3},
+				AttesterSlashings:     []*phase0.AttesterSlashing{attesterSmall},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{voluntaryExit(1)},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{blsToExecutionChange(1)},
+			},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{},
+				AttesterSlashings:     []*phase0.AttesterSlashing{},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+		{
+			name:   "ProposerSlashings",
+			config: config,
+			candidates: &packing.Operations{
+				ProposerSlashings: []*phase0.ProposerSlashing{nil, unslashableProposer, proposer5, proposer4, proposer3, proposerSlashing(3)},
+			},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{proposer3, proposer4},
+				AttesterSlashings:     []*phase0.AttesterSlashing{},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+		{
+			name:   "AttesterSlashings",
+			config: config,
+			candidates: &packing.Operations{
+				AttesterSlashings: []*phase0.AttesterSlashing{nil, unslashableAttester, misorderedAttester, attesterSmall, attesterCovered, attesterLarge},
+			},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{},
+				AttesterSlashings:     []*phase0.AttesterSlashing{attesterLarge},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+		{
+			name:   "AttesterSlashingsAfterProposerSlashings",
+			config: config,
+			candidates: &packing.Operations{
+				ProposerSlashings: []*phase0.ProposerSlashing{proposerSlashing(11)},
+				AttesterSlashings: []*phase0.AttesterSlashing{attesterOther, attesterSmall},
+			},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{proposerSlashing(11)},
+				AttesterSlashings:     []*phase0.AttesterSlashing{attesterSmall},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+		{
+			name:   "VoluntaryExits",
+			config: config,
+			candidates: &packing.Operations{
+				ProposerSlashings: []*phase0.ProposerSlashing{proposer3},
+				AttesterSlashings: []*phase0.AttesterSlashing{attesterSmall},
+				VoluntaryExits: []*phase0.SignedVoluntaryExit{
+					nil, voluntaryExit(6), voluntaryExit(2), voluntaryExit(3), voluntaryExit(5), voluntaryExit(6), voluntaryExit(4),
+				},
+			},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{proposer3},
+				AttesterSlashings:     []*phase0.AttesterSlashing{attesterSmall},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{voluntaryExit(4), voluntaryExit(5), voluntaryExit(6)},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+		{
+			name:   "BLSToExecutionChanges",
+			config: config,
+			candidates: &packing.Operations{
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{
+					nil, blsToExecutionChange(3), blsToExecutionChange(1), blsToExecutionChange(3), blsToExecutionChange(2),
+				},
+			},
+			expected: &packing.Operations{
+				ProposerSlashings:     []*phase0.ProposerSlashing{},
+				AttesterSlashings:     []*phase0.AttesterSlashing{},
+				VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{blsToExecutionChange(1), blsToExecutionChange(2)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := packing.PackOperations(test.config, test.candidates)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package packing selects the attestations to include in a block body from a pool
+// of candidate aggregates.
+package packing
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// attester identifies a single validator's vote, by its committee and position within it.
+type attester struct {
+	slot           phase0.Slot
+	committeeIndex phase0.CommitteeIndex
+	position       uint64
+}
+
+// candidate is an attestation under consideration for inclusion.
+type candidate struct {
+	attestation *phase0.Attestation
+	attesters   []attester
+	selected    bool
+}
+
+// ProfitFunc returns the value of including the vote of the validator at the given
+// position of the given attestation's committee.  It is called only for votes that
+// are not already included by a previously selected attestation.
+type ProfitFunc func(attestation *phase0.Attestation, position uint64) uint64
+
+// UniformProfit values every newly included vote equally, which maximises the
+// number of votes included in the block.
+func UniformProfit(_ *phase0.Attestation, _ uint64) uint64 {
+	return 1
+}
+
+// Pack selects up to MAX_ATTESTATIONS attestations from the supplied candidates, maximising
+// the total profit of the votes they include.  Votes are counted at most once: an attestation
+// only contributes the votes that are not already covered by an attestation selected before
+// it, and attestations that would add nothing are not selected.
+//
+// Selection is greedy, taking the attestation with the highest marginal profit at each step.
+// Choosing the optimal set is NP-hard in general, but the greedy result is guaranteed to be
+// within a factor of (1-1/e) of optimal, and is optimal for the common case where aggregates
+// for each committee are disjoint.
+//
+// included contains attestations that have already been included on chain, and whose votes
+// are therefore worthless; it can be nil.  If profit is nil UniformProfit is used.
+//
+// The returned attestations are in the order they were selected, highest marginal profit first.
+func Pack(config *Config,
+	candidates []*phase0.Attestation,
+	included []*phase0.Attestation,
+	profit ProfitFunc,
+) (
+	[]*phase0.Attestation,
+	error,
+) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if profit == nil {
+		profit = UniformProfit
+	}
+
+	covered := make(map[attester]bool)
+	for _, attestation := range included {
+		attesters, err := attestersOf(attestation)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid included attestation")
+		}
+		for _, attester := range attesters {
+			covered[attester] = true
+		}
+	}
+
+	pool := make([]*candidate, 0, len(candidates))
+	for _, attestation := range candidates {
+		attesters, err := attestersOf(attestation)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid candidate attestation")
+		}
+		pool = append(pool, &candidate{
+			attestation: attestation,
+			attesters:   attesters,
+		})
+	}
+
+	res := make([]*phase0.Attestation, 0)
+	for uint64(len(res)) < config.MaxAttestations {
+		var best *candidate
+		bestProfit := uint64(0)
+		for _, candidate := range pool {
+			if candidate.selected {
+				continue
+			}
+			candidateProfit := uint64(0)
+			for _, attester := range candidate.attesters {
+				if !covered[attester] {
+					candidateProfit += profit(candidate.attestation, attester.position)
+				}
+			}
+			if candidateProfit > bestProfit {
+				best = candidate
+				bestProfit = candidateProfit
+			}
+		}
+		if best == nil {
+			// Nothing left that adds value.
+			break
+		}
+
+		best.selected = true
+		for _, attester := range best.attesters {
+			covered[attester] = true
+		}
+		res = append(res, best.attestation)
+	}
+
+	return res, nil
+}
+
+// attestersOf returns the attesters whose votes are included in the attestation.
+func attestersOf(attestation *phase0.Attestation) ([]attester, error) {
+	if attestation == nil {
+		return nil, errors.New("attestation missing")
+	}
+	if attestation.Data == nil {
+		return nil, errors.New("attestation data missing")
+	}
+	if attestation.AggregationBits == nil {
+		return nil, errors.New("aggregation bits missing")
+	}
+
+	attesters := make([]attester, 0, attestation.AggregationBits.Count())
+	for i := uint64(0); i < attestation.AggregationBits.Len(); i++ {
+		if attestation.AggregationBits.BitAt(i) {
+			attesters = append(attesters, attester{
+				slot:           attestation.Data.Slot,
+				committeeIndex: attestation.Data.Index,
+				position:       i,
+			})
+		}
+	}
+
+	return attesters, nil
+}
@@ -0,0 +1,155 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packing_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/packing"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// attestation creates an attestation for the given committee with the given bits set.
+func attestation(slot phase0.Slot, index phase0.CommitteeIndex, size uint64, positions ...uint64) *phase0.Attestation {
+	bits := bitfield.NewBitlist(size)
+	for _, position := range positions {
+		bits.SetBitAt(position, true)
+	}
+	return &phase0.Attestation{
+		AggregationBits: bits,
+		Data: &phase0.AttestationData{
+			Slot:            slot,
+			Index:           index,
+			Source:          &phase0.Checkpoint{},
+			Target:          &phase0.Checkpoint{},
+			BeaconBlockRoot: phase0.Root{byte(slot)},
+		},
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	_, err := packing.NewConfig(map[string]interface{}{})
+	require.EqualError(t, err, "MAX_ATTESTATIONS not found in spec")
+
+	_, err = packing.NewConfig(map[string]interface{}{"MAX_ATTESTATIONS": "128"})
+	require.EqualError(t, err, "MAX_ATTESTATIONS of unexpected type")
+
+	_, err = packing.NewConfig(map[string]interface{}{"MAX_ATTESTATIONS": uint64(0)})
+	require.EqualError(t, err, "MAX_ATTESTATIONS must be greater than 0")
+
+	config, err := packing.NewConfig(map[string]interface{}{"MAX_ATTESTATIONS": uint64(128)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(128), config.MaxAttestations)
+}
+
+func TestPack(t *testing.T) {
+	a := attestation(1, 0, 8, 0, 1, 2, 3)
+	b := attestation(1, 0, 8, 2, 3, 4)
+	c := attestation(1, 0, 8, 4, 5, 6, 7)
+	d := attestation(1, 1, 8, 0)
+	e := attestation(2, 0, 8, 0, 1)
+	subset := attestation(1, 0, 8, 1, 2)
+
+	tests := []struct {
+		name       string
+		max        uint64
+		candidates []*phase0.Attestation
+		included   []*phase0.Attestation
+		profit     packing.ProfitFunc
+		expected   []*phase0.Attestation
+		err        string
+	}{
+		{
+			name:       "Empty",
+			max:        128,
+			candidates: []*phase0.Attestation{},
+			expected:   []*phase0.Attestation{},
+		},
+		{
+			name:       "NilCandidate",
+			max:        128,
+			candidates: []*phase0.Attestation{a, nil},
+			err:        "invalid candidate attestation: attestation missing",
+		},
+		{
+			name:       "NilData",
+			max:        128,
+			candidates: []*phase0.Attestation{{AggregationBits: bitfield.NewBitlist(8)}},
+			err:        "invalid candidate attestation: attestation data missing",
+		},
+		{
+			name:       "NilIncluded",
+			max:        128,
+			candidates: []*phase0.Attestation{a},
+			included:   []*phase0.Attestation{nil},
+			err:        "invalid included attestation: attestation missing",
+		},
+		{
+			name:       "OverlapSkipped",
+			max:        128,
+			candidates: []*phase0.Attestation{b, a, c, subset},
+			expected:   []*phase0.Attestation{a, c},
+		},
+		{
+			name:       "Max",
+			max:        2,
+			candidates: []*phase0.Attestation{d, e, a, c},
+			expected:   []*phase0.Attestation{a, c},
+		},
+		{
+			name:       "SeparateCommittees",
+			max:        128,
+			candidates: []*phase0.Attestation{d, e, a},
+			expected:   []*phase0.Attestation{a, e, d},
+		},
+		{
+			name:       "Included",
+			max:        128,
+			candidates: []*phase0.Attestation{a, b, c},
+			included:   []*phase0.Attestation{attestation(1, 0, 8, 0, 1, 5, 6, 7)},
+			expected:   []*phase0.Attestation{b},
+		},
+		{
+			name:       "CustomProfit",
+			max:        1,
+			candidates: []*phase0.Attestation{a, d},
+			profit: func(attestation *phase0.Attestation, _ uint64) uint64 {
+				if attestation.Data.Index == 1 {
+					return 10
+				}
+				return 1
+			},
+			expected: []*phase0.Attestation{d},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := packing.Pack(&packing.Config{MaxAttestations: test.max}, test.candidates, test.included, test.profit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestPackNoConfig(t *testing.T) {
+	_, err := packing.Pack(nil, nil, nil, nil)
+	require.EqualError(t, err, "no config supplied")
+}
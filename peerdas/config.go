@@ -0,0 +1,61 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package peerdas
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Config contains the spec values required for PeerDAS custody calculations.
+type Config struct {
+	NumberOfColumns              uint64
+	NumberOfCustodyGroups        uint64
+	DataColumnSidecarSubnetCount uint64
+}
+
+// NewConfig creates a configuration from a spec, as returned by a SpecProvider.
+func NewConfig(spec map[string]interface{}) (*Config, error) {
+	config := &Config{}
+
+	uintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "NUMBER_OF_COLUMNS", value: &config.NumberOfColumns},
+		{key: "NUMBER_OF_CUSTODY_GROUPS", value: &config.NumberOfCustodyGroups},
+		{key: "DATA_COLUMN_SIDECAR_SUBNET_COUNT", value: &config.DataColumnSidecarSubnetCount},
+	}
+	for _, uintValue := range uintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", uintValue.key)
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		if val == 0 {
+			return nil, fmt.Errorf("%s must be greater than 0", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+
+	if config.NumberOfColumns%config.NumberOfCustodyGroups != 0 {
+		return nil, errors.New("NUMBER_OF_COLUMNS must be a multiple of NUMBER_OF_CUSTODY_GROUPS")
+	}
+
+	return config, nil
+}
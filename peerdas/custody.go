@@ -0,0 +1,126 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package peerdas provides the PeerDAS custody calculations of the Fulu spec,
+// allowing data availability sampling tooling to derive the data column sidecars
+// that a node must custody.
+package peerdas
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// NodeID is the identifier of a node on the network, as a big-endian 256-bit
+// unsigned integer.
+type NodeID [32]byte
+
+// CustodyIndex is the index of a custody group.
+type CustodyIndex uint64
+
+// ColumnIndex is the index of a data column.
+type ColumnIndex uint64
+
+// CustodyGroups returns the custody groups of a node that custodies the given
+// number of groups, in increasing order.
+func CustodyGroups(config *Config, nodeID NodeID, custodyGroupCount uint64) ([]CustodyIndex, error) {
+	if custodyGroupCount > config.NumberOfCustodyGroups {
+		return nil, fmt.Errorf("custody group count %d greater than number of custody groups %d",
+			custodyGroupCount, config.NumberOfCustodyGroups)
+	}
+
+	groups := make([]CustodyIndex, 0, custodyGroupCount)
+	if custodyGroupCount == config.NumberOfCustodyGroups {
+		// All groups are custodied.
+		for i := uint64(0); i < config.NumberOfCustodyGroups; i++ {
+			groups = append(groups, CustodyIndex(i))
+		}
+		return groups, nil
+	}
+
+	currentID := nodeID
+	found := make(map[CustodyIndex]bool, custodyGroupCount)
+	// The spec hashes the little-endian encoding of the ID.
+	encoded := make([]byte, 32)
+	for uint64(len(groups)) < custodyGroupCount {
+		for i := range currentID {
+			encoded[i] = currentID[31-i]
+		}
+		hash := sha256.Sum256(encoded)
+		group := CustodyIndex(binary.LittleEndian.Uint64(hash[0:8]) % config.NumberOfCustodyGroups)
+		if !found[group] {
+			found[group] = true
+			groups = append(groups, group)
+		}
+		// Increment the ID, wrapping to 0 after the maximum value.
+		for i := len(currentID) - 1; i >= 0; i-- {
+			currentID[i]++
+			if currentID[i] != 0 {
+				break
+			}
+		}
+	}
+	sort.Slice(groups, func(i int, j int) bool {
+		return groups[i] < groups[j]
+	})
+
+	return groups, nil
+}
+
+// ColumnsForCustodyGroup returns the columns in the given custody group, in
+// increasing order.
+func ColumnsForCustodyGroup(config *Config, group CustodyIndex) ([]ColumnIndex, error) {
+	if uint64(group) >= config.NumberOfCustodyGroups {
+		return nil, fmt.Errorf("custody group %d out of range", group)
+	}
+
+	columnsPerGroup := config.NumberOfColumns / config.NumberOfCustodyGroups
+	columns := make([]ColumnIndex, 0, columnsPerGroup)
+	for i := uint64(0); i < columnsPerGroup; i++ {
+		columns = append(columns, ColumnIndex(config.NumberOfCustodyGroups*i+uint64(group)))
+	}
+
+	return columns, nil
+}
+
+// CustodyColumns returns the columns that a node that custodies the given number
+// of groups must custody, in increasing order.  The custody group count is that
+// advertised by the node, previously known as the custody subnet count.
+func CustodyColumns(config *Config, nodeID NodeID, custodyGroupCount uint64) ([]ColumnIndex, error) {
+	groups, err := CustodyGroups(config, nodeID, custodyGroupCount)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]ColumnIndex, 0, uint64(len(groups))*(config.NumberOfColumns/config.NumberOfCustodyGroups))
+	for _, group := range groups {
+		groupColumns, err := ColumnsForCustodyGroup(config, group)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, groupColumns...)
+	}
+	sort.Slice(columns, func(i int, j int) bool {
+		return columns[i] < columns[j]
+	})
+
+	return columns, nil
+}
+
+// ColumnSubnet returns the subnet on which the data column sidecar for the given
+// column is published.
+func ColumnSubnet(config *Config, column ColumnIndex) uint64 {
+	return uint64(column) % config.DataColumnSidecarSubnetCount
+}
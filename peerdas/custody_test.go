@@ -0,0 +1,167 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package peerdas_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/peerdas"
+	"github.com/stretchr/testify/require"
+)
+
+var mainnetConfig = &peerdas.Config{
+	NumberOfColumns:              128,
+	NumberOfCustodyGroups:        128,
+	DataColumnSidecarSubnetCount: 128,
+}
+
+func nodeID(t *testing.T, input string) peerdas.NodeID {
+	t.Helper()
+	data, err := hex.DecodeString(input)
+	require.NoError(t, err)
+	var res peerdas.NodeID
+	copy(res[:], data)
+	return res
+}
+
+func TestCustodyGroups(t *testing.T) {
+	tests := []struct {
+		name              string
+		config            *peerdas.Config
+		nodeID            string
+		custodyGroupCount uint64
+		expected          []peerdas.CustodyIndex
+		err               string
+	}{
+		{
+			name:              "TooMany",
+			config:            mainnetConfig,
+			nodeID:            "0000000000000000000000000000000000000000000000000000000000000000",
+			custodyGroupCount: 129,
+			err:               "custody group count 129 greater than number of custody groups 128",
+		},
+		{
+			name:              "None",
+			config:            mainnetConfig,
+			nodeID:            "0000000000000000000000000000000000000000000000000000000000000000",
+			custodyGroupCount: 0,
+			expected:          []peerdas.CustodyIndex{},
+		},
+		{
+			name:              "Zero",
+			config:            mainnetConfig,
+			nodeID:            "0000000000000000000000000000000000000000000000000000000000000000",
+			custodyGroupCount: 4,
+			expected:          []peerdas.CustodyIndex{1, 17, 87, 102},
+		},
+		{
+			name:              "Wrap",
+			config:            mainnetConfig,
+			nodeID:            "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			custodyGroupCount: 4,
+			expected:          []peerdas.CustodyIndex{1, 47, 87, 102},
+		},
+		{
+			name:              "Arbitrary",
+			config:            mainnetConfig,
+			nodeID:            "8f2c5d6a1b3e4f7081928374655647382910abcdef0123456789abcdef012345",
+			custodyGroupCount: 8,
+			expected:          []peerdas.CustodyIndex{1, 15, 37, 47, 58, 84, 86, 106},
+		},
+		{
+			name: "FewerGroups",
+			config: &peerdas.Config{
+				NumberOfColumns:              128,
+				NumberOfCustodyGroups:        64,
+				DataColumnSidecarSubnetCount: 64,
+			},
+			nodeID:            "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			custodyGroupCount: 4,
+			expected:          []peerdas.CustodyIndex{1, 23, 38, 47},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			groups, err := peerdas.CustodyGroups(test.config, nodeID(t, test.nodeID), test.custodyGroupCount)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, groups)
+			}
+		})
+	}
+}
+
+func TestCustodyGroupsAll(t *testing.T) {
+	groups, err := peerdas.CustodyGroups(mainnetConfig, peerdas.NodeID{}, 128)
+	require.NoError(t, err)
+	require.Len(t, groups, 128)
+	for i, group := range groups {
+		require.Equal(t, peerdas.CustodyIndex(i), group)
+	}
+}
+
+func TestCustodyColumns(t *testing.T) {
+	config := &peerdas.Config{
+		NumberOfColumns:              128,
+		NumberOfCustodyGroups:        64,
+		DataColumnSidecarSubnetCount: 32,
+	}
+
+	columns, err := peerdas.ColumnsForCustodyGroup(config, 5)
+	require.NoError(t, err)
+	require.Equal(t, []peerdas.ColumnIndex{5, 69}, columns)
+	_, err = peerdas.ColumnsForCustodyGroup(config, 64)
+	require.EqualError(t, err, "custody group 64 out of range")
+
+	columns, err = peerdas.CustodyColumns(config, nodeID(t, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), 4)
+	require.NoError(t, err)
+	require.Equal(t, []peerdas.ColumnIndex{1, 23, 38, 47, 65, 87, 102, 111}, columns)
+
+	require.Equal(t, uint64(15), peerdas.ColumnSubnet(config, 111))
+}
+
+func TestNewConfig(t *testing.T) {
+	_, err := peerdas.NewConfig(map[string]interface{}{})
+	require.EqualError(t, err, "NUMBER_OF_COLUMNS not found in spec")
+	_, err = peerdas.NewConfig(map[string]interface{}{
+		"NUMBER_OF_COLUMNS":                "128",
+		"NUMBER_OF_CUSTODY_GROUPS":         uint64(128),
+		"DATA_COLUMN_SIDECAR_SUBNET_COUNT": uint64(128),
+	})
+	require.EqualError(t, err, "NUMBER_OF_COLUMNS of unexpected type")
+	_, err = peerdas.NewConfig(map[string]interface{}{
+		"NUMBER_OF_COLUMNS":                uint64(128),
+		"NUMBER_OF_CUSTODY_GROUPS":         uint64(0),
+		"DATA_COLUMN_SIDECAR_SUBNET_COUNT": uint64(128),
+	})
+	require.EqualError(t, err, "NUMBER_OF_CUSTODY_GROUPS must be greater than 0")
+	_, err = peerdas.NewConfig(map[string]interface{}{
+		"NUMBER_OF_COLUMNS":                uint64(128),
+		"NUMBER_OF_CUSTODY_GROUPS":         uint64(96),
+		"DATA_COLUMN_SIDECAR_SUBNET_COUNT": uint64(128),
+	})
+	require.EqualError(t, err, "NUMBER_OF_COLUMNS must be a multiple of NUMBER_OF_CUSTODY_GROUPS")
+
+	config, err := peerdas.NewConfig(map[string]interface{}{
+		"NUMBER_OF_COLUMNS":                uint64(128),
+		"NUMBER_OF_CUSTODY_GROUPS":         uint64(128),
+		"DATA_COLUMN_SIDECAR_SUBNET_COUNT": uint64(128),
+	})
+	require.NoError(t, err)
+	require.Equal(t, mainnetConfig, config)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposers
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Config contains the spec values required to calculate proposers.
+type Config struct {
+	SlotsPerEpoch             uint64
+	ShuffleRoundCount         uint64
+	MaxEffectiveBalance       phase0.Gwei
+	EpochsPerHistoricalVector uint64
+	MinSeedLookahead          uint64
+	DomainBeaconProposer      phase0.DomainType
+}
+
+// NewConfig creates a configuration from a spec, as returned by a SpecProvider.
+func NewConfig(spec map[string]interface{}) (*Config, error) {
+	config := &Config{}
+
+	uintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "SLOTS_PER_EPOCH", value: &config.SlotsPerEpoch},
+		{key: "SHUFFLE_ROUND_COUNT", value: &config.ShuffleRoundCount},
+		{key: "EPOCHS_PER_HISTORICAL_VECTOR", value: &config.EpochsPerHistoricalVector},
+		{key: "MIN_SEED_LOOKAHEAD", value: &config.MinSeedLookahead},
+	}
+	for _, uintValue := range uintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", uintValue.key)
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+
+	tmp, exists := spec["MAX_EFFECTIVE_BALANCE"]
+	if !exists {
+		return nil, errors.New("MAX_EFFECTIVE_BALANCE not found in spec")
+	}
+	maxEffectiveBalance, isUint := tmp.(uint64)
+	if !isUint {
+		return nil, errors.New("MAX_EFFECTIVE_BALANCE of unexpected type")
+	}
+	config.MaxEffectiveBalance = phase0.Gwei(maxEffectiveBalance)
+
+	tmp, exists = spec["DOMAIN_BEACON_PROPOSER"]
+	if !exists {
+		return nil, errors.New("DOMAIN_BEACON_PROPOSER not found in spec")
+	}
+	domainType, isDomainType := tmp.(phase0.DomainType)
+	if !isDomainType {
+		return nil, errors.New("DOMAIN_BEACON_PROPOSER of unexpected type")
+	}
+	config.DomainBeaconProposer = domainType
+
+	if config.SlotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH must be greater than 0")
+	}
+	if config.EpochsPerHistoricalVector == 0 {
+		return nil, errors.New("EPOCHS_PER_HISTORICAL_VECTOR must be greater than 0")
+	}
+
+	return config, nil
+}
@@ -0,0 +1,159 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proposers calculates block proposers locally from a beacon state,
+// following the consensus specification.
+package proposers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// maxRandomByte is the maximum value of a random byte.
+const maxRandomByte = 1<<8 - 1
+
+// ProposerDuties calculates the proposer duties for all slots of the epoch of the given state.
+// The state can be at any slot in the epoch; duties are only available for the epoch in which
+// the state resides, as effective balances and the active validator set can change at each
+// epoch transition.
+func ProposerDuties(config *Config, state *spec.VersionedBeaconState) ([]*apiv1.ProposerDuty, error) {
+	if config == nil {
+		return nil, errors.New("no config specified")
+	}
+	if state == nil {
+		return nil, errors.New("no state specified")
+	}
+
+	stateSlot, err := state.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state slot")
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state validators")
+	}
+	randaoMixes, err := state.RANDAOMixes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state RANDAO mixes")
+	}
+	if uint64(len(randaoMixes)) != config.EpochsPerHistoricalVector {
+		return nil, fmt.Errorf("expected %d RANDAO mixes, found %d", config.EpochsPerHistoricalVector, len(randaoMixes))
+	}
+
+	epoch := phase0.Epoch(uint64(stateSlot) / config.SlotsPerEpoch)
+	indices := activeValidatorIndices(validators, epoch)
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("no active validators at epoch %d", epoch)
+	}
+	epochSeed := seed(config, randaoMixes, epoch)
+
+	startSlot := phase0.Slot(uint64(epoch) * config.SlotsPerEpoch)
+	duties := make([]*apiv1.ProposerDuty, 0, config.SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+phase0.Slot(config.SlotsPerEpoch); slot++ {
+		slotSeed := hash(epochSeed[:], uint64Bytes(uint64(slot)))
+		index := proposerIndex(config, validators, indices, slotSeed)
+		duties = append(duties, &apiv1.ProposerDuty{
+			PubKey:         validators[index].PublicKey,
+			Slot:           slot,
+			ValidatorIndex: index,
+		})
+	}
+
+	return duties, nil
+}
+
+// activeValidatorIndices returns the indices of the validators active at the given epoch.
+func activeValidatorIndices(validators []*phase0.Validator, epoch phase0.Epoch) []phase0.ValidatorIndex {
+	indices := make([]phase0.ValidatorIndex, 0, len(validators))
+	for i, validator := range validators {
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			indices = append(indices, phase0.ValidatorIndex(i))
+		}
+	}
+	return indices
+}
+
+// seed returns the proposer seed for the given epoch.
+func seed(config *Config, randaoMixes []phase0.Root, epoch phase0.Epoch) [32]byte {
+	mixEpoch := (uint64(epoch) + config.EpochsPerHistoricalVector - config.MinSeedLookahead - 1) % config.EpochsPerHistoricalVector
+	mix := randaoMixes[mixEpoch]
+	return hash(config.DomainBeaconProposer[:], uint64Bytes(uint64(epoch)), mix[:])
+}
+
+// proposerIndex selects a proposer from the active indices, weighted by effective balance.
+func proposerIndex(config *Config,
+	validators []*phase0.Validator,
+	indices []phase0.ValidatorIndex,
+	seed [32]byte,
+) phase0.ValidatorIndex {
+	total := uint64(len(indices))
+	var randomBytes [32]byte
+	for i := uint64(0); ; i++ {
+		candidate := indices[shuffledIndex(config, i%total, total, seed)]
+		if i%32 == 0 {
+			randomBytes = hash(seed[:], uint64Bytes(i/32))
+		}
+		randomByte := uint64(randomBytes[i%32])
+		effectiveBalance := uint64(validators[candidate].EffectiveBalance)
+		if effectiveBalance*maxRandomByte >= uint64(config.MaxEffectiveBalance)*randomByte {
+			return candidate
+		}
+	}
+}
+
+// shuffledIndex returns the shuffled position of the given index, using the swap-or-not shuffle.
+func shuffledIndex(config *Config, index uint64, count uint64, seed [32]byte) uint64 {
+	buf := make([]byte, 32+1+4)
+	copy(buf, seed[:])
+	for round := uint64(0); round < config.ShuffleRoundCount; round++ {
+		buf[32] = byte(round)
+		pivotHash := sha256.Sum256(buf[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		flip := (pivot + count - index) % count
+		position := index
+		if flip > position {
+			position = flip
+		}
+		binary.LittleEndian.PutUint32(buf[33:], uint32(position/256))
+		source := sha256.Sum256(buf)
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index
+}
+
+// hash returns the SHA-256 hash of the concatenation of the supplied data.
+func hash(data ...[]byte) [32]byte {
+	h := sha256.New()
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+	var res [32]byte
+	copy(res[:], h.Sum(nil))
+	return res
+}
+
+// uint64Bytes returns the little-endian encoding of a uint64.
+func uint64Bytes(val uint64) []byte {
+	res := make([]byte, 8)
+	binary.LittleEndian.PutUint64(res, val)
+	return res
+}
@@ -0,0 +1,83 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposers
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/spectest"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShuffledIndexPermutation(t *testing.T) {
+	config := &Config{ShuffleRoundCount: 90}
+	seed := hash([]byte("seed"))
+
+	for _, count := range []uint64{1, 2, 7, 100} {
+		seen := make(map[uint64]bool)
+		for i := uint64(0); i < count; i++ {
+			shuffled := shuffledIndex(config, i, count, seed)
+			require.Less(t, shuffled, count)
+			require.False(t, seen[shuffled])
+			seen[shuffled] = true
+		}
+	}
+}
+
+// shuffleRoundCounts are the shuffle round counts for each preset.
+var shuffleRoundCounts = map[string]uint64{
+	spectest.PresetMainnet: 90,
+	spectest.PresetMinimal: 10,
+}
+
+// TestShuffledIndexSpec checks the shuffle against the known-answer vectors
+// in the consensus spec tests.
+func TestShuffledIndexSpec(t *testing.T) {
+	for _, preset := range spectest.Presets(t) {
+		t.Run(preset, func(t *testing.T) {
+			config := &Config{ShuffleRoundCount: shuffleRoundCounts[preset]}
+			baseDir := filepath.Join(spectest.Dir(t), preset, "phase0", "shuffling", "core", "shuffle")
+			entries, err := os.ReadDir(baseDir)
+			if os.IsNotExist(err) {
+				t.Skipf("no shuffling spec tests for %s", preset)
+			}
+			require.NoError(t, err)
+			for _, entry := range entries {
+				t.Run(entry.Name(), func(t *testing.T) {
+					data, err := os.ReadFile(filepath.Join(baseDir, entry.Name(), "mapping.yaml"))
+					require.NoError(t, err)
+					testCase := struct {
+						Seed    string   `yaml:"seed"`
+						Count   uint64   `yaml:"count"`
+						Mapping []uint64 `yaml:"mapping"`
+					}{}
+					require.NoError(t, yaml.Unmarshal(data, &testCase))
+					seedBytes, err := hex.DecodeString(strings.TrimPrefix(testCase.Seed, "0x"))
+					require.NoError(t, err)
+					var seed [32]byte
+					copy(seed[:], seedBytes)
+					require.Len(t, testCase.Mapping, int(testCase.Count))
+					for i := uint64(0); i < testCase.Count; i++ {
+						require.Equal(t, testCase.Mapping[i], shuffledIndex(config, i, testCase.Count, seed))
+					}
+				})
+			}
+		})
+	}
+}
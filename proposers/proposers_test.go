@@ -0,0 +1,179 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposers_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/proposers"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *proposers.Config {
+	return &proposers.Config{
+		SlotsPerEpoch:             32,
+		ShuffleRoundCount:         90,
+		MaxEffectiveBalance:       32000000000,
+		EpochsPerHistoricalVector: 16,
+		MinSeedLookahead:          1,
+		DomainBeaconProposer:      phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+	}
+}
+
+func testState(slot phase0.Slot, validators []*phase0.Validator) *spec.VersionedBeaconState {
+	mixes := make([]phase0.Root, 16)
+	for i := range mixes {
+		mixes[i] = phase0.Root{byte(i), 0x01}
+	}
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Slot:        slot,
+			Validators:  validators,
+			RANDAOMixes: mixes,
+		},
+	}
+}
+
+func testValidators(count int) []*phase0.Validator {
+	validators := make([]*phase0.Validator, count)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:        phase0.BLSPubKey{byte(i), byte(i >> 8)},
+			EffectiveBalance: 32000000000,
+			ActivationEpoch:  0,
+			ExitEpoch:        0xffffffffffffffff,
+		}
+	}
+	return validators
+}
+
+func TestNewConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		spec map[string]interface{}
+		err  string
+	}{
+		{
+			name: "Empty",
+			spec: map[string]interface{}{},
+			err:  "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "WrongType",
+			spec: map[string]interface{}{
+				"SLOTS_PER_EPOCH":              "32",
+				"SHUFFLE_ROUND_COUNT":          uint64(90),
+				"EPOCHS_PER_HISTORICAL_VECTOR": uint64(65536),
+				"MIN_SEED_LOOKAHEAD":           uint64(1),
+			},
+			err: "SLOTS_PER_EPOCH of unexpected type",
+		},
+		{
+			name: "DomainMissing",
+			spec: map[string]interface{}{
+				"SLOTS_PER_EPOCH":              uint64(32),
+				"SHUFFLE_ROUND_COUNT":          uint64(90),
+				"EPOCHS_PER_HISTORICAL_VECTOR": uint64(65536),
+				"MIN_SEED_LOOKAHEAD":           uint64(1),
+				"MAX_EFFECTIVE_BALANCE":        uint64(32000000000),
+			},
+			err: "DOMAIN_BEACON_PROPOSER not found in spec",
+		},
+		{
+			name: "Good",
+			spec: map[string]interface{}{
+				"SLOTS_PER_EPOCH":              uint64(32),
+				"SHUFFLE_ROUND_COUNT":          uint64(90),
+				"EPOCHS_PER_HISTORICAL_VECTOR": uint64(65536),
+				"MIN_SEED_LOOKAHEAD":           uint64(1),
+				"MAX_EFFECTIVE_BALANCE":        uint64(32000000000),
+				"DOMAIN_BEACON_PROPOSER":       phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := proposers.NewConfig(test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint64(32), config.SlotsPerEpoch)
+			}
+		})
+	}
+}
+
+func TestProposerDuties(t *testing.T) {
+	validators := testValidators(64)
+	// Validators that are exited, not yet active or have no balance can never propose.
+	validators[0].ExitEpoch = 1
+	validators[1].ActivationEpoch = 10
+	validators[2].EffectiveBalance = 0
+
+	tests := []struct {
+		name   string
+		config *proposers.Config
+		state  *spec.VersionedBeaconState
+		err    string
+	}{
+		{
+			name:  "ConfigNil",
+			state: testState(100, validators),
+			err:   "no config specified",
+		},
+		{
+			name:   "StateNil",
+			config: testConfig(),
+			err:    "no state specified",
+		},
+		{
+			name:   "NoActiveValidators",
+			config: testConfig(),
+			state:  testState(100, nil),
+			err:    "no active validators at epoch 3",
+		},
+		{
+			name:   "Good",
+			config: testConfig(),
+			state:  testState(100, validators),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duties, err := proposers.ProposerDuties(test.config, test.state)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, duties, 32)
+			for i, duty := range duties {
+				require.Equal(t, phase0.Slot(96+i), duty.Slot)
+				require.Greater(t, uint64(duty.ValidatorIndex), uint64(2))
+				require.Equal(t, validators[duty.ValidatorIndex].PublicKey, duty.PubKey)
+			}
+
+			// Calculation must be deterministic.
+			again, err := proposers.ProposerDuties(test.config, test.state)
+			require.NoError(t, err)
+			require.Equal(t, duties, again)
+		})
+	}
+}
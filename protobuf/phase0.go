@@ -0,0 +1,467 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func marshalCheckpoint(c *phase0.Checkpoint) []byte {
+	e := &encoder{}
+	e.uint64(1, uint64(c.Epoch))
+	e.bytes(2, c.Root[:])
+	return e.buf
+}
+
+func unmarshalCheckpoint(data []byte) (*phase0.Checkpoint, error) {
+	c := &phase0.Checkpoint{}
+	err := decode(data, func(f *field) error {
+		var err error
+		switch f.num {
+		case 1:
+			var v uint64
+			v, err = f.uint64()
+			c.Epoch = phase0.Epoch(v)
+		case 2:
+			err = f.fixedBytes(c.Root[:])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid checkpoint")
+	}
+	return c, nil
+}
+
+func marshalAttestationData(a *phase0.AttestationData) []byte {
+	e := &encoder{}
+	e.uint64(1, uint64(a.Slot))
+	e.uint64(2, uint64(a.Index))
+	e.bytes(3, a.BeaconBlockRoot[:])
+	if a.Source != nil {
+		e.message(4, marshalCheckpoint(a.Source))
+	}
+	if a.Target != nil {
+		e.message(5, marshalCheckpoint(a.Target))
+	}
+	return e.buf
+}
+
+func unmarshalAttestationData(data []byte) (*phase0.AttestationData, error) {
+	a := &phase0.AttestationData{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var v uint64
+		var b []byte
+		switch f.num {
+		case 1:
+			v, err = f.uint64()
+			a.Slot = phase0.Slot(v)
+		case 2:
+			v, err = f.uint64()
+			a.Index = phase0.CommitteeIndex(v)
+		case 3:
+			err = f.fixedBytes(a.BeaconBlockRoot[:])
+		case 4:
+			if b, err = f.bytes(); err == nil {
+				a.Source, err = unmarshalCheckpoint(b)
+			}
+		case 5:
+			if b, err = f.bytes(); err == nil {
+				a.Target, err = unmarshalCheckpoint(b)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attestation data")
+	}
+	return a, nil
+}
+
+func marshalAttestation(a *phase0.Attestation) []byte {
+	e := &encoder{}
+	e.bytes(1, a.AggregationBits)
+	if a.Data != nil {
+		e.message(2, marshalAttestationData(a.Data))
+	}
+	e.bytes(3, a.Signature[:])
+	return e.buf
+}
+
+func unmarshalAttestation(data []byte) (*phase0.Attestation, error) {
+	a := &phase0.Attestation{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			if b, err = f.bytes(); err == nil {
+				a.AggregationBits = append([]byte{}, b...)
+			}
+		case 2:
+			if b, err = f.bytes(); err == nil {
+				a.Data, err = unmarshalAttestationData(b)
+			}
+		case 3:
+			err = f.fixedBytes(a.Signature[:])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attestation")
+	}
+	return a, nil
+}
+
+func marshalIndexedAttestation(a *phase0.IndexedAttestation) []byte {
+	e := &encoder{}
+	e.packedUint64s(1, a.AttestingIndices)
+	if a.Data != nil {
+		e.message(2, marshalAttestationData(a.Data))
+	}
+	e.bytes(3, a.Signature[:])
+	return e.buf
+}
+
+func unmarshalIndexedAttestation(data []byte) (*phase0.IndexedAttestation, error) {
+	a := &phase0.IndexedAttestation{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			var indices []uint64
+			if indices, err = f.uint64s(); err == nil {
+				a.AttestingIndices = append(a.AttestingIndices, indices...)
+			}
+		case 2:
+			if b, err = f.bytes(); err == nil {
+				a.Data, err = unmarshalAttestationData(b)
+			}
+		case 3:
+			err = f.fixedBytes(a.Signature[:])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid indexed attestation")
+	}
+	return a, nil
+}
+
+func marshalBeaconBlockHeader(h *phase0.BeaconBlockHeader) []byte {
+	e := &encoder{}
+	e.uint64(1, uint64(h.Slot))
+	e.uint64(2, uint64(h.ProposerIndex))
+	e.bytes(3, h.ParentRoot[:])
+	e.bytes(4, h.StateRoot[:])
+	e.bytes(5, h.BodyRoot[:])
+	return e.buf
+}
+
+func unmarshalBeaconBlockHeader(data []byte) (*phase0.BeaconBlockHeader, error) {
+	h := &phase0.BeaconBlockHeader{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var v uint64
+		switch f.num {
+		case 1:
+			v, err = f.uint64()
+			h.Slot = phase0.Slot(v)
+		case 2:
+			v, err = f.uint64()
+			h.ProposerIndex = phase0.ValidatorIndex(v)
+		case 3:
+			err = f.fixedBytes(h.ParentRoot[:])
+		case 4:
+			err = f.fixedBytes(h.StateRoot[:])
+		case 5:
+			err = f.fixedBytes(h.BodyRoot[:])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid beacon block header")
+	}
+	return h, nil
+}
+
+func marshalSignedBeaconBlockHeader(h *phase0.SignedBeaconBlockHeader) []byte {
+	e := &encoder{}
+	if h.Message != nil {
+		e.message(1, marshalBeaconBlockHeader(h.Message))
+	}
+	e.bytes(2, h.Signature[:])
+	return e.buf
+}
+
+func unmarshalSignedBeaconBlockHeader(data []byte) (*phase0.SignedBeaconBlockHeader, error) {
+	h := &phase0.SignedBeaconBlockHeader{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			if b, err = f.bytes(); err == nil {
+				h.Message, err = unmarshalBeaconBlockHeader(b)
+			}
+		case 2:
+			err = f.fixedBytes(h.Signature[:])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signed beacon block header")
+	}
+	return h, nil
+}
+
+func marshalProposerSlashing(s *phase0.ProposerSlashing) []byte {
+	e := &encoder{}
+	if s.SignedHeader1 != nil {
+		e.message(1, marshalSignedBeaconBlockHeader(s.SignedHeader1))
+	}
+	if s.SignedHeader2 != nil {
+		e.message(2, marshalSignedBeaconBlockHeader(s.SignedHeader2))
+	}
+	return e.buf
+}
+
+func unmarshalProposerSlashing(data []byte) (*phase0.ProposerSlashing, error) {
+	s := &phase0.ProposerSlashing{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			if b, err = f.bytes(); err == nil {
+				s.SignedHeader1, err = unmarshalSignedBeaconBlockHeader(b)
+			}
+		case 2:
+			if b, err = f.bytes(); err == nil {
+				s.SignedHeader2, err = unmarshalSignedBeaconBlockHeader(b)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proposer slashing")
+	}
+	return s, nil
+}
+
+func marshalAttesterSlashing(s *phase0.AttesterSlashing) []byte {
+	e := &encoder{}
+	if s.Attestation1 != nil {
+		e.message(1, marshalIndexedAttestation(s.Attestation1))
+	}
+	if s.Attestation2 != nil {
+		e.message(2, marshalIndexedAttestation(s.Attestation2))
+	}
+	return e.buf
+}
+
+func unmarshalAttesterSlashing(data []byte) (*phase0.AttesterSlashing, error) {
+	s := &phase0.AttesterSlashing{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			if b, err = f.bytes(); err == nil {
+				s.Attestation1, err = unmarshalIndexedAttestation(b)
+			}
+		case 2:
+			if b, err = f.bytes(); err == nil {
+				s.Attestation2, err = unmarshalIndexedAttestation(b)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attester slashing")
+	}
+	return s, nil
+}
+
+func marshalVoluntaryExit(v *phase0.VoluntaryExit) []byte {
+	e := &encoder{}
+	e.uint64(1, uint64(v.Epoch))
+	e.uint64(2, uint64(v.ValidatorIndex))
+	return e.buf
+}
+
+func unmarshalVoluntaryExit(data []byte) (*phase0.VoluntaryExit, error) {
+	v := &phase0.VoluntaryExit{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var val uint64
+		switch f.num {
+		case 1:
+			val, err = f.uint64()
+			v.Epoch = phase0.Epoch(val)
+		case 2:
+			val, err = f.uint64()
+			v.ValidatorIndex = phase0.ValidatorIndex(val)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid voluntary exit")
+	}
+	return v, nil
+}
+
+func marshalSignedVoluntaryExit(v *phase0.SignedVoluntaryExit) []byte {
+	e := &encoder{}
+	if v.Message != nil {
+		e.message(1, marshalVoluntaryExit(v.Message))
+	}
+	e.bytes(2, v.Signature[:])
+	return e.buf
+}
+
+func unmarshalSignedVoluntaryExit(data []byte) (*phase0.SignedVoluntaryExit, error) {
+	v := &phase0.SignedVoluntaryExit{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			if b, err = f.bytes(); err == nil {
+				v.Message, err = unmarshalVoluntaryExit(b)
+			}
+		case 2:
+			err = f.fixedBytes(v.Signature[:])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signed voluntary exit")
+	}
+	return v, nil
+}
+
+func marshalETH1Data(d *phase0.ETH1Data) []byte {
+	e := &encoder{}
+	e.bytes(1, d.DepositRoot[:])
+	e.uint64(2, d.DepositCount)
+	e.bytes(3, d.BlockHash)
+	return e.buf
+}
+
+func unmarshalETH1Data(data []byte) (*phase0.ETH1Data, error) {
+	d := &phase0.ETH1Data{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var b []byte
+		switch f.num {
+		case 1:
+			err = f.fixedBytes(d.DepositRoot[:])
+		case 2:
+			d.DepositCount, err = f.uint64()
+		case 3:
+			if b, err = f.bytes(); err == nil {
+				d.BlockHash = append([]byte{}, b...)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ETH1 data")
+	}
+	return d, nil
+}
+
+func marshalFork(fork *phase0.Fork) []byte {
+	e := &encoder{}
+	e.bytes(1, fork.PreviousVersion[:])
+	e.bytes(2, fork.CurrentVersion[:])
+	e.uint64(3, uint64(fork.Epoch))
+	return e.buf
+}
+
+func unmarshalFork(data []byte) (*phase0.Fork, error) {
+	fork := &phase0.Fork{}
+	err := decode(data, func(f *field) error {
+		var err error
+		var v uint64
+		switch f.num {
+		case 1:
+			err = f.fixedBytes(fork.PreviousVersion[:])
+		case 2:
+			err = f.fixedBytes(fork.CurrentVersion[:])
+		case 3:
+			v, err = f.uint64()
+			fork.Epoch = phase0.Epoch(v)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fork")
+	}
+	return fork, nil
+}
+
+func marshalValidator(v *phase0.Validator) []byte {
+	e := &encoder{}
+	e.bytes(1, v.PublicKey[:])
+	e.bytes(2, v.WithdrawalCredentials)
+	e.uint64(3, uint64(v.EffectiveBalance))
+	e.bool(4, v.Slashed)
+	e.uint64(5, uint64(v.ActivationEligibilityEpoch))
+	e.uint64(6, uint64(v.ActivationEpoch))
+	e.uint64(7, uint64(v.ExitEpoch))
+	e.uint64(8, uint64(v.WithdrawableEpoch))
+	return e.buf
+}
+
+func unmarshalValidator(data []byte) (*phase0.Validator, error) {
+	v := &phase0.Validator{}
+	epochs := map[protowire.Number]*phase0.Epoch{
+		5: &v.ActivationEligibilityEpoch,
+		6: &v.ActivationEpoch,
+		7: &v.ExitEpoch,
+		8: &v.WithdrawableEpoch,
+	}
+	err := decode(data, func(f *field) error {
+		var err error
+		var val uint64
+		var b []byte
+		switch f.num {
+		case 1:
+			err = f.fixedBytes(v.PublicKey[:])
+		case 2:
+			if b, err = f.bytes(); err == nil {
+				v.WithdrawalCredentials = append([]byte{}, b...)
+			}
+		case 3:
+			val, err = f.uint64()
+			v.EffectiveBalance = phase0.Gwei(val)
+		case 4:
+			v.Slashed, err = f.bool()
+		case 5, 6, 7, 8:
+			val, err = f.uint64()
+			*epochs[f.num] = phase0.Epoch(val)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid validator")
+	}
+	return v, nil
+}
@@ -0,0 +1,182 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protobuf converts spec types to and from the protobuf wire format used by
+// the ethereum.eth.v1 messages of Prysm's gRPC API.  The message definitions are in
+// the proto directory; conversion operates directly on the wire format, so no
+// generated bindings are required by consumers.
+package protobuf
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Marshal encodes a supported spec object as a protobuf message.
+func Marshal(v interface{}) ([]byte, error) {
+	switch obj := v.(type) {
+	case *phase0.Checkpoint:
+		return marshalCheckpoint(obj), nil
+	case *phase0.AttestationData:
+		return marshalAttestationData(obj), nil
+	case *phase0.Attestation:
+		return marshalAttestation(obj), nil
+	case *phase0.IndexedAttestation:
+		return marshalIndexedAttestation(obj), nil
+	case *phase0.BeaconBlockHeader:
+		return marshalBeaconBlockHeader(obj), nil
+	case *phase0.SignedBeaconBlockHeader:
+		return marshalSignedBeaconBlockHeader(obj), nil
+	case *phase0.ProposerSlashing:
+		return marshalProposerSlashing(obj), nil
+	case *phase0.AttesterSlashing:
+		return marshalAttesterSlashing(obj), nil
+	case *phase0.VoluntaryExit:
+		return marshalVoluntaryExit(obj), nil
+	case *phase0.SignedVoluntaryExit:
+		return marshalSignedVoluntaryExit(obj), nil
+	case *phase0.ETH1Data:
+		return marshalETH1Data(obj), nil
+	case *phase0.Fork:
+		return marshalFork(obj), nil
+	case *phase0.Validator:
+		return marshalValidator(obj), nil
+	default:
+		return nil, fmt.Errorf("type %T does not support protobuf encoding", v)
+	}
+}
+
+// Unmarshal decodes a protobuf message in to a supported spec object.
+func Unmarshal(data []byte, v interface{}) error {
+	var err error
+	switch obj := v.(type) {
+	case *phase0.Checkpoint:
+		var res *phase0.Checkpoint
+		if res, err = unmarshalCheckpoint(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.AttestationData:
+		var res *phase0.AttestationData
+		if res, err = unmarshalAttestationData(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.Attestation:
+		var res *phase0.Attestation
+		if res, err = unmarshalAttestation(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.IndexedAttestation:
+		var res *phase0.IndexedAttestation
+		if res, err = unmarshalIndexedAttestation(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.BeaconBlockHeader:
+		var res *phase0.BeaconBlockHeader
+		if res, err = unmarshalBeaconBlockHeader(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.SignedBeaconBlockHeader:
+		var res *phase0.SignedBeaconBlockHeader
+		if res, err = unmarshalSignedBeaconBlockHeader(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.ProposerSlashing:
+		var res *phase0.ProposerSlashing
+		if res, err = unmarshalProposerSlashing(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.AttesterSlashing:
+		var res *phase0.AttesterSlashing
+		if res, err = unmarshalAttesterSlashing(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.VoluntaryExit:
+		var res *phase0.VoluntaryExit
+		if res, err = unmarshalVoluntaryExit(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.SignedVoluntaryExit:
+		var res *phase0.SignedVoluntaryExit
+		if res, err = unmarshalSignedVoluntaryExit(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.ETH1Data:
+		var res *phase0.ETH1Data
+		if res, err = unmarshalETH1Data(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.Fork:
+		var res *phase0.Fork
+		if res, err = unmarshalFork(data); err == nil {
+			*obj = *res
+		}
+	case *phase0.Validator:
+		var res *phase0.Validator
+		if res, err = unmarshalValidator(data); err == nil {
+			*obj = *res
+		}
+	default:
+		return fmt.Errorf("type %T does not support protobuf decoding", v)
+	}
+
+	return err
+}
+
+// Codec is a codec for the protobuf wire format, for use with the codecs registry.
+var Codec codecs.Codec = &codec{}
+
+type codec struct{}
+
+// Name returns the unique name of the codec.
+func (c *codec) Name() string {
+	return "protobuf"
+}
+
+// ContentType returns the MIME content type of the wire format.
+func (c *codec) ContentType() string {
+	return "application/x-protobuf"
+}
+
+// Supports returns true if the codec can encode and decode the given object.
+func (c *codec) Supports(v interface{}) bool {
+	switch v.(type) {
+	case *phase0.Checkpoint,
+		*phase0.AttestationData,
+		*phase0.Attestation,
+		*phase0.IndexedAttestation,
+		*phase0.BeaconBlockHeader,
+		*phase0.SignedBeaconBlockHeader,
+		*phase0.ProposerSlashing,
+		*phase0.AttesterSlashing,
+		*phase0.VoluntaryExit,
+		*phase0.SignedVoluntaryExit,
+		*phase0.ETH1Data,
+		*phase0.Fork,
+		*phase0.Validator:
+		return true
+	default:
+		return false
+	}
+}
+
+// Marshal encodes the object.
+func (c *codec) Marshal(v interface{}) ([]byte, error) {
+	return Marshal(v)
+}
+
+// Unmarshal decodes the data in to the object.
+func (c *codec) Unmarshal(data []byte, v interface{}) error {
+	return Unmarshal(data, v)
+}
@@ -0,0 +1,264 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/protobuf"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func testAttestationData() *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            100,
+		Index:           1,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x02}},
+		Target:          &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{0x03}},
+	}
+}
+
+func testIndexedAttestation() *phase0.IndexedAttestation {
+	return &phase0.IndexedAttestation{
+		AttestingIndices: []uint64{1, 200, 30000},
+		Data:             testAttestationData(),
+		Signature:        phase0.BLSSignature{0x04},
+	}
+}
+
+func testSignedBeaconBlockHeader(slot phase0.Slot) *phase0.SignedBeaconBlockHeader {
+	return &phase0.SignedBeaconBlockHeader{
+		Message: &phase0.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: 2,
+			ParentRoot:    phase0.Root{0x05},
+			StateRoot:     phase0.Root{0x06},
+			BodyRoot:      phase0.Root{0x07},
+		},
+		Signature: phase0.BLSSignature{0x08},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		res   interface{}
+	}{
+		{
+			name:  "Checkpoint",
+			input: &phase0.Checkpoint{Epoch: 12345, Root: phase0.Root{0x01}},
+			res:   &phase0.Checkpoint{},
+		},
+		{
+			name:  "AttestationData",
+			input: testAttestationData(),
+			res:   &phase0.AttestationData{},
+		},
+		{
+			name: "Attestation",
+			input: &phase0.Attestation{
+				AggregationBits: []byte{0x01, 0x02, 0x03},
+				Data:            testAttestationData(),
+				Signature:       phase0.BLSSignature{0x04},
+			},
+			res: &phase0.Attestation{},
+		},
+		{
+			name:  "IndexedAttestation",
+			input: testIndexedAttestation(),
+			res:   &phase0.IndexedAttestation{},
+		},
+		{
+			name:  "SignedBeaconBlockHeader",
+			input: testSignedBeaconBlockHeader(1),
+			res:   &phase0.SignedBeaconBlockHeader{},
+		},
+		{
+			name: "ProposerSlashing",
+			input: &phase0.ProposerSlashing{
+				SignedHeader1: testSignedBeaconBlockHeader(1),
+				SignedHeader2: testSignedBeaconBlockHeader(1),
+			},
+			res: &phase0.ProposerSlashing{},
+		},
+		{
+			name: "AttesterSlashing",
+			input: &phase0.AttesterSlashing{
+				Attestation1: testIndexedAttestation(),
+				Attestation2: testIndexedAttestation(),
+			},
+			res: &phase0.AttesterSlashing{},
+		},
+		{
+			name: "SignedVoluntaryExit",
+			input: &phase0.SignedVoluntaryExit{
+				Message:   &phase0.VoluntaryExit{Epoch: 1, ValidatorIndex: 2},
+				Signature: phase0.BLSSignature{0x09},
+			},
+			res: &phase0.SignedVoluntaryExit{},
+		},
+		{
+			name: "ETH1Data",
+			input: &phase0.ETH1Data{
+				DepositRoot:  phase0.Root{0x0a},
+				DepositCount: 3,
+				BlockHash:    []byte{0x0b, 0x0c},
+			},
+			res: &phase0.ETH1Data{},
+		},
+		{
+			name: "Fork",
+			input: &phase0.Fork{
+				PreviousVersion: phase0.Version{0x01},
+				CurrentVersion:  phase0.Version{0x02},
+				Epoch:           3,
+			},
+			res: &phase0.Fork{},
+		},
+		{
+			name: "Validator",
+			input: &phase0.Validator{
+				PublicKey:                  phase0.BLSPubKey{0x0d},
+				WithdrawalCredentials:      []byte{0x0e},
+				EffectiveBalance:           32000000000,
+				Slashed:                    true,
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  3,
+				WithdrawableEpoch:          4,
+			},
+			res: &phase0.Validator{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.True(t, protobuf.Codec.Supports(test.input))
+			data, err := protobuf.Codec.Marshal(test.input)
+			require.NoError(t, err)
+			require.NoError(t, protobuf.Codec.Unmarshal(data, test.res))
+			require.Equal(t, test.input, test.res)
+		})
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	require.False(t, protobuf.Codec.Supports(&phase0.BeaconBlock{}))
+	_, err := protobuf.Marshal(&phase0.BeaconBlock{})
+	require.EqualError(t, err, "type *phase0.BeaconBlock does not support protobuf encoding")
+	require.EqualError(t, protobuf.Unmarshal(nil, &phase0.BeaconBlock{}), "type *phase0.BeaconBlock does not support protobuf decoding")
+}
+
+func TestInvalid(t *testing.T) {
+	// Root of incorrect length.
+	err := protobuf.Unmarshal([]byte{0x12, 0x01, 0x00}, &phase0.Checkpoint{})
+	require.EqualError(t, err, "invalid checkpoint: incorrect length 1 for field 2")
+	// Epoch with incorrect wire type.
+	err = protobuf.Unmarshal([]byte{0x0a, 0x01, 0x00}, &phase0.Checkpoint{})
+	require.EqualError(t, err, "invalid checkpoint: unexpected wire type 2 for field 1")
+	// Truncated message.
+	err = protobuf.Unmarshal([]byte{0x12, 0x20}, &phase0.Checkpoint{})
+	require.Error(t, err)
+}
+
+// attestationFileDescriptor builds the descriptor for the messages in attestation.proto.
+func attestationFileDescriptor(t *testing.T) protoreflect.FileDescriptor {
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string, repeated bool) *descriptorpb.FieldDescriptorProto {
+		label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		if repeated {
+			label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		}
+		res := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(num),
+			Type:   typ.Enum(),
+			Label:  label.Enum(),
+		}
+		if typeName != "" {
+			res.TypeName = proto.String(typeName)
+		}
+		return res
+	}
+	uint64Type := descriptorpb.FieldDescriptorProto_TYPE_UINT64
+	bytesType := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+	messageType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("ethereum/eth/v1/attestation.proto"),
+		Package: proto.String("ethereum.eth.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Checkpoint"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("epoch", 1, uint64Type, "", false),
+					field("root", 2, bytesType, "", false),
+				},
+			},
+			{
+				Name: proto.String("AttestationData"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("slot", 1, uint64Type, "", false),
+					field("index", 2, uint64Type, "", false),
+					field("beacon_block_root", 3, bytesType, "", false),
+					field("source", 4, messageType, ".ethereum.eth.v1.Checkpoint", false),
+					field("target", 5, messageType, ".ethereum.eth.v1.Checkpoint", false),
+				},
+			},
+			{
+				Name: proto.String("IndexedAttestation"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("attesting_indices", 1, uint64Type, "", true),
+					field("data", 2, messageType, ".ethereum.eth.v1.AttestationData", false),
+					field("signature", 3, bytesType, "", false),
+				},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	return fd
+}
+
+// TestWireCompatibility confirms that the encoding matches that of the standard protobuf library.
+func TestWireCompatibility(t *testing.T) {
+	fd := attestationFileDescriptor(t)
+	messages := fd.Messages()
+
+	input := testIndexedAttestation()
+	data, err := protobuf.Marshal(input)
+	require.NoError(t, err)
+
+	// Decode with the standard library and re-encode.
+	msg := dynamicpb.NewMessage(messages.ByName("IndexedAttestation"))
+	require.NoError(t, proto.Unmarshal(data, msg))
+	indices := msg.Get(messages.ByName("IndexedAttestation").Fields().ByName("attesting_indices")).List()
+	require.Equal(t, 3, indices.Len())
+	require.Equal(t, uint64(30000), indices.Get(2).Uint())
+	reencoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, data, reencoded)
+
+	res := &phase0.IndexedAttestation{}
+	require.NoError(t, protobuf.Unmarshal(reencoded, res))
+	require.Equal(t, input, res)
+}
@@ -0,0 +1,172 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// encoder builds a protobuf message.  As per proto3, scalar fields with
+// default values are omitted.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) uint64(num protowire.Number, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.buf = protowire.AppendTag(e.buf, num, protowire.VarintType)
+	e.buf = protowire.AppendVarint(e.buf, v)
+}
+
+func (e *encoder) bool(num protowire.Number, v bool) {
+	if !v {
+		return
+	}
+	e.buf = protowire.AppendTag(e.buf, num, protowire.VarintType)
+	e.buf = protowire.AppendVarint(e.buf, protowire.EncodeBool(v))
+}
+
+func (e *encoder) bytes(num protowire.Number, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	e.buf = protowire.AppendTag(e.buf, num, protowire.BytesType)
+	e.buf = protowire.AppendBytes(e.buf, v)
+}
+
+// message appends an embedded message.  Unlike scalars, an empty message is
+// still written so that its presence is retained.
+func (e *encoder) message(num protowire.Number, v []byte) {
+	e.buf = protowire.AppendTag(e.buf, num, protowire.BytesType)
+	e.buf = protowire.AppendBytes(e.buf, v)
+}
+
+// packedUint64s appends a repeated uint64 field in packed form.
+func (e *encoder) packedUint64s(num protowire.Number, vs []uint64) {
+	if len(vs) == 0 {
+		return
+	}
+	packed := make([]byte, 0, len(vs)*2)
+	for _, v := range vs {
+		packed = protowire.AppendVarint(packed, v)
+	}
+	e.buf = protowire.AppendTag(e.buf, num, protowire.BytesType)
+	e.buf = protowire.AppendBytes(e.buf, packed)
+}
+
+// field is a single decoded field of a protobuf message.
+type field struct {
+	num    protowire.Number
+	typ    protowire.Type
+	varint uint64
+	data   []byte
+}
+
+// decode calls the handler for each field of the message.  Fields with
+// wire types other than varint and length-delimited are skipped.
+func decode(data []byte, handler func(f *field) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		f := &field{
+			num: num,
+			typ: typ,
+		}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			f.data, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+		if err := handler(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *field) uint64() (uint64, error) {
+	if f.typ != protowire.VarintType {
+		return 0, fmt.Errorf("unexpected wire type %d for field %d", f.typ, f.num)
+	}
+	return f.varint, nil
+}
+
+func (f *field) bool() (bool, error) {
+	v, err := f.uint64()
+	if err != nil {
+		return false, err
+	}
+	return protowire.DecodeBool(v), nil
+}
+
+func (f *field) bytes() ([]byte, error) {
+	if f.typ != protowire.BytesType {
+		return nil, fmt.Errorf("unexpected wire type %d for field %d", f.typ, f.num)
+	}
+	return f.data, nil
+}
+
+// fixedBytes copies the field in to a fixed-length destination.
+func (f *field) fixedBytes(dst []byte) error {
+	v, err := f.bytes()
+	if err != nil {
+		return err
+	}
+	if len(v) != len(dst) {
+		return fmt.Errorf("incorrect length %d for field %d", len(v), f.num)
+	}
+	copy(dst, v)
+	return nil
+}
+
+// uint64s decodes a repeated uint64 field, in either packed or unpacked form.
+func (f *field) uint64s() ([]uint64, error) {
+	if f.typ == protowire.VarintType {
+		return []uint64{f.varint}, nil
+	}
+	data, err := f.bytes()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]uint64, 0, len(data))
+	for len(data) > 0 {
+		v, n := protowire.ConsumeVarint(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		res = append(res, v)
+		data = data[n:]
+	}
+	return res, nil
+}
@@ -0,0 +1,203 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry manages clients for multiple independent networks, keyed by network
+// name, and fans out queries across them.
+//
+// Each network has its own client, so cached values such as genesis and spec are never
+// shared between networks.  The registry checks when a client is registered that its
+// genesis does not match that of a different network, to catch clients that have been
+// pointed at the wrong node.
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// ErrUnknownNetwork is returned when a network is not registered.
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// network is a registered network.
+type network struct {
+	client consensusclient.Service
+	// genesis is the genesis of the network, if the client provides it.
+	genesis *apiv1.Genesis
+}
+
+// Registry is a registry of clients keyed by network.
+type Registry struct {
+	log zerolog.Logger
+
+	mu       sync.RWMutex
+	networks map[string]*network
+}
+
+// FanOutResult is the result of a fan out call for a single network.
+type FanOutResult struct {
+	Value interface{}
+	Err   error
+}
+
+// FanOutFunc is a call carried out against the client for a single network.
+type FanOutFunc func(ctx context.Context, network string, client consensusclient.Service) (interface{}, error)
+
+// New creates a new registry.
+func New(_ context.Context, params ...Parameter) (*Registry, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "registry").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Registry{
+		log:      log,
+		networks: make(map[string]*network),
+	}, nil
+}
+
+// Register registers the client for a network, replacing any existing client for the network.
+func (r *Registry) Register(ctx context.Context, name string, client consensusclient.Service) error {
+	if name == "" {
+		return errors.New("no network name specified")
+	}
+	if client == nil {
+		return errors.New("no client specified")
+	}
+
+	n := &network{
+		client: client,
+	}
+	if genesisProvider, isProvider := client.(consensusclient.GenesisProvider); isProvider {
+		genesis, err := genesisProvider.Genesis(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain genesis")
+		}
+		n.genesis = genesis
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if n.genesis != nil {
+		for otherName, other := range r.networks {
+			if otherName == name || other.genesis == nil {
+				continue
+			}
+			if other.genesis.GenesisValidatorsRoot == n.genesis.GenesisValidatorsRoot {
+				return fmt.Errorf("client for network %s has the same genesis as network %s", name, otherName)
+			}
+		}
+	}
+	r.networks[name] = n
+	r.log.Trace().Str("network", name).Str("address", client.Address()).Msg("Registered network")
+
+	return nil
+}
+
+// Deregister removes the client for a network.
+func (r *Registry) Deregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.networks, name)
+}
+
+// Client provides the client for a network.
+func (r *Registry) Client(name string) (consensusclient.Service, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	n, exists := r.networks[name]
+	if !exists {
+		return nil, errors.Wrap(ErrUnknownNetwork, name)
+	}
+
+	return n.client, nil
+}
+
+// Genesis provides the genesis of a network, as obtained when its client was registered.
+// This returns nil if the client does not provide genesis information.
+func (r *Registry) Genesis(name string) (*apiv1.Genesis, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	n, exists := r.networks[name]
+	if !exists {
+		return nil, errors.Wrap(ErrUnknownNetwork, name)
+	}
+	if n.genesis == nil {
+		return nil, nil
+	}
+	res := *n.genesis
+
+	return &res, nil
+}
+
+// Networks provides the names of the registered networks, in alphabetical order.
+func (r *Registry) Networks() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.networks))
+	for name := range r.networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// FanOut carries out the call against the clients of all registered networks in parallel,
+// returning the result for each network.
+func (r *Registry) FanOut(ctx context.Context, call FanOutFunc) map[string]*FanOutResult {
+	r.mu.RLock()
+	clients := make(map[string]consensusclient.Service, len(r.networks))
+	for name, n := range r.networks {
+		clients[name] = n.client
+	}
+	r.mu.RUnlock()
+
+	var mu sync.Mutex
+	res := make(map[string]*FanOutResult, len(clients))
+	var wg sync.WaitGroup
+	for name, client := range clients {
+		wg.Add(1)
+		go func(name string, client consensusclient.Service) {
+			defer wg.Done()
+			value, err := call(ctx, name, client)
+			if err != nil {
+				r.log.Trace().Str("network", name).Err(err).Msg("Fan out call failed")
+			}
+			mu.Lock()
+			res[name] = &FanOutResult{Value: value, Err: err}
+			mu.Unlock()
+		}(name, client)
+	}
+	wg.Wait()
+
+	return res
+}
@@ -0,0 +1,92 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/registry"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type testClient struct {
+	address string
+	genesis *apiv1.Genesis
+}
+
+func (c *testClient) Name() string    { return "test" }
+func (c *testClient) Address() string { return c.address }
+
+func (c *testClient) Genesis(_ context.Context) (*apiv1.Genesis, error) {
+	return c.genesis, nil
+}
+
+func TestRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := registry.New(ctx, registry.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	mainnet := &testClient{address: "mainnet", genesis: &apiv1.Genesis{GenesisValidatorsRoot: phase0.Root{0x01}}}
+	holesky := &testClient{address: "holesky", genesis: &apiv1.Genesis{GenesisValidatorsRoot: phase0.Root{0x02}}}
+	require.NoError(t, r.Register(ctx, "mainnet", mainnet))
+	require.NoError(t, r.Register(ctx, "holesky", holesky))
+	require.Equal(t, []string{"holesky", "mainnet"}, r.Networks())
+
+	// A client for a different network with the same genesis is rejected.
+	err = r.Register(ctx, "devnet", &testClient{address: "devnet", genesis: mainnet.genesis})
+	require.EqualError(t, err, "client for network devnet has the same genesis as network mainnet")
+	// Replacing the client for the same network is allowed.
+	require.NoError(t, r.Register(ctx, "mainnet", &testClient{address: "mainnet2", genesis: mainnet.genesis}))
+
+	client, err := r.Client("mainnet")
+	require.NoError(t, err)
+	require.Equal(t, "mainnet2", client.Address())
+	genesis, err := r.Genesis("holesky")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x02}, genesis.GenesisValidatorsRoot)
+
+	_, err = r.Client("gnosis")
+	require.True(t, errors.Is(err, registry.ErrUnknownNetwork))
+
+	results := r.FanOut(ctx, func(_ context.Context, network string, client consensusclient.Service) (interface{}, error) {
+		if network == "holesky" {
+			return nil, errors.New("failed")
+		}
+		return client.Address(), nil
+	})
+	require.Len(t, results, 2)
+	require.Equal(t, "mainnet2", results["mainnet"].Value)
+	require.NoError(t, results["mainnet"].Err)
+	require.EqualError(t, results["holesky"].Err, "failed")
+
+	r.Deregister("holesky")
+	require.Equal(t, []string{"mainnet"}, r.Networks())
+}
+
+func TestRegisterErrors(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := registry.New(ctx)
+	require.NoError(t, err)
+
+	require.EqualError(t, r.Register(ctx, "", &testClient{}), "no network name specified")
+	require.EqualError(t, r.Register(ctx, "mainnet", nil), "no client specified")
+}
@@ -0,0 +1,185 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reorg resolves the blocks affected by a chain reorganisation.
+package reorg
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Block is a block affected by a reorganisation.
+type Block struct {
+	Root phase0.Root
+	Slot phase0.Slot
+}
+
+// Reorg is the result of resolving a chain reorganisation.
+type Reorg struct {
+	// CommonAncestor is the most recent block present on both the old and new chains.
+	CommonAncestor *Block
+	// Depth is the number of slots between the old head and the common ancestor.
+	Depth uint64
+	// Orphaned are the blocks that were on the old chain but are not on the new chain,
+	// in increasing slot order.
+	Orphaned []*Block
+	// Canonical are the blocks that were not on the old chain but are on the new chain,
+	// in increasing slot order.
+	Canonical []*Block
+}
+
+// AffectedSlots returns the slots that have an orphaned or newly canonical block, in
+// increasing order.  A slot that had a block on both chains is returned once.
+func (r *Reorg) AffectedSlots() []phase0.Slot {
+	seen := make(map[phase0.Slot]bool, len(r.Orphaned)+len(r.Canonical))
+	slots := make([]phase0.Slot, 0, len(r.Orphaned)+len(r.Canonical))
+	for _, blocks := range [][]*Block{r.Orphaned, r.Canonical} {
+		for _, block := range blocks {
+			if !seen[block.Slot] {
+				seen[block.Slot] = true
+				slots = append(slots, block.Slot)
+			}
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+
+	return slots
+}
+
+// chain is a cursor walking back along a chain of block headers.
+type chain struct {
+	root       phase0.Root
+	slot       phase0.Slot
+	parentRoot phase0.Root
+	blocks     []*Block
+}
+
+// Resolve walks back from the old and new heads of a chain reorganisation event to their
+// common ancestor, returning the blocks that were orphaned and the blocks that became
+// canonical as a result.
+//
+// Orphaned blocks must still be obtainable by root from the provider; most beacon nodes
+// retain them at least until they fall behind finality.
+func Resolve(ctx context.Context,
+	provider consensusclient.BeaconBlockHeadersProvider,
+	event *apiv1.ChainReorgEvent,
+) (
+	*Reorg,
+	error,
+) {
+	if provider == nil {
+		return nil, errors.New("no provider supplied")
+	}
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	oldChain, err := chainAt(ctx, provider, event.OldHeadBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain old head")
+	}
+	newChain, err := chainAt(ctx, provider, event.NewHeadBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain new head")
+	}
+	oldHeadSlot := oldChain.slot
+
+	// Step back along whichever chain has the higher slot until both are at the same block.
+	for oldChain.root != newChain.root {
+		stepOld := oldChain.slot >= newChain.slot
+		stepNew := newChain.slot >= oldChain.slot
+		if stepOld {
+			if err := oldChain.step(ctx, provider); err != nil {
+				return nil, errors.Wrap(err, "failed to walk old chain")
+			}
+		}
+		if stepNew {
+			if err := newChain.step(ctx, provider); err != nil {
+				return nil, errors.Wrap(err, "failed to walk new chain")
+			}
+		}
+	}
+
+	return &Reorg{
+		CommonAncestor: &Block{
+			Root: oldChain.root,
+			Slot: oldChain.slot,
+		},
+		Depth:     uint64(oldHeadSlot - oldChain.slot),
+		Orphaned:  reversed(oldChain.blocks),
+		Canonical: reversed(newChain.blocks),
+	}, nil
+}
+
+// chainAt creates a cursor at the given block.
+func chainAt(ctx context.Context,
+	provider consensusclient.BeaconBlockHeadersProvider,
+	root phase0.Root,
+) (
+	*chain,
+	error,
+) {
+	c := &chain{}
+	if err := c.moveTo(ctx, provider, root); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// step records the current block as affected and moves the cursor to its parent.
+func (c *chain) step(ctx context.Context, provider consensusclient.BeaconBlockHeadersProvider) error {
+	if c.parentRoot == (phase0.Root{}) {
+		return errors.New("reached genesis without finding a common ancestor")
+	}
+	c.blocks = append(c.blocks, &Block{
+		Root: c.root,
+		Slot: c.slot,
+	})
+
+	return c.moveTo(ctx, provider, c.parentRoot)
+}
+
+// moveTo moves the cursor to the given block.
+func (c *chain) moveTo(ctx context.Context, provider consensusclient.BeaconBlockHeadersProvider, root phase0.Root) error {
+	header, err := provider.BeaconBlockHeader(ctx, root.String())
+	if err != nil {
+		return err
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return fmt.Errorf("block %s not available", root.String())
+	}
+
+	c.root = root
+	c.slot = header.Header.Message.Slot
+	c.parentRoot = header.Header.Message.ParentRoot
+
+	return nil
+}
+
+// reversed returns the blocks in reverse order.
+func reversed(blocks []*Block) []*Block {
+	res := make([]*Block, len(blocks))
+	for i := range blocks {
+		res[len(blocks)-1-i] = blocks[i]
+	}
+
+	return res
+}
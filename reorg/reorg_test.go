@@ -0,0 +1,150 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reorg_test
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/reorg"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// headers is a beacon block headers provider backed by a map.
+type headers map[string]*apiv1.BeaconBlockHeader
+
+func (h headers) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	if blockID == (phase0.Root{0xee}).String() {
+		return nil, errors.New("mock error")
+	}
+	return h[blockID], nil
+}
+
+// add adds a block with the given root, slot and parent.
+func (h headers) add(root byte, slot phase0.Slot, parent byte) {
+	var parentRoot phase0.Root
+	if parent != 0 {
+		parentRoot = phase0.Root{parent}
+	}
+	h[(phase0.Root{root}).String()] = &apiv1.BeaconBlockHeader{
+		Root: phase0.Root{root},
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: parentRoot,
+			},
+		},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+
+	// Chain layout:
+	//
+	//   0x01(1) - 0x02(2) - 0x03(3) - 0x04(4) - 0x05(6)
+	//                     \
+	//                      0x13(4) - 0x14(5)
+	//
+	// with an unrelated chain 0x21(3) - 0x22(4).
+	provider := headers{}
+	provider.add(0x01, 1, 0)
+	provider.add(0x02, 2, 0x01)
+	provider.add(0x03, 3, 0x02)
+	provider.add(0x04, 4, 0x03)
+	provider.add(0x05, 6, 0x04)
+	provider.add(0x13, 4, 0x02)
+	provider.add(0x14, 5, 0x13)
+	provider.add(0x21, 3, 0)
+	provider.add(0x22, 4, 0x21)
+	provider.add(0x30, 5, 0xee)
+
+	tests := []struct {
+		name      string
+		event     *apiv1.ChainReorgEvent
+		ancestor  *reorg.Block
+		depth     uint64
+		orphaned  []*reorg.Block
+		canonical []*reorg.Block
+		slots     []phase0.Slot
+		err       string
+	}{
+		{
+			name: "EventNil",
+			err:  "no event supplied",
+		},
+		{
+			name:  "OldHeadMissing",
+			event: &apiv1.ChainReorgEvent{OldHeadBlock: phase0.Root{0xff}, NewHeadBlock: phase0.Root{0x05}},
+			err:   "failed to obtain old head: block 0xff00000000000000000000000000000000000000000000000000000000000000 not available",
+		},
+		{
+			name:  "ParentError",
+			event: &apiv1.ChainReorgEvent{OldHeadBlock: phase0.Root{0x30}, NewHeadBlock: phase0.Root{0x04}},
+			err:   "failed to walk old chain: mock error",
+		},
+		{
+			name:  "Unrelated",
+			event: &apiv1.ChainReorgEvent{OldHeadBlock: phase0.Root{0x22}, NewHeadBlock: phase0.Root{0x04}},
+			err:   "failed to walk old chain: reached genesis without finding a common ancestor",
+		},
+		{
+			name:     "Same",
+			event:    &apiv1.ChainReorgEvent{OldHeadBlock: phase0.Root{0x04}, NewHeadBlock: phase0.Root{0x04}},
+			ancestor: &reorg.Block{Root: phase0.Root{0x04}, Slot: 4},
+			slots:    []phase0.Slot{},
+		},
+		{
+			name:      "Fork",
+			event:     &apiv1.ChainReorgEvent{OldHeadBlock: phase0.Root{0x14}, NewHeadBlock: phase0.Root{0x05}},
+			ancestor:  &reorg.Block{Root: phase0.Root{0x02}, Slot: 2},
+			depth:     3,
+			orphaned:  []*reorg.Block{{Root: phase0.Root{0x13}, Slot: 4}, {Root: phase0.Root{0x14}, Slot: 5}},
+			canonical: []*reorg.Block{{Root: phase0.Root{0x03}, Slot: 3}, {Root: phase0.Root{0x04}, Slot: 4}, {Root: phase0.Root{0x05}, Slot: 6}},
+			slots:     []phase0.Slot{3, 4, 5, 6},
+		},
+		{
+			name:      "Extension",
+			event:     &apiv1.ChainReorgEvent{OldHeadBlock: phase0.Root{0x03}, NewHeadBlock: phase0.Root{0x05}},
+			ancestor:  &reorg.Block{Root: phase0.Root{0x03}, Slot: 3},
+			canonical: []*reorg.Block{{Root: phase0.Root{0x04}, Slot: 4}, {Root: phase0.Root{0x05}, Slot: 6}},
+			slots:     []phase0.Slot{4, 6},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := reorg.Resolve(ctx, provider, test.event)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.ancestor, res.CommonAncestor)
+			require.Equal(t, test.depth, res.Depth)
+			require.Len(t, res.Orphaned, len(test.orphaned))
+			for i := range test.orphaned {
+				require.Equal(t, test.orphaned[i], res.Orphaned[i])
+			}
+			require.Len(t, res.Canonical, len(test.canonical))
+			for i := range test.canonical {
+				require.Equal(t, test.canonical[i], res.Canonical[i])
+			}
+			require.Equal(t, test.slots, res.AffectedSlots())
+		})
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewards
+
+import (
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/attestations"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Proposer reward values, as per the Altair spec.  These are the same for all presets.
+const (
+	proposerWeight               = 8
+	syncRewardWeight             = 2
+	whistleblowerRewardQuotient  = 512
+	minAttestationInclusionDelay = 1
+)
+
+// BlockReward contains the consensus rewards for the proposer of a block, broken down by
+// source, in Gwei.  Execution rewards paid to the fee recipient require transaction receipts
+// from the execution layer, so are not included.
+type BlockReward struct {
+	ProposerIndex     phase0.ValidatorIndex
+	Attestations      phase0.Gwei
+	SyncAggregate     phase0.Gwei
+	ProposerSlashings phase0.Gwei
+	AttesterSlashings phase0.Gwei
+}
+
+// Total returns the total consensus reward for the proposer.
+func (r *BlockReward) Total() phase0.Gwei {
+	return r.Attestations + r.SyncAggregate + r.ProposerSlashings + r.AttesterSlashings
+}
+
+// BlockRewards calculates the rewards for the proposer of the given block.
+//
+// The state must be the state at the slot of the block prior to the block being applied, that
+// is the state of the parent block advanced to the block's slot.  The committees must include
+// those for the epochs of the attestations in the block, as returned by BeaconCommittees().
+func BlockRewards(config *Config,
+	state *spec.VersionedBeaconState,
+	block *spec.VersionedSignedBeaconBlock,
+	committees []*apiv1.BeaconCommittee,
+) (
+	*BlockReward,
+	error,
+) {
+	if config == nil {
+		return nil, errors.New("no config specified")
+	}
+	if state == nil {
+		return nil, errors.New("no state specified")
+	}
+	if block == nil {
+		return nil, errors.New("no block specified")
+	}
+	if state.Version == spec.DataVersionPhase0 || block.Version == spec.DataVersionPhase0 {
+		return nil, errors.New("phase0 blocks are not supported")
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block slot")
+	}
+	stateSlot, err := state.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state slot")
+	}
+	if stateSlot != slot {
+		return nil, fmt.Errorf("state slot %d does not match block slot %d", stateSlot, slot)
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block proposer index")
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state validators")
+	}
+
+	c := &blockRewardsCalculator{
+		config:       config,
+		state:        state,
+		slot:         slot,
+		currentEpoch: phase0.Epoch(uint64(slot) / config.SlotsPerEpoch),
+		validators:   validators,
+		slashed:      make(map[phase0.ValidatorIndex]bool),
+	}
+	c.setBaseRewardPerIncrement()
+
+	res := &BlockReward{
+		ProposerIndex: proposerIndex,
+	}
+	if res.ProposerSlashings, err = c.proposerSlashingRewards(block); err != nil {
+		return nil, err
+	}
+	if res.AttesterSlashings, err = c.attesterSlashingRewards(block); err != nil {
+		return nil, err
+	}
+	if res.Attestations, err = c.attestationRewards(block, committees); err != nil {
+		return nil, err
+	}
+	if res.SyncAggregate, err = c.syncAggregateRewards(block); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// blockRewardsCalculator holds the information required to calculate the rewards for a block.
+type blockRewardsCalculator struct {
+	config                 *Config
+	state                  *spec.VersionedBeaconState
+	slot                   phase0.Slot
+	currentEpoch           phase0.Epoch
+	validators             []*phase0.Validator
+	totalActiveBalance     uint64
+	baseRewardPerIncrement uint64
+	// slashed holds the validators slashed by the block so far.
+	slashed map[phase0.ValidatorIndex]bool
+}
+
+func (c *blockRewardsCalculator) setBaseRewardPerIncrement() {
+	increment := uint64(c.config.EffectiveBalanceIncrement)
+	for _, validator := range c.validators {
+		if isActive(validator, c.currentEpoch) {
+			c.totalActiveBalance += uint64(validator.EffectiveBalance)
+		}
+	}
+	if c.totalActiveBalance < increment {
+		c.totalActiveBalance = increment
+	}
+	c.baseRewardPerIncrement = increment * c.config.BaseRewardFactor / integerSquareRoot(c.totalActiveBalance)
+}
+
+func (c *blockRewardsCalculator) baseReward(index phase0.ValidatorIndex) uint64 {
+	return uint64(c.validators[index].EffectiveBalance) / uint64(c.config.EffectiveBalanceIncrement) * c.baseRewardPerIncrement
+}
+
+// slash returns the reward to the proposer for slashing the validator, which as
+// whistleblower receives the whole of the whistleblower reward.
+func (c *blockRewardsCalculator) slash(index phase0.ValidatorIndex) (phase0.Gwei, error) {
+	if int(index) >= len(c.validators) {
+		return 0, fmt.Errorf("slashed validator %d not in state", index)
+	}
+	c.slashed[index] = true
+
+	return c.validators[index].EffectiveBalance / whistleblowerRewardQuotient, nil
+}
+
+func (c *blockRewardsCalculator) proposerSlashingRewards(block *spec.VersionedSignedBeaconBlock) (phase0.Gwei, error) {
+	proposerSlashings, err := block.ProposerSlashings()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain proposer slashings")
+	}
+
+	total := phase0.Gwei(0)
+	for _, proposerSlashing := range proposerSlashings {
+		if proposerSlashing == nil || proposerSlashing.SignedHeader1 == nil || proposerSlashing.SignedHeader1.Message == nil {
+			return 0, errors.New("invalid proposer slashing")
+		}
+		reward, err := c.slash(proposerSlashing.SignedHeader1.Message.ProposerIndex)
+		if err != nil {
+			return 0, err
+		}
+		total += reward
+	}
+
+	return total, nil
+}
+
+func (c *blockRewardsCalculator) attesterSlashingRewards(block *spec.VersionedSignedBeaconBlock) (phase0.Gwei, error) {
+	attesterSlashings, err := block.AttesterSlashings()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain attester slashings")
+	}
+
+	total := phase0.Gwei(0)
+	for _, attesterSlashing := range attesterSlashings {
+		if attesterSlashing == nil || attesterSlashing.Attestation1 == nil || attesterSlashing.Attestation2 == nil {
+			return 0, errors.New("invalid attester slashing")
+		}
+		indices2 := make(map[uint64]bool, len(attesterSlashing.Attestation2.AttestingIndices))
+		for _, index := range attesterSlashing.Attestation2.AttestingIndices {
+			indices2[index] = true
+		}
+		for _, index := range attesterSlashing.Attestation1.AttestingIndices {
+			if !indices2[index] || !c.isSlashable(phase0.ValidatorIndex(index)) {
+				continue
+			}
+			reward, err := c.slash(phase0.ValidatorIndex(index))
+			if err != nil {
+				return 0, err
+			}
+			total += reward
+		}
+	}
+
+	return total, nil
+}
+
+func (c *blockRewardsCalculator) isSlashable(index phase0.ValidatorIndex) bool {
+	if int(index) >= len(c.validators) || c.slashed[index] {
+		return false
+	}
+	validator := c.validators[index]
+
+	return !validator.Slashed &&
+		validator.ActivationEpoch <= c.currentEpoch &&
+		c.currentEpoch < validator.WithdrawableEpoch
+}
+
+func (c *blockRewardsCalculator) attestationRewards(block *spec.VersionedSignedBeaconBlock,
+	committees []*apiv1.BeaconCommittee,
+) (
+	phase0.Gwei,
+	error,
+) {
+	blockAttestations, err := block.Attestations()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain attestations")
+	}
+	blockRoots, err := c.state.BlockRoots()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain state block roots")
+	}
+	currentJustified, err := c.state.CurrentJustifiedCheckpoint()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain state current justified checkpoint")
+	}
+	previousJustified, err := c.state.PreviousJustifiedCheckpoint()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain state previous justified checkpoint")
+	}
+	currentParticipation, err := c.state.CurrentEpochParticipation()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain state current epoch participation")
+	}
+	previousParticipation, err := c.state.PreviousEpochParticipation()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain state previous epoch participation")
+	}
+	if currentJustified == nil || previousJustified == nil {
+		return 0, errors.New("state has no justified checkpoints")
+	}
+	// Participation is updated by each attestation, so work on copies.
+	currentParticipation = append([]altair.ParticipationFlags{}, currentParticipation...)
+	previousParticipation = append([]altair.ParticipationFlags{}, previousParticipation...)
+
+	blockRootAtSlot := func(slot phase0.Slot) (phase0.Root, error) {
+		if slot >= c.slot || uint64(c.slot) > uint64(slot)+uint64(len(blockRoots)) {
+			return phase0.Root{}, fmt.Errorf("block root for slot %d not available", slot)
+		}
+		return blockRoots[uint64(slot)%uint64(len(blockRoots))], nil
+	}
+
+	denominator := uint64((weightDenominator - proposerWeight) * weightDenominator / proposerWeight)
+	total := phase0.Gwei(0)
+	for _, attestation := range blockAttestations {
+		if attestation == nil || attestation.Data == nil || attestation.Data.Source == nil || attestation.Data.Target == nil {
+			return 0, errors.New("invalid attestation")
+		}
+		data := attestation.Data
+
+		var justified *phase0.Checkpoint
+		var participation []altair.ParticipationFlags
+		switch {
+		case data.Target.Epoch == c.currentEpoch:
+			justified = currentJustified
+			participation = currentParticipation
+		case data.Target.Epoch+1 == c.currentEpoch:
+			justified = previousJustified
+			participation = previousParticipation
+		default:
+			return 0, fmt.Errorf("attestation for epoch %d cannot be included at epoch %d", data.Target.Epoch, c.currentEpoch)
+		}
+
+		inclusionDelay := uint64(c.slot - data.Slot)
+		targetRoot, err := blockRootAtSlot(phase0.Slot(uint64(data.Target.Epoch) * c.config.SlotsPerEpoch))
+		if err != nil {
+			return 0, err
+		}
+		headRoot, err := blockRootAtSlot(data.Slot)
+		if err != nil {
+			return 0, err
+		}
+		matchingSource := data.Source.Epoch == justified.Epoch && data.Source.Root == justified.Root
+		matchingTarget := matchingSource && data.Target.Root == targetRoot
+		matchingHead := matchingTarget && data.BeaconBlockRoot == headRoot
+		flags := make([]bool, len(flagWeights))
+		flags[altair.TimelySourceFlagIndex] = matchingSource && inclusionDelay <= integerSquareRoot(c.config.SlotsPerEpoch)
+		flags[altair.TimelyTargetFlagIndex] = matchingTarget && inclusionDelay <= c.config.SlotsPerEpoch
+		flags[altair.TimelyHeadFlagIndex] = matchingHead && inclusionDelay == minAttestationInclusionDelay
+
+		participants, err := attestations.Participants(attestation, committees)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to obtain attestation participants")
+		}
+		numerator := uint64(0)
+		for _, index := range participants {
+			if int(index) >= len(participation) {
+				return 0, fmt.Errorf("attesting validator %d not in state", index)
+			}
+			for _, flagWeight := range flagWeights {
+				if flags[flagWeight.flag] && !hasFlag(participation[index], flagWeight.flag) {
+					participation[index] |= 1 << flagWeight.flag
+					numerator += c.baseReward(index) * flagWeight.weight
+				}
+			}
+		}
+		total += phase0.Gwei(numerator / denominator)
+	}
+
+	return total, nil
+}
+
+func (c *blockRewardsCalculator) syncAggregateRewards(block *spec.VersionedSignedBeaconBlock) (phase0.Gwei, error) {
+	syncAggregate, err := block.SyncAggregate()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain sync aggregate")
+	}
+	if syncAggregate == nil || syncAggregate.SyncCommitteeBits.Len() == 0 {
+		return 0, nil
+	}
+
+	totalActiveIncrements := c.totalActiveBalance / uint64(c.config.EffectiveBalanceIncrement)
+	totalBaseRewards := c.baseRewardPerIncrement * totalActiveIncrements
+	maxParticipantRewards := totalBaseRewards * syncRewardWeight / weightDenominator / c.config.SlotsPerEpoch
+	participantReward := maxParticipantRewards / syncAggregate.SyncCommitteeBits.Len()
+	proposerReward := participantReward * proposerWeight / (weightDenominator - proposerWeight)
+
+	return phase0.Gwei(proposerReward * syncAggregate.SyncCommitteeBits.Count()), nil
+}
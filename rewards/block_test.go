@@ -0,0 +1,152 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewards_test
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/rewards"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlockState creates a state at slot 321 with 64 validators, of which validator 14 has been slashed.
+func testBlockState() *spec.VersionedBeaconState {
+	validators := make([]*phase0.Validator, 64)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			EffectiveBalance:  32000000000,
+			ExitEpoch:         0xffffffffffffffff,
+			WithdrawableEpoch: 0xffffffffffffffff,
+		}
+	}
+	validators[14].Slashed = true
+	blockRoots := make([]phase0.Root, 64)
+	for i := range blockRoots {
+		blockRoots[i] = phase0.Root{byte(i)}
+	}
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconState{
+			Slot:                        321,
+			Validators:                  validators,
+			BlockRoots:                  blockRoots,
+			PreviousEpochParticipation:  make([]altair.ParticipationFlags, 64),
+			CurrentEpochParticipation:   make([]altair.ParticipationFlags, 64),
+			PreviousJustifiedCheckpoint: &phase0.Checkpoint{Epoch: 8, Root: phase0.Root{0x08}},
+			CurrentJustifiedCheckpoint:  &phase0.Checkpoint{Epoch: 9, Root: phase0.Root{0x09}},
+		},
+	}
+}
+
+func testBlockAttestation() *phase0.Attestation {
+	aggregationBits := bitfield.NewBitlist(8)
+	for i := uint64(0); i < 8; i++ {
+		aggregationBits.SetBitAt(i, true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &phase0.AttestationData{
+			Slot:            320,
+			Index:           0,
+			BeaconBlockRoot: phase0.Root{byte(320 % 64)},
+			Source:          &phase0.Checkpoint{Epoch: 9, Root: phase0.Root{0x09}},
+			Target:          &phase0.Checkpoint{Epoch: 10, Root: phase0.Root{byte(320 % 64)}},
+		},
+	}
+}
+
+func TestBlockRewards(t *testing.T) {
+	syncCommitteeBits := bitfield.NewBitvector512()
+	for i := uint64(0); i < 256; i++ {
+		syncCommitteeBits.SetBitAt(i, true)
+	}
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.SignedBeaconBlock{
+			Message: &altair.BeaconBlock{
+				Slot:          321,
+				ProposerIndex: 5,
+				Body: &altair.BeaconBlockBody{
+					ProposerSlashings: []*phase0.ProposerSlashing{
+						{
+							SignedHeader1: &phase0.SignedBeaconBlockHeader{Message: &phase0.BeaconBlockHeader{ProposerIndex: 10}},
+							SignedHeader2: &phase0.SignedBeaconBlockHeader{Message: &phase0.BeaconBlockHeader{ProposerIndex: 10}},
+						},
+					},
+					AttesterSlashings: []*phase0.AttesterSlashing{
+						{
+							// Only 12 is slashed; 14 has already been slashed.
+							Attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{11, 12, 14}},
+							Attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{12, 13, 14}},
+						},
+					},
+					Attestations: []*phase0.Attestation{
+						testBlockAttestation(),
+						// A duplicate attestation earns nothing.
+						testBlockAttestation(),
+					},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: syncCommitteeBits,
+					},
+				},
+			},
+		},
+	}
+	committees := []*apiv1.BeaconCommittee{
+		{
+			Slot:       320,
+			Index:      0,
+			Validators: []phase0.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+	}
+
+	reward, err := rewards.BlockRewards(testConfig(), testBlockState(), block, committees)
+	require.NoError(t, err)
+	require.Equal(t, &rewards.BlockReward{
+		ProposerIndex:     5,
+		Attestations:      1379962,
+		SyncAggregate:     6144,
+		ProposerSlashings: 62500000,
+		AttesterSlashings: 62500000,
+	}, reward)
+	require.Equal(t, phase0.Gwei(126386106), reward.Total())
+}
+
+func TestBlockRewardsErrors(t *testing.T) {
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.SignedBeaconBlock{
+			Message: &altair.BeaconBlock{
+				Slot: 322,
+				Body: &altair.BeaconBlockBody{},
+			},
+		},
+	}
+
+	_, err := rewards.BlockRewards(nil, testBlockState(), block, nil)
+	require.EqualError(t, err, "no config specified")
+	_, err = rewards.BlockRewards(testConfig(), nil, block, nil)
+	require.EqualError(t, err, "no state specified")
+	_, err = rewards.BlockRewards(testConfig(), testBlockState(), nil, nil)
+	require.EqualError(t, err, "no block specified")
+	_, err = rewards.BlockRewards(testConfig(), testBlockState(), block, nil)
+	require.EqualError(t, err, "state slot 321 does not match block slot 322")
+}
@@ -0,0 +1,69 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewards
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Config contains the spec values required to calculate rewards.
+type Config struct {
+	SlotsPerEpoch                      uint64
+	EffectiveBalanceIncrement          phase0.Gwei
+	BaseRewardFactor                   uint64
+	MinEpochsToInactivityPenalty       uint64
+	InactivityScoreBias                uint64
+	InactivityScoreRecoveryRate        uint64
+	InactivityPenaltyQuotientAltair    uint64
+	InactivityPenaltyQuotientBellatrix uint64
+}
+
+// NewConfig creates a configuration from a spec, as returned by a SpecProvider.
+func NewConfig(spec map[string]interface{}) (*Config, error) {
+	config := &Config{}
+
+	var effectiveBalanceIncrement uint64
+	uintValues := []struct {
+		key   string
+		value *uint64
+	}{
+		{key: "SLOTS_PER_EPOCH", value: &config.SlotsPerEpoch},
+		{key: "EFFECTIVE_BALANCE_INCREMENT", value: &effectiveBalanceIncrement},
+		{key: "BASE_REWARD_FACTOR", value: &config.BaseRewardFactor},
+		{key: "MIN_EPOCHS_TO_INACTIVITY_PENALTY", value: &config.MinEpochsToInactivityPenalty},
+		{key: "INACTIVITY_SCORE_BIAS", value: &config.InactivityScoreBias},
+		{key: "INACTIVITY_SCORE_RECOVERY_RATE", value: &config.InactivityScoreRecoveryRate},
+		{key: "INACTIVITY_PENALTY_QUOTIENT_ALTAIR", value: &config.InactivityPenaltyQuotientAltair},
+		{key: "INACTIVITY_PENALTY_QUOTIENT_BELLATRIX", value: &config.InactivityPenaltyQuotientBellatrix},
+	}
+	for _, uintValue := range uintValues {
+		tmp, exists := spec[uintValue.key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", uintValue.key)
+		}
+		val, isUint := tmp.(uint64)
+		if !isUint {
+			return nil, fmt.Errorf("%s of unexpected type", uintValue.key)
+		}
+		if val == 0 && uintValue.key != "MIN_EPOCHS_TO_INACTIVITY_PENALTY" {
+			return nil, fmt.Errorf("%s must be greater than 0", uintValue.key)
+		}
+		*uintValue.value = val
+	}
+	config.EffectiveBalanceIncrement = phase0.Gwei(effectiveBalanceIncrement)
+
+	return config, nil
+}
@@ -0,0 +1,236 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rewards calculates expected attestation rewards and penalties, and block
+// proposer rewards, locally from a beacon state, following the Altair accounting rules.
+package rewards
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Participation flag weights, as per the Altair spec.
+const (
+	timelySourceWeight = 14
+	timelyTargetWeight = 26
+	timelyHeadWeight   = 14
+	weightDenominator  = 64
+)
+
+var flagWeights = []struct {
+	flag   altair.ParticipationFlag
+	weight uint64
+}{
+	{flag: altair.TimelySourceFlagIndex, weight: timelySourceWeight},
+	{flag: altair.TimelyTargetFlagIndex, weight: timelyTargetWeight},
+	{flag: altair.TimelyHeadFlagIndex, weight: timelyHeadWeight},
+}
+
+// AttestationReward contains the attestation rewards and penalties for a validator.
+// Values are in Gwei; positive values are rewards and negative values are penalties.
+type AttestationReward struct {
+	ValidatorIndex phase0.ValidatorIndex
+	Source         int64
+	Target         int64
+	Head           int64
+	Inactivity     int64
+}
+
+// Total returns the net reward for the validator.
+func (r *AttestationReward) Total() int64 {
+	return r.Source + r.Target + r.Head + r.Inactivity
+}
+
+// AttestationRewards calculates the attestation rewards and penalties that will be applied to
+// each eligible validator at the end of the epoch of the given state, for its attestations in
+// the previous epoch.
+//
+// Inactivity scores are updated as per the spec before calculating inactivity penalties.  The
+// inactivity leak is determined from the finalized checkpoint of the supplied state, so any change
+// to finality at the epoch transition is not taken into account.
+func AttestationRewards(config *Config, state *spec.VersionedBeaconState) ([]*AttestationReward, error) {
+	if config == nil {
+		return nil, errors.New("no config specified")
+	}
+	if state == nil {
+		return nil, errors.New("no state specified")
+	}
+
+	var inactivityPenaltyQuotient uint64
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		return nil, errors.New("phase0 states are not supported")
+	case spec.DataVersionAltair:
+		inactivityPenaltyQuotient = config.InactivityPenaltyQuotientAltair
+	default:
+		inactivityPenaltyQuotient = config.InactivityPenaltyQuotientBellatrix
+	}
+
+	slot, err := state.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state slot")
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state validators")
+	}
+	participation, err := state.PreviousEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state previous epoch participation")
+	}
+	inactivityScores, err := state.InactivityScores()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state inactivity scores")
+	}
+	finalizedCheckpoint, err := state.FinalizedCheckpoint()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state finalized checkpoint")
+	}
+	if finalizedCheckpoint == nil {
+		return nil, errors.New("state has no finalized checkpoint")
+	}
+	if len(participation) != len(validators) {
+		return nil, fmt.Errorf("expected %d participation flags, found %d", len(validators), len(participation))
+	}
+	if len(inactivityScores) != len(validators) {
+		return nil, fmt.Errorf("expected %d inactivity scores, found %d", len(validators), len(inactivityScores))
+	}
+
+	currentEpoch := phase0.Epoch(uint64(slot) / config.SlotsPerEpoch)
+	if currentEpoch == 0 {
+		// No rewards or penalties are applied at the end of the genesis epoch.
+		return []*AttestationReward{}, nil
+	}
+	previousEpoch := currentEpoch - 1
+	inactivityLeak := uint64(previousEpoch-finalizedCheckpoint.Epoch) > config.MinEpochsToInactivityPenalty
+
+	// Total balances.
+	increment := uint64(config.EffectiveBalanceIncrement)
+	totalActiveBalance := uint64(0)
+	participatingBalances := make([]uint64, len(flagWeights))
+	for i, validator := range validators {
+		if isActive(validator, currentEpoch) {
+			totalActiveBalance += uint64(validator.EffectiveBalance)
+		}
+		if !isActive(validator, previousEpoch) || validator.Slashed {
+			continue
+		}
+		for j, flagWeight := range flagWeights {
+			if hasFlag(participation[i], flagWeight.flag) {
+				participatingBalances[j] += uint64(validator.EffectiveBalance)
+			}
+		}
+	}
+	if totalActiveBalance < increment {
+		totalActiveBalance = increment
+	}
+	for j := range participatingBalances {
+		if participatingBalances[j] < increment {
+			participatingBalances[j] = increment
+		}
+	}
+	activeIncrements := totalActiveBalance / increment
+	baseRewardPerIncrement := increment * config.BaseRewardFactor / integerSquareRoot(totalActiveBalance)
+
+	rewards := make([]*AttestationReward, 0, len(validators))
+	for i, validator := range validators {
+		if !isEligible(validator, previousEpoch) {
+			continue
+		}
+		participated := !validator.Slashed && isActive(validator, previousEpoch)
+		effectiveBalance := uint64(validator.EffectiveBalance)
+		baseReward := effectiveBalance / increment * baseRewardPerIncrement
+
+		flagRewards := make([]int64, len(flagWeights))
+		for j, flagWeight := range flagWeights {
+			switch {
+			case participated && hasFlag(participation[i], flagWeight.flag):
+				if !inactivityLeak {
+					numerator := baseReward * flagWeight.weight * (participatingBalances[j] / increment)
+					flagRewards[j] = int64(numerator / (activeIncrements * weightDenominator))
+				}
+			case flagWeight.flag != altair.TimelyHeadFlagIndex:
+				flagRewards[j] = -int64(baseReward * flagWeight.weight / weightDenominator)
+			}
+		}
+
+		// Update the inactivity score prior to calculating the inactivity penalty.
+		targetParticipated := participated && hasFlag(participation[i], altair.TimelyTargetFlagIndex)
+		inactivityScore := inactivityScores[i]
+		if targetParticipated {
+			if inactivityScore > 0 {
+				inactivityScore--
+			}
+		} else {
+			inactivityScore += config.InactivityScoreBias
+		}
+		if !inactivityLeak {
+			if inactivityScore > config.InactivityScoreRecoveryRate {
+				inactivityScore -= config.InactivityScoreRecoveryRate
+			} else {
+				inactivityScore = 0
+			}
+		}
+
+		inactivity := int64(0)
+		if !targetParticipated {
+			numerator := effectiveBalance * inactivityScore
+			inactivity = -int64(numerator / (config.InactivityScoreBias * inactivityPenaltyQuotient))
+		}
+
+		rewards = append(rewards, &AttestationReward{
+			ValidatorIndex: phase0.ValidatorIndex(i),
+			Source:         flagRewards[0],
+			Target:         flagRewards[1],
+			Head:           flagRewards[2],
+			Inactivity:     inactivity,
+		})
+	}
+
+	return rewards, nil
+}
+
+// isActive returns true if the validator is active at the given epoch.
+func isActive(validator *phase0.Validator, epoch phase0.Epoch) bool {
+	return validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch
+}
+
+// isEligible returns true if the validator is eligible for rewards and penalties for the given epoch.
+func isEligible(validator *phase0.Validator, previousEpoch phase0.Epoch) bool {
+	return isActive(validator, previousEpoch) ||
+		(validator.Slashed && previousEpoch+1 < validator.WithdrawableEpoch)
+}
+
+// hasFlag returns true if the participation flags contain the given flag.
+func hasFlag(flags altair.ParticipationFlags, flag altair.ParticipationFlag) bool {
+	return flags&(1<<flag) != 0
+}
+
+// integerSquareRoot returns the largest integer x such that x*x <= n.
+func integerSquareRoot(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	x := n
+	y := x/2 + x%2
+	for y < x {
+		x = y
+		y = (x + n/x) / 2
+	}
+	return x
+}
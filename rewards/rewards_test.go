@@ -0,0 +1,167 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewards_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/rewards"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *rewards.Config {
+	return &rewards.Config{
+		SlotsPerEpoch:                      32,
+		EffectiveBalanceIncrement:          1000000000,
+		BaseRewardFactor:                   64,
+		MinEpochsToInactivityPenalty:       4,
+		InactivityScoreBias:                4,
+		InactivityScoreRecoveryRate:        16,
+		InactivityPenaltyQuotientAltair:    3 * 1 << 24,
+		InactivityPenaltyQuotientBellatrix: 1 << 24,
+	}
+}
+
+// testState creates a state at the start of epoch 10 with 64 validators, where:
+//   - validator 1 did not attest in the previous epoch
+//   - validator 2 has been slashed
+//   - validator 3 exited before the previous epoch
+func testState(finalizedEpoch phase0.Epoch) *spec.VersionedBeaconState {
+	validators := make([]*phase0.Validator, 64)
+	participation := make([]altair.ParticipationFlags, 64)
+	inactivityScores := make([]uint64, 64)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			EffectiveBalance:  32000000000,
+			ExitEpoch:         0xffffffffffffffff,
+			WithdrawableEpoch: 0xffffffffffffffff,
+		}
+		participation[i] = 0x07
+	}
+	participation[1] = 0x00
+	inactivityScores[1] = 100
+	validators[2].Slashed = true
+	validators[3].ExitEpoch = 9
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconState{
+			Slot:                       320,
+			Validators:                 validators,
+			PreviousEpochParticipation: participation,
+			InactivityScores:           inactivityScores,
+			FinalizedCheckpoint: &phase0.Checkpoint{
+				Epoch: finalizedEpoch,
+			},
+		},
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	_, err := rewards.NewConfig(map[string]interface{}{})
+	require.EqualError(t, err, "SLOTS_PER_EPOCH not found in spec")
+
+	config, err := rewards.NewConfig(map[string]interface{}{
+		"SLOTS_PER_EPOCH":                       uint64(32),
+		"EFFECTIVE_BALANCE_INCREMENT":           uint64(1000000000),
+		"BASE_REWARD_FACTOR":                    uint64(64),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":      uint64(4),
+		"INACTIVITY_SCORE_BIAS":                 uint64(4),
+		"INACTIVITY_SCORE_RECOVERY_RATE":        uint64(16),
+		"INACTIVITY_PENALTY_QUOTIENT_ALTAIR":    uint64(3 * 1 << 24),
+		"INACTIVITY_PENALTY_QUOTIENT_BELLATRIX": uint64(1 << 24),
+	})
+	require.NoError(t, err)
+	require.Equal(t, testConfig(), config)
+}
+
+func TestAttestationRewards(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *rewards.Config
+		state    *spec.VersionedBeaconState
+		expected map[phase0.ValidatorIndex]*rewards.AttestationReward
+		err      string
+	}{
+		{
+			name:  "ConfigNil",
+			state: testState(8),
+			err:   "no config specified",
+		},
+		{
+			name:   "StateNil",
+			config: testConfig(),
+			err:    "no state specified",
+		},
+		{
+			name:   "Phase0",
+			config: testConfig(),
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionPhase0,
+				Phase0:  &phase0.BeaconState{},
+			},
+			err: "phase0 states are not supported",
+		},
+		{
+			name:   "Good",
+			config: testConfig(),
+			state:  testState(8),
+			expected: map[phase0.ValidatorIndex]*rewards.AttestationReward{
+				0: {ValidatorIndex: 0, Source: 305501, Target: 567360, Head: 305501},
+				1: {ValidatorIndex: 1, Source: -315518, Target: -585962, Inactivity: -13987},
+				2: {ValidatorIndex: 2, Source: -315518, Target: -585962},
+			},
+		},
+		{
+			name:   "InactivityLeak",
+			config: testConfig(),
+			state:  testState(0),
+			expected: map[phase0.ValidatorIndex]*rewards.AttestationReward{
+				0: {ValidatorIndex: 0},
+				1: {ValidatorIndex: 1, Source: -315518, Target: -585962, Inactivity: -16530},
+				2: {ValidatorIndex: 2, Source: -315518, Target: -585962, Inactivity: -635},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := rewards.AttestationRewards(test.config, test.state)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			// Validator 3 is not eligible.
+			require.Len(t, res, 63)
+			for _, reward := range res {
+				require.NotEqual(t, phase0.ValidatorIndex(3), reward.ValidatorIndex)
+				if expected, exists := test.expected[reward.ValidatorIndex]; exists {
+					require.Equal(t, expected, reward)
+				}
+			}
+		})
+	}
+}
+
+func TestAttestationRewardsGenesis(t *testing.T) {
+	state := testState(0)
+	state.Altair.Slot = 5
+	res, err := rewards.AttestationRewards(testConfig(), state)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
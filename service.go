@@ -113,6 +113,13 @@ type SignedBeaconBlockProvider interface {
 	SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error)
 }
 
+// SignedBeaconBlocksProvider is the interface for providing multiple beacon blocks.
+type SignedBeaconBlocksProvider interface {
+	// SignedBeaconBlocks fetches signed beacon blocks given their roots.
+	// Blocks that are not available are not present in the returned map.
+	SignedBeaconBlocks(ctx context.Context, roots []phase0.Root) (map[phase0.Root]*spec.VersionedSignedBeaconBlock, error)
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the epoch at the given state.
@@ -122,6 +129,27 @@ type BeaconCommitteesProvider interface {
 	BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error)
 }
 
+// IndexedBeaconCommitteesProvider is the interface for providing filtered beacon committees indexed for lookup.
+type IndexedBeaconCommitteesProvider interface {
+	// IndexedBeaconCommittees fetches the beacon committees matching the filter at the given state.
+	// The filter can be nil, in which case all committees for the epoch of the state are returned.
+	IndexedBeaconCommittees(ctx context.Context,
+		stateID string,
+		filter *apiv1.BeaconCommitteesFilter,
+	) (
+		*apiv1.BeaconCommittees,
+		error,
+	)
+}
+
+// AttesterAssignmentsProvider is the interface for providing the attester assignments of
+// all validators for an epoch.
+type AttesterAssignmentsProvider interface {
+	// AllAttesterAssignments fetches the beacon committees for the given epoch and provides
+	// the assignment of each validator in them, keyed by validator index.
+	AllAttesterAssignments(ctx context.Context, epoch phase0.Epoch) (map[phase0.ValidatorIndex]*apiv1.AttesterAssignment, error)
+}
+
 // SyncCommitteesProvider is the interface for providing sync committees.
 type SyncCommitteesProvider interface {
 	// SyncCommittee fetches the sync committee for the given state.
@@ -162,6 +190,18 @@ type AttestationPoolProvider interface {
 	AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error)
 }
 
+// PagedAttestationPoolProvider is the interface for providing attestation pools a page at a time, for use
+// where the size of the pool could exhaust available memory.
+type PagedAttestationPoolProvider interface {
+	// AttestationPoolPage fetches a page of the attestation pool for the given slot.
+	// The returned flag is true if the pool has further entries after the page.
+	AttestationPoolPage(ctx context.Context, slot phase0.Slot, opts *api.PageOptions) ([]*phase0.Attestation, bool, error)
+
+	// AttestationPoolPages iterates over the attestation pool for the given slot, calling the supplied function
+	// with successive pages of up to pageSize attestations.
+	AttestationPoolPages(ctx context.Context, slot phase0.Slot, pageSize uint64, fn func([]*phase0.Attestation) error) error
+}
+
 // AttestationsSubmitter is the interface for submitting attestations.
 type AttestationsSubmitter interface {
 	// SubmitAttestations submits attestations.
@@ -218,6 +258,13 @@ type BeaconBlockHeadersProvider interface {
 	BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error)
 }
 
+// BeaconBlockHeadersRangeProvider is the interface for providing beacon block headers over a range of slots.
+type BeaconBlockHeadersRangeProvider interface {
+	// BeaconBlockHeadersRange provides the block headers for a range of slots.
+	// The returned slice has an entry for each slot in the range in order; slots without a block have a nil entry.
+	BeaconBlockHeadersRange(ctx context.Context, startSlot phase0.Slot, count uint64) ([]*apiv1.BeaconBlockHeader, error)
+}
+
 // BeaconBlockProposalProvider is the interface for providing beacon block proposals.
 type BeaconBlockProposalProvider interface {
 	// BeaconBlockProposal fetches a proposed beacon block for signing.
@@ -236,6 +283,20 @@ type BeaconBlockSubmitter interface {
 	SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error
 }
 
+// ValidatedBeaconBlockSubmitter is the interface for submitting beacon blocks with broadcast validation.
+type ValidatedBeaconBlockSubmitter interface {
+	// SubmitBeaconBlockWithValidation submits a beacon block, requesting that the node carry out
+	// the given validation before broadcasting it.  A block that fails validation results in a
+	// receipt with Broadcast set to false, rather than an error.
+	SubmitBeaconBlockWithValidation(ctx context.Context,
+		block *spec.VersionedSignedBeaconBlock,
+		validation apiv1.BroadcastValidation,
+	) (
+		*apiv1.SubmissionReceipt,
+		error,
+	)
+}
+
 // BeaconCommitteeSubscriptionsSubmitter is the interface for submitting beacon committee subnet subscription requests.
 type BeaconCommitteeSubscriptionsSubmitter interface {
 	// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
@@ -248,6 +309,14 @@ type BeaconStateProvider interface {
 	BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error)
 }
 
+// BeaconStateNearProvider is the interface for providing the available beacon state
+// nearest to a slot.
+type BeaconStateNearProvider interface {
+	// BeaconStateNear fetches the beacon state at the given slot or, if it is not
+	// available, the available state nearest to it within the bounds of the options.
+	BeaconStateNear(ctx context.Context, slot phase0.Slot, opts *api.BeaconStateNearOptions) (*api.BeaconStateNear, error)
+}
+
 // BeaconStateRandaoProvider is the interface for providing beacon state RANDAOs.
 type BeaconStateRandaoProvider interface {
 	// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
@@ -272,6 +341,20 @@ type BlindedBeaconBlockSubmitter interface {
 	SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error
 }
 
+// ValidatedBlindedBeaconBlockSubmitter is the interface for submitting blinded beacon blocks with broadcast validation.
+type ValidatedBlindedBeaconBlockSubmitter interface {
+	// SubmitBlindedBeaconBlockWithValidation submits a blinded beacon block, requesting that the node
+	// carry out the given validation before broadcasting it.  A block that fails validation results in
+	// a receipt with Broadcast set to false, rather than an error.
+	SubmitBlindedBeaconBlockWithValidation(ctx context.Context,
+		block *api.VersionedSignedBlindedBeaconBlock,
+		validation apiv1.BroadcastValidation,
+	) (
+		*apiv1.SubmissionReceipt,
+		error,
+	)
+}
+
 // ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
 type ValidatorRegistrationsSubmitter interface {
 	// SubmitValidatorRegistrations submits a validator registration.
@@ -284,6 +367,36 @@ type EventsProvider interface {
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// LightService is the interface for services restricted to cheap calls, for
+// bandwidth-constrained consumers that do not download states or block bodies.
+type LightService interface {
+	Service
+	GenesisProvider
+	SpecProvider
+	NodeSyncingProvider
+	BeaconBlockHeadersProvider
+	BeaconBlockRootProvider
+	FinalityProvider
+	AttesterDutiesProvider
+	ProposerDutiesProvider
+	SyncCommitteeDutiesProvider
+	EventsProvider
+	Closer
+}
+
+// Closer is the interface for services that can be shut down.
+type Closer interface {
+	// Close stops the service's background activity and waits for it to finish,
+	// returning an error if the context is done first.
+	Close(ctx context.Context) error
+}
+
+// HeadSubscriber is the interface for subscribing to head events.
+type HeadSubscriber interface {
+	// SubscribeHeads provides a channel of deduplicated head events.
+	SubscribeHeads(ctx context.Context) (<-chan *apiv1.HeadEvent, error)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
@@ -302,6 +415,18 @@ type ForkScheduleProvider interface {
 	ForkSchedule(ctx context.Context) ([]*phase0.Fork, error)
 }
 
+// ForkVersionsProvider is the interface for providing fork information derived from the fork schedule.
+type ForkVersionsProvider interface {
+	// ForkAtEpoch provides the fork in effect at the given epoch, according to the fork schedule.
+	ForkAtEpoch(ctx context.Context, epoch phase0.Epoch) (*phase0.Fork, error)
+
+	// ForkVersionAtSlot provides the fork version in effect at the given slot, according to the fork schedule.
+	ForkVersionAtSlot(ctx context.Context, slot phase0.Slot) (phase0.Version, error)
+
+	// DataVersionAtSlot provides the data version in effect at the given slot, according to the fork schedule.
+	DataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error)
+}
+
 // GenesisProvider is the interface for providing genesis information.
 type GenesisProvider interface {
 	// Genesis fetches genesis information for the chain.
@@ -314,6 +439,48 @@ type NodeSyncingProvider interface {
 	NodeSyncing(ctx context.Context) (*apiv1.SyncState, error)
 }
 
+// NodePeerCountProvider is the interface for providing the peer count of the node.
+type NodePeerCountProvider interface {
+	// NodePeerCount provides the peer count of the node.
+	NodePeerCount(ctx context.Context) (*apiv1.PeerCount, error)
+}
+
+// PagedNodePeersProvider is the interface for providing the peers of the node a page at a time.
+type PagedNodePeersProvider interface {
+	// NodePeersPage fetches a page of the peers of the node.
+	// The returned flag is true if there are further peers after the page.
+	NodePeersPage(ctx context.Context, opts *api.PageOptions) ([]*apiv1.Peer, bool, error)
+
+	// NodePeersPages iterates over the peers of the node, calling the supplied function
+	// with successive pages of up to pageSize peers.
+	NodePeersPages(ctx context.Context, pageSize uint64, fn func([]*apiv1.Peer) error) error
+}
+
+// PagedForkChoiceNodesProvider is the interface for providing the nodes of the fork choice a page at a time.
+type PagedForkChoiceNodesProvider interface {
+	// ForkChoiceNodesPage fetches a page of the nodes of the fork choice.
+	// The returned flag is true if there are further nodes after the page.
+	ForkChoiceNodesPage(ctx context.Context, opts *api.PageOptions) ([]*apiv1.ForkChoiceNode, bool, error)
+
+	// ForkChoiceNodesPages iterates over the nodes of the fork choice, calling the supplied function
+	// with successive pages of up to pageSize nodes.
+	ForkChoiceNodesPages(ctx context.Context, pageSize uint64, fn func([]*apiv1.ForkChoiceNode) error) error
+}
+
+// ChainReadinessProvider is the interface for providing the readiness of the node to be
+// used for proposals.
+type ChainReadinessProvider interface {
+	// ChainReadiness provides the readiness of the node to be used for proposals.
+	ChainReadiness(ctx context.Context) (*apiv1.ChainReadiness, error)
+}
+
+// ProposalProvider is the interface for providing proposals, which may be full or blinded blocks.
+type ProposalProvider interface {
+	// Proposal fetches a proposal for signing.  The beacon node chooses whether the proposal
+	// contains a full or blinded block, and reports the value of the proposal with it.
+	Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error)
+}
+
 // ProposalPreparationsSubmitter is the interface for submitting proposal preparations.
 type ProposalPreparationsSubmitter interface {
 	// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
@@ -334,12 +501,39 @@ type SpecProvider interface {
 	Spec(ctx context.Context) (map[string]interface{}, error)
 }
 
+// SpecValuesProvider is the interface for providing individual typed spec values.
+type SpecValuesProvider interface {
+	// SpecUint64 provides the named spec value as an integer.
+	SpecUint64(ctx context.Context, name string) (uint64, error)
+	// SpecDuration provides the named spec value as a duration.
+	SpecDuration(ctx context.Context, name string) (time.Duration, error)
+	// SpecBytes provides the named spec value as a byte slice.
+	SpecBytes(ctx context.Context, name string) ([]byte, error)
+	// SpecVersion provides the named spec value as a fork version.
+	SpecVersion(ctx context.Context, name string) (phase0.Version, error)
+}
+
 // SyncStateProvider is the interface for providing synchronization state.
 type SyncStateProvider interface {
 	// SyncState provides the state of the node's synchronization with the chain.
 	SyncState(ctx context.Context) (*apiv1.SyncState, error)
 }
 
+// ValidatorBalanceHistoryProvider is the interface for providing the balance history of a validator.
+type ValidatorBalanceHistoryProvider interface {
+	// ValidatorBalanceHistory provides the balances of a validator at the start of every step epochs from
+	// fromEpoch to toEpoch inclusive.
+	ValidatorBalanceHistory(ctx context.Context,
+		index phase0.ValidatorIndex,
+		fromEpoch phase0.Epoch,
+		toEpoch phase0.Epoch,
+		step uint64,
+	) (
+		*apiv1.ValidatorBalanceHistory,
+		error,
+	)
+}
+
 // ValidatorBalancesProvider is the interface for providing validator balances.
 type ValidatorBalancesProvider interface {
 	// ValidatorBalances provides the validator balances for a given state.
@@ -386,6 +580,13 @@ type GenesisTimeProvider interface {
 	GenesisTime(ctx context.Context) (time.Time, error)
 }
 
+// ExtensionsProvider is the interface for providing implementation-specific extensions.
+type ExtensionsProvider interface {
+	// Extensions provides the implementation-specific extensions supported by the node, keyed by name.
+	// Each extension should be cast to the interfaces defined by the package that provides it.
+	Extensions(ctx context.Context) (map[string]interface{}, error)
+}
+
 // NodeClientProvider provides the client for the node.
 type NodeClientProvider interface {
 	// NodeClient provides the client for the node.
@@ -0,0 +1,242 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slotticker provides channels that fire at points within each slot of the
+// chain, derived from the genesis time and slot duration of a client.
+package slotticker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// tick is a point within a slot at which the ticker fires.
+type tick int
+
+const (
+	tickSlotStart tick = iota
+	tickAttestationDeadline
+	tickAggregationDeadline
+	ticksPerSlot
+)
+
+// Service provides channels that fire at the start of each slot, at the attestation
+// deadline one third of the way through the slot and at the aggregation deadline two
+// thirds of the way through the slot.  Each channel receives the slot to which the
+// tick refers.
+//
+// Channels are buffered by a single tick; if a consumer is slow to receive then
+// ticks are dropped rather than delivered late.
+type Service struct {
+	log                 zerolog.Logger
+	nodeSyncingProvider consensusclient.NodeSyncingProvider
+	genesisTime         time.Time
+	slotDuration        time.Duration
+	driftThreshold      uint64
+	now                 func() time.Time
+
+	slotStart           chan phase0.Slot
+	attestationDeadline chan phase0.Slot
+	aggregationDeadline chan phase0.Slot
+
+	driftMu sync.RWMutex
+	drift   int64
+}
+
+// New creates a new slot ticker.
+// The ticker runs until the supplied context is done, at which point its channels are closed.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "slotticker").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	genesisTimeProvider, isProvider := parameters.client.(consensusclient.GenesisTimeProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide genesis time")
+	}
+	slotDurationProvider, isProvider := parameters.client.(consensusclient.SlotDurationProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide slot duration")
+	}
+	// Drift detection is optional.
+	nodeSyncingProvider, _ := parameters.client.(consensusclient.NodeSyncingProvider)
+
+	genesisTime, err := genesisTimeProvider.GenesisTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := slotDurationProvider.SlotDuration(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("slot duration cannot be 0")
+	}
+
+	s := &Service{
+		log:                 log,
+		nodeSyncingProvider: nodeSyncingProvider,
+		genesisTime:         genesisTime,
+		slotDuration:        slotDuration,
+		driftThreshold:      parameters.driftThreshold,
+		now:                 time.Now,
+		slotStart:           make(chan phase0.Slot, 1),
+		attestationDeadline: make(chan phase0.Slot, 1),
+		aggregationDeadline: make(chan phase0.Slot, 1),
+	}
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// SlotStart provides a channel that receives each slot as it starts.
+func (s *Service) SlotStart() <-chan phase0.Slot {
+	return s.slotStart
+}
+
+// AttestationDeadline provides a channel that receives each slot one third of the way through it.
+func (s *Service) AttestationDeadline() <-chan phase0.Slot {
+	return s.attestationDeadline
+}
+
+// AggregationDeadline provides a channel that receives each slot two thirds of the way through it.
+func (s *Service) AggregationDeadline() <-chan phase0.Slot {
+	return s.aggregationDeadline
+}
+
+// CurrentSlot provides the current wall-clock slot.
+// Prior to genesis this returns 0.
+func (s *Service) CurrentSlot() phase0.Slot {
+	now := s.now()
+	if now.Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(now.Sub(s.genesisTime) / s.slotDuration)
+}
+
+// Drift provides the number of slots by which the node's head slot was behind the
+// wall-clock slot when last checked.  A negative value means that the node's head
+// slot was ahead of the wall-clock slot, which suggests that the local clock is slow.
+func (s *Service) Drift() int64 {
+	s.driftMu.RLock()
+	defer s.driftMu.RUnlock()
+
+	return s.drift
+}
+
+func (s *Service) run(ctx context.Context) {
+	defer func() {
+		close(s.slotStart)
+		close(s.attestationDeadline)
+		close(s.aggregationDeadline)
+	}()
+
+	for {
+		at, slot, tick := s.nextTick(s.now())
+		timer := time.NewTimer(at.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		switch tick {
+		case tickSlotStart:
+			s.send(s.slotStart, slot, "slot start")
+			if s.nodeSyncingProvider != nil {
+				go s.checkDrift(ctx, slot)
+			}
+		case tickAttestationDeadline:
+			s.send(s.attestationDeadline, slot, "attestation deadline")
+		case tickAggregationDeadline:
+			s.send(s.aggregationDeadline, slot, "aggregation deadline")
+		}
+	}
+}
+
+// nextTick provides the time, slot and kind of the first tick strictly after the given time.
+func (s *Service) nextTick(now time.Time) (time.Time, phase0.Slot, tick) {
+	if now.Before(s.genesisTime) {
+		return s.genesisTime, 0, tickSlotStart
+	}
+
+	slot := phase0.Slot(now.Sub(s.genesisTime) / s.slotDuration)
+	for t := tickAttestationDeadline; t < ticksPerSlot; t++ {
+		if at := s.tickTime(slot, t); at.After(now) {
+			return at, slot, t
+		}
+	}
+
+	return s.tickTime(slot+1, tickSlotStart), slot + 1, tickSlotStart
+}
+
+// tickTime provides the time of the given tick in the given slot.
+func (s *Service) tickTime(slot phase0.Slot, t tick) time.Time {
+	return s.genesisTime.
+		Add(time.Duration(slot) * s.slotDuration).
+		Add(time.Duration(t) * s.slotDuration / time.Duration(ticksPerSlot))
+}
+
+func (s *Service) send(ch chan phase0.Slot, slot phase0.Slot, name string) {
+	select {
+	case ch <- slot:
+	default:
+		s.log.Debug().Uint64("slot", uint64(slot)).Str("tick", name).Msg("Consumer not ready; tick dropped")
+	}
+}
+
+// checkDrift compares the node's head slot with the wall-clock slot.
+func (s *Service) checkDrift(ctx context.Context, slot phase0.Slot) {
+	syncState, err := s.nodeSyncingProvider.NodeSyncing(ctx)
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain sync state for drift check")
+		return
+	}
+	if syncState == nil {
+		return
+	}
+
+	drift := int64(slot) - int64(syncState.HeadSlot)
+	s.driftMu.Lock()
+	s.drift = drift
+	s.driftMu.Unlock()
+
+	abs := drift
+	if abs < 0 {
+		abs = -abs
+	}
+	if uint64(abs) > s.driftThreshold {
+		s.log.Warn().
+			Uint64("wall_clock_slot", uint64(slot)).
+			Uint64("head_slot", uint64(syncState.HeadSlot)).
+			Int64("drift", drift).
+			Msg("Node head slot differs from wall-clock slot")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slotticker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type testClient struct {
+	genesisTime  time.Time
+	slotDuration time.Duration
+	headSlot     phase0.Slot
+}
+
+func (c *testClient) Name() string {
+	return "test"
+}
+
+func (c *testClient) Address() string {
+	return "test"
+}
+
+func (c *testClient) GenesisTime(_ context.Context) (time.Time, error) {
+	return c.genesisTime, nil
+}
+
+func (c *testClient) SlotDuration(_ context.Context) (time.Duration, error) {
+	return c.slotDuration, nil
+}
+
+func (c *testClient) NodeSyncing(_ context.Context) (*apiv1.SyncState, error) {
+	return &apiv1.SyncState{HeadSlot: c.headSlot}, nil
+}
+
+func TestNextTick(t *testing.T) {
+	genesis := time.Unix(1600000000, 0)
+	s := &Service{
+		genesisTime:  genesis,
+		slotDuration: 12 * time.Second,
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		at   time.Time
+		slot phase0.Slot
+		tick tick
+	}{
+		{
+			name: "PreGenesis",
+			now:  genesis.Add(-time.Hour),
+			at:   genesis,
+			slot: 0,
+			tick: tickSlotStart,
+		},
+		{
+			name: "Genesis",
+			now:  genesis,
+			at:   genesis.Add(4 * time.Second),
+			slot: 0,
+			tick: tickAttestationDeadline,
+		},
+		{
+			name: "AttestationDeadline",
+			now:  genesis.Add(16 * time.Second),
+			at:   genesis.Add(20 * time.Second),
+			slot: 1,
+			tick: tickAggregationDeadline,
+		},
+		{
+			name: "LateInSlot",
+			now:  genesis.Add(23 * time.Second),
+			at:   genesis.Add(24 * time.Second),
+			slot: 2,
+			tick: tickSlotStart,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			at, slot, tick := s.nextTick(test.now)
+			require.Equal(t, test.at, at)
+			require.Equal(t, test.slot, slot)
+			require.Equal(t, test.tick, tick)
+		})
+	}
+}
+
+func TestNextTickUnevenSlot(t *testing.T) {
+	genesis := time.Unix(1600000000, 0)
+	s := &Service{
+		genesisTime:  genesis,
+		slotDuration: 10 * time.Nanosecond,
+	}
+
+	at, slot, tick := s.nextTick(genesis.Add(9 * time.Nanosecond))
+	require.Equal(t, genesis.Add(10*time.Nanosecond), at)
+	require.Equal(t, phase0.Slot(1), slot)
+	require.Equal(t, tickSlotStart, tick)
+}
+
+func TestTicks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &testClient{
+		genesisTime:  time.Now(),
+		slotDuration: 90 * time.Millisecond,
+		headSlot:     100,
+	}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClient(client),
+	)
+	require.NoError(t, err)
+
+	attestationSlot := <-s.AttestationDeadline()
+	aggregationSlot := <-s.AggregationDeadline()
+	require.Equal(t, attestationSlot, aggregationSlot)
+	slotStart := <-s.SlotStart()
+	require.Equal(t, aggregationSlot+1, slotStart)
+
+	// Drift is checked asynchronously at slot start.
+	require.Eventually(t, func() bool {
+		return s.Drift() < 0
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	for range s.SlotStart() {
+	}
+}
+
+func TestParameters(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := New(ctx)
+	require.EqualError(t, err, "problem with parameters: no client specified")
+
+	_, err = New(ctx, WithClient(&testClient{genesisTime: time.Now()}))
+	require.EqualError(t, err, "slot duration cannot be 0")
+}
@@ -147,6 +147,9 @@ func (b *BeaconBlock) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (b *BeaconBlock) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
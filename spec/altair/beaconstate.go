@@ -460,6 +460,9 @@ func (s *BeaconState) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *BeaconState) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -118,6 +118,9 @@ func (a *ContributionAndProof) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (a *ContributionAndProof) String() string {
+	if a == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(a)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,207 @@
+// Code generated by copygen. DO NOT EDIT.
+package altair
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Fork = b.Fork.Copy()
+	res.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.BlockRoots != nil {
+		res.BlockRoots = append(b.BlockRoots[:0:0], b.BlockRoots...)
+	}
+	if b.StateRoots != nil {
+		res.StateRoots = append(b.StateRoots[:0:0], b.StateRoots...)
+	}
+	if b.HistoricalRoots != nil {
+		res.HistoricalRoots = append(b.HistoricalRoots[:0:0], b.HistoricalRoots...)
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ETH1DataVotes != nil {
+		res.ETH1DataVotes = append(b.ETH1DataVotes[:0:0], b.ETH1DataVotes...)
+		for i := range b.ETH1DataVotes {
+			res.ETH1DataVotes[i] = b.ETH1DataVotes[i].Copy()
+		}
+	}
+	if b.Validators != nil {
+		res.Validators = append(b.Validators[:0:0], b.Validators...)
+		for i := range b.Validators {
+			res.Validators[i] = b.Validators[i].Copy()
+		}
+	}
+	if b.Balances != nil {
+		res.Balances = append(b.Balances[:0:0], b.Balances...)
+	}
+	if b.RANDAOMixes != nil {
+		res.RANDAOMixes = append(b.RANDAOMixes[:0:0], b.RANDAOMixes...)
+	}
+	if b.Slashings != nil {
+		res.Slashings = append(b.Slashings[:0:0], b.Slashings...)
+	}
+	if b.PreviousEpochParticipation != nil {
+		res.PreviousEpochParticipation = append(b.PreviousEpochParticipation[:0:0], b.PreviousEpochParticipation...)
+	}
+	if b.CurrentEpochParticipation != nil {
+		res.CurrentEpochParticipation = append(b.CurrentEpochParticipation[:0:0], b.CurrentEpochParticipation...)
+	}
+	if b.JustificationBits != nil {
+		res.JustificationBits = append(b.JustificationBits[:0:0], b.JustificationBits...)
+	}
+	res.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	res.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	res.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		res.InactivityScores = append(b.InactivityScores[:0:0], b.InactivityScores...)
+	}
+	res.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	res.NextSyncCommittee = b.NextSyncCommittee.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (c *ContributionAndProof) Copy() *ContributionAndProof {
+	if c == nil {
+		return nil
+	}
+	res := *c
+	res.Contribution = c.Contribution.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedContributionAndProof) Copy() *SignedContributionAndProof {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncAggregate) Copy() *SyncAggregate {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.SyncCommitteeBits != nil {
+		res.SyncCommitteeBits = append(s.SyncCommitteeBits[:0:0], s.SyncCommitteeBits...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncAggregatorSelectionData) Copy() *SyncAggregatorSelectionData {
+	if s == nil {
+		return nil
+	}
+	res := *s
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncCommittee) Copy() *SyncCommittee {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.Pubkeys != nil {
+		res.Pubkeys = append(s.Pubkeys[:0:0], s.Pubkeys...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncCommitteeContribution) Copy() *SyncCommitteeContribution {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.AggregationBits != nil {
+		res.AggregationBits = append(s.AggregationBits[:0:0], s.AggregationBits...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SyncCommitteeMessage) Copy() *SyncCommitteeMessage {
+	if s == nil {
+		return nil
+	}
+	res := *s
+
+	return &res
+}
@@ -119,6 +119,9 @@ func (s *SyncAggregate) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncAggregate) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
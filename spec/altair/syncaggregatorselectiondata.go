@@ -14,6 +14,8 @@
 package altair
 
 import (
+	"fmt"
+
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -23,3 +25,11 @@ type SyncAggregatorSelectionData struct {
 	Slot              phase0.Slot
 	SubcommitteeIndex uint64
 }
+
+// String returns a string version of the structure.
+func (s *SyncAggregatorSelectionData) String() string {
+	if s == nil {
+		return ""
+	}
+	return fmt.Sprintf("slot: %d\nsubcommittee_index: %d\n", s.Slot, s.SubcommitteeIndex)
+}
@@ -128,6 +128,9 @@ func (s *SyncCommittee) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncCommittee) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -151,6 +151,9 @@ func (s *SyncCommitteeContribution) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncCommitteeContribution) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -138,6 +138,9 @@ func (s *SyncCommitteeMessage) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SyncCommitteeMessage) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,58 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// BaseFeePerGasBig returns the base fee per gas of the payload.
+func (e *ExecutionPayload) BaseFeePerGasBig() *big.Int {
+	return Uint256(e.BaseFeePerGas).Big()
+}
+
+// SetBaseFeePerGasBig sets the base fee per gas of the payload.
+func (e *ExecutionPayload) SetBaseFeePerGasBig(baseFeePerGas *big.Int) error {
+	if baseFeePerGas == nil {
+		return errors.New("no base fee per gas supplied")
+	}
+	var res Uint256
+	if err := res.SetBig(baseFeePerGas); err != nil {
+		return errors.Wrap(err, "invalid base fee per gas")
+	}
+	e.BaseFeePerGas = res
+
+	return nil
+}
+
+// BaseFeePerGasBig returns the base fee per gas of the payload header.
+func (e *ExecutionPayloadHeader) BaseFeePerGasBig() *big.Int {
+	return Uint256(e.BaseFeePerGas).Big()
+}
+
+// SetBaseFeePerGasBig sets the base fee per gas of the payload header.
+func (e *ExecutionPayloadHeader) SetBaseFeePerGasBig(baseFeePerGas *big.Int) error {
+	if baseFeePerGas == nil {
+		return errors.New("no base fee per gas supplied")
+	}
+	var res Uint256
+	if err := res.SetBig(baseFeePerGas); err != nil {
+		return errors.Wrap(err, "invalid base fee per gas")
+	}
+	e.BaseFeePerGas = res
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseFeePerGasBig(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	tests := []struct {
+		name  string
+		input *big.Int
+		le    [32]byte
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "no base fee per gas supplied",
+		},
+		{
+			name:  "Negative",
+			input: big.NewInt(-1),
+			err:   "invalid base fee per gas: negative value",
+		},
+		{
+			name:  "Overflow",
+			input: new(big.Int).Add(max, big.NewInt(1)),
+			err:   "invalid base fee per gas: overflow for uint256",
+		},
+		{
+			name:  "Zero",
+			input: big.NewInt(0),
+		},
+		{
+			name:  "Value",
+			input: big.NewInt(0x0102),
+			le:    [32]byte{0x02, 0x01},
+		},
+		{
+			name:  "Max",
+			input: max,
+			le: [32]byte{
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload := &bellatrix.ExecutionPayload{}
+			header := &bellatrix.ExecutionPayloadHeader{}
+			err := payload.SetBaseFeePerGasBig(test.input)
+			headerErr := header.SetBaseFeePerGasBig(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.EqualError(t, headerErr, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, headerErr)
+			require.Equal(t, test.le, payload.BaseFeePerGas)
+			require.Equal(t, test.le, header.BaseFeePerGas)
+			require.Equal(t, 0, test.input.Cmp(payload.BaseFeePerGasBig()))
+			require.Equal(t, 0, test.input.Cmp(header.BaseFeePerGasBig()))
+		})
+	}
+}
+
+func TestBaseFeePerGasBigJSON(t *testing.T) {
+	// The accessor matches the decimal JSON encoding.
+	payload := &bellatrix.ExecutionPayload{}
+	require.NoError(t, payload.SetBaseFeePerGasBig(big.NewInt(123456789)))
+	payload.ExtraData = []byte{}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"base_fee_per_gas":"123456789"`)
+}
@@ -184,6 +184,9 @@ func (b *BeaconBlockBody) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (b *BeaconBlockBody) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -374,6 +374,9 @@ func (e *ExecutionPayload) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *ExecutionPayload) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
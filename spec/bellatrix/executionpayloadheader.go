@@ -360,9 +360,42 @@ func (e *ExecutionPayloadHeader) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *ExecutionPayloadHeader) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
 	}
 	return string(data)
 }
+
+// GetParentHash returns the parent hash of the execution payload header.
+func (e *ExecutionPayloadHeader) GetParentHash() phase0.Hash32 {
+	return e.ParentHash
+}
+
+// GetFeeRecipient returns the fee recipient of the execution payload header.
+func (e *ExecutionPayloadHeader) GetFeeRecipient() ExecutionAddress {
+	return e.FeeRecipient
+}
+
+// GetBlockNumber returns the block number of the execution payload header.
+func (e *ExecutionPayloadHeader) GetBlockNumber() uint64 {
+	return e.BlockNumber
+}
+
+// GetTimestamp returns the timestamp of the execution payload header.
+func (e *ExecutionPayloadHeader) GetTimestamp() uint64 {
+	return e.Timestamp
+}
+
+// GetBlockHash returns the block hash of the execution payload header.
+func (e *ExecutionPayloadHeader) GetBlockHash() phase0.Hash32 {
+	return e.BlockHash
+}
+
+// GetTransactionsRoot returns the transactions root of the execution payload header.
+func (e *ExecutionPayloadHeader) GetTransactionsRoot() phase0.Root {
+	return e.TransactionsRoot
+}
@@ -0,0 +1,68 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyPayloadMatchesHeader(t *testing.T) {
+	payload := &bellatrix.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+		BlockNumber:   6,
+		GasLimit:      30000000,
+		ExtraData:     []byte{},
+		BaseFeePerGas: [32]byte{0x09},
+		BlockHash:     phase0.Hash32{0x0a},
+		Transactions: []bellatrix.Transaction{
+			{0x01, 0x02, 0x03},
+			make([]byte, 100),
+		},
+	}
+	header, err := payload.Header()
+	require.NoError(t, err)
+
+	// The root of a payload and its header are the same.
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, headerRoot)
+
+	require.NoError(t, bellatrix.VerifyPayloadMatchesHeader(payload, header))
+
+	// Alter the transactions.
+	payload.Transactions = payload.Transactions[:1]
+	err = bellatrix.VerifyPayloadMatchesHeader(payload, header)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "transactions root mismatch")
+
+	// Empty transactions.
+	payload.Transactions = nil
+	header, err = payload.Header()
+	require.NoError(t, err)
+	payloadRoot, err = payload.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err = header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, headerRoot)
+
+	require.EqualError(t, bellatrix.VerifyPayloadMatchesHeader(nil, header), "no execution payload supplied")
+	require.EqualError(t, bellatrix.VerifyPayloadMatchesHeader(payload, nil), "no execution payload header supplied")
+}
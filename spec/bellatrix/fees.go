@@ -0,0 +1,54 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"math/big"
+)
+
+// GasUtilisation returns the fraction of the gas limit of the payload that was used.
+func (e *ExecutionPayload) GasUtilisation() float64 {
+	return GasUtilisation(e.GasUsed, e.GasLimit)
+}
+
+// BurntFees returns the fees burnt by the payload, being its base fee per gas
+// multiplied by the gas used.
+func (e *ExecutionPayload) BurntFees() *big.Int {
+	return BurntFees(e.BaseFeePerGas, e.GasUsed)
+}
+
+// GasUtilisation returns the fraction of the gas limit of the payload header that was used.
+func (e *ExecutionPayloadHeader) GasUtilisation() float64 {
+	return GasUtilisation(e.GasUsed, e.GasLimit)
+}
+
+// BurntFees returns the fees burnt by the payload header, being its base fee per gas
+// multiplied by the gas used.
+func (e *ExecutionPayloadHeader) BurntFees() *big.Int {
+	return BurntFees(e.BaseFeePerGas, e.GasUsed)
+}
+
+// GasUtilisation returns the fraction of the gas limit that was used.
+func GasUtilisation(gasUsed uint64, gasLimit uint64) float64 {
+	if gasLimit == 0 {
+		return 0
+	}
+
+	return float64(gasUsed) / float64(gasLimit)
+}
+
+// BurntFees returns the fees burnt for the given base fee per gas and gas used.
+func BurntFees(baseFeePerGas Uint256, gasUsed uint64) *big.Int {
+	return new(big.Int).Mul(baseFeePerGas.Big(), new(big.Int).SetUint64(gasUsed))
+}
@@ -0,0 +1,75 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFees(t *testing.T) {
+	tests := []struct {
+		name          string
+		gasLimit      uint64
+		gasUsed       uint64
+		baseFeePerGas *big.Int
+		utilisation   float64
+		burnt         *big.Int
+	}{
+		{
+			name:          "Empty",
+			baseFeePerGas: big.NewInt(0),
+			burnt:         big.NewInt(0),
+		},
+		{
+			name:          "Half",
+			gasLimit:      30000000,
+			gasUsed:       15000000,
+			baseFeePerGas: big.NewInt(7),
+			utilisation:   0.5,
+			burnt:         big.NewInt(105000000),
+		},
+		{
+			name:          "Large",
+			gasLimit:      30000000,
+			gasUsed:       30000000,
+			baseFeePerGas: new(big.Int).Lsh(big.NewInt(1), 200),
+			utilisation:   1,
+			burnt:         new(big.Int).Mul(new(big.Int).Lsh(big.NewInt(1), 200), big.NewInt(30000000)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload := &bellatrix.ExecutionPayload{
+				GasLimit: test.gasLimit,
+				GasUsed:  test.gasUsed,
+			}
+			require.NoError(t, payload.SetBaseFeePerGasBig(test.baseFeePerGas))
+			require.Equal(t, test.utilisation, payload.GasUtilisation())
+			require.Equal(t, 0, test.burnt.Cmp(payload.BurntFees()))
+
+			header := &bellatrix.ExecutionPayloadHeader{
+				GasLimit: test.gasLimit,
+				GasUsed:  test.gasUsed,
+			}
+			require.NoError(t, header.SetBaseFeePerGasBig(test.baseFeePerGas))
+			require.Equal(t, test.utilisation, header.GasUtilisation())
+			require.Equal(t, 0, test.burnt.Cmp(header.BurntFees()))
+		})
+	}
+}
@@ -17,3 +17,6 @@ package bellatrix
 //go:generate rm -f beaconblock_encoding.go beaconblockbody_encoding.go beaconstate_encoding.go executionpayload_encoding.go executionpayloadheader_encoding.go signedbeaconblock_encoding.go
 //go:generate sszgen --path . --objs BeaconBlock,BeaconBlockBody,BeaconState,ExecutionPayload,ExecutionPaylodHeader,SignedBeaconBlock
 //go:generate goimports -w beaconblock_encoding.go beaconblockbody_encoding.go beaconstate_encoding.go executionpayload_encoding.go executionpayloadheader_encoding.go signedbeaconblock_encoding.go
+
+// Deep copy methods are generated by copygen.
+//go:generate go run ../../internal/copygen .
@@ -13,7 +13,13 @@
 
 package bellatrix
 
-import "fmt"
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 // Transaction is an opaque execution layer transaction.
 type Transaction []byte
@@ -41,3 +47,22 @@ func (a ExecutionAddress) Format(state fmt.State, v rune) {
 		fmt.Fprintf(state, "%"+format, a[:])
 	}
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (a ExecutionAddress) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *ExecutionAddress) UnmarshalText(input []byte) error {
+	data, err := hex.DecodeString(strings.TrimPrefix(string(input), "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for execution address")
+	}
+	if len(data) != len(a) {
+		return fmt.Errorf("incorrect length %d for execution address", len(data))
+	}
+	copy(a[:], data)
+
+	return nil
+}
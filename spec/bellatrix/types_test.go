@@ -0,0 +1,34 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionAddressText(t *testing.T) {
+	address := bellatrix.ExecutionAddress{0x01, 0x02}
+	text, err := address.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "0x0102000000000000000000000000000000000000", string(text))
+
+	var res bellatrix.ExecutionAddress
+	require.NoError(t, res.UnmarshalText(text))
+	require.Equal(t, address, res)
+
+	require.EqualError(t, res.UnmarshalText([]byte("0x01")), "incorrect length 1 for execution address")
+}
@@ -0,0 +1,109 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Uint256 is a 256-bit unsigned integer, stored little-endian as it is in the SSZ
+// encoding of execution payloads.  It is encoded in JSON as a decimal string.
+type Uint256 [32]byte
+
+// MaxUint256 returns the maximum value of a Uint256.
+func MaxUint256() *big.Int {
+	return new(big.Int).Set(maxBaseFeePerGas)
+}
+
+// Big returns the value as a big.Int.
+func (u Uint256) Big() *big.Int {
+	var beBytes [32]byte
+	for i := 0; i < 32; i++ {
+		beBytes[i] = u[32-1-i]
+	}
+
+	return new(big.Int).SetBytes(beBytes[:])
+}
+
+// SetBig sets the value from a big.Int.
+func (u *Uint256) SetBig(val *big.Int) error {
+	if val == nil {
+		return errors.New("no value supplied")
+	}
+	if val.Sign() < 0 {
+		return errors.New("negative value")
+	}
+	if val.Cmp(maxBaseFeePerGas) > 0 {
+		return errors.New("overflow for uint256")
+	}
+
+	var res Uint256
+	beBytes := val.Bytes()
+	for i := range beBytes {
+		res[i] = beBytes[len(beBytes)-1-i]
+	}
+	*u = res
+
+	return nil
+}
+
+// String returns the value as a decimal string.
+func (u Uint256) String() string {
+	return u.Big().String()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Uint256) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Uint256) UnmarshalJSON(input []byte) error {
+	var data string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return u.UnmarshalText([]byte(data))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Both decimal and 0x-prefixed hexadecimal values are accepted.
+func (u *Uint256) UnmarshalText(input []byte) error {
+	data := string(input)
+	if data == "" {
+		return errors.New("uint256 missing")
+	}
+	val := new(big.Int)
+	var ok bool
+	if strings.HasPrefix(data, "0x") {
+		hexData := strings.TrimPrefix(data, "0x")
+		if len(hexData)%2 == 1 {
+			hexData = fmt.Sprintf("0%s", hexData)
+		}
+		val, ok = val.SetString(hexData, 16)
+	} else {
+		val, ok = val.SetString(data, 10)
+	}
+	if !ok {
+		return errors.New("invalid value for uint256")
+	}
+
+	return u.SetBig(val)
+}
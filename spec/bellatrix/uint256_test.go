@@ -0,0 +1,100 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUint256JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected *big.Int
+		output   []byte
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: []byte(`""`),
+			err:   "uint256 missing",
+		},
+		{
+			name:  "WrongType",
+			input: []byte(`1`),
+			err:   "invalid JSON: json: cannot unmarshal number into Go value of type string",
+		},
+		{
+			name:  "Invalid",
+			input: []byte(`"abc"`),
+			err:   "invalid value for uint256",
+		},
+		{
+			name:  "Negative",
+			input: []byte(`"-1"`),
+			err:   "negative value",
+		},
+		{
+			name:  "Overflow",
+			input: []byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639936"`),
+			err:   "overflow for uint256",
+		},
+		{
+			name:     "Decimal",
+			input:    []byte(`"123456789"`),
+			expected: big.NewInt(123456789),
+			output:   []byte(`"123456789"`),
+		},
+		{
+			name:     "Hex",
+			input:    []byte(`"0x102"`),
+			expected: big.NewInt(0x0102),
+			output:   []byte(`"258"`),
+		},
+		{
+			name:     "Max",
+			input:    []byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639935"`),
+			expected: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)),
+			output:   []byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639935"`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res bellatrix.Uint256
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, test.expected.Cmp(res.Big()))
+			output, err := json.Marshal(res)
+			require.NoError(t, err)
+			require.Equal(t, string(test.output), string(output))
+			require.Equal(t, test.expected.String(), res.String())
+		})
+	}
+}
+
+func TestUint256LittleEndian(t *testing.T) {
+	var res bellatrix.Uint256
+	require.NoError(t, res.SetBig(big.NewInt(0x0102)))
+	require.Equal(t, bellatrix.Uint256{0x02, 0x01}, res)
+}
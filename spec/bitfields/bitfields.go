@@ -0,0 +1,159 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bitfields provides bounds-checked operations on the bitfields used in
+// spec containers, such as attestation aggregation bits, sync committee bits and
+// justification bits.  All operations use the bit order of the spec, where bit i
+// is bit i%8 of byte i/8, so callers need not handle the encoding themselves.
+package bitfields
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Bitfield is the interface implemented by the bitlists and bitvectors used in
+// spec containers.
+type Bitfield interface {
+	BitAt(idx uint64) bool
+	SetBitAt(idx uint64, val bool)
+	Len() uint64
+	Count() uint64
+	Bytes() []byte
+	BitIndices() []int
+}
+
+// Get returns the value of the bit at the given index.
+func Get(b Bitfield, index uint64) (bool, error) {
+	if index >= b.Len() {
+		return false, fmt.Errorf("index %d out of range for bitfield of length %d", index, b.Len())
+	}
+
+	return b.BitAt(index), nil
+}
+
+// Set sets the bit at the given index.
+func Set(b Bitfield, index uint64) error {
+	if index >= b.Len() {
+		return fmt.Errorf("index %d out of range for bitfield of length %d", index, b.Len())
+	}
+	b.SetBitAt(index, true)
+
+	return nil
+}
+
+// Clear clears the bit at the given index.
+func Clear(b Bitfield, index uint64) error {
+	if index >= b.Len() {
+		return fmt.Errorf("index %d out of range for bitfield of length %d", index, b.Len())
+	}
+	b.SetBitAt(index, false)
+
+	return nil
+}
+
+// Indices returns the indices of the set bits, in increasing order.
+func Indices(b Bitfield) []uint64 {
+	bitIndices := b.BitIndices()
+	res := make([]uint64, len(bitIndices))
+	for i, index := range bitIndices {
+		res[i] = uint64(index)
+	}
+
+	return res
+}
+
+// ForEach calls the supplied function with the index of each set bit, in increasing
+// order, stopping early if the function returns false.
+func ForEach(b Bitfield, fn func(index uint64) bool) {
+	for _, index := range b.BitIndices() {
+		if !fn(uint64(index)) {
+			return
+		}
+	}
+}
+
+// Intersection returns the indices of the bits set in both bitfields, in increasing order.
+func Intersection(a Bitfield, b Bitfield) ([]uint64, error) {
+	if a.Len() != b.Len() {
+		return nil, fmt.Errorf("bitfield lengths %d and %d differ", a.Len(), b.Len())
+	}
+
+	res := make([]uint64, 0)
+	for _, index := range a.BitIndices() {
+		if b.BitAt(uint64(index)) {
+			res = append(res, uint64(index))
+		}
+	}
+
+	return res, nil
+}
+
+// Overlaps returns true if any bit is set in both bitfields.
+func Overlaps(a Bitfield, b Bitfield) (bool, error) {
+	intersection, err := Intersection(a, b)
+	if err != nil {
+		return false, err
+	}
+
+	return len(intersection) > 0, nil
+}
+
+// Contains returns true if every bit set in b is also set in a.
+func Contains(a Bitfield, b Bitfield) (bool, error) {
+	if a.Len() != b.Len() {
+		return false, fmt.Errorf("bitfield lengths %d and %d differ", a.Len(), b.Len())
+	}
+
+	for _, index := range b.BitIndices() {
+		if !a.BitAt(uint64(index)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// BitlistFromSSZ decodes a bitlist, such as attestation aggregation bits, from its
+// standalone SSZ encoding, checking that it is well-formed and no longer than the
+// given limit.
+func BitlistFromSSZ(data []byte, limit uint64) (bitfield.Bitlist, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no data supplied")
+	}
+	if data[len(data)-1] == 0 {
+		return nil, errors.New("bitlist missing length bit")
+	}
+
+	res := make(bitfield.Bitlist, len(data))
+	copy(res, data)
+	if res.Len() > limit {
+		return nil, fmt.Errorf("bitlist length %d exceeds limit %d", res.Len(), limit)
+	}
+
+	return res, nil
+}
+
+// BitlistToSSZ encodes a bitlist to its standalone SSZ encoding.
+func BitlistToSSZ(b bitfield.Bitlist) ([]byte, error) {
+	if len(b) == 0 || b[len(b)-1] == 0 {
+		return nil, errors.New("bitlist missing length bit")
+	}
+
+	res := make([]byte, len(b))
+	copy(res, b)
+
+	return res, nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitfields_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bitfields"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSet(t *testing.T) {
+	attestation := &phase0.Attestation{
+		AggregationBits: bitfield.NewBitlist(10),
+	}
+	require.NoError(t, bitfields.Set(attestation.AggregationBits, 3))
+	require.NoError(t, bitfields.Set(attestation.AggregationBits, 9))
+	require.EqualError(t, bitfields.Set(attestation.AggregationBits, 10), "index 10 out of range for bitfield of length 10")
+
+	set, err := bitfields.Get(attestation.AggregationBits, 3)
+	require.NoError(t, err)
+	require.True(t, set)
+	set, err = bitfields.Get(attestation.AggregationBits, 4)
+	require.NoError(t, err)
+	require.False(t, set)
+	_, err = bitfields.Get(attestation.AggregationBits, 10)
+	require.EqualError(t, err, "index 10 out of range for bitfield of length 10")
+
+	// Spec bit order: bit 3 is bit 3 of byte 0, bit 9 is bit 1 of byte 1.
+	require.Equal(t, bitfield.Bitlist{0x08, 0x06}, attestation.AggregationBits)
+	require.Equal(t, uint64(2), attestation.AggregationBits.Count())
+	require.Equal(t, []uint64{3, 9}, bitfields.Indices(attestation.AggregationBits))
+
+	require.NoError(t, bitfields.Clear(attestation.AggregationBits, 3))
+	require.Equal(t, []uint64{9}, bitfields.Indices(attestation.AggregationBits))
+}
+
+func TestVectors(t *testing.T) {
+	syncAggregate := &altair.SyncAggregate{
+		SyncCommitteeBits: bitfield.NewBitvector512(),
+	}
+	require.NoError(t, bitfields.Set(syncAggregate.SyncCommitteeBits, 511))
+	require.EqualError(t, bitfields.Set(syncAggregate.SyncCommitteeBits, 512), "index 512 out of range for bitfield of length 512")
+	require.Equal(t, []uint64{511}, bitfields.Indices(syncAggregate.SyncCommitteeBits))
+
+	justificationBits := bitfield.NewBitvector4()
+	require.NoError(t, bitfields.Set(justificationBits, 0))
+	require.EqualError(t, bitfields.Set(justificationBits, 4), "index 4 out of range for bitfield of length 4")
+	require.Equal(t, []uint64{0}, bitfields.Indices(justificationBits))
+}
+
+func TestForEach(t *testing.T) {
+	bits := bitfield.NewBitlist(16)
+	for _, index := range []uint64{1, 5, 12} {
+		require.NoError(t, bitfields.Set(bits, index))
+	}
+
+	indices := make([]uint64, 0)
+	bitfields.ForEach(bits, func(index uint64) bool {
+		indices = append(indices, index)
+		return true
+	})
+	require.Equal(t, []uint64{1, 5, 12}, indices)
+
+	indices = indices[:0]
+	bitfields.ForEach(bits, func(index uint64) bool {
+		indices = append(indices, index)
+		return index < 5
+	})
+	require.Equal(t, []uint64{1, 5}, indices)
+}
+
+func TestSetOperations(t *testing.T) {
+	a := bitfield.NewBitlist(8)
+	b := bitfield.NewBitlist(8)
+	require.NoError(t, bitfields.Set(a, 1))
+	require.NoError(t, bitfields.Set(a, 2))
+	require.NoError(t, bitfields.Set(b, 2))
+
+	intersection, err := bitfields.Intersection(a, b)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, intersection)
+
+	overlaps, err := bitfields.Overlaps(a, b)
+	require.NoError(t, err)
+	require.True(t, overlaps)
+
+	contains, err := bitfields.Contains(a, b)
+	require.NoError(t, err)
+	require.True(t, contains)
+	contains, err = bitfields.Contains(b, a)
+	require.NoError(t, err)
+	require.False(t, contains)
+
+	require.NoError(t, bitfields.Clear(b, 2))
+	overlaps, err = bitfields.Overlaps(a, b)
+	require.NoError(t, err)
+	require.False(t, overlaps)
+
+	_, err = bitfields.Overlaps(a, bitfield.NewBitlist(9))
+	require.EqualError(t, err, "bitfield lengths 8 and 9 differ")
+	_, err = bitfields.Contains(a, bitfield.NewBitlist(9))
+	require.EqualError(t, err, "bitfield lengths 8 and 9 differ")
+}
+
+func TestBitlistSSZ(t *testing.T) {
+	_, err := bitfields.BitlistFromSSZ(nil, 2048)
+	require.EqualError(t, err, "no data supplied")
+	_, err = bitfields.BitlistFromSSZ([]byte{0x01, 0x00}, 2048)
+	require.EqualError(t, err, "bitlist missing length bit")
+	_, err = bitfields.BitlistFromSSZ([]byte{0x00, 0x02}, 8)
+	require.EqualError(t, err, "bitlist length 9 exceeds limit 8")
+
+	bits, err := bitfields.BitlistFromSSZ([]byte{0x08, 0x06}, 2048)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), bits.Len())
+	require.Equal(t, []uint64{3, 9}, bitfields.Indices(bits))
+
+	data, err := bitfields.BitlistToSSZ(bits)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x08, 0x06}, data)
+	_, err = bitfields.BitlistToSSZ(bitfield.Bitlist{})
+	require.EqualError(t, err, "bitlist missing length bit")
+}
@@ -0,0 +1,56 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MinBlobBaseFee is the minimum base fee per unit of blob gas, as defined in EIP-4844.
+	MinBlobBaseFee = 1
+	// CancunBlobBaseFeeUpdateFraction is the fraction controlling the rate of change of
+	// the blob base fee from the Cancun fork, as defined in EIP-4844.
+	CancunBlobBaseFeeUpdateFraction = 3338477
+	// PragueBlobBaseFeeUpdateFraction is the fraction controlling the rate of change of
+	// the blob base fee from the Prague fork, as defined in EIP-7691.
+	PragueBlobBaseFeeUpdateFraction = 5007716
+)
+
+// BlobBaseFee returns the base fee per unit of blob gas for a block with the given
+// excess blob gas, as defined in EIP-4844.  The update fraction changes between forks,
+// so must be that of the fork of the block.
+func BlobBaseFee(excessBlobGas uint64, updateFraction uint64) (*big.Int, error) {
+	if updateFraction == 0 {
+		return nil, errors.New("no blob base fee update fraction supplied")
+	}
+
+	return fakeExponential(big.NewInt(MinBlobBaseFee), new(big.Int).SetUint64(excessBlobGas), new(big.Int).SetUint64(updateFraction)), nil
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using a
+// Taylor expansion, as defined in EIP-4844.
+func fakeExponential(factor *big.Int, numerator *big.Int, denominator *big.Int) *big.Int {
+	output := new(big.Int)
+	numeratorAccum := new(big.Int).Mul(factor, denominator)
+	for i := int64(1); numeratorAccum.Sign() > 0; i++ {
+		output.Add(output, numeratorAccum)
+		numeratorAccum.Mul(numeratorAccum, numerator)
+		numeratorAccum.Div(numeratorAccum, new(big.Int).Mul(denominator, big.NewInt(i)))
+	}
+
+	return output.Div(output, denominator)
+}
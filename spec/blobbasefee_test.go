@@ -0,0 +1,84 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobBaseFee(t *testing.T) {
+	tests := []struct {
+		name           string
+		excessBlobGas  uint64
+		updateFraction uint64
+		expected       uint64
+		err            string
+	}{
+		{
+			name:          "UpdateFractionMissing",
+			excessBlobGas: 1,
+			err:           "no blob base fee update fraction supplied",
+		},
+		{
+			name:           "Zero",
+			updateFraction: spec.CancunBlobBaseFeeUpdateFraction,
+			expected:       1,
+		},
+		{
+			name:           "One",
+			excessBlobGas:  1,
+			updateFraction: spec.CancunBlobBaseFeeUpdateFraction,
+			expected:       1,
+		},
+		{
+			name:           "UpdateFraction",
+			excessBlobGas:  3338477,
+			updateFraction: spec.CancunBlobBaseFeeUpdateFraction,
+			expected:       2,
+		},
+		{
+			name:           "Blobs",
+			excessBlobGas:  7864320,
+			updateFraction: spec.CancunBlobBaseFeeUpdateFraction,
+			expected:       10,
+		},
+		{
+			name:           "High",
+			excessBlobGas:  33384770,
+			updateFraction: spec.CancunBlobBaseFeeUpdateFraction,
+			expected:       22026,
+		},
+		{
+			name:           "Prague",
+			excessBlobGas:  33384770,
+			updateFraction: spec.PragueBlobBaseFeeUpdateFraction,
+			expected:       785,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := spec.BlobBaseFee(test.excessBlobGas, test.updateFraction)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.Uint64())
+			}
+		})
+	}
+}
@@ -32,7 +32,7 @@ var responseBuilderVersionStrings = [...]string{
 
 // MarshalJSON implements json.Marshaler.
 func (d *BuilderVersion) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf("%q", responseBuilderVersionStrings[*d])), nil
+	return []byte(fmt.Sprintf("%q", d.String())), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -47,10 +47,20 @@ func (d *BuilderVersion) UnmarshalJSON(input []byte) error {
 	return err
 }
 
-// String returns a string representation of the
+// String returns a string representation of the builder version.
 func (d BuilderVersion) String() string {
 	if int(d) >= len(responseBuilderVersionStrings) {
 		return "unknown"
 	}
 	return responseBuilderVersionStrings[d]
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (d BuilderVersion) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *BuilderVersion) UnmarshalText(input []byte) error {
+	return d.UnmarshalJSON([]byte(fmt.Sprintf("%q", input)))
+}
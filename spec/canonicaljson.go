@@ -0,0 +1,134 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// hexRegex matches hex strings as used in the beacon API.
+var hexRegex = regexp.MustCompile(`^0[xX][0-9a-fA-F]*$`)
+
+// CanonicalJSON returns the canonical beacon API JSON representation of the supplied value.
+// The canonical representation is compact, retains the field order of the spec containers,
+// uses lower-case hex for byte values, quotes all integers and does not escape HTML
+// characters, allowing it to be compared byte-for-byte with the output of other clients.
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	data, err := marshalJSON(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	buf := &bytes.Buffer{}
+	if err := canonicalizeJSON(decoder, buf); err != nil {
+		return nil, errors.Wrap(err, "failed to canonicalize JSON")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// canonicalizeJSON writes the next JSON value from the decoder in canonical form.
+func canonicalizeJSON(decoder *json.Decoder, buf *bytes.Buffer) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			buf.WriteByte('{')
+			for i := 0; decoder.More(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				key, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				encodedKey, err := marshalJSON(key)
+				if err != nil {
+					return err
+				}
+				buf.Write(encodedKey)
+				buf.WriteByte(':')
+				if err := canonicalizeJSON(decoder, buf); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte('}')
+		case '[':
+			buf.WriteByte('[')
+			for i := 0; decoder.More(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if err := canonicalizeJSON(decoder, buf); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+		default:
+			return fmt.Errorf("unexpected delimiter %v", t)
+		}
+		// Consume the closing delimiter.
+		if _, err := decoder.Token(); err != nil {
+			return err
+		}
+	case string:
+		if hexRegex.MatchString(t) {
+			t = strings.ToLower(t)
+		}
+		encoded, err := marshalJSON(t)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoded)
+	case json.Number:
+		if strings.ContainsAny(t.String(), ".eE") {
+			// Not an integer; leave as-is.
+			buf.WriteString(t.String())
+		} else {
+			buf.WriteString(fmt.Sprintf("%q", t.String()))
+		}
+	case bool:
+		buf.WriteString(fmt.Sprintf("%t", t))
+	case nil:
+		buf.WriteString("null")
+	default:
+		return fmt.Errorf("unexpected token %v", t)
+	}
+
+	return nil
+}
+
+// marshalJSON marshals a value to compact JSON without escaping HTML characters.
+func marshalJSON(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
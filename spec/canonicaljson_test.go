@@ -0,0 +1,87 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+		err      string
+	}{
+		{
+			name:     "Nil",
+			expected: `null`,
+		},
+		{
+			name:  "Unmarshalable",
+			input: make(chan int),
+			err:   "failed to marshal JSON: json: unsupported type: chan int",
+		},
+		{
+			name: "Container",
+			input: &phase0.Checkpoint{
+				Epoch: 1,
+				Root:  phase0.Root{0x01, 0xab},
+			},
+			expected: `{"epoch":"1","root":"0x01ab000000000000000000000000000000000000000000000000000000000000"}`,
+		},
+		{
+			name: "Integers",
+			input: struct {
+				A uint64  `json:"a"`
+				B []int   `json:"b"`
+				C float64 `json:"c"`
+				D bool    `json:"d"`
+			}{
+				A: 1,
+				B: []int{2, 3},
+				C: 1.5,
+				D: true,
+			},
+			expected: `{"a":"1","b":["2","3"],"c":1.5,"d":true}`,
+		},
+		{
+			name:     "FieldOrder",
+			input:    json.RawMessage(`{"z":"0x0A","a":{"y":"0XBB","b":[]},"m":"<&>"}`),
+			expected: `{"z":"0x0a","a":{"y":"0xbb","b":[]},"m":"<&>"}`,
+		},
+		{
+			name:     "NonHex",
+			input:    map[string]string{"a": "0xZZ", "b": "AB"},
+			expected: `{"a":"0xZZ","b":"AB"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := spec.CanonicalJSON(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, string(res))
+			}
+		})
+	}
+}
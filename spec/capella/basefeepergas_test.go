@@ -0,0 +1,38 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseFeePerGasBig(t *testing.T) {
+	payload := &capella.ExecutionPayload{}
+	require.EqualError(t, payload.SetBaseFeePerGasBig(nil), "no base fee per gas supplied")
+	require.EqualError(t, payload.SetBaseFeePerGasBig(big.NewInt(-1)), "invalid base fee per gas: negative value")
+	require.NoError(t, payload.SetBaseFeePerGasBig(big.NewInt(0x0102)))
+	require.Equal(t, bellatrix.Uint256{0x02, 0x01}, payload.BaseFeePerGas)
+	require.Equal(t, big.NewInt(0x0102), payload.BaseFeePerGasBig())
+
+	header := &capella.ExecutionPayloadHeader{}
+	require.EqualError(t, header.SetBaseFeePerGasBig(nil), "no base fee per gas supplied")
+	require.NoError(t, header.SetBaseFeePerGasBig(big.NewInt(0x0102)))
+	require.Equal(t, bellatrix.Uint256{0x02, 0x01}, header.BaseFeePerGas)
+	require.Equal(t, big.NewInt(0x0102), header.BaseFeePerGasBig())
+}
@@ -0,0 +1,283 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+const (
+	// benchmarkValidators is the number of validators in the benchmark state,
+	// roughly that of mainnet.
+	benchmarkValidators = 1000000
+	// benchmarkTransactions is the number of transactions in the benchmark block.
+	benchmarkTransactions = 1000
+	// benchmarkTransactionSize is the size of each transaction in the benchmark block.
+	benchmarkTransactionSize = 1024
+)
+
+func benchmarkRoots(n int) []phase0.Root {
+	roots := make([]phase0.Root, n)
+	for i := range roots {
+		roots[i][0] = byte(i)
+		roots[i][1] = byte(i >> 8)
+	}
+
+	return roots
+}
+
+func benchmarkSyncCommittee() *altair.SyncCommittee {
+	pubkeys := make([]phase0.BLSPubKey, 512)
+	for i := range pubkeys {
+		pubkeys[i][0] = byte(i)
+	}
+
+	return &altair.SyncCommittee{
+		Pubkeys: pubkeys,
+	}
+}
+
+// benchmarkState returns a mainnet-sized beacon state.
+func benchmarkState() *capella.BeaconState {
+	validators := make([]*phase0.Validator, benchmarkValidators)
+	balances := make([]phase0.Gwei, benchmarkValidators)
+	participation := make([]altair.ParticipationFlags, benchmarkValidators)
+	inactivityScores := make([]uint64, benchmarkValidators)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:                  phase0.BLSPubKey{byte(i), byte(i >> 8), byte(i >> 16)},
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  phase0.Epoch(0xffffffffffffffff),
+			WithdrawableEpoch:          phase0.Epoch(0xffffffffffffffff),
+		}
+		balances[i] = 32000000000 + phase0.Gwei(i)
+		participation[i] = 7
+	}
+
+	return &capella.BeaconState{
+		Slot:                         6000000,
+		Fork:                         &phase0.Fork{},
+		LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+		BlockRoots:                   benchmarkRoots(8192),
+		StateRoots:                   benchmarkRoots(8192),
+		HistoricalRoots:              benchmarkRoots(700),
+		ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes:                []*phase0.ETH1Data{},
+		Validators:                   validators,
+		Balances:                     balances,
+		RANDAOMixes:                  benchmarkRoots(65536),
+		Slashings:                    make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:   participation,
+		CurrentEpochParticipation:    participation,
+		JustificationBits:            bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+		FinalizedCheckpoint:          &phase0.Checkpoint{},
+		InactivityScores:             inactivityScores,
+		CurrentSyncCommittee:         benchmarkSyncCommittee(),
+		NextSyncCommittee:            benchmarkSyncCommittee(),
+		LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+		HistoricalSummaries:          []*capella.HistoricalSummary{},
+	}
+}
+
+// benchmarkBlock returns a signed beacon block with a full set of attestations and
+// withdrawals, and a large execution payload.
+func benchmarkBlock() *capella.SignedBeaconBlock {
+	attestations := make([]*phase0.Attestation, 128)
+	for i := range attestations {
+		attestations[i] = &phase0.Attestation{
+			AggregationBits: bitfield.NewBitlist(512),
+			Data: &phase0.AttestationData{
+				Slot:   phase0.Slot(i),
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		}
+	}
+
+	transactions := make([]bellatrix.Transaction, benchmarkTransactions)
+	for i := range transactions {
+		transactions[i] = make(bellatrix.Transaction, benchmarkTransactionSize)
+		transactions[i][0] = byte(i)
+	}
+
+	withdrawals := make([]*capella.Withdrawal, 16)
+	for i := range withdrawals {
+		withdrawals[i] = &capella.Withdrawal{
+			Index:          capella.WithdrawalIndex(i),
+			ValidatorIndex: phase0.ValidatorIndex(i),
+			Amount:         phase0.Gwei(i),
+		}
+	}
+
+	return &capella.SignedBeaconBlock{
+		Message: &capella.BeaconBlock{
+			Slot: 6000000,
+			Body: &capella.BeaconBlockBody{
+				ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ProposerSlashings: []*phase0.ProposerSlashing{},
+				AttesterSlashings: []*phase0.AttesterSlashing{},
+				Attestations:      attestations,
+				Deposits:          []*phase0.Deposit{},
+				VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+				ExecutionPayload: &capella.ExecutionPayload{
+					ExtraData:    []byte{},
+					Transactions: transactions,
+					Withdrawals:  withdrawals,
+				},
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			},
+		},
+	}
+}
+
+func BenchmarkBeaconStateMarshalSSZ(b *testing.B) {
+	state := benchmarkState()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := state.MarshalSSZ(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateUnmarshalSSZ(b *testing.B) {
+	data, err := benchmarkState().MarshalSSZ()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var state capella.BeaconState
+		if err := state.UnmarshalSSZ(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateHashTreeRoot(b *testing.B) {
+	state := benchmarkState()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := state.HashTreeRoot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateMarshalJSON(b *testing.B) {
+	state := benchmarkState()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(state); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateUnmarshalJSON(b *testing.B) {
+	data, err := json.Marshal(benchmarkState())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var state capella.BeaconState
+		if err := json.Unmarshal(data, &state); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockMarshalSSZ(b *testing.B) {
+	block := benchmarkBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := block.MarshalSSZ(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockUnmarshalSSZ(b *testing.B) {
+	data, err := benchmarkBlock().MarshalSSZ()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var block capella.SignedBeaconBlock
+		if err := block.UnmarshalSSZ(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockHashTreeRoot(b *testing.B) {
+	block := benchmarkBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := block.HashTreeRoot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockMarshalJSON(b *testing.B) {
+	block := benchmarkBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockUnmarshalJSON(b *testing.B) {
+	data, err := json.Marshal(benchmarkBlock())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var block capella.SignedBeaconBlock
+		if err := json.Unmarshal(data, &block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
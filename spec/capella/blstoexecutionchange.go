@@ -129,6 +129,9 @@ func (b *BLSToExecutionChange) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (b *BLSToExecutionChange) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,221 @@
+// Code generated by copygen. DO NOT EDIT.
+package capella
+
+// Copy returns a deep copy of the structure.
+func (b *BLSToExecutionChange) Copy() *BLSToExecutionChange {
+	if b == nil {
+		return nil
+	}
+	res := *b
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+	res.ExecutionPayload = b.ExecutionPayload.Copy()
+	if b.BLSToExecutionChanges != nil {
+		res.BLSToExecutionChanges = append(b.BLSToExecutionChanges[:0:0], b.BLSToExecutionChanges...)
+		for i := range b.BLSToExecutionChanges {
+			res.BLSToExecutionChanges[i] = b.BLSToExecutionChanges[i].Copy()
+		}
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Fork = b.Fork.Copy()
+	res.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.BlockRoots != nil {
+		res.BlockRoots = append(b.BlockRoots[:0:0], b.BlockRoots...)
+	}
+	if b.StateRoots != nil {
+		res.StateRoots = append(b.StateRoots[:0:0], b.StateRoots...)
+	}
+	if b.HistoricalRoots != nil {
+		res.HistoricalRoots = append(b.HistoricalRoots[:0:0], b.HistoricalRoots...)
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ETH1DataVotes != nil {
+		res.ETH1DataVotes = append(b.ETH1DataVotes[:0:0], b.ETH1DataVotes...)
+		for i := range b.ETH1DataVotes {
+			res.ETH1DataVotes[i] = b.ETH1DataVotes[i].Copy()
+		}
+	}
+	if b.Validators != nil {
+		res.Validators = append(b.Validators[:0:0], b.Validators...)
+		for i := range b.Validators {
+			res.Validators[i] = b.Validators[i].Copy()
+		}
+	}
+	if b.Balances != nil {
+		res.Balances = append(b.Balances[:0:0], b.Balances...)
+	}
+	if b.RANDAOMixes != nil {
+		res.RANDAOMixes = append(b.RANDAOMixes[:0:0], b.RANDAOMixes...)
+	}
+	if b.Slashings != nil {
+		res.Slashings = append(b.Slashings[:0:0], b.Slashings...)
+	}
+	if b.PreviousEpochParticipation != nil {
+		res.PreviousEpochParticipation = append(b.PreviousEpochParticipation[:0:0], b.PreviousEpochParticipation...)
+	}
+	if b.CurrentEpochParticipation != nil {
+		res.CurrentEpochParticipation = append(b.CurrentEpochParticipation[:0:0], b.CurrentEpochParticipation...)
+	}
+	if b.JustificationBits != nil {
+		res.JustificationBits = append(b.JustificationBits[:0:0], b.JustificationBits...)
+	}
+	res.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	res.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	res.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		res.InactivityScores = append(b.InactivityScores[:0:0], b.InactivityScores...)
+	}
+	res.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	res.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	res.LatestExecutionPayloadHeader = b.LatestExecutionPayloadHeader.Copy()
+	if b.HistoricalSummaries != nil {
+		res.HistoricalSummaries = append(b.HistoricalSummaries[:0:0], b.HistoricalSummaries...)
+		for i := range b.HistoricalSummaries {
+			res.HistoricalSummaries[i] = b.HistoricalSummaries[i].Copy()
+		}
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (e *ExecutionPayload) Copy() *ExecutionPayload {
+	if e == nil {
+		return nil
+	}
+	res := *e
+	if e.ExtraData != nil {
+		res.ExtraData = append(e.ExtraData[:0:0], e.ExtraData...)
+	}
+	if e.Transactions != nil {
+		res.Transactions = append(e.Transactions[:0:0], e.Transactions...)
+		for i := range e.Transactions {
+			if e.Transactions[i] != nil {
+				res.Transactions[i] = append(e.Transactions[i][:0:0], e.Transactions[i]...)
+			}
+		}
+	}
+	if e.Withdrawals != nil {
+		res.Withdrawals = append(e.Withdrawals[:0:0], e.Withdrawals...)
+		for i := range e.Withdrawals {
+			res.Withdrawals[i] = e.Withdrawals[i].Copy()
+		}
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (e *ExecutionPayloadHeader) Copy() *ExecutionPayloadHeader {
+	if e == nil {
+		return nil
+	}
+	res := *e
+	if e.ExtraData != nil {
+		res.ExtraData = append(e.ExtraData[:0:0], e.ExtraData...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (h *HistoricalSummary) Copy() *HistoricalSummary {
+	if h == nil {
+		return nil
+	}
+	res := *h
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedBLSToExecutionChange) Copy() *SignedBLSToExecutionChange {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (w *Withdrawal) Copy() *Withdrawal {
+	if w == nil {
+		return nil
+	}
+	res := *w
+
+	return &res
+}
@@ -41,7 +41,7 @@ type ExecutionPayload struct {
 	GasUsed       uint64
 	Timestamp     uint64
 	ExtraData     []byte                  `ssz-max:"32"`
-	BaseFeePerGas [32]byte                `ssz-size:"32"`
+	BaseFeePerGas bellatrix.Uint256       `ssz-size:"32"`
 	BlockHash     phase0.Hash32           `ssz-size:"32"`
 	Transactions  []bellatrix.Transaction `ssz-max:"1048576,1073741824" ssz-size:"?,?"`
 	Withdrawals   []*Withdrawal           `ssz-max:"16"`
@@ -281,7 +281,7 @@ func (e *ExecutionPayload) unpack(data *executionPayloadJSON) error {
 	if !ok {
 		return errors.New("invalid value for base fee per gas")
 	}
-	if baseFeePerGas.Cmp(maxBaseFeePerGas) > 0 {
+	if baseFeePerGas.Cmp(bellatrix.MaxUint256()) > 0 {
 		return errors.New("overflow for base fee per gas")
 	}
 	// We need to store internally as little-endian, but big.Int uses
@@ -385,6 +385,9 @@ func (e *ExecutionPayload) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *ExecutionPayload) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
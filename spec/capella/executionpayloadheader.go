@@ -40,11 +40,11 @@ type ExecutionPayloadHeader struct {
 	GasLimit         uint64
 	GasUsed          uint64
 	Timestamp        uint64
-	ExtraData        []byte        `ssz-max:"32"`
-	BaseFeePerGas    [32]byte      `ssz-size:"32"`
-	BlockHash        phase0.Hash32 `ssz-size:"32"`
-	TransactionsRoot phase0.Root   `ssz-size:"32"`
-	WithdrawalsRoot  phase0.Root   `ssz-size:"32"`
+	ExtraData        []byte            `ssz-max:"32"`
+	BaseFeePerGas    bellatrix.Uint256 `ssz-size:"32"`
+	BlockHash        phase0.Hash32     `ssz-size:"32"`
+	TransactionsRoot phase0.Root       `ssz-size:"32"`
+	WithdrawalsRoot  phase0.Root       `ssz-size:"32"`
 }
 
 // executionPayloadHeaderJSON is the spec representation of the struct.
@@ -276,7 +276,7 @@ func (e *ExecutionPayloadHeader) unpack(data *executionPayloadHeaderJSON) error
 	if !ok {
 		return errors.New("invalid value for base fee per gas")
 	}
-	if baseFeePerGas.Cmp(maxBaseFeePerGas) > 0 {
+	if baseFeePerGas.Cmp(bellatrix.MaxUint256()) > 0 {
 		return errors.New("overflow for base fee per gas")
 	}
 	// We need to store internally as little-endian, but big.Int uses
@@ -378,9 +378,42 @@ func (e *ExecutionPayloadHeader) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *ExecutionPayloadHeader) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
 	}
 	return string(data)
 }
+
+// GetParentHash returns the parent hash of the execution payload header.
+func (e *ExecutionPayloadHeader) GetParentHash() phase0.Hash32 {
+	return e.ParentHash
+}
+
+// GetFeeRecipient returns the fee recipient of the execution payload header.
+func (e *ExecutionPayloadHeader) GetFeeRecipient() bellatrix.ExecutionAddress {
+	return e.FeeRecipient
+}
+
+// GetBlockNumber returns the block number of the execution payload header.
+func (e *ExecutionPayloadHeader) GetBlockNumber() uint64 {
+	return e.BlockNumber
+}
+
+// GetTimestamp returns the timestamp of the execution payload header.
+func (e *ExecutionPayloadHeader) GetTimestamp() uint64 {
+	return e.Timestamp
+}
+
+// GetBlockHash returns the block hash of the execution payload header.
+func (e *ExecutionPayloadHeader) GetBlockHash() phase0.Hash32 {
+	return e.BlockHash
+}
+
+// GetTransactionsRoot returns the transactions root of the execution payload header.
+func (e *ExecutionPayloadHeader) GetTransactionsRoot() phase0.Root {
+	return e.TransactionsRoot
+}
@@ -0,0 +1,143 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// WithdrawalsRoot returns the root of a list of withdrawals, as held in the
+// withdrawals root of an execution payload header.
+func WithdrawalsRoot(withdrawals []*Withdrawal) (phase0.Root, error) {
+	num := uint64(len(withdrawals))
+	if num > 16 {
+		return phase0.Root{}, ssz.ErrIncorrectListSize
+	}
+
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	for _, withdrawal := range withdrawals {
+		if withdrawal == nil {
+			return phase0.Root{}, errors.New("nil withdrawal")
+		}
+		if err := withdrawal.HashTreeRootWith(hh); err != nil {
+			return phase0.Root{}, err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, num, 16)
+
+	return hh.HashRoot()
+}
+
+// Header returns the header of the execution payload.
+func (e *ExecutionPayload) Header() (*ExecutionPayloadHeader, error) {
+	transactionsRoot, err := bellatrix.TransactionsRoot(e.Transactions)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate transactions root")
+	}
+	withdrawalsRoot, err := WithdrawalsRoot(e.Withdrawals)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate withdrawals root")
+	}
+
+	return &ExecutionPayloadHeader{
+		ParentHash:       e.ParentHash,
+		FeeRecipient:     e.FeeRecipient,
+		StateRoot:        e.StateRoot,
+		ReceiptsRoot:     e.ReceiptsRoot,
+		LogsBloom:        e.LogsBloom,
+		PrevRandao:       e.PrevRandao,
+		BlockNumber:      e.BlockNumber,
+		GasLimit:         e.GasLimit,
+		GasUsed:          e.GasUsed,
+		Timestamp:        e.Timestamp,
+		ExtraData:        e.ExtraData,
+		BaseFeePerGas:    e.BaseFeePerGas,
+		BlockHash:        e.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+	}, nil
+}
+
+// VerifyPayloadMatchesHeader confirms that the execution payload matches the
+// execution payload header, for example to check that a payload revealed for a
+// signed blinded block is the one that was committed to.  An error naming the
+// first field that differs is returned if they do not match.
+func VerifyPayloadMatchesHeader(payload *ExecutionPayload, header *ExecutionPayloadHeader) error {
+	if payload == nil {
+		return errors.New("no execution payload supplied")
+	}
+	if header == nil {
+		return errors.New("no execution payload header supplied")
+	}
+
+	payloadHeader, err := payload.Header()
+	if err != nil {
+		return err
+	}
+
+	if payloadHeader.ParentHash != header.ParentHash {
+		return fmt.Errorf("parent hash mismatch: payload %#x, header %#x", payloadHeader.ParentHash, header.ParentHash)
+	}
+	if payloadHeader.FeeRecipient != header.FeeRecipient {
+		return fmt.Errorf("fee recipient mismatch: payload %#x, header %#x", payloadHeader.FeeRecipient, header.FeeRecipient)
+	}
+	if payloadHeader.StateRoot != header.StateRoot {
+		return fmt.Errorf("state root mismatch: payload %#x, header %#x", payloadHeader.StateRoot, header.StateRoot)
+	}
+	if payloadHeader.ReceiptsRoot != header.ReceiptsRoot {
+		return fmt.Errorf("receipts root mismatch: payload %#x, header %#x", payloadHeader.ReceiptsRoot, header.ReceiptsRoot)
+	}
+	if payloadHeader.LogsBloom != header.LogsBloom {
+		return fmt.Errorf("logs bloom mismatch: payload %#x, header %#x", payloadHeader.LogsBloom, header.LogsBloom)
+	}
+	if payloadHeader.PrevRandao != header.PrevRandao {
+		return fmt.Errorf("prev randao mismatch: payload %#x, header %#x", payloadHeader.PrevRandao, header.PrevRandao)
+	}
+	if payloadHeader.BlockNumber != header.BlockNumber {
+		return fmt.Errorf("block number mismatch: payload %d, header %d", payloadHeader.BlockNumber, header.BlockNumber)
+	}
+	if payloadHeader.GasLimit != header.GasLimit {
+		return fmt.Errorf("gas limit mismatch: payload %d, header %d", payloadHeader.GasLimit, header.GasLimit)
+	}
+	if payloadHeader.GasUsed != header.GasUsed {
+		return fmt.Errorf("gas used mismatch: payload %d, header %d", payloadHeader.GasUsed, header.GasUsed)
+	}
+	if payloadHeader.Timestamp != header.Timestamp {
+		return fmt.Errorf("timestamp mismatch: payload %d, header %d", payloadHeader.Timestamp, header.Timestamp)
+	}
+	if !bytes.Equal(payloadHeader.ExtraData, header.ExtraData) {
+		return fmt.Errorf("extra data mismatch: payload %#x, header %#x", payloadHeader.ExtraData, header.ExtraData)
+	}
+	if payloadHeader.BaseFeePerGas != header.BaseFeePerGas {
+		return fmt.Errorf("base fee per gas mismatch: payload %s, header %s", payloadHeader.BaseFeePerGas, header.BaseFeePerGas)
+	}
+	if payloadHeader.BlockHash != header.BlockHash {
+		return fmt.Errorf("block hash mismatch: payload %#x, header %#x", payloadHeader.BlockHash, header.BlockHash)
+	}
+	if payloadHeader.TransactionsRoot != header.TransactionsRoot {
+		return fmt.Errorf("transactions root mismatch: payload %#x, header %#x", payloadHeader.TransactionsRoot, header.TransactionsRoot)
+	}
+	if payloadHeader.WithdrawalsRoot != header.WithdrawalsRoot {
+		return fmt.Errorf("withdrawals root mismatch: payload %#x, header %#x", payloadHeader.WithdrawalsRoot, header.WithdrawalsRoot)
+	}
+
+	return nil
+}
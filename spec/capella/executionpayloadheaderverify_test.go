@@ -0,0 +1,125 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testPayload() *capella.ExecutionPayload {
+	return &capella.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+		StateRoot:     [32]byte{0x03},
+		ReceiptsRoot:  [32]byte{0x04},
+		PrevRandao:    [32]byte{0x05},
+		BlockNumber:   6,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1681338455,
+		ExtraData:     []byte{0x07, 0x08},
+		BaseFeePerGas: [32]byte{0x09},
+		BlockHash:     phase0.Hash32{0x0a},
+		Transactions: []bellatrix.Transaction{
+			{0x01, 0x02, 0x03},
+			make([]byte, 100),
+		},
+		Withdrawals: []*capella.Withdrawal{
+			{Index: 1, ValidatorIndex: 2, Address: bellatrix.ExecutionAddress{0x03}, Amount: 4},
+		},
+	}
+}
+
+func TestExecutionPayloadHeader(t *testing.T) {
+	payload := testPayload()
+	header, err := payload.Header()
+	require.NoError(t, err)
+
+	// The root of a payload and its header are the same.
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, headerRoot)
+
+	withdrawalsRoot, err := capella.WithdrawalsRoot(nil)
+	require.NoError(t, err)
+	require.Equal(t, spec.EmptyWithdrawalsRoot(), withdrawalsRoot)
+	_, err = capella.WithdrawalsRoot([]*capella.Withdrawal{nil})
+	require.EqualError(t, err, "nil withdrawal")
+}
+
+func TestVerifyPayloadMatchesHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*capella.ExecutionPayloadHeader)
+		err    string
+	}{
+		{
+			name: "Good",
+		},
+		{
+			name:   "BlockHash",
+			mutate: func(h *capella.ExecutionPayloadHeader) { h.BlockHash[0] = 0xff },
+			err:    "block hash mismatch: payload 0x0a00000000000000000000000000000000000000000000000000000000000000, header 0xff00000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:   "GasUsed",
+			mutate: func(h *capella.ExecutionPayloadHeader) { h.GasUsed = 1 },
+			err:    "gas used mismatch: payload 21000, header 1",
+		},
+		{
+			name:   "ExtraData",
+			mutate: func(h *capella.ExecutionPayloadHeader) { h.ExtraData = []byte{0x07} },
+			err:    "extra data mismatch: payload 0x0708, header 0x07",
+		},
+		{
+			name:   "TransactionsRoot",
+			mutate: func(h *capella.ExecutionPayloadHeader) { h.TransactionsRoot = phase0.Root{} },
+		},
+		{
+			name:   "WithdrawalsRoot",
+			mutate: func(h *capella.ExecutionPayloadHeader) { h.WithdrawalsRoot = spec.EmptyWithdrawalsRoot() },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload := testPayload()
+			header, err := payload.Header()
+			require.NoError(t, err)
+			if test.mutate != nil {
+				test.mutate(header)
+			}
+			err = capella.VerifyPayloadMatchesHeader(payload, header)
+			switch {
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			case test.mutate != nil:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.EqualError(t, capella.VerifyPayloadMatchesHeader(nil, &capella.ExecutionPayloadHeader{}), "no execution payload supplied")
+	require.EqualError(t, capella.VerifyPayloadMatchesHeader(testPayload(), nil), "no execution payload header supplied")
+}
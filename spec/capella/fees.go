@@ -0,0 +1,42 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+)
+
+// GasUtilisation returns the fraction of the gas limit of the payload that was used.
+func (e *ExecutionPayload) GasUtilisation() float64 {
+	return bellatrix.GasUtilisation(e.GasUsed, e.GasLimit)
+}
+
+// BurntFees returns the fees burnt by the payload, being its base fee per gas
+// multiplied by the gas used.
+func (e *ExecutionPayload) BurntFees() *big.Int {
+	return bellatrix.BurntFees(e.BaseFeePerGas, e.GasUsed)
+}
+
+// GasUtilisation returns the fraction of the gas limit of the payload header that was used.
+func (e *ExecutionPayloadHeader) GasUtilisation() float64 {
+	return bellatrix.GasUtilisation(e.GasUsed, e.GasLimit)
+}
+
+// BurntFees returns the fees burnt by the payload header, being its base fee per gas
+// multiplied by the gas used.
+func (e *ExecutionPayloadHeader) BurntFees() *big.Int {
+	return bellatrix.BurntFees(e.BaseFeePerGas, e.GasUsed)
+}
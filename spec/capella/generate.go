@@ -17,3 +17,6 @@ package capella
 //go:generate rm -f blstoexecutionchange_encoding.go signedblstoexecutionchange_encoding.go withdrawal.go
 //go:generate sszgen --path . --objs BLSToExecutionChange SignedBLSToExecutionChange Withdrawal
 //go:generate goimports -w blstoexecutionchange_encoding.go signedblstoexecutionchange_encoding.go withdrawal_encoding.go
+
+// Deep copy methods are generated by copygen.
+//go:generate go run ../../internal/copygen .
@@ -28,6 +28,9 @@ type HistoricalSummary struct {
 
 // String returns a string version of the structure.
 func (h *HistoricalSummary) String() string {
+	if h == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(h)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
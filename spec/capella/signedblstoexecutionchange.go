@@ -105,6 +105,9 @@ func (s *SignedBLSToExecutionChange) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SignedBLSToExecutionChange) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -140,6 +140,9 @@ func (w *Withdrawal) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (w *Withdrawal) String() string {
+	if w == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(w)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,120 @@
+// Code generated by copygen. DO NOT EDIT.
+package spec
+
+import (
+	"math/big"
+)
+
+// Copy returns a deep copy of the structure.
+func (c *ConsolidationRequest) Copy() *ConsolidationRequest {
+	if c == nil {
+		return nil
+	}
+	res := *c
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+// A, B are copied shallowly.
+func (d *Difference) Copy() *Difference {
+	if d == nil {
+		return nil
+	}
+	res := *d
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (g *GenesisMetadata) Copy() *GenesisMetadata {
+	if g == nil {
+		return nil
+	}
+	res := *g
+	res.Fork = g.Fork.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SystemContractCall) Copy() *SystemContractCall {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	if s.Data != nil {
+		res.Data = append(s.Data[:0:0], s.Data...)
+	}
+	if s.Value != nil {
+		res.Value = new(big.Int).Set(s.Value)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedBeaconBlock) Copy() *VersionedBeaconBlock {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedBeaconBlockBody) Copy() *VersionedBeaconBlockBody {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedBeaconState) Copy() *VersionedBeaconState {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VersionedSignedBeaconBlock) Copy() *VersionedSignedBeaconBlock {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	res.Phase0 = v.Phase0.Copy()
+	res.Altair = v.Altair.Copy()
+	res.Bellatrix = v.Bellatrix.Copy()
+	res.Capella = v.Capella.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (w *WithdrawalRequest) Copy() *WithdrawalRequest {
+	if w == nil {
+		return nil
+	}
+	res := *w
+
+	return &res
+}
@@ -41,7 +41,7 @@ var dataVersionStrings = [...]string{
 
 // MarshalJSON implements json.Marshaler.
 func (d *DataVersion) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf("%q", dataVersionStrings[*d])), nil
+	return []byte(fmt.Sprintf("%q", d.String())), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -62,10 +62,32 @@ func (d *DataVersion) UnmarshalJSON(input []byte) error {
 	return err
 }
 
-// String returns a string representation of the
+// String returns a string representation of the data version.
 func (d DataVersion) String() string {
 	if int(d) >= len(dataVersionStrings) {
 		return "unknown"
 	}
 	return dataVersionStrings[d]
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DataVersion) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DataVersion) UnmarshalText(input []byte) error {
+	return d.UnmarshalJSON([]byte(fmt.Sprintf("%q", input)))
+}
+
+// populatedVersions returns the number of versions for which a container holds data.
+func populatedVersions(populated ...bool) int {
+	count := 0
+	for _, p := range populated {
+		if p {
+			count++
+		}
+	}
+
+	return count
+}
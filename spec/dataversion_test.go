@@ -0,0 +1,54 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataVersionText(t *testing.T) {
+	for _, version := range []spec.DataVersion{
+		spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+	} {
+		text, err := version.MarshalText()
+		require.NoError(t, err)
+		var res spec.DataVersion
+		require.NoError(t, res.UnmarshalText(text))
+		require.Equal(t, version, res)
+	}
+
+	var res spec.DataVersion
+	require.EqualError(t, res.UnmarshalText([]byte("unknown")), `unrecognised data version "unknown"`)
+}
+
+func TestVersionJSONMapKeys(t *testing.T) {
+	input := map[spec.DataVersion]spec.BuilderVersion{
+		spec.DataVersionBellatrix: spec.BuilderVersionV1,
+		spec.DataVersionCapella:   spec.BuilderVersionV1,
+	}
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+	require.Equal(t, `{"bellatrix":"V1","capella":"V1"}`, string(data))
+
+	var output map[spec.DataVersion]spec.BuilderVersion
+	require.NoError(t, json.Unmarshal(data, &output))
+	require.Equal(t, input, output)
+}
@@ -0,0 +1,211 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Difference is the first difference found between two containers.
+type Difference struct {
+	// Path is the spec-style path of the differing field, for example
+	// "body.execution_payload.transactions[3]".  It is empty if the
+	// containers differ at the top level.
+	Path string
+	// A is the value of the field in the first container.
+	A interface{}
+	// B is the value of the field in the second container.
+	B interface{}
+}
+
+// String returns a string version of the structure.
+func (d *Difference) String() string {
+	if d == nil {
+		return ""
+	}
+	path := d.Path
+	if path == "" {
+		path = "(root)"
+	}
+	return fmt.Sprintf("%s: %v != %v", path, d.A, d.B)
+}
+
+// Equal returns true if the two containers hold the same data.
+func Equal(a interface{}, b interface{}) bool {
+	return Diff(a, b) == nil
+}
+
+// Diff returns the first difference between the two containers, or nil if they are equal.
+// Fields are walked in declaration order and list elements in index order, so the
+// difference returned is the first one that would be encountered when serializing.
+func Diff(a interface{}, b interface{}) *Difference {
+	return diff("", reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func diff(path string, a reflect.Value, b reflect.Value) *Difference {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			return newDifference(path, a, b)
+		}
+		return nil
+	}
+	if a.Type() != b.Type() {
+		return &Difference{
+			Path: path,
+			A:    a.Type().String(),
+			B:    b.Type().String(),
+		}
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				return newDifference(path, a, b)
+			}
+			return nil
+		}
+		return diff(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		if a.NumField() > 0 && a.Type().Field(0).PkgPath != "" {
+			// Opaque structure, for example time.Time; compare as a whole.
+			if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+				return newDifference(path, a, b)
+			}
+			return nil
+		}
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if field.PkgPath != "" {
+				// Unexported.
+				continue
+			}
+			if d := diff(joinPath(path, fieldName(field.Name)), a.Field(i), b.Field(i)); d != nil {
+				return d
+			}
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		if a.Type().Elem().Kind() == reflect.Uint8 {
+			// Byte sequences are compared as a single value.
+			if !bytes.Equal(byteSlice(a), byteSlice(b)) {
+				return newDifference(path, a, b)
+			}
+			return nil
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if d := diff(fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i)); d != nil {
+				return d
+			}
+		}
+		if a.Len() != b.Len() {
+			// One list is a prefix of the other; report the first missing element.
+			index := a.Len()
+			if b.Len() < index {
+				index = b.Len()
+			}
+			d := &Difference{
+				Path: fmt.Sprintf("%s[%d]", path, index),
+			}
+			if index < a.Len() {
+				d.A = a.Index(index).Interface()
+			}
+			if index < b.Len() {
+				d.B = b.Index(index).Interface()
+			}
+			return d
+		}
+		return nil
+	case reflect.Map:
+		keys := make(map[string]reflect.Value)
+		for _, key := range a.MapKeys() {
+			keys[fmt.Sprintf("%v", key.Interface())] = key
+		}
+		for _, key := range b.MapKeys() {
+			keys[fmt.Sprintf("%v", key.Interface())] = key
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			key := keys[name]
+			if d := diff(fmt.Sprintf("%s[%s]", path, name), a.MapIndex(key), b.MapIndex(key)); d != nil {
+				return d
+			}
+		}
+		return nil
+	default:
+		if a.Interface() != b.Interface() {
+			return newDifference(path, a, b)
+		}
+		return nil
+	}
+}
+
+// newDifference creates a difference from two values, either of which may be invalid.
+func newDifference(path string, a reflect.Value, b reflect.Value) *Difference {
+	d := &Difference{
+		Path: path,
+	}
+	if a.IsValid() {
+		d.A = a.Interface()
+	}
+	if b.IsValid() {
+		d.B = b.Interface()
+	}
+	return d
+}
+
+// byteSlice returns the contents of a byte slice or array.
+func byteSlice(v reflect.Value) []byte {
+	if v.Kind() == reflect.Slice {
+		return v.Bytes()
+	}
+	res := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(res), v)
+	return res
+}
+
+// joinPath joins a field name to a path.
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", path, name)
+}
+
+// fieldName converts a Go field name to its spec equivalent, for example
+// "ExecutionPayload" to "execution_payload" and "ETH1Data" to "eth1_data".
+func fieldName(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
@@ -0,0 +1,153 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlock() *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionBellatrix,
+		Bellatrix: &bellatrix.SignedBeaconBlock{
+			Message: &bellatrix.BeaconBlock{
+				Slot: 1,
+				Body: &bellatrix.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						DepositCount: 5,
+					},
+					Attestations: []*phase0.Attestation{},
+					ExecutionPayload: &bellatrix.ExecutionPayload{
+						BlockNumber: 10,
+						Transactions: []bellatrix.Transaction{
+							{0x01}, {0x02}, {0x03}, {0x04},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     interface{}
+		b     func() interface{}
+		path  string
+		equal bool
+	}{
+		{
+			name:  "Equal",
+			a:     testBlock(),
+			b:     func() interface{} { return testBlock() },
+			equal: true,
+		},
+		{
+			name: "TopLevelNil",
+			a:    testBlock(),
+			b:    func() interface{} { return nil },
+			path: "",
+		},
+		{
+			name: "Version",
+			a:    testBlock(),
+			b: func() interface{} {
+				block := testBlock()
+				block.Version = spec.DataVersionCapella
+				return block
+			},
+			path: "version",
+		},
+		{
+			name: "Slot",
+			a:    testBlock(),
+			b: func() interface{} {
+				block := testBlock()
+				block.Bellatrix.Message.Slot = 2
+				return block
+			},
+			path: "bellatrix.message.slot",
+		},
+		{
+			name: "ETH1Data",
+			a:    testBlock(),
+			b: func() interface{} {
+				block := testBlock()
+				block.Bellatrix.Message.Body.ETH1Data.DepositCount = 6
+				return block
+			},
+			path: "bellatrix.message.body.eth1_data.deposit_count",
+		},
+		{
+			name: "Transaction",
+			a:    testBlock().Bellatrix.Message.Body,
+			b: func() interface{} {
+				block := testBlock()
+				block.Bellatrix.Message.Body.ExecutionPayload.Transactions[3] = bellatrix.Transaction{0x05}
+				return block.Bellatrix.Message.Body
+			},
+			path: "execution_payload.transactions[3]",
+		},
+		{
+			name: "TransactionMissing",
+			a:    testBlock().Bellatrix.Message.Body,
+			b: func() interface{} {
+				block := testBlock()
+				block.Bellatrix.Message.Body.ExecutionPayload.Transactions = block.Bellatrix.Message.Body.ExecutionPayload.Transactions[:2]
+				return block.Bellatrix.Message.Body
+			},
+			path: "execution_payload.transactions[2]",
+		},
+		{
+			name: "ByteArray",
+			a:    testBlock().Bellatrix.Message.Body,
+			b: func() interface{} {
+				block := testBlock()
+				block.Bellatrix.Message.Body.ExecutionPayload.StateRoot[5] = 0x01
+				return block.Bellatrix.Message.Body
+			},
+			path: "execution_payload.state_root",
+		},
+		{
+			name: "NilPointer",
+			a:    testBlock().Bellatrix.Message.Body,
+			b: func() interface{} {
+				block := testBlock()
+				block.Bellatrix.Message.Body.ExecutionPayload = nil
+				return block.Bellatrix.Message.Body
+			},
+			path: "execution_payload",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := test.b()
+			require.Equal(t, test.equal, spec.Equal(test.a, b))
+			diff := spec.Diff(test.a, b)
+			if test.equal {
+				require.Nil(t, diff)
+			} else {
+				require.NotNil(t, diff)
+				require.Equal(t, test.path, diff.Path)
+			}
+		})
+	}
+}
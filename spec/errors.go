@@ -11,13 +11,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package capella
+package spec
 
-import "math/big"
+import "errors"
 
-var maxBaseFeePerGas = new(big.Int).SetBytes([]byte{
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-})
+// ErrDataMissing is returned when a versioned container does not hold the data for
+// its version, or the data is incomplete.
+var ErrDataMissing = errors.New("data missing")
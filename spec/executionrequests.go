@@ -0,0 +1,187 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+var (
+	// WithdrawalRequestPredeployAddress is the address of the withdrawal request
+	// system contract, as defined in EIP-7002.
+	WithdrawalRequestPredeployAddress = bellatrix.ExecutionAddress{
+		0x00, 0x00, 0x09, 0x61, 0xef, 0x48, 0x0e, 0xb5, 0x5e, 0x80,
+		0xd1, 0x9a, 0xd8, 0x35, 0x79, 0xa6, 0x4c, 0x00, 0x70, 0x02,
+	}
+	// ConsolidationRequestPredeployAddress is the address of the consolidation
+	// request system contract, as defined in EIP-7251.
+	ConsolidationRequestPredeployAddress = bellatrix.ExecutionAddress{
+		0x00, 0x00, 0xbb, 0xdd, 0xc7, 0xce, 0x48, 0x86, 0x42, 0xfb,
+		0x57, 0x9f, 0x8b, 0x00, 0xf3, 0xa5, 0x90, 0x00, 0x72, 0x51,
+	}
+)
+
+const (
+	// FullExitRequestAmount is the withdrawal request amount that requests a full
+	// exit of the validator, as defined in EIP-7002.
+	FullExitRequestAmount = phase0.Gwei(0)
+	// MinWithdrawalRequestFee is the minimum fee for a withdrawal request, as
+	// defined in EIP-7002.
+	MinWithdrawalRequestFee = 1
+	// WithdrawalRequestFeeUpdateFraction is the fraction controlling the rate of
+	// change of the withdrawal request fee, as defined in EIP-7002.
+	WithdrawalRequestFeeUpdateFraction = 17
+	// MinConsolidationRequestFee is the minimum fee for a consolidation request,
+	// as defined in EIP-7251.
+	MinConsolidationRequestFee = 1
+	// ConsolidationRequestFeeUpdateFraction is the fraction controlling the rate
+	// of change of the consolidation request fee, as defined in EIP-7251.
+	ConsolidationRequestFeeUpdateFraction = 17
+
+	withdrawalRequestCallDataLen    = 48 + 8
+	consolidationRequestCallDataLen = 48 + 48
+)
+
+// WithdrawalRequest is an execution layer triggered withdrawal request, as
+// defined in EIP-7002.
+type WithdrawalRequest struct {
+	SourceAddress   bellatrix.ExecutionAddress
+	ValidatorPubkey phase0.BLSPubKey
+	Amount          phase0.Gwei
+}
+
+// ConsolidationRequest is an execution layer triggered consolidation request,
+// as defined in EIP-7251.
+type ConsolidationRequest struct {
+	SourceAddress bellatrix.ExecutionAddress
+	SourcePubkey  phase0.BLSPubKey
+	TargetPubkey  phase0.BLSPubKey
+}
+
+// SystemContractCall is a call to an execution layer system contract.
+// The call must be sent from the source address of the request, with at least
+// the current request fee as its value.
+type SystemContractCall struct {
+	From  bellatrix.ExecutionAddress
+	To    bellatrix.ExecutionAddress
+	Data  []byte
+	Value *big.Int
+}
+
+// IsFullExit returns true if the request is for a full exit of the validator.
+func (r *WithdrawalRequest) IsFullExit() bool {
+	return r.Amount == FullExitRequestAmount
+}
+
+// CallData returns the call data for the withdrawal request system contract.
+func (r *WithdrawalRequest) CallData() []byte {
+	data := make([]byte, withdrawalRequestCallDataLen)
+	copy(data[0:48], r.ValidatorPubkey[:])
+	binary.BigEndian.PutUint64(data[48:56], uint64(r.Amount))
+
+	return data
+}
+
+// Call returns the system contract call that submits the withdrawal request
+// with the given fee.
+func (r *WithdrawalRequest) Call(fee *big.Int) (*SystemContractCall, error) {
+	if fee == nil {
+		return nil, errors.New("no fee specified")
+	}
+	if fee.Cmp(big.NewInt(MinWithdrawalRequestFee)) < 0 {
+		return nil, fmt.Errorf("fee %s below minimum of %d", fee.String(), MinWithdrawalRequestFee)
+	}
+
+	return &SystemContractCall{
+		From:  r.SourceAddress,
+		To:    WithdrawalRequestPredeployAddress,
+		Data:  r.CallData(),
+		Value: new(big.Int).Set(fee),
+	}, nil
+}
+
+// WithdrawalRequestFromCallData returns the withdrawal request for the given
+// source address and withdrawal request system contract call data.
+func WithdrawalRequestFromCallData(source bellatrix.ExecutionAddress, data []byte) (*WithdrawalRequest, error) {
+	if len(data) != withdrawalRequestCallDataLen {
+		return nil, fmt.Errorf("incorrect length %d for withdrawal request call data", len(data))
+	}
+	request := &WithdrawalRequest{
+		SourceAddress: source,
+		Amount:        phase0.Gwei(binary.BigEndian.Uint64(data[48:56])),
+	}
+	copy(request.ValidatorPubkey[:], data[0:48])
+
+	return request, nil
+}
+
+// CallData returns the call data for the consolidation request system contract.
+func (r *ConsolidationRequest) CallData() []byte {
+	data := make([]byte, consolidationRequestCallDataLen)
+	copy(data[0:48], r.SourcePubkey[:])
+	copy(data[48:96], r.TargetPubkey[:])
+
+	return data
+}
+
+// Call returns the system contract call that submits the consolidation request
+// with the given fee.
+func (r *ConsolidationRequest) Call(fee *big.Int) (*SystemContractCall, error) {
+	if fee == nil {
+		return nil, errors.New("no fee specified")
+	}
+	if fee.Cmp(big.NewInt(MinConsolidationRequestFee)) < 0 {
+		return nil, fmt.Errorf("fee %s below minimum of %d", fee.String(), MinConsolidationRequestFee)
+	}
+
+	return &SystemContractCall{
+		From:  r.SourceAddress,
+		To:    ConsolidationRequestPredeployAddress,
+		Data:  r.CallData(),
+		Value: new(big.Int).Set(fee),
+	}, nil
+}
+
+// ConsolidationRequestFromCallData returns the consolidation request for the
+// given source address and consolidation request system contract call data.
+func ConsolidationRequestFromCallData(source bellatrix.ExecutionAddress, data []byte) (*ConsolidationRequest, error) {
+	if len(data) != consolidationRequestCallDataLen {
+		return nil, fmt.Errorf("incorrect length %d for consolidation request call data", len(data))
+	}
+	request := &ConsolidationRequest{
+		SourceAddress: source,
+	}
+	copy(request.SourcePubkey[:], data[0:48])
+	copy(request.TargetPubkey[:], data[48:96])
+
+	return request, nil
+}
+
+// WithdrawalRequestFee returns the fee for a withdrawal request given the
+// excess withdrawal requests held by the system contract, as defined in EIP-7002.
+func WithdrawalRequestFee(excess uint64) *big.Int {
+	return fakeExponential(big.NewInt(MinWithdrawalRequestFee), new(big.Int).SetUint64(excess), big.NewInt(WithdrawalRequestFeeUpdateFraction))
+}
+
+// ConsolidationRequestFee returns the fee for a consolidation request given the
+// excess consolidation requests held by the system contract, as defined in EIP-7251.
+func ConsolidationRequestFee(excess uint64) *big.Int {
+	return fakeExponential(big.NewInt(MinConsolidationRequestFee), new(big.Int).SetUint64(excess), big.NewInt(ConsolidationRequestFeeUpdateFraction))
+}
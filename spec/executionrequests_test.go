@@ -0,0 +1,116 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalRequestCall(t *testing.T) {
+	request := &spec.WithdrawalRequest{
+		SourceAddress:   bellatrix.ExecutionAddress{0x01},
+		ValidatorPubkey: phase0.BLSPubKey{0x02},
+		Amount:          phase0.Gwei(0x0102030405060708),
+	}
+	require.False(t, request.IsFullExit())
+
+	_, err := request.Call(nil)
+	require.EqualError(t, err, "no fee specified")
+	_, err = request.Call(big.NewInt(0))
+	require.EqualError(t, err, "fee 0 below minimum of 1")
+
+	call, err := request.Call(big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, request.SourceAddress, call.From)
+	require.Equal(t, spec.WithdrawalRequestPredeployAddress, call.To)
+	require.Equal(t, big.NewInt(5), call.Value)
+	require.Len(t, call.Data, 56)
+	require.Equal(t, request.ValidatorPubkey[:], call.Data[:48])
+	require.Equal(t, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, call.Data[48:])
+
+	parsed, err := spec.WithdrawalRequestFromCallData(request.SourceAddress, call.Data)
+	require.NoError(t, err)
+	require.Equal(t, request, parsed)
+
+	_, err = spec.WithdrawalRequestFromCallData(request.SourceAddress, call.Data[1:])
+	require.EqualError(t, err, "incorrect length 55 for withdrawal request call data")
+
+	exit := &spec.WithdrawalRequest{Amount: spec.FullExitRequestAmount}
+	require.True(t, exit.IsFullExit())
+	require.True(t, bytes.Equal(make([]byte, 8), exit.CallData()[48:]))
+}
+
+func TestConsolidationRequestCall(t *testing.T) {
+	request := &spec.ConsolidationRequest{
+		SourceAddress: bellatrix.ExecutionAddress{0x01},
+		SourcePubkey:  phase0.BLSPubKey{0x02},
+		TargetPubkey:  phase0.BLSPubKey{0x03},
+	}
+
+	_, err := request.Call(nil)
+	require.EqualError(t, err, "no fee specified")
+
+	call, err := request.Call(big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, request.SourceAddress, call.From)
+	require.Equal(t, spec.ConsolidationRequestPredeployAddress, call.To)
+	require.Len(t, call.Data, 96)
+	require.Equal(t, request.SourcePubkey[:], call.Data[:48])
+	require.Equal(t, request.TargetPubkey[:], call.Data[48:])
+
+	parsed, err := spec.ConsolidationRequestFromCallData(request.SourceAddress, call.Data)
+	require.NoError(t, err)
+	require.Equal(t, request, parsed)
+
+	_, err = spec.ConsolidationRequestFromCallData(request.SourceAddress, call.Data[:95])
+	require.EqualError(t, err, "incorrect length 95 for consolidation request call data")
+}
+
+func TestRequestFees(t *testing.T) {
+	tests := []struct {
+		name     string
+		excess   uint64
+		expected uint64
+	}{
+		{
+			name:     "Zero",
+			excess:   0,
+			expected: 1,
+		},
+		{
+			name:     "One",
+			excess:   17,
+			expected: 2,
+		},
+		{
+			name:     "Large",
+			excess:   170,
+			expected: 22019,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, spec.WithdrawalRequestFee(test.excess).Uint64())
+			require.Equal(t, test.expected, spec.ConsolidationRequestFee(test.excess).Uint64())
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GeneralizedIndex is the generalized index of a node in the Merkle tree of an SSZ
+// container, as used to construct and verify Merkle proofs.  The root has index 1,
+// and the children of the node with index i have indices 2i and 2i+1.
+type GeneralizedIndex uint64
+
+const (
+	// BeaconBlockBodyGeneralizedIndex is the generalized index of the body in a beacon
+	// block, which is the same for all forks.
+	BeaconBlockBodyGeneralizedIndex GeneralizedIndex = 12
+	// BeaconBlockStateRootGeneralizedIndex is the generalized index of the state root in
+	// a beacon block or beacon block header, which is the same for all forks.
+	BeaconBlockStateRootGeneralizedIndex GeneralizedIndex = 11
+
+	// maxAttestations is the value of MAX_ATTESTATIONS, the limit of the attestations
+	// list in a beacon block body.
+	maxAttestations = 128
+)
+
+// beaconBlockBodyFields are the fields of the beacon block body at each fork.
+var beaconBlockBodyFields = map[DataVersion][]string{
+	DataVersionPhase0: {
+		"randao_reveal",
+		"eth1_data",
+		"graffiti",
+		"proposer_slashings",
+		"attester_slashings",
+		"attestations",
+		"deposits",
+		"voluntary_exits",
+	},
+}
+
+// beaconStateFields are the fields of the beacon state at each fork.
+var beaconStateFields = map[DataVersion][]string{
+	DataVersionPhase0: {
+		"genesis_time",
+		"genesis_validators_root",
+		"slot",
+		"fork",
+		"latest_block_header",
+		"block_roots",
+		"state_roots",
+		"historical_roots",
+		"eth1_data",
+		"eth1_data_votes",
+		"eth1_deposit_index",
+		"validators",
+		"balances",
+		"randao_mixes",
+		"slashings",
+		"previous_epoch_attestations",
+		"current_epoch_attestations",
+		"justification_bits",
+		"previous_justified_checkpoint",
+		"current_justified_checkpoint",
+		"finalized_checkpoint",
+	},
+}
+
+func init() {
+	// Later forks append to the fields of earlier forks, other than Altair which also
+	// replaces the pending attestations in the state with participation flags.
+	body := beaconBlockBodyFields[DataVersionPhase0]
+	body = append(body[:len(body):len(body)], "sync_aggregate")
+	beaconBlockBodyFields[DataVersionAltair] = body
+	body = append(body[:len(body):len(body)], "execution_payload")
+	beaconBlockBodyFields[DataVersionBellatrix] = body
+	body = append(body[:len(body):len(body)], "bls_to_execution_changes")
+	beaconBlockBodyFields[DataVersionCapella] = body
+
+	state := append([]string{}, beaconStateFields[DataVersionPhase0]...)
+	state[15] = "previous_epoch_participation"
+	state[16] = "current_epoch_participation"
+	state = append(state, "inactivity_scores", "current_sync_committee", "next_sync_committee")
+	beaconStateFields[DataVersionAltair] = state
+	state = append(state[:len(state):len(state)], "latest_execution_payload_header")
+	beaconStateFields[DataVersionBellatrix] = state
+	state = append(state[:len(state):len(state)], "next_withdrawal_index", "next_withdrawal_validator_index", "historical_summaries")
+	beaconStateFields[DataVersionCapella] = state
+}
+
+// fieldGeneralizedIndex returns the generalized index of the named field in a
+// container with the given fields.
+func fieldGeneralizedIndex(fields []string, field string) (GeneralizedIndex, bool) {
+	// Fields are the leaves of a tree padded to the next power of two.
+	width := uint64(1)
+	for width < uint64(len(fields)) {
+		width *= 2
+	}
+	for i := range fields {
+		if fields[i] == field {
+			return GeneralizedIndex(width + uint64(i)), true
+		}
+	}
+
+	return 0, false
+}
+
+// BeaconBlockBodyFieldGeneralizedIndex returns the generalized index of the named
+// field, as it appears in the spec, in the beacon block body of the given version.
+func BeaconBlockBodyFieldGeneralizedIndex(version DataVersion, field string) (GeneralizedIndex, error) {
+	fields, exists := beaconBlockBodyFields[version]
+	if !exists {
+		return 0, errors.New("unsupported version")
+	}
+	gindex, exists := fieldGeneralizedIndex(fields, field)
+	if !exists {
+		return 0, fmt.Errorf("no field %s in %s beacon block body", field, version)
+	}
+
+	return gindex, nil
+}
+
+// BeaconStateFieldGeneralizedIndex returns the generalized index of the named field,
+// as it appears in the spec, in the beacon state of the given version.
+func BeaconStateFieldGeneralizedIndex(version DataVersion, field string) (GeneralizedIndex, error) {
+	fields, exists := beaconStateFields[version]
+	if !exists {
+		return 0, errors.New("unsupported version")
+	}
+	gindex, exists := fieldGeneralizedIndex(fields, field)
+	if !exists {
+		return 0, fmt.Errorf("no field %s in %s beacon state", field, version)
+	}
+
+	return gindex, nil
+}
+
+// ExecutionPayloadGeneralizedIndex returns the generalized index of the execution
+// payload in the beacon block body of the given version.
+func ExecutionPayloadGeneralizedIndex(version DataVersion) (GeneralizedIndex, error) {
+	return BeaconBlockBodyFieldGeneralizedIndex(version, "execution_payload")
+}
+
+// SyncAggregateGeneralizedIndex returns the generalized index of the sync aggregate
+// in the beacon block body of the given version.
+func SyncAggregateGeneralizedIndex(version DataVersion) (GeneralizedIndex, error) {
+	return BeaconBlockBodyFieldGeneralizedIndex(version, "sync_aggregate")
+}
+
+// AttestationGeneralizedIndex returns the generalized index of the attestation at
+// the given position in the attestations of the beacon block body of the given
+// version.
+func AttestationGeneralizedIndex(version DataVersion, index uint64) (GeneralizedIndex, error) {
+	if index >= maxAttestations {
+		return 0, fmt.Errorf("attestation index %d out of range", index)
+	}
+	attestations, err := BeaconBlockBodyFieldGeneralizedIndex(version, "attestations")
+	if err != nil {
+		return 0, err
+	}
+
+	// The list root mixes in the length, so the elements are below its left child.
+	return ConcatGeneralizedIndices(attestations, 2, GeneralizedIndex(maxAttestations+index)), nil
+}
+
+// FinalizedRootGeneralizedIndex returns the generalized index of the root of the
+// finalized checkpoint in the beacon state of the given version.
+func FinalizedRootGeneralizedIndex(version DataVersion) (GeneralizedIndex, error) {
+	checkpoint, err := BeaconStateFieldGeneralizedIndex(version, "finalized_checkpoint")
+	if err != nil {
+		return 0, err
+	}
+
+	return ConcatGeneralizedIndices(checkpoint, 3), nil
+}
+
+// CurrentSyncCommitteeGeneralizedIndex returns the generalized index of the current
+// sync committee in the beacon state of the given version.
+func CurrentSyncCommitteeGeneralizedIndex(version DataVersion) (GeneralizedIndex, error) {
+	return BeaconStateFieldGeneralizedIndex(version, "current_sync_committee")
+}
+
+// NextSyncCommitteeGeneralizedIndex returns the generalized index of the next sync
+// committee in the beacon state of the given version.
+func NextSyncCommitteeGeneralizedIndex(version DataVersion) (GeneralizedIndex, error) {
+	return BeaconStateFieldGeneralizedIndex(version, "next_sync_committee")
+}
+
+// ConcatGeneralizedIndices returns the generalized index of a path through nested
+// containers, where each index is relative to the node given by the previous index.
+func ConcatGeneralizedIndices(indices ...GeneralizedIndex) GeneralizedIndex {
+	res := GeneralizedIndex(1)
+	for _, index := range indices {
+		depth := index.Depth()
+		res = res<<depth | (index ^ (1 << depth))
+	}
+
+	return res
+}
+
+// Depth returns the depth of the node with the generalized index in its tree, where
+// the root has depth 0.
+func (g GeneralizedIndex) Depth() uint64 {
+	depth := uint64(0)
+	for g > 1 {
+		g >>= 1
+		depth++
+	}
+
+	return depth
+}
+
+// SubtreeIndex returns the position of the node with the generalized index amongst
+// the nodes at the same depth of its tree, as used to order the branch of a proof.
+func (g GeneralizedIndex) SubtreeIndex() uint64 {
+	return uint64(g) % (1 << g.Depth())
+}
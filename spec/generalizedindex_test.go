@@ -0,0 +1,164 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneralizedIndices(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(spec.DataVersion) (spec.GeneralizedIndex, error)
+		version  spec.DataVersion
+		expected spec.GeneralizedIndex
+		err      string
+	}{
+		{
+			name:    "ExecutionPayloadPhase0",
+			fn:      spec.ExecutionPayloadGeneralizedIndex,
+			version: spec.DataVersionPhase0,
+			err:     "no field execution_payload in phase0 beacon block body",
+		},
+		{
+			name:     "ExecutionPayloadBellatrix",
+			fn:       spec.ExecutionPayloadGeneralizedIndex,
+			version:  spec.DataVersionBellatrix,
+			expected: 25,
+		},
+		{
+			name:     "ExecutionPayloadCapella",
+			fn:       spec.ExecutionPayloadGeneralizedIndex,
+			version:  spec.DataVersionCapella,
+			expected: 25,
+		},
+		{
+			name:     "SyncAggregateAltair",
+			fn:       spec.SyncAggregateGeneralizedIndex,
+			version:  spec.DataVersionAltair,
+			expected: 24,
+		},
+		{
+			name:     "FinalizedRootPhase0",
+			fn:       spec.FinalizedRootGeneralizedIndex,
+			version:  spec.DataVersionPhase0,
+			expected: 105,
+		},
+		{
+			name:     "FinalizedRootCapella",
+			fn:       spec.FinalizedRootGeneralizedIndex,
+			version:  spec.DataVersionCapella,
+			expected: 105,
+		},
+		{
+			name:    "CurrentSyncCommitteePhase0",
+			fn:      spec.CurrentSyncCommitteeGeneralizedIndex,
+			version: spec.DataVersionPhase0,
+			err:     "no field current_sync_committee in phase0 beacon state",
+		},
+		{
+			name:     "CurrentSyncCommitteeAltair",
+			fn:       spec.CurrentSyncCommitteeGeneralizedIndex,
+			version:  spec.DataVersionAltair,
+			expected: 54,
+		},
+		{
+			name:     "NextSyncCommitteeCapella",
+			fn:       spec.NextSyncCommitteeGeneralizedIndex,
+			version:  spec.DataVersionCapella,
+			expected: 55,
+		},
+		{
+			name:    "UnsupportedVersion",
+			fn:      spec.NextSyncCommitteeGeneralizedIndex,
+			version: spec.DataVersion(99),
+			err:     "unsupported version",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gindex, err := test.fn(test.version)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, gindex)
+			}
+		})
+	}
+}
+
+func TestStateFieldGeneralizedIndices(t *testing.T) {
+	gindex, err := spec.BeaconStateFieldGeneralizedIndex(spec.DataVersionBellatrix, "latest_execution_payload_header")
+	require.NoError(t, err)
+	require.Equal(t, spec.GeneralizedIndex(56), gindex)
+	gindex, err = spec.BeaconStateFieldGeneralizedIndex(spec.DataVersionCapella, "historical_summaries")
+	require.NoError(t, err)
+	require.Equal(t, spec.GeneralizedIndex(59), gindex)
+	gindex, err = spec.BeaconStateFieldGeneralizedIndex(spec.DataVersionAltair, "previous_epoch_participation")
+	require.NoError(t, err)
+	require.Equal(t, spec.GeneralizedIndex(47), gindex)
+	_, err = spec.BeaconStateFieldGeneralizedIndex(spec.DataVersionAltair, "previous_epoch_attestations")
+	require.EqualError(t, err, "no field previous_epoch_attestations in altair beacon state")
+}
+
+func TestAttestationGeneralizedIndex(t *testing.T) {
+	_, err := spec.AttestationGeneralizedIndex(spec.DataVersionPhase0, 128)
+	require.EqualError(t, err, "attestation index 128 out of range")
+
+	aggregationBits := bitfield.NewBitlist(8)
+	aggregationBits.SetBitAt(1, true)
+	attestations := make([]*phase0.Attestation, 0)
+	for i := 0; i < 3; i++ {
+		attestations = append(attestations, &phase0.Attestation{
+			AggregationBits: aggregationBits,
+			Data: &phase0.AttestationData{
+				Slot:   phase0.Slot(i),
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		})
+	}
+	body := &phase0.BeaconBlockBody{
+		ETH1Data:     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		Attestations: attestations,
+	}
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+
+	for i, attestation := range attestations {
+		gindex, err := spec.AttestationGeneralizedIndex(spec.DataVersionPhase0, uint64(i))
+		require.NoError(t, err)
+		node, err := tree.Get(int(gindex))
+		require.NoError(t, err)
+		root, err := attestation.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, root[:], node.Hash())
+	}
+}
+
+func TestConcatGeneralizedIndices(t *testing.T) {
+	require.Equal(t, spec.GeneralizedIndex(1), spec.ConcatGeneralizedIndices())
+	require.Equal(t, spec.GeneralizedIndex(25), spec.ConcatGeneralizedIndices(25))
+	// Execution payload in a beacon block.
+	require.Equal(t, spec.GeneralizedIndex(201), spec.ConcatGeneralizedIndices(spec.BeaconBlockBodyGeneralizedIndex, 25))
+	require.Equal(t, uint64(4), spec.GeneralizedIndex(25).Depth())
+	require.Equal(t, uint64(9), spec.GeneralizedIndex(25).SubtreeIndex())
+}
@@ -0,0 +1,261 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// GenesisMetadata is the metadata that accompanies an SSZ-encoded genesis state
+// in devnet genesis artefacts.
+type GenesisMetadata struct {
+	Version               DataVersion
+	GenesisTime           time.Time
+	GenesisValidatorsRoot phase0.Root
+	Fork                  *phase0.Fork
+}
+
+// genesisMetadataJSON is the spec representation of the struct.
+type genesisMetadataJSON struct {
+	Version               string       `json:"version"`
+	GenesisTime           string       `json:"genesis_time"`
+	GenesisValidatorsRoot string       `json:"genesis_validators_root"`
+	Fork                  *phase0.Fork `json:"fork"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *GenesisMetadata) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&genesisMetadataJSON{
+		Version:               m.Version.String(),
+		GenesisTime:           fmt.Sprintf("%d", m.GenesisTime.Unix()),
+		GenesisValidatorsRoot: fmt.Sprintf("%#x", m.GenesisValidatorsRoot),
+		Fork:                  m.Fork,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *GenesisMetadata) UnmarshalJSON(input []byte) error {
+	var data genesisMetadataJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Version == "" {
+		return errors.New("version missing")
+	}
+	if err := m.Version.UnmarshalJSON([]byte(fmt.Sprintf("%q", data.Version))); err != nil {
+		return errors.Wrap(err, "invalid value for version")
+	}
+
+	if data.GenesisTime == "" {
+		return errors.New("genesis time missing")
+	}
+	genesisTime, err := strconv.ParseInt(data.GenesisTime, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for genesis time")
+	}
+	m.GenesisTime = time.Unix(genesisTime, 0)
+
+	if data.GenesisValidatorsRoot == "" {
+		return errors.New("genesis validators root missing")
+	}
+	genesisValidatorsRoot, err := hex.DecodeString(strings.TrimPrefix(data.GenesisValidatorsRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for genesis validators root")
+	}
+	if len(genesisValidatorsRoot) != len(m.GenesisValidatorsRoot) {
+		return fmt.Errorf("incorrect length %d for genesis validators root", len(genesisValidatorsRoot))
+	}
+	copy(m.GenesisValidatorsRoot[:], genesisValidatorsRoot)
+
+	if data.Fork == nil {
+		return errors.New("fork missing")
+	}
+	m.Fork = data.Fork
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (m *GenesisMetadata) String() string {
+	if m == nil {
+		return ""
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// ExportGenesis writes the SSZ encoding of the state to sszWriter, and its genesis
+// metadata as JSON to metadataWriter.
+func ExportGenesis(state *VersionedBeaconState, sszWriter io.Writer, metadataWriter io.Writer) error {
+	data, err := marshalStateSSZ(state)
+	if err != nil {
+		return err
+	}
+	genesisTime, err := state.GenesisTime()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis time")
+	}
+	genesisValidatorsRoot, err := state.GenesisValidatorsRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis validators root")
+	}
+	fork, err := state.Fork()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain fork")
+	}
+	metadata := &GenesisMetadata{
+		Version:               state.Version,
+		GenesisTime:           time.Unix(int64(genesisTime), 0),
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+		Fork:                  fork,
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal metadata")
+	}
+
+	if _, err := sszWriter.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write state")
+	}
+	if _, err := metadataWriter.Write(metadataJSON); err != nil {
+		return errors.Wrap(err, "failed to write metadata")
+	}
+
+	return nil
+}
+
+// ImportGenesis reads an SSZ-encoded state from sszReader and its genesis metadata
+// as JSON from metadataReader.  The metadata supplies the version with which to
+// decode the state, and must agree with the state's genesis time, genesis validators
+// root and fork.
+func ImportGenesis(sszReader io.Reader, metadataReader io.Reader) (*VersionedBeaconState, *GenesisMetadata, error) {
+	metadataJSON, err := ioutil.ReadAll(metadataReader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read metadata")
+	}
+	var metadata GenesisMetadata
+	if err := json.Unmarshal(metadataJSON, &metadata); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse metadata")
+	}
+
+	data, err := ioutil.ReadAll(sszReader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read state")
+	}
+	lazyState, err := NewLazyBeaconState(metadata.Version, data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse state")
+	}
+	stateMetadata, err := genesisMetadata(lazyState)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkGenesisMetadata(&metadata, stateMetadata); err != nil {
+		return nil, nil, err
+	}
+
+	state, err := lazyState.State()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return state, &metadata, nil
+}
+
+// genesisMetadata obtains the genesis metadata from a state.
+func genesisMetadata(state *LazyBeaconState) (*GenesisMetadata, error) {
+	fork, err := state.Fork()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GenesisMetadata{
+		Version:               state.Version(),
+		GenesisTime:           time.Unix(int64(state.GenesisTime()), 0),
+		GenesisValidatorsRoot: state.GenesisValidatorsRoot(),
+		Fork:                  fork,
+	}, nil
+}
+
+// checkGenesisMetadata ensures that supplied metadata matches that of the state.
+func checkGenesisMetadata(metadata *GenesisMetadata, stateMetadata *GenesisMetadata) error {
+	if !metadata.GenesisTime.Equal(stateMetadata.GenesisTime) {
+		return fmt.Errorf("metadata genesis time %d does not match state genesis time %d",
+			metadata.GenesisTime.Unix(), stateMetadata.GenesisTime.Unix())
+	}
+	if metadata.GenesisValidatorsRoot != stateMetadata.GenesisValidatorsRoot {
+		return fmt.Errorf("metadata genesis validators root %#x does not match state genesis validators root %#x",
+			metadata.GenesisValidatorsRoot, stateMetadata.GenesisValidatorsRoot)
+	}
+	if metadata.Fork.PreviousVersion != stateMetadata.Fork.PreviousVersion ||
+		metadata.Fork.CurrentVersion != stateMetadata.Fork.CurrentVersion ||
+		metadata.Fork.Epoch != stateMetadata.Fork.Epoch {
+		return fmt.Errorf("metadata fork %v does not match state fork %v", metadata.Fork, stateMetadata.Fork)
+	}
+
+	return nil
+}
+
+// marshalStateSSZ returns the SSZ encoding of the state.
+func marshalStateSSZ(state *VersionedBeaconState) ([]byte, error) {
+	if state == nil {
+		return nil, ErrDataMissing
+	}
+
+	var data []byte
+	var err error
+	switch state.Version {
+	case DataVersionPhase0:
+		if state.Phase0 == nil {
+			return nil, errors.Wrap(ErrDataMissing, "no Phase0 state")
+		}
+		data, err = state.Phase0.MarshalSSZ()
+	case DataVersionAltair:
+		if state.Altair == nil {
+			return nil, errors.Wrap(ErrDataMissing, "no Altair state")
+		}
+		data, err = state.Altair.MarshalSSZ()
+	case DataVersionBellatrix:
+		if state.Bellatrix == nil {
+			return nil, errors.Wrap(ErrDataMissing, "no Bellatrix state")
+		}
+		data, err = state.Bellatrix.MarshalSSZ()
+	case DataVersionCapella:
+		if state.Capella == nil {
+			return nil, errors.Wrap(ErrDataMissing, "no Capella state")
+		}
+		data, err = state.Capella.MarshalSSZ()
+	default:
+		return nil, errors.New("unknown version")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode state")
+	}
+
+	return data, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	tests := []struct {
+		name  string
+		state *spec.VersionedBeaconState
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "data missing",
+		},
+		{
+			name:  "Empty",
+			state: &spec.VersionedBeaconState{Version: spec.DataVersionCapella},
+			err:   "no Capella state: data missing",
+		},
+		{
+			name:  "Capella",
+			state: &spec.VersionedBeaconState{Version: spec.DataVersionCapella, Capella: lazyTestCapellaState()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sszBuf := &bytes.Buffer{}
+			metadataBuf := &bytes.Buffer{}
+			err := spec.ExportGenesis(test.state, sszBuf, metadataBuf)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			data := sszBuf.Bytes()
+
+			state, metadata, err := spec.ImportGenesis(bytes.NewReader(data), metadataBuf)
+			require.NoError(t, err)
+			require.Equal(t, test.state.Version, state.Version)
+			require.Equal(t, test.state.Version, metadata.Version)
+
+			// Round trip the imported state to ensure it is unchanged.
+			reexported := &bytes.Buffer{}
+			require.NoError(t, spec.ExportGenesis(state, reexported, &bytes.Buffer{}))
+			require.Equal(t, data, reexported.Bytes())
+		})
+	}
+}
+
+func TestGenesisMetadataJSON(t *testing.T) {
+	metadata := &spec.GenesisMetadata{
+		Version:               spec.DataVersionCapella,
+		GenesisTime:           time.Unix(1606824023, 0),
+		GenesisValidatorsRoot: phase0.Root{0x01},
+		Fork: &phase0.Fork{
+			PreviousVersion: phase0.Version{0x02},
+			CurrentVersion:  phase0.Version{0x03},
+			Epoch:           100,
+		},
+	}
+	expected := `{"version":"capella","genesis_time":"1606824023","genesis_validators_root":"0x0100000000000000000000000000000000000000000000000000000000000000","fork":{"previous_version":"0x02000000","current_version":"0x03000000","epoch":"100"}}`
+	require.Equal(t, expected, metadata.String())
+
+	var res spec.GenesisMetadata
+	require.NoError(t, res.UnmarshalJSON([]byte(expected)))
+	require.Equal(t, metadata, &res)
+
+	require.EqualError(t, res.UnmarshalJSON([]byte(`{"genesis_time":"1606824023"}`)), "version missing")
+}
+
+func TestImportGenesisMismatch(t *testing.T) {
+	state := &spec.VersionedBeaconState{Version: spec.DataVersionCapella, Capella: lazyTestCapellaState()}
+	sszBuf := &bytes.Buffer{}
+	metadataBuf := &bytes.Buffer{}
+	require.NoError(t, spec.ExportGenesis(state, sszBuf, metadataBuf))
+
+	metadata := strings.Replace(metadataBuf.String(), `"genesis_time":"1606824023"`, `"genesis_time":"1606824024"`, 1)
+	_, _, err := spec.ImportGenesis(sszBuf, strings.NewReader(metadata))
+	require.EqualError(t, err, "metadata genesis time 1606824024 does not match state genesis time 1606824023")
+}
@@ -0,0 +1,301 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Positions of fields in the fixed part of the SSZ-encoded beacon state that are
+// common to all forks.
+const (
+	lazyGenesisTimePos           = 0
+	lazyGenesisValidatorsRootPos = 8
+	lazySlotPos                  = 40
+	lazyForkPos                  = 48
+
+	lazyForkSize       = 16
+	lazyCheckpointSize = 40
+	lazyValidatorSize  = 121
+)
+
+// Variable-sized fields of the beacon state, in the order in which they are encoded.
+const (
+	lazyHistoricalRoots = iota
+	lazyETH1DataVotes
+	lazyValidators
+	lazyBalances
+	lazyPreviousEpoch
+	lazyCurrentEpoch
+	lazyInactivityScores
+	lazyLatestExecutionPayloadHeader
+	lazyHistoricalSummaries
+)
+
+// lazyLayout describes the SSZ layout of a beacon state for a given fork.
+type lazyLayout struct {
+	fixedSize              int
+	finalizedCheckpointPos int
+	// offsetPositions are the positions of the offsets of variable-sized fields in the fixed part.
+	offsetPositions []int
+}
+
+// lazyLayouts are the layouts of the beacon state for each fork, as encoded by the
+// respective BeaconState types.
+var lazyLayouts = map[DataVersion]*lazyLayout{
+	DataVersionPhase0: {
+		fixedSize:              2687369,
+		finalizedCheckpointPos: 2687329,
+		offsetPositions:        []int{524464, 524540, 524544, 524548, 2687240, 2687244},
+	},
+	DataVersionAltair: {
+		fixedSize:              2736629,
+		finalizedCheckpointPos: 2687337,
+		offsetPositions:        []int{524464, 524540, 524552, 524556, 2687248, 2687252, 2687377},
+	},
+	DataVersionBellatrix: {
+		fixedSize:              2736633,
+		finalizedCheckpointPos: 2687337,
+		offsetPositions:        []int{524464, 524540, 524552, 524556, 2687248, 2687252, 2687377, 2736629},
+	},
+	DataVersionCapella: {
+		fixedSize:              2736653,
+		finalizedCheckpointPos: 2687337,
+		offsetPositions:        []int{524464, 524540, 524552, 524556, 2687248, 2687252, 2687377, 2736629, 2736649},
+	},
+}
+
+// LazyBeaconState is an SSZ-encoded beacon state that decodes individual fields on demand.
+// Only the offsets of the fixed part are parsed on creation, so obtaining a small number of
+// fields from a large state avoids decoding the state in full.
+type LazyBeaconState struct {
+	version DataVersion
+	layout  *lazyLayout
+	data    []byte
+	offsets []int
+}
+
+// NewLazyBeaconState creates a lazy beacon state from its SSZ encoding.
+// The data is referenced rather than copied, so must not be altered after this call.
+func NewLazyBeaconState(version DataVersion, data []byte) (*LazyBeaconState, error) {
+	layout, exists := lazyLayouts[version]
+	if !exists {
+		return nil, errors.New("unknown version")
+	}
+	if len(data) < layout.fixedSize {
+		return nil, fmt.Errorf("state of %d bytes shorter than fixed size %d", len(data), layout.fixedSize)
+	}
+
+	offsets := make([]int, len(layout.offsetPositions))
+	for i, pos := range layout.offsetPositions {
+		offsets[i] = int(binary.LittleEndian.Uint32(data[pos : pos+4]))
+		switch {
+		case i == 0 && offsets[i] != layout.fixedSize:
+			return nil, fmt.Errorf("invalid first offset %d", offsets[i])
+		case i > 0 && offsets[i] < offsets[i-1]:
+			return nil, fmt.Errorf("offset %d out of order", i)
+		case offsets[i] > len(data):
+			return nil, fmt.Errorf("offset %d beyond end of state", i)
+		}
+	}
+
+	return &LazyBeaconState{
+		version: version,
+		layout:  layout,
+		data:    data,
+		offsets: offsets,
+	}, nil
+}
+
+// Version returns the version of the state.
+func (s *LazyBeaconState) Version() DataVersion {
+	return s.version
+}
+
+// GenesisTime returns the genesis time of the state.
+func (s *LazyBeaconState) GenesisTime() uint64 {
+	return binary.LittleEndian.Uint64(s.data[lazyGenesisTimePos : lazyGenesisTimePos+8])
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the state.
+func (s *LazyBeaconState) GenesisValidatorsRoot() phase0.Root {
+	var root phase0.Root
+	copy(root[:], s.data[lazyGenesisValidatorsRootPos:lazyGenesisValidatorsRootPos+32])
+	return root
+}
+
+// Slot returns the slot of the state.
+func (s *LazyBeaconState) Slot() phase0.Slot {
+	return phase0.Slot(binary.LittleEndian.Uint64(s.data[lazySlotPos : lazySlotPos+8]))
+}
+
+// Fork returns the fork of the state.
+func (s *LazyBeaconState) Fork() (*phase0.Fork, error) {
+	fork := &phase0.Fork{}
+	if err := fork.UnmarshalSSZ(s.data[lazyForkPos : lazyForkPos+lazyForkSize]); err != nil {
+		return nil, errors.Wrap(err, "failed to decode fork")
+	}
+	return fork, nil
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the state.
+func (s *LazyBeaconState) FinalizedCheckpoint() (*phase0.Checkpoint, error) {
+	checkpoint := &phase0.Checkpoint{}
+	if err := checkpoint.UnmarshalSSZ(s.data[s.layout.finalizedCheckpointPos : s.layout.finalizedCheckpointPos+lazyCheckpointSize]); err != nil {
+		return nil, errors.Wrap(err, "failed to decode finalized checkpoint")
+	}
+	return checkpoint, nil
+}
+
+// ValidatorCount returns the number of validators in the state.
+func (s *LazyBeaconState) ValidatorCount() (int, error) {
+	data, err := s.fixedItems(lazyValidators, lazyValidatorSize, "validators")
+	if err != nil {
+		return 0, err
+	}
+	return len(data) / lazyValidatorSize, nil
+}
+
+// Validator returns a single validator of the state.
+func (s *LazyBeaconState) Validator(index phase0.ValidatorIndex) (*phase0.Validator, error) {
+	data, err := s.fixedItems(lazyValidators, lazyValidatorSize, "validators")
+	if err != nil {
+		return nil, err
+	}
+	if uint64(index) >= uint64(len(data)/lazyValidatorSize) {
+		return nil, fmt.Errorf("validator %d not present in state", index)
+	}
+
+	validator := &phase0.Validator{}
+	start := int(index) * lazyValidatorSize
+	if err := validator.UnmarshalSSZ(data[start : start+lazyValidatorSize]); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to decode validator %d", index))
+	}
+	return validator, nil
+}
+
+// Validators returns the validators of the state.
+func (s *LazyBeaconState) Validators() ([]*phase0.Validator, error) {
+	data, err := s.fixedItems(lazyValidators, lazyValidatorSize, "validators")
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]*phase0.Validator, len(data)/lazyValidatorSize)
+	for i := range validators {
+		validators[i] = &phase0.Validator{}
+		if err := validators[i].UnmarshalSSZ(data[i*lazyValidatorSize : (i+1)*lazyValidatorSize]); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to decode validator %d", i))
+		}
+	}
+	return validators, nil
+}
+
+// Balance returns the balance of a single validator of the state.
+func (s *LazyBeaconState) Balance(index phase0.ValidatorIndex) (phase0.Gwei, error) {
+	data, err := s.fixedItems(lazyBalances, 8, "balances")
+	if err != nil {
+		return 0, err
+	}
+	if uint64(index) >= uint64(len(data)/8) {
+		return 0, fmt.Errorf("balance %d not present in state", index)
+	}
+	return phase0.Gwei(binary.LittleEndian.Uint64(data[int(index)*8 : int(index)*8+8])), nil
+}
+
+// Balances returns the validator balances of the state.
+func (s *LazyBeaconState) Balances() ([]phase0.Gwei, error) {
+	data, err := s.fixedItems(lazyBalances, 8, "balances")
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]phase0.Gwei, len(data)/8)
+	for i := range balances {
+		balances[i] = phase0.Gwei(binary.LittleEndian.Uint64(data[i*8 : i*8+8]))
+	}
+	return balances, nil
+}
+
+// InactivityScores returns the inactivity scores of the state.
+func (s *LazyBeaconState) InactivityScores() ([]uint64, error) {
+	if s.version == DataVersionPhase0 {
+		return nil, errors.New("state does not provide inactivity scores")
+	}
+	data, err := s.fixedItems(lazyInactivityScores, 8, "inactivity scores")
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]uint64, len(data)/8)
+	for i := range scores {
+		scores[i] = binary.LittleEndian.Uint64(data[i*8 : i*8+8])
+	}
+	return scores, nil
+}
+
+// State decodes the full state.
+func (s *LazyBeaconState) State() (*VersionedBeaconState, error) {
+	res := &VersionedBeaconState{
+		Version: s.version,
+	}
+
+	var err error
+	switch s.version {
+	case DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconState{}
+		err = res.Phase0.UnmarshalSSZ(s.data)
+	case DataVersionAltair:
+		res.Altair = &altair.BeaconState{}
+		err = res.Altair.UnmarshalSSZ(s.data)
+	case DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{}
+		err = res.Bellatrix.UnmarshalSSZ(s.data)
+	case DataVersionCapella:
+		res.Capella = &capella.BeaconState{}
+		err = res.Capella.UnmarshalSSZ(s.data)
+	default:
+		err = errors.New("unknown version")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode state")
+	}
+
+	return res, nil
+}
+
+// variableField returns the raw data of a variable-sized field.
+func (s *LazyBeaconState) variableField(field int) []byte {
+	end := len(s.data)
+	if field+1 < len(s.offsets) {
+		end = s.offsets[field+1]
+	}
+	return s.data[s.offsets[field]:end]
+}
+
+// fixedItems returns the raw data of a variable-sized list of fixed-size items.
+func (s *LazyBeaconState) fixedItems(field int, itemSize int, name string) ([]byte, error) {
+	data := s.variableField(field)
+	if len(data)%itemSize != 0 {
+		return nil, fmt.Errorf("invalid length %d for %s", len(data), name)
+	}
+	return data, nil
+}
@@ -0,0 +1,192 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func lazyTestValidators() ([]*phase0.Validator, []phase0.Gwei) {
+	validators := make([]*phase0.Validator, 5)
+	balances := make([]phase0.Gwei, 5)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ActivationEpoch:       phase0.Epoch(i),
+			ExitEpoch:             0xffffffffffffffff,
+			WithdrawableEpoch:     0xffffffffffffffff,
+		}
+		balances[i] = phase0.Gwei(32000000000 + i)
+	}
+	return validators, balances
+}
+
+func lazyTestSyncCommittee() *altair.SyncCommittee {
+	return &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+}
+
+func lazyTestCapellaState() *capella.BeaconState {
+	validators, balances := lazyTestValidators()
+	return &capella.BeaconState{
+		GenesisTime:                  1606824023,
+		GenesisValidatorsRoot:        phase0.Root{0x01},
+		Slot:                         12345,
+		Fork:                         &phase0.Fork{PreviousVersion: phase0.Version{0x02}, CurrentVersion: phase0.Version{0x03}, Epoch: 100},
+		LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+		BlockRoots:                   make([]phase0.Root, 8192),
+		StateRoots:                   make([]phase0.Root, 8192),
+		HistoricalRoots:              []phase0.Root{{0x04}},
+		ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		Validators:                   validators,
+		Balances:                     balances,
+		RANDAOMixes:                  make([]phase0.Root, 65536),
+		Slashings:                    make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:   make([]altair.ParticipationFlags, 5),
+		CurrentEpochParticipation:    make([]altair.ParticipationFlags, 5),
+		JustificationBits:            []byte{0x00},
+		PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+		FinalizedCheckpoint:          &phase0.Checkpoint{Epoch: 380, Root: phase0.Root{0x05}},
+		InactivityScores:             []uint64{0, 1, 2, 3, 4},
+		CurrentSyncCommittee:         lazyTestSyncCommittee(),
+		NextSyncCommittee:            lazyTestSyncCommittee(),
+		LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+		HistoricalSummaries:          []*capella.HistoricalSummary{{}},
+	}
+}
+
+func lazyTestPhase0State() *phase0.BeaconState {
+	validators, balances := lazyTestValidators()
+	return &phase0.BeaconState{
+		GenesisTime:                 1606824023,
+		Slot:                        12345,
+		Fork:                        &phase0.Fork{},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, 8192),
+		StateRoots:                  make([]phase0.Root, 8192),
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		Validators:                  validators,
+		Balances:                    balances,
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           []byte{0x00},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 380},
+	}
+}
+
+func TestLazyBeaconStateCapella(t *testing.T) {
+	state := lazyTestCapellaState()
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	lazy, err := spec.NewLazyBeaconState(spec.DataVersionCapella, data)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionCapella, lazy.Version())
+	require.Equal(t, state.GenesisTime, lazy.GenesisTime())
+	require.Equal(t, state.GenesisValidatorsRoot, lazy.GenesisValidatorsRoot())
+	require.Equal(t, state.Slot, lazy.Slot())
+
+	fork, err := lazy.Fork()
+	require.NoError(t, err)
+	require.Equal(t, state.Fork, fork)
+
+	finalized, err := lazy.FinalizedCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, state.FinalizedCheckpoint, finalized)
+
+	count, err := lazy.ValidatorCount()
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+
+	validators, err := lazy.Validators()
+	require.NoError(t, err)
+	require.Equal(t, state.Validators, validators)
+
+	validator, err := lazy.Validator(3)
+	require.NoError(t, err)
+	require.Equal(t, state.Validators[3], validator)
+	_, err = lazy.Validator(5)
+	require.EqualError(t, err, "validator 5 not present in state")
+
+	balances, err := lazy.Balances()
+	require.NoError(t, err)
+	require.Equal(t, state.Balances, balances)
+
+	balance, err := lazy.Balance(4)
+	require.NoError(t, err)
+	require.Equal(t, state.Balances[4], balance)
+	_, err = lazy.Balance(5)
+	require.EqualError(t, err, "balance 5 not present in state")
+
+	scores, err := lazy.InactivityScores()
+	require.NoError(t, err)
+	require.Equal(t, state.InactivityScores, scores)
+
+	full, err := lazy.State()
+	require.NoError(t, err)
+	require.Equal(t, state.Validators, full.Capella.Validators)
+	require.Equal(t, state.HistoricalSummaries, full.Capella.HistoricalSummaries)
+}
+
+func TestLazyBeaconStatePhase0(t *testing.T) {
+	state := lazyTestPhase0State()
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	lazy, err := spec.NewLazyBeaconState(spec.DataVersionPhase0, data)
+	require.NoError(t, err)
+	require.Equal(t, state.Slot, lazy.Slot())
+
+	validators, err := lazy.Validators()
+	require.NoError(t, err)
+	require.Equal(t, state.Validators, validators)
+
+	balances, err := lazy.Balances()
+	require.NoError(t, err)
+	require.Equal(t, state.Balances, balances)
+
+	_, err = lazy.InactivityScores()
+	require.EqualError(t, err, "state does not provide inactivity scores")
+}
+
+func TestLazyBeaconStateInvalid(t *testing.T) {
+	data, err := lazyTestCapellaState().MarshalSSZ()
+	require.NoError(t, err)
+
+	_, err = spec.NewLazyBeaconState(spec.DataVersion(99), data)
+	require.EqualError(t, err, "unknown version")
+
+	_, err = spec.NewLazyBeaconState(spec.DataVersionCapella, data[:1000])
+	require.EqualError(t, err, "state of 1000 bytes shorter than fixed size 2736653")
+
+	// Decoding as the wrong version results in an invalid first offset.
+	_, err = spec.NewLazyBeaconState(spec.DataVersionAltair, data)
+	require.EqualError(t, err, "invalid first offset 2736653")
+
+	// Truncating the state leaves offsets beyond its end.
+	_, err = spec.NewLazyBeaconState(spec.DataVersionCapella, data[:len(data)-70])
+	require.EqualError(t, err, "offset 8 beyond end of state")
+}
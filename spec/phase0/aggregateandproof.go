@@ -117,6 +117,9 @@ func (a *AggregateAndProof) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (a *AggregateAndProof) String() string {
+	if a == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(a)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
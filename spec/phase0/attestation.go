@@ -117,6 +117,9 @@ func (a *Attestation) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (a *Attestation) String() string {
+	if a == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(a)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
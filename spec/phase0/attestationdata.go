@@ -137,8 +137,11 @@ func (a *AttestationData) UnmarshalYAML(input []byte) error {
 	return a.unpack(&attestationDataJSON)
 }
 
-// String provids a string representation of the struct.
+// String returns a string version of the structure.
 func (a *AttestationData) String() string {
+	if a == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(a)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
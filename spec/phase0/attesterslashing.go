@@ -92,7 +92,11 @@ func (a *AttesterSlashing) UnmarshalYAML(input []byte) error {
 	return a.unpack(&attesterSlashingJSON)
 }
 
+// String returns a string version of the structure.
 func (a *AttesterSlashing) String() string {
+	if a == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(a)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
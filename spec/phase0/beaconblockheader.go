@@ -151,8 +151,11 @@ func (b *BeaconBlockHeader) UnmarshalYAML(input []byte) error {
 	return b.unpack(&beaconBlockHeaderJSON)
 }
 
-// String returns a string representation of the struct.
+// String returns a string version of the structure.
 func (b *BeaconBlockHeader) String() string {
+	if b == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(b)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
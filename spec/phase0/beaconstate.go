@@ -289,6 +289,9 @@ func (s *BeaconState) UnmarshalJSON(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *BeaconState) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -109,6 +109,9 @@ func (c *Checkpoint) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (c *Checkpoint) String() string {
+	if c == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(c)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -0,0 +1,379 @@
+// Code generated by copygen. DO NOT EDIT.
+package phase0
+
+// Copy returns a deep copy of the structure.
+func (a *AggregateAndProof) Copy() *AggregateAndProof {
+	if a == nil {
+		return nil
+	}
+	res := *a
+	res.Aggregate = a.Aggregate.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (a *Attestation) Copy() *Attestation {
+	if a == nil {
+		return nil
+	}
+	res := *a
+	if a.AggregationBits != nil {
+		res.AggregationBits = append(a.AggregationBits[:0:0], a.AggregationBits...)
+	}
+	res.Data = a.Data.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (a *AttestationData) Copy() *AttestationData {
+	if a == nil {
+		return nil
+	}
+	res := *a
+	res.Source = a.Source.Copy()
+	res.Target = a.Target.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (a *AttesterSlashing) Copy() *AttesterSlashing {
+	if a == nil {
+		return nil
+	}
+	res := *a
+	res.Attestation1 = a.Attestation1.Copy()
+	res.Attestation2 = a.Attestation2.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Body = b.Body.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = append(b.ProposerSlashings[:0:0], b.ProposerSlashings...)
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = append(b.AttesterSlashings[:0:0], b.AttesterSlashings...)
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = append(b.Attestations[:0:0], b.Attestations...)
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = append(b.Deposits[:0:0], b.Deposits...)
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = append(b.VoluntaryExits[:0:0], b.VoluntaryExits...)
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+	res := *b
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+	res := *b
+	res.Fork = b.Fork.Copy()
+	res.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.BlockRoots != nil {
+		res.BlockRoots = append(b.BlockRoots[:0:0], b.BlockRoots...)
+	}
+	if b.StateRoots != nil {
+		res.StateRoots = append(b.StateRoots[:0:0], b.StateRoots...)
+	}
+	if b.HistoricalRoots != nil {
+		res.HistoricalRoots = append(b.HistoricalRoots[:0:0], b.HistoricalRoots...)
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ETH1DataVotes != nil {
+		res.ETH1DataVotes = append(b.ETH1DataVotes[:0:0], b.ETH1DataVotes...)
+		for i := range b.ETH1DataVotes {
+			res.ETH1DataVotes[i] = b.ETH1DataVotes[i].Copy()
+		}
+	}
+	if b.Validators != nil {
+		res.Validators = append(b.Validators[:0:0], b.Validators...)
+		for i := range b.Validators {
+			res.Validators[i] = b.Validators[i].Copy()
+		}
+	}
+	if b.Balances != nil {
+		res.Balances = append(b.Balances[:0:0], b.Balances...)
+	}
+	if b.RANDAOMixes != nil {
+		res.RANDAOMixes = append(b.RANDAOMixes[:0:0], b.RANDAOMixes...)
+	}
+	if b.Slashings != nil {
+		res.Slashings = append(b.Slashings[:0:0], b.Slashings...)
+	}
+	if b.PreviousEpochAttestations != nil {
+		res.PreviousEpochAttestations = append(b.PreviousEpochAttestations[:0:0], b.PreviousEpochAttestations...)
+		for i := range b.PreviousEpochAttestations {
+			res.PreviousEpochAttestations[i] = b.PreviousEpochAttestations[i].Copy()
+		}
+	}
+	if b.CurrentEpochAttestations != nil {
+		res.CurrentEpochAttestations = append(b.CurrentEpochAttestations[:0:0], b.CurrentEpochAttestations...)
+		for i := range b.CurrentEpochAttestations {
+			res.CurrentEpochAttestations[i] = b.CurrentEpochAttestations[i].Copy()
+		}
+	}
+	if b.JustificationBits != nil {
+		res.JustificationBits = append(b.JustificationBits[:0:0], b.JustificationBits...)
+	}
+	res.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	res.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	res.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (c *Checkpoint) Copy() *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	res := *c
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (d *Deposit) Copy() *Deposit {
+	if d == nil {
+		return nil
+	}
+	res := *d
+	if d.Proof != nil {
+		res.Proof = append(d.Proof[:0:0], d.Proof...)
+		for i := range d.Proof {
+			if d.Proof[i] != nil {
+				res.Proof[i] = append(d.Proof[i][:0:0], d.Proof[i]...)
+			}
+		}
+	}
+	res.Data = d.Data.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (d *DepositData) Copy() *DepositData {
+	if d == nil {
+		return nil
+	}
+	res := *d
+	if d.WithdrawalCredentials != nil {
+		res.WithdrawalCredentials = append(d.WithdrawalCredentials[:0:0], d.WithdrawalCredentials...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (d *DepositMessage) Copy() *DepositMessage {
+	if d == nil {
+		return nil
+	}
+	res := *d
+	if d.WithdrawalCredentials != nil {
+		res.WithdrawalCredentials = append(d.WithdrawalCredentials[:0:0], d.WithdrawalCredentials...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (e *ETH1Data) Copy() *ETH1Data {
+	if e == nil {
+		return nil
+	}
+	res := *e
+	if e.BlockHash != nil {
+		res.BlockHash = append(e.BlockHash[:0:0], e.BlockHash...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (f *Fork) Copy() *Fork {
+	if f == nil {
+		return nil
+	}
+	res := *f
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (f *ForkData) Copy() *ForkData {
+	if f == nil {
+		return nil
+	}
+	res := *f
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (i *IndexedAttestation) Copy() *IndexedAttestation {
+	if i == nil {
+		return nil
+	}
+	res := *i
+	if i.AttestingIndices != nil {
+		res.AttestingIndices = append(i.AttestingIndices[:0:0], i.AttestingIndices...)
+	}
+	res.Data = i.Data.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *PendingAttestation) Copy() *PendingAttestation {
+	if p == nil {
+		return nil
+	}
+	res := *p
+	if p.AggregationBits != nil {
+		res.AggregationBits = append(p.AggregationBits[:0:0], p.AggregationBits...)
+	}
+	res.Data = p.Data.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (p *ProposerSlashing) Copy() *ProposerSlashing {
+	if p == nil {
+		return nil
+	}
+	res := *p
+	res.SignedHeader1 = p.SignedHeader1.Copy()
+	res.SignedHeader2 = p.SignedHeader2.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedAggregateAndProof) Copy() *SignedAggregateAndProof {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedBeaconBlockHeader) Copy() *SignedBeaconBlockHeader {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SignedVoluntaryExit) Copy() *SignedVoluntaryExit {
+	if s == nil {
+		return nil
+	}
+	res := *s
+	res.Message = s.Message.Copy()
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (s *SigningData) Copy() *SigningData {
+	if s == nil {
+		return nil
+	}
+	res := *s
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *Validator) Copy() *Validator {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	if v.WithdrawalCredentials != nil {
+		res.WithdrawalCredentials = append(v.WithdrawalCredentials[:0:0], v.WithdrawalCredentials...)
+	}
+
+	return &res
+}
+
+// Copy returns a deep copy of the structure.
+func (v *VoluntaryExit) Copy() *VoluntaryExit {
+	if v == nil {
+		return nil
+	}
+	res := *v
+
+	return &res
+}
@@ -0,0 +1,68 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockCopy(t *testing.T) {
+	var nilBlock *phase0.BeaconBlock
+	require.Nil(t, nilBlock.Copy())
+
+	block := &phase0.BeaconBlock{
+		Slot: 1,
+		Body: &phase0.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{BlockHash: []byte{0x01}},
+			Graffiti: [32]byte{0x02},
+			Attestations: []*phase0.Attestation{
+				{
+					AggregationBits: bitfield.NewBitlist(8),
+					Data: &phase0.AttestationData{
+						Source: &phase0.Checkpoint{Epoch: 1},
+						Target: &phase0.Checkpoint{Epoch: 2},
+					},
+				},
+			},
+			Deposits:       []*phase0.Deposit{},
+			VoluntaryExits: nil,
+		},
+	}
+
+	copied := block.Copy()
+	require.Equal(t, block, copied)
+
+	// Changes to the copy should not affect the original.
+	copied.Slot = 2
+	copied.Body.ETH1Data.BlockHash[0] = 0xff
+	copied.Body.Graffiti[0] = 0xff
+	copied.Body.Attestations[0].AggregationBits.SetBitAt(1, true)
+	copied.Body.Attestations[0].Data.Source.Epoch = 10
+	copied.Body.Attestations = append(copied.Body.Attestations, &phase0.Attestation{})
+
+	require.Equal(t, phase0.Slot(1), block.Slot)
+	require.Equal(t, []byte{0x01}, block.Body.ETH1Data.BlockHash)
+	require.Equal(t, byte(0x02), block.Body.Graffiti[0])
+	require.False(t, block.Body.Attestations[0].AggregationBits.BitAt(1))
+	require.Equal(t, phase0.Epoch(1), block.Body.Attestations[0].Data.Source.Epoch)
+	require.Len(t, block.Body.Attestations, 1)
+
+	// Empty and nil lists retain their state.
+	require.NotNil(t, copied.Body.Deposits)
+	require.Nil(t, copied.Body.VoluntaryExits)
+}
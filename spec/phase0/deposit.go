@@ -116,6 +116,9 @@ func (d *Deposit) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (d *Deposit) String() string {
+	if d == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(d)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
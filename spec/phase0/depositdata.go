@@ -138,6 +138,9 @@ func (d *DepositData) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (d *DepositData) String() string {
+	if d == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(d)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -122,6 +122,9 @@ func (d *DepositMessage) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (d *DepositMessage) String() string {
+	if d == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(d)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -121,6 +121,9 @@ func (e *ETH1Data) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (e *ETH1Data) String() string {
+	if e == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -127,6 +127,9 @@ func (f *Fork) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (f *Fork) String() string {
+	if f == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(f)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
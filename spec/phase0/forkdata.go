@@ -112,6 +112,9 @@ func (f *ForkData) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (f *ForkData) String() string {
+	if f == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(f)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
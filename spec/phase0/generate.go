@@ -16,3 +16,6 @@ package phase0
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
 //go:generate rm -f aggregateandproof_encoding.go attestationdata_encoding.go attestation_encoding.go attesterslashing_encoding.go beaconblockbody_encoding.go beaconblock_encoding.go beaconblockheader_encoding.go beaconstate_encoding.go checkpoint_encoding.go depositdata_encoding.go deposit_encoding.go depositmessage_encoding.go eth1data_encoding.go forkdata_encoding.go fork_encoding.go indexedattestation_encoding.go pendingattestation_encoding.go proposerslashing_encoding.go signedaggregateandproof_encoding.go signedbeaconblock_encoding.go signedbeaconblockheader_encoding.go signedvoluntaryexit_encoding.go signingdata_encoding.go validator_encoding.go voluntaryexit_encoding.go
 //go:generate sszgen --path . --objs AggregateAndProof,AttestationData,Attestation,AttesterSlashing,BeaconBlockBody,BeaconBlock,BeaconBlockHeader,BeaconState,Checkpoint,Deposit,DepositData,DepositMessage,ETH1Data,Fork,ForkData,IndexedAttestation,PendingAttestation,ProposerSlashing,SignedAggregateAndProof,SignedBeaconBlock,SignedBeaconBlockHeader,SignedVoluntaryExit,SigningData,Validator,VoluntaryExit
+
+// Deep copy methods are generated by copygen.
+//go:generate go run ../../internal/copygen .
@@ -128,6 +128,9 @@ func (i *IndexedAttestation) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (i *IndexedAttestation) String() string {
+	if i == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(i)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
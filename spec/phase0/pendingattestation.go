@@ -127,6 +127,9 @@ func (p *PendingAttestation) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (p *PendingAttestation) String() string {
+	if p == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(p)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -94,6 +94,9 @@ func (p *ProposerSlashing) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (p *ProposerSlashing) String() string {
+	if p == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(p)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
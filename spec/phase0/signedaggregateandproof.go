@@ -103,6 +103,9 @@ func (s *SignedAggregateAndProof) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SignedAggregateAndProof) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
@@ -100,6 +100,9 @@ func (s *SignedVoluntaryExit) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SignedVoluntaryExit) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
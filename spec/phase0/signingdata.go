@@ -110,6 +110,9 @@ func (s *SigningData) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (s *SigningData) String() string {
+	if s == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
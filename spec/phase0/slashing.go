@@ -0,0 +1,138 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"github.com/pkg/errors"
+)
+
+// IsDoubleVote returns true if the attestation data and the other attestation data
+// are different votes for the same target epoch.
+func (a *AttestationData) IsDoubleVote(other *AttestationData) bool {
+	if a == nil || other == nil || a.Target == nil || other.Target == nil {
+		return false
+	}
+
+	return a.Target.Epoch == other.Target.Epoch && !a.equal(other)
+}
+
+// IsSurroundVote returns true if either the attestation data surrounds the other
+// attestation data or vice versa.
+func (a *AttestationData) IsSurroundVote(other *AttestationData) bool {
+	if a == nil || other == nil ||
+		a.Source == nil || a.Target == nil ||
+		other.Source == nil || other.Target == nil {
+		return false
+	}
+
+	return surrounds(a, other) || surrounds(other, a)
+}
+
+// IsSlashable returns true if the attestation data and the other attestation data
+// together constitute a slashable offence.
+func (a *AttestationData) IsSlashable(other *AttestationData) bool {
+	return a.IsDoubleVote(other) || a.IsSurroundVote(other)
+}
+
+// IsSlashableAttestationData returns true if the two attestation data, in the order given,
+// constitute a slashable offence as required by an attester slashing: either a double
+// vote, or the vote of data1 surrounding the vote of data2.
+func IsSlashableAttestationData(data1 *AttestationData, data2 *AttestationData) bool {
+	return data1.IsDoubleVote(data2) || (data1.IsSurroundVote(data2) && surrounds(data1, data2))
+}
+
+// surrounds returns true if the vote of data1 surrounds the vote of data2.
+func surrounds(data1 *AttestationData, data2 *AttestationData) bool {
+	return data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch
+}
+
+// equal returns true if the attestation data is the same as the other attestation data.
+func (a *AttestationData) equal(other *AttestationData) bool {
+	return a.Slot == other.Slot &&
+		a.Index == other.Index &&
+		a.BeaconBlockRoot == other.BeaconBlockRoot &&
+		checkpointsEqual(a.Source, other.Source) &&
+		checkpointsEqual(a.Target, other.Target)
+}
+
+// checkpointsEqual returns true if the two checkpoints are the same.
+func checkpointsEqual(c1 *Checkpoint, c2 *Checkpoint) bool {
+	if c1 == nil || c2 == nil {
+		return c1 == c2
+	}
+
+	return c1.Epoch == c2.Epoch && c1.Root == c2.Root
+}
+
+// IsSlashableBlockProposal returns true if the two signed block headers are different
+// proposals by the same proposer for the same slot.
+func IsSlashableBlockProposal(header1 *SignedBeaconBlockHeader, header2 *SignedBeaconBlockHeader) bool {
+	if header1 == nil || header2 == nil || header1.Message == nil || header2.Message == nil {
+		return false
+	}
+
+	return header1.Message.Slot == header2.Message.Slot &&
+		header1.Message.ProposerIndex == header2.Message.ProposerIndex &&
+		*header1.Message != *header2.Message
+}
+
+// NewAttesterSlashing creates an attester slashing from a pair of offending attestations.
+// For a surround vote the attestations are ordered so that the surrounding attestation
+// comes first, as required for the slashing to be valid.
+func NewAttesterSlashing(attestation1 *IndexedAttestation, attestation2 *IndexedAttestation) (*AttesterSlashing, error) {
+	if attestation1 == nil || attestation2 == nil {
+		return nil, errors.New("attestation missing")
+	}
+	if !attestation1.Data.IsSlashable(attestation2.Data) {
+		return nil, errors.New("attestations are not slashable")
+	}
+	if !attestation1.Data.IsDoubleVote(attestation2.Data) && surrounds(attestation2.Data, attestation1.Data) {
+		attestation1, attestation2 = attestation2, attestation1
+	}
+
+	attesters := make(map[uint64]struct{}, len(attestation1.AttestingIndices))
+	for _, index := range attestation1.AttestingIndices {
+		attesters[index] = struct{}{}
+	}
+	found := false
+	for _, index := range attestation2.AttestingIndices {
+		if _, exists := attesters[index]; exists {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New("attestations have no common attesters")
+	}
+
+	return &AttesterSlashing{
+		Attestation1: attestation1,
+		Attestation2: attestation2,
+	}, nil
+}
+
+// NewProposerSlashing creates a proposer slashing from a pair of offending signed block headers.
+func NewProposerSlashing(header1 *SignedBeaconBlockHeader, header2 *SignedBeaconBlockHeader) (*ProposerSlashing, error) {
+	if header1 == nil || header2 == nil {
+		return nil, errors.New("header missing")
+	}
+	if !IsSlashableBlockProposal(header1, header2) {
+		return nil, errors.New("headers are not slashable")
+	}
+
+	return &ProposerSlashing{
+		SignedHeader1: header1,
+		SignedHeader2: header2,
+	}, nil
+}
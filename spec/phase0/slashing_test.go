@@ -0,0 +1,215 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testAttestationData(slot phase0.Slot, source phase0.Epoch, target phase0.Epoch) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            slot,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: source, Root: phase0.Root{0x02}},
+		Target:          &phase0.Checkpoint{Epoch: target, Root: phase0.Root{0x03}},
+	}
+}
+
+func TestAttestationDataSlashable(t *testing.T) {
+	tests := []struct {
+		name     string
+		data1    *phase0.AttestationData
+		data2    *phase0.AttestationData
+		double   bool
+		surround bool
+		// ordered is true if the data are slashable in the order given.
+		ordered bool
+	}{
+		{
+			name:  "Nil",
+			data1: testAttestationData(64, 1, 2),
+		},
+		{
+			name:  "Identical",
+			data1: testAttestationData(64, 1, 2),
+			data2: testAttestationData(64, 1, 2),
+		},
+		{
+			name:    "DoubleVote",
+			data1:   testAttestationData(64, 1, 2),
+			data2:   testAttestationData(65, 1, 2),
+			double:  true,
+			ordered: true,
+		},
+		{
+			name:  "Consecutive",
+			data1: testAttestationData(64, 1, 2),
+			data2: testAttestationData(96, 2, 3),
+		},
+		{
+			name:     "Surrounding",
+			data1:    testAttestationData(128, 1, 4),
+			data2:    testAttestationData(96, 2, 3),
+			surround: true,
+			ordered:  true,
+		},
+		{
+			name:     "Surrounded",
+			data1:    testAttestationData(96, 2, 3),
+			data2:    testAttestationData(128, 1, 4),
+			surround: true,
+		},
+		{
+			name:  "SameSource",
+			data1: testAttestationData(96, 1, 3),
+			data2: testAttestationData(128, 1, 4),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.double, test.data1.IsDoubleVote(test.data2))
+			require.Equal(t, test.surround, test.data1.IsSurroundVote(test.data2))
+			require.Equal(t, test.double || test.surround, test.data1.IsSlashable(test.data2))
+			require.Equal(t, test.ordered, phase0.IsSlashableAttestationData(test.data1, test.data2))
+		})
+	}
+}
+
+func TestNewAttesterSlashing(t *testing.T) {
+	tests := []struct {
+		name         string
+		attestation1 *phase0.IndexedAttestation
+		attestation2 *phase0.IndexedAttestation
+		swapped      bool
+		err          string
+	}{
+		{
+			name:         "Missing",
+			attestation1: &phase0.IndexedAttestation{Data: testAttestationData(64, 1, 2)},
+			err:          "attestation missing",
+		},
+		{
+			name:         "NotSlashable",
+			attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: testAttestationData(64, 1, 2)},
+			attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: testAttestationData(64, 1, 2)},
+			err:          "attestations are not slashable",
+		},
+		{
+			name:         "NoCommonAttesters",
+			attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 2}, Data: testAttestationData(64, 1, 2)},
+			attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{3}, Data: testAttestationData(65, 1, 2)},
+			err:          "attestations have no common attesters",
+		},
+		{
+			name:         "Good",
+			attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 2}, Data: testAttestationData(64, 1, 2)},
+			attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{2, 3}, Data: testAttestationData(65, 1, 2)},
+		},
+		{
+			name:         "Surrounding",
+			attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: testAttestationData(128, 1, 4)},
+			attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: testAttestationData(96, 2, 3)},
+		},
+		{
+			name:         "Surrounded",
+			attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: testAttestationData(96, 2, 3)},
+			attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: testAttestationData(128, 1, 4)},
+			swapped:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := phase0.NewAttesterSlashing(test.attestation1, test.attestation2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if test.swapped {
+				require.Equal(t, test.attestation2, res.Attestation1)
+				require.Equal(t, test.attestation1, res.Attestation2)
+			} else {
+				require.Equal(t, test.attestation1, res.Attestation1)
+				require.Equal(t, test.attestation2, res.Attestation2)
+			}
+		})
+	}
+}
+
+func TestNewProposerSlashing(t *testing.T) {
+	header := func(slot phase0.Slot, proposer phase0.ValidatorIndex, bodyRoot byte) *phase0.SignedBeaconBlockHeader {
+		return &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposer,
+				BodyRoot:      phase0.Root{bodyRoot},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		header1 *phase0.SignedBeaconBlockHeader
+		header2 *phase0.SignedBeaconBlockHeader
+		err     string
+	}{
+		{
+			name:    "Missing",
+			header1: header(1, 2, 0x01),
+			err:     "header missing",
+		},
+		{
+			name:    "Identical",
+			header1: header(1, 2, 0x01),
+			header2: header(1, 2, 0x01),
+			err:     "headers are not slashable",
+		},
+		{
+			name:    "DifferentSlots",
+			header1: header(1, 2, 0x01),
+			header2: header(2, 2, 0x02),
+			err:     "headers are not slashable",
+		},
+		{
+			name:    "DifferentProposers",
+			header1: header(1, 2, 0x01),
+			header2: header(1, 3, 0x02),
+			err:     "headers are not slashable",
+		},
+		{
+			name:    "Good",
+			header1: header(1, 2, 0x01),
+			header2: header(1, 2, 0x02),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.err == "", phase0.IsSlashableBlockProposal(test.header1, test.header2))
+			res, err := phase0.NewProposerSlashing(test.header1, test.header2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.header1, res.SignedHeader1)
+			require.Equal(t, test.header2, res.SignedHeader2)
+		})
+	}
+}
@@ -13,7 +13,13 @@
 
 package phase0
 
-import "fmt"
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 // Slot is a slot number.
 type Slot uint64
@@ -54,18 +60,152 @@ func (r Root) Format(state fmt.State, v rune) {
 	}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (r Root) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *Root) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "root", r[:])
+}
+
 // Version is a fork version.
 type Version [4]byte
 
+// String returns a string version of the structure.
+func (ver Version) String() string {
+	return fmt.Sprintf("%#x", ver)
+}
+
+// Format formats the version.
+func (ver Version) Format(state fmt.State, v rune) {
+	format := string(v)
+	switch v {
+	case 's':
+		fmt.Fprint(state, ver.String())
+	case 'x', 'X':
+		if state.Flag('#') {
+			format = "#" + format
+		}
+		fmt.Fprintf(state, "%"+format, ver[:])
+	default:
+		fmt.Fprintf(state, "%"+format, ver[:])
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (ver Version) MarshalText() ([]byte, error) {
+	return []byte(ver.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (ver *Version) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "version", ver[:])
+}
+
 // DomainType is a domain type.
 type DomainType [4]byte
 
+// String returns a string version of the structure.
+func (d DomainType) String() string {
+	return fmt.Sprintf("%#x", d)
+}
+
+// Format formats the domain type.
+func (d DomainType) Format(state fmt.State, v rune) {
+	format := string(v)
+	switch v {
+	case 's':
+		fmt.Fprint(state, d.String())
+	case 'x', 'X':
+		if state.Flag('#') {
+			format = "#" + format
+		}
+		fmt.Fprintf(state, "%"+format, d[:])
+	default:
+		fmt.Fprintf(state, "%"+format, d[:])
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DomainType) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DomainType) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "domain type", d[:])
+}
+
 // ForkDigest is a digest of fork data.
 type ForkDigest [4]byte
 
+// String returns a string version of the structure.
+func (f ForkDigest) String() string {
+	return fmt.Sprintf("%#x", f)
+}
+
+// Format formats the fork digest.
+func (f ForkDigest) Format(state fmt.State, v rune) {
+	format := string(v)
+	switch v {
+	case 's':
+		fmt.Fprint(state, f.String())
+	case 'x', 'X':
+		if state.Flag('#') {
+			format = "#" + format
+		}
+		fmt.Fprintf(state, "%"+format, f[:])
+	default:
+		fmt.Fprintf(state, "%"+format, f[:])
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f ForkDigest) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *ForkDigest) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "fork digest", f[:])
+}
+
 // Domain is a signature domain.
 type Domain [32]byte
 
+// String returns a string version of the structure.
+func (d Domain) String() string {
+	return fmt.Sprintf("%#x", d)
+}
+
+// Format formats the domain.
+func (d Domain) Format(state fmt.State, v rune) {
+	format := string(v)
+	switch v {
+	case 's':
+		fmt.Fprint(state, d.String())
+	case 'x', 'X':
+		if state.Flag('#') {
+			format = "#" + format
+		}
+		fmt.Fprintf(state, "%"+format, d[:])
+	default:
+		fmt.Fprintf(state, "%"+format, d[:])
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Domain) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Domain) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "domain", d[:])
+}
+
 // BLSPubKey is a BLS12-381 public key.
 type BLSPubKey [48]byte
 
@@ -90,6 +230,16 @@ func (pk BLSPubKey) Format(state fmt.State, v rune) {
 	}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (pk BLSPubKey) MarshalText() ([]byte, error) {
+	return []byte(pk.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (pk *BLSPubKey) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "public key", pk[:])
+}
+
 // BLSSignature is a BLS12-381 signature.
 type BLSSignature [96]byte
 
@@ -114,6 +264,16 @@ func (s BLSSignature) Format(state fmt.State, v rune) {
 	}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (s BLSSignature) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BLSSignature) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "signature", s[:])
+}
+
 // Hash32 is a 32-byte hash.
 type Hash32 [32]byte
 
@@ -137,3 +297,28 @@ func (h Hash32) Format(state fmt.State, v rune) {
 		fmt.Fprintf(state, "%"+format, h[:])
 	}
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (h Hash32) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *Hash32) UnmarshalText(input []byte) error {
+	return unmarshalFixedHex(input, "hash", h[:])
+}
+
+// unmarshalFixedHex decodes a hex string, with or without a 0x prefix, in to
+// a fixed-length byte slice.
+func unmarshalFixedHex(input []byte, name string, dst []byte) error {
+	data, err := hex.DecodeString(strings.TrimPrefix(string(input), "0x"))
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+	if len(data) != len(dst) {
+		return fmt.Errorf("incorrect length %d for %s", len(data), name)
+	}
+	copy(dst, data)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypesMarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{ MarshalText() ([]byte, error) }
+		expected string
+	}{
+		{
+			name:     "Root",
+			input:    phase0.Root{0x01, 0x02},
+			expected: "0x0102000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:     "Version",
+			input:    phase0.Version{0x01, 0x02, 0x03, 0x04},
+			expected: "0x01020304",
+		},
+		{
+			name:     "DomainType",
+			input:    phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+			expected: "0x07000000",
+		},
+		{
+			name:     "ForkDigest",
+			input:    phase0.ForkDigest{0xaa, 0xbb, 0xcc, 0xdd},
+			expected: "0xaabbccdd",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.input.MarshalText()
+			require.NoError(t, err)
+			require.Equal(t, test.expected, string(res))
+		})
+	}
+}
+
+func TestTypesStringNil(t *testing.T) {
+	var checkpoint *phase0.Checkpoint
+	require.Equal(t, "", checkpoint.String())
+	var attestation *phase0.Attestation
+	require.Equal(t, "", attestation.String())
+	var block *phase0.SignedBeaconBlock
+	require.Equal(t, "", block.String())
+}
+
+func TestTypesTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  interface{ MarshalText() ([]byte, error) }
+		output interface{ UnmarshalText([]byte) error }
+	}{
+		{
+			name:   "Root",
+			input:  phase0.Root{0x01, 0x02},
+			output: &phase0.Root{},
+		},
+		{
+			name:   "Version",
+			input:  phase0.Version{0x01, 0x02, 0x03, 0x04},
+			output: &phase0.Version{},
+		},
+		{
+			name:   "DomainType",
+			input:  phase0.DomainType{0x07},
+			output: &phase0.DomainType{},
+		},
+		{
+			name:   "ForkDigest",
+			input:  phase0.ForkDigest{0xaa, 0xbb, 0xcc, 0xdd},
+			output: &phase0.ForkDigest{},
+		},
+		{
+			name:   "Domain",
+			input:  phase0.Domain{0x01, 0x02},
+			output: &phase0.Domain{},
+		},
+		{
+			name:   "BLSPubKey",
+			input:  phase0.BLSPubKey{0x01, 0x02},
+			output: &phase0.BLSPubKey{},
+		},
+		{
+			name:   "BLSSignature",
+			input:  phase0.BLSSignature{0x01, 0x02},
+			output: &phase0.BLSSignature{},
+		},
+		{
+			name:   "Hash32",
+			input:  phase0.Hash32{0x01, 0x02},
+			output: &phase0.Hash32{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, err := test.input.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, test.output.UnmarshalText(text))
+			res, err := test.output.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+			require.NoError(t, err)
+			require.Equal(t, text, res)
+		})
+	}
+}
+
+func TestTypesUnmarshalTextInvalid(t *testing.T) {
+	var root phase0.Root
+	require.EqualError(t, root.UnmarshalText([]byte("0x0102")), "incorrect length 2 for root")
+	require.EqualError(t, root.UnmarshalText([]byte("0xzz")), "invalid value for root: encoding/hex: invalid byte: U+007A 'z'")
+}
+
+func TestTypesJSONMapKeys(t *testing.T) {
+	input := map[phase0.Root]phase0.BLSPubKey{
+		{0x01}: {0x02},
+		{0x03}: {0x04},
+	}
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	var output map[phase0.Root]phase0.BLSPubKey
+	require.NoError(t, json.Unmarshal(data, &output))
+	require.Equal(t, input, output)
+}
@@ -104,6 +104,9 @@ func (v *VoluntaryExit) UnmarshalYAML(input []byte) error {
 
 // String returns a string version of the structure.
 func (v *VoluntaryExit) String() string {
+	if v == nil {
+		return ""
+	}
 	data, err := yaml.Marshal(v)
 	if err != nil {
 		return fmt.Sprintf("ERR: %v", err)
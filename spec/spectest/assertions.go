@@ -0,0 +1,65 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// RequireRootsEqual requires that the hash tree root of the container is the expected root.
+func RequireRootsEqual(t *testing.T, expected [32]byte, container ssz.HashRoot) {
+	t.Helper()
+
+	require.NotNil(t, container, "no container supplied")
+	root, err := container.HashTreeRoot()
+	require.NoError(t, err, "failed to calculate hash tree root")
+	require.Equal(t, fmt.Sprintf("%#x", expected), fmt.Sprintf("%#x", root), "hash tree root mismatch")
+}
+
+// RequireSSZRoundTrip requires that the container survives a round trip through its SSZ
+// encoding: the encoding matches the reported size and is the same when appended to a
+// buffer, decoding it into a new container of the same type succeeds, and the new
+// container has the same encoding and hash tree root as the original.
+func RequireSSZRoundTrip(t *testing.T, container Object) {
+	t.Helper()
+
+	require.NotNil(t, container, "no container supplied")
+	containerType := reflect.TypeOf(container)
+	require.Equal(t, reflect.Ptr, containerType.Kind(), "container must be a pointer")
+
+	data, err := container.MarshalSSZ()
+	require.NoError(t, err, "failed to marshal")
+	if sizer, isSizer := container.(interface{ SizeSSZ() int }); isSizer {
+		require.Equal(t, sizer.SizeSSZ(), len(data), "encoding does not match reported size")
+	}
+	prefix := []byte{0x01, 0x02}
+	appended, err := container.MarshalSSZTo(append([]byte{}, prefix...))
+	require.NoError(t, err, "failed to marshal to buffer")
+	require.Equal(t, append(append([]byte{}, prefix...), data...), appended, "encoding to buffer differs")
+
+	decoded := reflect.New(containerType.Elem()).Interface().(Object)
+	require.NoError(t, decoded.UnmarshalSSZ(data), "failed to unmarshal")
+	redata, err := decoded.MarshalSSZ()
+	require.NoError(t, err, "failed to marshal decoded container")
+	require.Equal(t, data, redata, "encoding differs after round trip")
+
+	root, err := container.HashTreeRoot()
+	require.NoError(t, err, "failed to calculate hash tree root")
+	RequireRootsEqual(t, root, decoded)
+}
@@ -0,0 +1,64 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectest_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/spectest"
+)
+
+func TestRequireRootsEqual(t *testing.T) {
+	checkpoint := &phase0.Checkpoint{
+		Epoch: 1,
+		Root:  phase0.Root{0x01},
+	}
+	// Root is hash(epoch || root).
+	spectest.RequireRootsEqual(t, phase0.Root{
+		0x56, 0xd8, 0xa6, 0x6f, 0xba, 0xe0, 0x30, 0x0e, 0xfb, 0xa7, 0xec, 0x2c, 0x53, 0x19, 0x73, 0xaa,
+		0xae, 0x22, 0xe7, 0xa2, 0xed, 0x6d, 0xed, 0x08, 0x1b, 0x5b, 0x32, 0xd0, 0x7a, 0x32, 0x78, 0x0a,
+	}, checkpoint)
+}
+
+func TestRequireSSZRoundTrip(t *testing.T) {
+	spectest.RequireSSZRoundTrip(t, &phase0.Checkpoint{
+		Epoch: 1,
+		Root:  phase0.Root{0x01},
+	})
+	spectest.RequireSSZRoundTrip(t, &capella.Withdrawal{
+		Index:          1,
+		ValidatorIndex: 2,
+		Address:        [20]byte{0x03},
+		Amount:         4,
+	})
+	// Variable-length containers.
+	spectest.RequireSSZRoundTrip(t, &phase0.AttesterSlashing{
+		Attestation1: &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2, 3},
+			Data: &phase0.AttestationData{
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+		Attestation2: &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{4},
+			Data: &phase0.AttestationData{
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectest_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/spectest"
+	"github.com/stretchr/testify/require"
+)
+
+// specDir is the directory holding the spec packages, relative to this package.
+const specDir = ".."
+
+// registered returns the ssz_static types that are run against the spec tests by the
+// spec packages, by fork.  These are found from the calls to Run and RunPreset in the
+// tests of the spec packages.
+func registered(t *testing.T) map[string]map[string]bool {
+	t.Helper()
+
+	res := make(map[string]map[string]bool)
+	fset := token.NewFileSet()
+	err := filepath.Walk(specDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, isCall := node.(*ast.CallExpr)
+			if !isCall {
+				return true
+			}
+			selector, isSelector := call.Fun.(*ast.SelectorExpr)
+			if !isSelector {
+				return true
+			}
+			pkg, isIdent := selector.X.(*ast.Ident)
+			if !isIdent || pkg.Name != "spectest" {
+				return true
+			}
+			var args []ast.Expr
+			switch {
+			case selector.Sel.Name == "Run" && len(call.Args) == 4:
+				args = call.Args[1:]
+			case selector.Sel.Name == "RunPreset" && len(call.Args) == 5:
+				args = call.Args[2:]
+			default:
+				return true
+			}
+			fork, forkOK := stringLiteral(args[0])
+			name, nameOK := stringLiteral(args[1])
+			if !forkOK || !nameOK {
+				t.Errorf("%s: fork and name must be string literals", fset.Position(call.Pos()))
+				return true
+			}
+			if _, exists := res[fork]; !exists {
+				res[fork] = make(map[string]bool)
+			}
+			res[fork][name] = true
+
+			return true
+		})
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+// stringLiteral returns the value of the expression if it is a string literal.
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, isLit := expr.(*ast.BasicLit)
+	if !isLit || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+
+	return value, true
+}
+
+// TestCoverage fails for each ssz_static type in the spec tests that is not run by the
+// spec packages.
+func TestCoverage(t *testing.T) {
+	forks := spectest.Forks(t, spectest.PresetMainnet)
+	tested := registered(t)
+	for _, fork := range forks {
+		for _, name := range spectest.Types(t, spectest.PresetMainnet, fork) {
+			if !tested[fork][name] {
+				t.Errorf("%s/%s is not tested", fork, name)
+			}
+		}
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	tested := registered(t)
+	require.True(t, tested["phase0"]["Attestation"])
+	require.True(t, tested["capella"]["BeaconState"])
+	require.False(t, tested["phase0"]["BeaconState"])
+}
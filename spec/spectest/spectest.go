@@ -0,0 +1,163 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spectest runs containers against the consensus spec tests.
+//
+// The location of the consensus spec tests is supplied in the environment
+// variable ETH2_SPEC_TESTS_DIR; if it is not present tests are skipped.
+package spectest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/goccy/go-yaml"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// PresetMainnet is the mainnet preset.
+	PresetMainnet = "mainnet"
+	// PresetMinimal is the minimal preset.
+	PresetMinimal = "minimal"
+)
+
+// Object is the interface for containers that can be run against the ssz_static spec tests.
+type Object interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// Dir returns the base directory of the spec tests, skipping the test if it is not supplied.
+func Dir(t *testing.T) string {
+	t.Helper()
+
+	dir := os.Getenv("ETH2_SPEC_TESTS_DIR")
+	if dir == "" {
+		t.Skip("ETH2_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+	return filepath.Join(dir, "tests")
+}
+
+// Presets returns the presets available in the spec tests.
+func Presets(t *testing.T) []string {
+	t.Helper()
+
+	return subdirs(t, Dir(t))
+}
+
+// Forks returns the forks available in the spec tests for the given preset.
+func Forks(t *testing.T, preset string) []string {
+	t.Helper()
+
+	return subdirs(t, filepath.Join(Dir(t), preset))
+}
+
+// Types returns the names of the ssz_static types available in the spec tests for the given preset and fork.
+func Types(t *testing.T, preset string, fork string) []string {
+	t.Helper()
+
+	return subdirs(t, filepath.Join(Dir(t), preset, fork, "ssz_static"))
+}
+
+// Run runs the ssz_static spec tests for the named type of the given fork against all available presets.
+// obj is used only to provide the type of the container; a new instance is created for each test case.
+func Run(t *testing.T, fork string, name string, obj Object) {
+	t.Helper()
+
+	for _, preset := range Presets(t) {
+		t.Run(preset, func(t *testing.T) {
+			RunPreset(t, preset, fork, name, obj)
+		})
+	}
+}
+
+// RunPreset runs the ssz_static spec tests for the named type of the given fork against a single preset.
+// This is used for containers whose sizes depend on preset values, and so only match a single preset.
+func RunPreset(t *testing.T, preset string, fork string, name string, obj Object) {
+	t.Helper()
+
+	baseDir := filepath.Join(Dir(t), preset, fork, "ssz_static", name)
+	if _, err := os.Stat(baseDir); os.IsNotExist(err) {
+		t.Skipf("no spec tests for %s/%s/%s", preset, fork, name)
+	}
+
+	objType := reflect.TypeOf(obj).Elem()
+	for _, suite := range subdirs(t, baseDir) {
+		suiteDir := filepath.Join(baseDir, suite)
+		for _, testCase := range subdirs(t, suiteDir) {
+			dir := filepath.Join(suiteDir, testCase)
+			t.Run(fmt.Sprintf("%s/%s", suite, testCase), func(t *testing.T) {
+				runCase(t, dir, objType)
+			})
+		}
+	}
+}
+
+// runCase runs a single ssz_static test case.
+func runCase(t *testing.T, dir string, objType reflect.Type) {
+	specYAML, err := os.ReadFile(filepath.Join(dir, "value.yaml"))
+	require.NoError(t, err)
+	res := reflect.New(objType).Interface().(Object)
+	require.NoError(t, yaml.Unmarshal(specYAML, res))
+
+	compressedSpecSSZ, err := os.ReadFile(filepath.Join(dir, "serialized.ssz_snappy"))
+	require.NoError(t, err)
+	var specSSZ []byte
+	specSSZ, err = snappy.Decode(specSSZ, compressedSpecSSZ)
+	require.NoError(t, err)
+
+	ssz, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, specSSZ, ssz)
+
+	unmarshalled := reflect.New(objType).Interface().(Object)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(specSSZ))
+	require.Equal(t, res, unmarshalled)
+	remarshalled, err := unmarshalled.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, specSSZ, remarshalled)
+
+	root, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	rootsYAML, err := os.ReadFile(filepath.Join(dir, "roots.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, string(rootsYAML), fmt.Sprintf("{root: '%#x'}\n", root))
+}
+
+// subdirs returns the sorted names of the subdirectories of the given directory.
+func subdirs(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+
+	res := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			res = append(res, entry.Name())
+		}
+	}
+	sort.Strings(res)
+	return res
+}
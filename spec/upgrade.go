@@ -0,0 +1,193 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// ErrUpgradeNotPossible is returned when a container cannot be upgraded to a
+// later fork without information that it does not contain.
+var ErrUpgradeNotPossible = errors.New("upgrade not possible")
+
+// EmptyWithdrawalsRoot returns the root of an empty list of withdrawals, as
+// used in the header of a capella execution payload without withdrawals.
+func EmptyWithdrawalsRoot() phase0.Root {
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	hh.MerkleizeWithMixin(indx, 0, 16)
+	// Hashing an empty list cannot fail.
+	root, _ := hh.HashRoot()
+
+	return root
+}
+
+// UpgradeExecutionPayloadToCapella upgrades a bellatrix execution payload to
+// a capella execution payload without withdrawals.
+// The upgraded payload shares its data with the original.
+func UpgradeExecutionPayloadToCapella(payload *bellatrix.ExecutionPayload) (*capella.ExecutionPayload, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("no execution payload: %w", ErrDataMissing)
+	}
+
+	return &capella.ExecutionPayload{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: payload.BaseFeePerGas,
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		Withdrawals:   make([]*capella.Withdrawal, 0),
+	}, nil
+}
+
+// UpgradeExecutionPayloadHeaderToCapella upgrades a bellatrix execution payload
+// header to a capella execution payload header.
+// A header does not contain the withdrawals of its payload, so the withdrawals
+// root must be supplied; use EmptyWithdrawalsRoot() for a payload without
+// withdrawals.
+func UpgradeExecutionPayloadHeaderToCapella(header *bellatrix.ExecutionPayloadHeader,
+	withdrawalsRoot phase0.Root,
+) (
+	*capella.ExecutionPayloadHeader,
+	error,
+) {
+	if header == nil {
+		return nil, fmt.Errorf("no execution payload header: %w", ErrDataMissing)
+	}
+
+	return &capella.ExecutionPayloadHeader{
+		ParentHash:       header.ParentHash,
+		FeeRecipient:     header.FeeRecipient,
+		StateRoot:        header.StateRoot,
+		ReceiptsRoot:     header.ReceiptsRoot,
+		LogsBloom:        header.LogsBloom,
+		PrevRandao:       header.PrevRandao,
+		BlockNumber:      header.BlockNumber,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Timestamp:        header.Timestamp,
+		ExtraData:        header.ExtraData,
+		BaseFeePerGas:    header.BaseFeePerGas,
+		BlockHash:        header.BlockHash,
+		TransactionsRoot: header.TransactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+	}, nil
+}
+
+// Upgrade upgrades the beacon block body to the given version, one fork at a time.
+// Bodies prior to the merge are upgraded to bellatrix with an empty execution
+// payload, and bellatrix bodies are upgraded to capella without withdrawals or
+// BLS to execution changes.  Phase 0 bodies cannot be upgraded, as they do not
+// contain a sync aggregate, and bodies cannot be downgraded.
+// The upgraded body shares its data with the original.
+func (v *VersionedBeaconBlockBody) Upgrade(version DataVersion) (*VersionedBeaconBlockBody, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	if version < v.Version {
+		return nil, fmt.Errorf("cannot downgrade from %s to %s: %w", v.Version, version, ErrUpgradeNotPossible)
+	}
+	if version > DataVersionCapella {
+		return nil, errors.New("unknown version")
+	}
+
+	res := v
+	for res.Version < version {
+		var err error
+		switch res.Version {
+		case DataVersionPhase0:
+			return nil, fmt.Errorf("phase0 body has no sync aggregate: %w", ErrUpgradeNotPossible)
+		case DataVersionAltair:
+			res, err = upgradeBodyToBellatrix(res)
+		case DataVersionBellatrix:
+			res, err = upgradeBodyToCapella(res)
+		default:
+			return nil, errors.New("unknown version")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func upgradeBodyToBellatrix(v *VersionedBeaconBlockBody) (*VersionedBeaconBlockBody, error) {
+	body := v.Altair
+	if body == nil {
+		return nil, fmt.Errorf("no altair body: %w", ErrDataMissing)
+	}
+
+	return &VersionedBeaconBlockBody{
+		Version: DataVersionBellatrix,
+		Bellatrix: &bellatrix.BeaconBlockBody{
+			RANDAOReveal:      body.RANDAOReveal,
+			ETH1Data:          body.ETH1Data,
+			Graffiti:          body.Graffiti,
+			ProposerSlashings: body.ProposerSlashings,
+			AttesterSlashings: body.AttesterSlashings,
+			Attestations:      body.Attestations,
+			Deposits:          body.Deposits,
+			VoluntaryExits:    body.VoluntaryExits,
+			SyncAggregate:     body.SyncAggregate,
+			ExecutionPayload: &bellatrix.ExecutionPayload{
+				ExtraData:    make([]byte, 0),
+				Transactions: make([]bellatrix.Transaction, 0),
+			},
+		},
+	}, nil
+}
+
+func upgradeBodyToCapella(v *VersionedBeaconBlockBody) (*VersionedBeaconBlockBody, error) {
+	body := v.Bellatrix
+	if body == nil {
+		return nil, fmt.Errorf("no bellatrix body: %w", ErrDataMissing)
+	}
+	executionPayload, err := UpgradeExecutionPayloadToCapella(body.ExecutionPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VersionedBeaconBlockBody{
+		Version: DataVersionCapella,
+		Capella: &capella.BeaconBlockBody{
+			RANDAOReveal:          body.RANDAOReveal,
+			ETH1Data:              body.ETH1Data,
+			Graffiti:              body.Graffiti,
+			ProposerSlashings:     body.ProposerSlashings,
+			AttesterSlashings:     body.AttesterSlashings,
+			Attestations:          body.Attestations,
+			Deposits:              body.Deposits,
+			VoluntaryExits:        body.VoluntaryExits,
+			SyncAggregate:         body.SyncAggregate,
+			ExecutionPayload:      executionPayload,
+			BLSToExecutionChanges: make([]*capella.SignedBLSToExecutionChange, 0),
+		},
+	}, nil
+}
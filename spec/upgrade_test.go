@@ -0,0 +1,151 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmptyWithdrawalsRoot(t *testing.T) {
+	root := spec.EmptyWithdrawalsRoot()
+	require.Equal(t, "0x792930bbd5baac43bcc798ee49aa8185ef76bb3b44ba62b91d86ae569e4bb535", root.String())
+}
+
+func TestUpgradeExecutionPayloadHeaderToCapella(t *testing.T) {
+	_, err := spec.UpgradeExecutionPayloadHeaderToCapella(nil, phase0.Root{})
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+
+	header := &bellatrix.ExecutionPayloadHeader{
+		BlockNumber:      12,
+		ExtraData:        []byte{0x01},
+		TransactionsRoot: phase0.Root{0x02},
+	}
+	upgraded, err := spec.UpgradeExecutionPayloadHeaderToCapella(header, spec.EmptyWithdrawalsRoot())
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), upgraded.BlockNumber)
+	require.Equal(t, []byte{0x01}, upgraded.ExtraData)
+	require.Equal(t, phase0.Root{0x02}, upgraded.TransactionsRoot)
+	require.Equal(t, spec.EmptyWithdrawalsRoot(), upgraded.WithdrawalsRoot)
+}
+
+func TestUpgradeExecutionPayloadToCapella(t *testing.T) {
+	_, err := spec.UpgradeExecutionPayloadToCapella(nil)
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+
+	payload := &bellatrix.ExecutionPayload{
+		BlockNumber:  12,
+		ExtraData:    []byte{},
+		Transactions: []bellatrix.Transaction{{0x01}},
+	}
+	upgraded, err := spec.UpgradeExecutionPayloadToCapella(payload)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), upgraded.BlockNumber)
+	require.Equal(t, payload.Transactions, upgraded.Transactions)
+	require.NotNil(t, upgraded.Withdrawals)
+	require.Empty(t, upgraded.Withdrawals)
+	_, err = upgraded.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestVersionedBeaconBlockBodyUpgrade(t *testing.T) {
+	altairBody := &spec.VersionedBeaconBlockBody{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconBlockBody{
+			ETH1Data:      &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			Graffiti:      [32]byte{0x01},
+			SyncAggregate: &altair.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512()},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		body    *spec.VersionedBeaconBlockBody
+		version spec.DataVersion
+		err     string
+	}{
+		{
+			name:    "Nil",
+			version: spec.DataVersionCapella,
+			err:     "data missing",
+		},
+		{
+			name:    "Same",
+			body:    altairBody,
+			version: spec.DataVersionAltair,
+		},
+		{
+			name:    "AltairToBellatrix",
+			body:    altairBody,
+			version: spec.DataVersionBellatrix,
+		},
+		{
+			name:    "AltairToCapella",
+			body:    altairBody,
+			version: spec.DataVersionCapella,
+		},
+		{
+			name:    "Downgrade",
+			body:    altairBody,
+			version: spec.DataVersionPhase0,
+			err:     "cannot downgrade from altair to phase0: upgrade not possible",
+		},
+		{
+			name: "Phase0",
+			body: &spec.VersionedBeaconBlockBody{
+				Version: spec.DataVersionPhase0,
+				Phase0:  &phase0.BeaconBlockBody{},
+			},
+			version: spec.DataVersionAltair,
+			err:     "phase0 body has no sync aggregate: upgrade not possible",
+		},
+		{
+			name: "AltairMissing",
+			body: &spec.VersionedBeaconBlockBody{
+				Version: spec.DataVersionAltair,
+			},
+			version: spec.DataVersionBellatrix,
+			err:     "no altair body: data missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.body.Upgrade(test.version)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.version, res.Version)
+			switch test.version {
+			case spec.DataVersionBellatrix:
+				require.Equal(t, test.body.Altair.Graffiti, res.Bellatrix.Graffiti)
+				_, err = res.Bellatrix.HashTreeRoot()
+				require.NoError(t, err)
+			case spec.DataVersionCapella:
+				require.Equal(t, test.body.Altair.Graffiti, res.Capella.Graffiti)
+				_, err = res.Capella.HashTreeRoot()
+				require.NoError(t, err)
+			}
+		})
+	}
+}
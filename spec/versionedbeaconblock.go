@@ -15,6 +15,7 @@ package spec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -33,30 +34,33 @@ type VersionedBeaconBlock struct {
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconBlock) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil
+	return v == nil || (v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil)
 }
 
 // Slot returns the slot of the beacon block.
 func (v *VersionedBeaconBlock) Slot() (phase0.Slot, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return 0, errors.New("no phase0 block")
+			return 0, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Slot, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return 0, errors.New("no altair block")
+			return 0, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Slot, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return 0, errors.New("no bellatrix block")
+			return 0, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Slot, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return 0, errors.New("no capella block")
+			return 0, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Slot, nil
 	default:
@@ -66,25 +70,28 @@ func (v *VersionedBeaconBlock) Slot() (phase0.Slot, error) {
 
 // Root returns the root of the beacon block.
 func (v *VersionedBeaconBlock) Root() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+		if v.Phase0 == nil || !phase0BodyHashable(v.Phase0.Body) {
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.HashTreeRoot()
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+		if v.Altair == nil || !altairBodyHashable(v.Altair.Body) {
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.HashTreeRoot()
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || !bellatrixBodyHashable(v.Bellatrix.Body) {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.HashTreeRoot()
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || !capellaBodyHashable(v.Capella.Body) {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.HashTreeRoot()
 	default:
@@ -94,25 +101,28 @@ func (v *VersionedBeaconBlock) Root() (phase0.Root, error) {
 
 // BodyRoot returns the body root of the beacon block.
 func (v *VersionedBeaconBlock) BodyRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+		if v.Phase0 == nil || !phase0BodyHashable(v.Phase0.Body) {
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Body.HashTreeRoot()
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+		if v.Altair == nil || !altairBodyHashable(v.Altair.Body) {
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Body.HashTreeRoot()
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || !bellatrixBodyHashable(v.Bellatrix.Body) {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Body.HashTreeRoot()
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || !capellaBodyHashable(v.Capella.Body) {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Body.HashTreeRoot()
 	default:
@@ -122,25 +132,28 @@ func (v *VersionedBeaconBlock) BodyRoot() (phase0.Root, error) {
 
 // ParentRoot returns the parent root of the beacon block.
 func (v *VersionedBeaconBlock) ParentRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.ParentRoot, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.ParentRoot, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.ParentRoot, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.ParentRoot, nil
 	default:
@@ -150,25 +163,28 @@ func (v *VersionedBeaconBlock) ParentRoot() (phase0.Root, error) {
 
 // StateRoot returns the state root of the beacon block.
 func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.StateRoot, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.StateRoot, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.StateRoot, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.StateRoot, nil
 	default:
@@ -178,25 +194,28 @@ func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
 
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Body == nil {
-			return nil, errors.New("no phase0 block")
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Body.Attestations, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Body == nil {
-			return nil, errors.New("no altair block")
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Body.Attestations, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
-			return nil, errors.New("no bellatrix block")
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Body.Attestations, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
-			return nil, errors.New("no capella block")
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Body.Attestations, nil
 	default:
@@ -206,25 +225,28 @@ func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 
 // AttesterSlashings returns the attester slashings of the beacon block.
 func (v *VersionedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Body == nil {
-			return nil, errors.New("no phase0 block")
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Body.AttesterSlashings, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Body == nil {
-			return nil, errors.New("no altair block")
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Body.AttesterSlashings, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
-			return nil, errors.New("no bellatrix block")
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Body.AttesterSlashings, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
-			return nil, errors.New("no capella block")
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Body.AttesterSlashings, nil
 	default:
@@ -234,25 +256,28 @@ func (v *VersionedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing,
 
 // ProposerSlashings returns the proposer slashings of the beacon block.
 func (v *VersionedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Body == nil {
-			return nil, errors.New("no phase0 block")
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Body.ProposerSlashings, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Body == nil {
-			return nil, errors.New("no altair block")
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Body.ProposerSlashings, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
-			return nil, errors.New("no bellatrix block")
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Body.ProposerSlashings, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
-			return nil, errors.New("no capella block")
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Body.ProposerSlashings, nil
 	default:
@@ -260,8 +285,66 @@ func (v *VersionedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing,
 	}
 }
 
+// ProposerIndex returns the proposer index of the beacon block.
+func (v *VersionedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
+		}
+		return v.Phase0.ProposerIndex, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+		return v.Altair.ProposerIndex, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.ProposerIndex, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		return v.Capella.ProposerIndex, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// FeeRecipient returns the fee recipient of the beacon block.
+// Blocks prior to bellatrix do not have a fee recipient.
+func (v *VersionedBeaconBlock) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	if v == nil {
+		return bellatrix.ExecutionAddress{}, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair:
+		return bellatrix.ExecutionAddress{}, errors.New("no fee recipient prior to bellatrix")
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil || v.Bellatrix.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no bellatrix block body execution payload: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.Body.ExecutionPayload.FeeRecipient, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Body == nil || v.Capella.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, fmt.Errorf("no capella block body execution payload: %w", ErrDataMissing)
+		}
+		return v.Capella.Body.ExecutionPayload.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconBlock) String() string {
+	if v == nil {
+		return ""
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -287,3 +370,61 @@ func (v *VersionedBeaconBlock) String() string {
 		return "unknown version"
 	}
 }
+
+// Validate checks that the structure holds complete data for its version, and no data for other versions.
+func (v *VersionedBeaconBlock) Validate() error {
+	if v == nil {
+		return ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Body == nil {
+			return fmt.Errorf("no phase0 block: %w", ErrDataMissing)
+		}
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Body == nil {
+			return fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
+			return fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		if v.Bellatrix.Body.ExecutionPayload == nil {
+			return fmt.Errorf("no bellatrix execution payload: %w", ErrDataMissing)
+		}
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Body == nil {
+			return fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		if v.Capella.Body.ExecutionPayload == nil {
+			return fmt.Errorf("no capella execution payload: %w", ErrDataMissing)
+		}
+	default:
+		return errors.New("unknown version")
+	}
+	if populatedVersions(v.Phase0 != nil, v.Altair != nil, v.Bellatrix != nil, v.Capella != nil) != 1 {
+		return errors.New("data present for multiple versions")
+	}
+
+	return nil
+}
+
+// phase0BodyHashable returns true if the body holds the data required to hash it.
+func phase0BodyHashable(body *phase0.BeaconBlockBody) bool {
+	return body != nil && body.ETH1Data != nil
+}
+
+// altairBodyHashable returns true if the body holds the data required to hash it.
+func altairBodyHashable(body *altair.BeaconBlockBody) bool {
+	return body != nil && body.ETH1Data != nil && body.SyncAggregate != nil
+}
+
+// bellatrixBodyHashable returns true if the body holds the data required to hash it.
+func bellatrixBodyHashable(body *bellatrix.BeaconBlockBody) bool {
+	return body != nil && body.ETH1Data != nil && body.SyncAggregate != nil && body.ExecutionPayload != nil
+}
+
+// capellaBodyHashable returns true if the body holds the data required to hash it.
+func capellaBodyHashable(body *capella.BeaconBlockBody) bool {
+	return body != nil && body.ETH1Data != nil && body.SyncAggregate != nil && body.ExecutionPayload != nil
+}
@@ -14,6 +14,9 @@
 package spec
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
@@ -31,6 +34,9 @@ type VersionedBeaconBlockBody struct {
 
 // String returns a string version of the structure.
 func (v *VersionedBeaconBlockBody) String() string {
+	if v == nil {
+		return ""
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -56,3 +62,35 @@ func (v *VersionedBeaconBlockBody) String() string {
 		return "unknown version"
 	}
 }
+
+// Validate checks that the structure holds data for its version, and no data for other versions.
+func (v *VersionedBeaconBlockBody) Validate() error {
+	if v == nil {
+		return ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return fmt.Errorf("no phase0 block body: %w", ErrDataMissing)
+		}
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return fmt.Errorf("no altair block body: %w", ErrDataMissing)
+		}
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.ExecutionPayload == nil {
+			return fmt.Errorf("no bellatrix block body: %w", ErrDataMissing)
+		}
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.ExecutionPayload == nil {
+			return fmt.Errorf("no capella block body: %w", ErrDataMissing)
+		}
+	default:
+		return errors.New("unknown version")
+	}
+	if populatedVersions(v.Phase0 != nil, v.Altair != nil, v.Bellatrix != nil, v.Capella != nil) != 1 {
+		return errors.New("data present for multiple versions")
+	}
+
+	return nil
+}
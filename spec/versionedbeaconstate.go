@@ -15,6 +15,7 @@ package spec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -33,30 +34,33 @@ type VersionedBeaconState struct {
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconState) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil
+	return v == nil || (v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil)
 }
 
 // Slot returns the slot of the state.
 func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return 0, errors.New("no Phase0 state")
+			return 0, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
 		}
 		return v.Phase0.Slot, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return 0, errors.New("no Altair state")
+			return 0, fmt.Errorf("no Altair state: %w", ErrDataMissing)
 		}
 		return v.Altair.Slot, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return 0, errors.New("no Bellatrix state")
+			return 0, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Slot, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return 0, errors.New("no Capella state")
+			return 0, fmt.Errorf("no Capella state: %w", ErrDataMissing)
 		}
 		return v.Capella.Slot, nil
 	default:
@@ -66,12 +70,15 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 
 // NextWithdrawalValidatorIndex returns the next withdrawal validator index of the state.
 func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorIndex, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix:
 		return 0, errors.New("state does not provide next withdrawal validator index")
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return 0, errors.New("no Capella state")
+			return 0, fmt.Errorf("no Capella state: %w", ErrDataMissing)
 		}
 		return v.Capella.NextWithdrawalValidatorIndex, nil
 	default:
@@ -81,25 +88,28 @@ func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorI
 
 // Validators returns the validators of the state.
 func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return nil, errors.New("no Phase0 state")
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
 		}
 		return v.Phase0.Validators, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return nil, errors.New("no Altair state")
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
 		}
 		return v.Altair.Validators, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return nil, errors.New("no Bellatrix state")
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Validators, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return nil, errors.New("no Capella state")
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
 		}
 		return v.Capella.Validators, nil
 	default:
@@ -109,25 +119,28 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 
 // ValidatorBalances returns the validator balances of the state.
 func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return nil, errors.New("no Phase0 state")
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
 		}
 		return v.Phase0.Balances, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return nil, errors.New("no Altair state")
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
 		}
 		return v.Altair.Balances, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return nil, errors.New("no Bellatrix state")
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Balances, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return nil, errors.New("no Capella state")
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
 		}
 		return v.Capella.Balances, nil
 	default:
@@ -135,8 +148,343 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 	}
 }
 
+// RANDAOMixes returns the RANDAO mixes of the state.
+func (v *VersionedBeaconState) RANDAOMixes() ([]phase0.Root, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.RANDAOMixes, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.RANDAOMixes, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.RANDAOMixes, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.RANDAOMixes, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// GenesisTime returns the genesis time of the state.
+func (v *VersionedBeaconState) GenesisTime() (uint64, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.GenesisTime, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.GenesisTime, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.GenesisTime, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.GenesisTime, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the state.
+func (v *VersionedBeaconState) GenesisValidatorsRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return phase0.Root{}, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.GenesisValidatorsRoot, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return phase0.Root{}, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.GenesisValidatorsRoot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Root{}, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.GenesisValidatorsRoot, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Root{}, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.GenesisValidatorsRoot, nil
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+}
+
+// Fork returns the fork of the state.
+func (v *VersionedBeaconState) Fork() (*phase0.Fork, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.Fork, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.Fork, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.Fork, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.Fork, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the state.
+func (v *VersionedBeaconState) FinalizedCheckpoint() (*phase0.Checkpoint, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.FinalizedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.FinalizedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.FinalizedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.FinalizedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BlockRoots returns the block roots of the state.
+func (v *VersionedBeaconState) BlockRoots() ([]phase0.Root, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.BlockRoots, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.BlockRoots, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.BlockRoots, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.BlockRoots, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// PreviousJustifiedCheckpoint returns the previous justified checkpoint of the state.
+func (v *VersionedBeaconState) PreviousJustifiedCheckpoint() (*phase0.Checkpoint, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.PreviousJustifiedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.PreviousJustifiedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.PreviousJustifiedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.PreviousJustifiedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentJustifiedCheckpoint returns the current justified checkpoint of the state.
+func (v *VersionedBeaconState) CurrentJustifiedCheckpoint() (*phase0.Checkpoint, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		return v.Phase0.CurrentJustifiedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.CurrentJustifiedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.CurrentJustifiedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.CurrentJustifiedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentEpochParticipation returns the current epoch participation flags of the state.
+func (v *VersionedBeaconState) CurrentEpochParticipation() ([]altair.ParticipationFlags, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide current epoch participation")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.CurrentEpochParticipation, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.CurrentEpochParticipation, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.CurrentEpochParticipation, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// PreviousEpochParticipation returns the previous epoch participation flags of the state.
+func (v *VersionedBeaconState) PreviousEpochParticipation() ([]altair.ParticipationFlags, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide previous epoch participation")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.PreviousEpochParticipation, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.PreviousEpochParticipation, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.PreviousEpochParticipation, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// InactivityScores returns the inactivity scores of the state.
+func (v *VersionedBeaconState) InactivityScores() ([]uint64, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide inactivity scores")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		return v.Altair.InactivityScores, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.InactivityScores, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		return v.Capella.InactivityScores, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconState) String() string {
+	if v == nil {
+		return ""
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -162,3 +510,119 @@ func (v *VersionedBeaconState) String() string {
 		return "unknown version"
 	}
 }
+
+// Validate checks that the structure holds complete and internally consistent data for its version,
+// and no data for other versions.
+func (v *VersionedBeaconState) Validate() error {
+	if v == nil {
+		return ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		s := v.Phase0
+		if s == nil {
+			return fmt.Errorf("no Phase0 state: %w", ErrDataMissing)
+		}
+		if err := validateStateFields(s.Fork, s.LatestBlockHeader, s.ETH1Data, s.PreviousJustifiedCheckpoint, s.CurrentJustifiedCheckpoint, s.FinalizedCheckpoint); err != nil {
+			return err
+		}
+		if len(s.Balances) != len(s.Validators) {
+			return errors.New("number of balances does not match number of validators")
+		}
+	case DataVersionAltair:
+		s := v.Altair
+		if s == nil {
+			return fmt.Errorf("no Altair state: %w", ErrDataMissing)
+		}
+		if err := validateStateFields(s.Fork, s.LatestBlockHeader, s.ETH1Data, s.PreviousJustifiedCheckpoint, s.CurrentJustifiedCheckpoint, s.FinalizedCheckpoint); err != nil {
+			return err
+		}
+		if err := validateAltairStateFields(s.CurrentSyncCommittee, s.NextSyncCommittee, len(s.Validators), len(s.Balances), len(s.PreviousEpochParticipation), len(s.CurrentEpochParticipation), len(s.InactivityScores)); err != nil {
+			return err
+		}
+	case DataVersionBellatrix:
+		s := v.Bellatrix
+		if s == nil {
+			return fmt.Errorf("no Bellatrix state: %w", ErrDataMissing)
+		}
+		if err := validateStateFields(s.Fork, s.LatestBlockHeader, s.ETH1Data, s.PreviousJustifiedCheckpoint, s.CurrentJustifiedCheckpoint, s.FinalizedCheckpoint); err != nil {
+			return err
+		}
+		if err := validateAltairStateFields(s.CurrentSyncCommittee, s.NextSyncCommittee, len(s.Validators), len(s.Balances), len(s.PreviousEpochParticipation), len(s.CurrentEpochParticipation), len(s.InactivityScores)); err != nil {
+			return err
+		}
+		if s.LatestExecutionPayloadHeader == nil {
+			return fmt.Errorf("no latest execution payload header: %w", ErrDataMissing)
+		}
+	case DataVersionCapella:
+		s := v.Capella
+		if s == nil {
+			return fmt.Errorf("no Capella state: %w", ErrDataMissing)
+		}
+		if err := validateStateFields(s.Fork, s.LatestBlockHeader, s.ETH1Data, s.PreviousJustifiedCheckpoint, s.CurrentJustifiedCheckpoint, s.FinalizedCheckpoint); err != nil {
+			return err
+		}
+		if err := validateAltairStateFields(s.CurrentSyncCommittee, s.NextSyncCommittee, len(s.Validators), len(s.Balances), len(s.PreviousEpochParticipation), len(s.CurrentEpochParticipation), len(s.InactivityScores)); err != nil {
+			return err
+		}
+		if s.LatestExecutionPayloadHeader == nil {
+			return fmt.Errorf("no latest execution payload header: %w", ErrDataMissing)
+		}
+	default:
+		return errors.New("unknown version")
+	}
+	if populatedVersions(v.Phase0 != nil, v.Altair != nil, v.Bellatrix != nil, v.Capella != nil) != 1 {
+		return errors.New("data present for multiple versions")
+	}
+
+	return nil
+}
+
+// validateStateFields checks the containers common to all state versions.
+func validateStateFields(fork *phase0.Fork,
+	latestBlockHeader *phase0.BeaconBlockHeader,
+	eth1Data *phase0.ETH1Data,
+	previousJustifiedCheckpoint *phase0.Checkpoint,
+	currentJustifiedCheckpoint *phase0.Checkpoint,
+	finalizedCheckpoint *phase0.Checkpoint,
+) error {
+	if fork == nil {
+		return fmt.Errorf("no fork: %w", ErrDataMissing)
+	}
+	if latestBlockHeader == nil {
+		return fmt.Errorf("no latest block header: %w", ErrDataMissing)
+	}
+	if eth1Data == nil {
+		return fmt.Errorf("no ETH1 data: %w", ErrDataMissing)
+	}
+	if previousJustifiedCheckpoint == nil || currentJustifiedCheckpoint == nil || finalizedCheckpoint == nil {
+		return fmt.Errorf("no checkpoint: %w", ErrDataMissing)
+	}
+
+	return nil
+}
+
+// validateAltairStateFields checks the fields introduced in Altair and retained by later versions.
+func validateAltairStateFields(currentSyncCommittee *altair.SyncCommittee,
+	nextSyncCommittee *altair.SyncCommittee,
+	validators int,
+	balances int,
+	previousEpochParticipation int,
+	currentEpochParticipation int,
+	inactivityScores int,
+) error {
+	if currentSyncCommittee == nil || nextSyncCommittee == nil {
+		return fmt.Errorf("no sync committee: %w", ErrDataMissing)
+	}
+	if balances != validators {
+		return errors.New("number of balances does not match number of validators")
+	}
+	if previousEpochParticipation != validators || currentEpochParticipation != validators {
+		return errors.New("number of participation flags does not match number of validators")
+	}
+	if inactivityScores != validators {
+		return errors.New("number of inactivity scores does not match number of validators")
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconStateNilSafety(t *testing.T) {
+	var state *spec.VersionedBeaconState
+	require.True(t, state.IsEmpty())
+	_, err := state.Slot()
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+	_, err = state.Validators()
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+
+	_, err = state.GenesisTime()
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+
+	state = &spec.VersionedBeaconState{Version: spec.DataVersionAltair}
+	_, err = state.GenesisValidatorsRoot()
+	require.EqualError(t, err, "no Altair state: data missing")
+	_, err = state.Fork()
+	require.EqualError(t, err, "no Altair state: data missing")
+	_, err = state.FinalizedCheckpoint()
+	require.EqualError(t, err, "no Altair state: data missing")
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+}
+
+func TestVersionedBeaconStateValidate(t *testing.T) {
+	goodState := func() *altair.BeaconState {
+		return &altair.BeaconState{
+			Fork:                        &phase0.Fork{},
+			LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+			ETH1Data:                    &phase0.ETH1Data{},
+			Validators:                  []*phase0.Validator{{}},
+			Balances:                    []phase0.Gwei{1},
+			PreviousEpochParticipation:  []altair.ParticipationFlags{0},
+			CurrentEpochParticipation:   []altair.ParticipationFlags{0},
+			InactivityScores:            []uint64{0},
+			PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+			FinalizedCheckpoint:         &phase0.Checkpoint{},
+			CurrentSyncCommittee:        &altair.SyncCommittee{},
+			NextSyncCommittee:           &altair.SyncCommittee{},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*altair.BeaconState)
+		err    string
+	}{
+		{
+			name:   "Good",
+			mutate: func(_ *altair.BeaconState) {},
+		},
+		{
+			name:   "NoFork",
+			mutate: func(s *altair.BeaconState) { s.Fork = nil },
+			err:    "no fork: data missing",
+		},
+		{
+			name:   "NoSyncCommittee",
+			mutate: func(s *altair.BeaconState) { s.NextSyncCommittee = nil },
+			err:    "no sync committee: data missing",
+		},
+		{
+			name:   "BalancesMismatch",
+			mutate: func(s *altair.BeaconState) { s.Balances = nil },
+			err:    "number of balances does not match number of validators",
+		},
+		{
+			name:   "ParticipationMismatch",
+			mutate: func(s *altair.BeaconState) { s.CurrentEpochParticipation = nil },
+			err:    "number of participation flags does not match number of validators",
+		},
+		{
+			name:   "InactivityScoresMismatch",
+			mutate: func(s *altair.BeaconState) { s.InactivityScores = append(s.InactivityScores, 0) },
+			err:    "number of inactivity scores does not match number of validators",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := goodState()
+			test.mutate(state)
+			err := (&spec.VersionedBeaconState{Version: spec.DataVersionAltair, Altair: state}).Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
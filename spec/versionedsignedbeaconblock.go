@@ -15,6 +15,7 @@ package spec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -33,25 +34,28 @@ type VersionedSignedBeaconBlock struct {
 
 // Slot returns the slot of the signed beacon block.
 func (v *VersionedSignedBeaconBlock) Slot() (phase0.Slot, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Message == nil {
-			return 0, errors.New("no phase0 block")
+			return 0, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.Slot, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Message == nil {
-			return 0, errors.New("no altair block")
+			return 0, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.Slot, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
-			return 0, errors.New("no bellatrix block")
+			return 0, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Slot, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Message == nil {
-			return 0, errors.New("no capella block")
+			return 0, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.Slot, nil
 	default:
@@ -59,27 +63,61 @@ func (v *VersionedSignedBeaconBlock) Slot() (phase0.Slot, error) {
 	}
 }
 
+// ProposerIndex returns the proposer index of the signed beacon block.
+func (v *VersionedSignedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v == nil {
+		return 0, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil {
+			return 0, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
+		}
+		return v.Phase0.Message.ProposerIndex, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil {
+			return 0, fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+		return v.Altair.Message.ProposerIndex, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return 0, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.Message.ProposerIndex, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return 0, fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		return v.Capella.Message.ProposerIndex, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
-			return nil, errors.New("no phase0 block")
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.Body.Attestations, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
-			return nil, errors.New("no altair block")
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.Body.Attestations, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
-			return nil, errors.New("no bellatrix block")
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.Attestations, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
-			return nil, errors.New("no capella block")
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.Body.Attestations, nil
 	default:
@@ -89,25 +127,28 @@ func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, erro
 
 // Root returns the root of the beacon block.
 func (v *VersionedSignedBeaconBlock) Root() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+		if v.Phase0 == nil || v.Phase0.Message == nil || !phase0BodyHashable(v.Phase0.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.HashTreeRoot()
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+		if v.Altair == nil || v.Altair.Message == nil || !altairBodyHashable(v.Altair.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.HashTreeRoot()
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || !bellatrixBodyHashable(v.Bellatrix.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.HashTreeRoot()
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || !capellaBodyHashable(v.Capella.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.HashTreeRoot()
 	default:
@@ -117,25 +158,28 @@ func (v *VersionedSignedBeaconBlock) Root() (phase0.Root, error) {
 
 // BodyRoot returns the body root of the beacon block.
 func (v *VersionedSignedBeaconBlock) BodyRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+		if v.Phase0 == nil || v.Phase0.Message == nil || !phase0BodyHashable(v.Phase0.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.Body.HashTreeRoot()
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+		if v.Altair == nil || v.Altair.Message == nil || !altairBodyHashable(v.Altair.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.Body.HashTreeRoot()
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || !bellatrixBodyHashable(v.Bellatrix.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.HashTreeRoot()
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || !capellaBodyHashable(v.Capella.Message.Body) {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.Body.HashTreeRoot()
 	default:
@@ -145,25 +189,28 @@ func (v *VersionedSignedBeaconBlock) BodyRoot() (phase0.Root, error) {
 
 // ParentRoot returns the parent root of the beacon block.
 func (v *VersionedSignedBeaconBlock) ParentRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+		if v.Phase0 == nil || v.Phase0.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.ParentRoot, nil
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+		if v.Altair == nil || v.Altair.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.ParentRoot, nil
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.ParentRoot, nil
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.ParentRoot, nil
 	default:
@@ -173,25 +220,28 @@ func (v *VersionedSignedBeaconBlock) ParentRoot() (phase0.Root, error) {
 
 // StateRoot returns the state root of the beacon block.
 func (v *VersionedSignedBeaconBlock) StateRoot() (phase0.Root, error) {
+	if v == nil {
+		return phase0.Root{}, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return phase0.Root{}, errors.New("no phase0 block")
+		if v.Phase0 == nil || v.Phase0.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.StateRoot, nil
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return phase0.Root{}, errors.New("no altair block")
+		if v.Altair == nil || v.Altair.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.StateRoot, nil
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return phase0.Root{}, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.StateRoot, nil
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return phase0.Root{}, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil {
+			return phase0.Root{}, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.StateRoot, nil
 	default:
@@ -201,25 +251,28 @@ func (v *VersionedSignedBeaconBlock) StateRoot() (phase0.Root, error) {
 
 // AttesterSlashings returns the attester slashings of the beacon block.
 func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return nil, errors.New("no phase0 block")
+		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.Body.AttesterSlashings, nil
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return nil, errors.New("no altair block")
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.Body.AttesterSlashings, nil
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return nil, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.AttesterSlashings, nil
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return nil, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.Body.AttesterSlashings, nil
 	default:
@@ -229,25 +282,28 @@ func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlas
 
 // ProposerSlashings returns the proposer slashings of the beacon block.
 func (v *VersionedSignedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
 	switch v.Version {
 	case DataVersionPhase0:
-		if v.Phase0 == nil {
-			return nil, errors.New("no phase0 block")
+		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
 		}
 		return v.Phase0.Message.Body.ProposerSlashings, nil
 	case DataVersionAltair:
-		if v.Altair == nil {
-			return nil, errors.New("no altair block")
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
 		}
 		return v.Altair.Message.Body.ProposerSlashings, nil
 	case DataVersionBellatrix:
-		if v.Bellatrix == nil {
-			return nil, errors.New("no bellatrix block")
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
 		}
 		return v.Bellatrix.Message.Body.ProposerSlashings, nil
 	case DataVersionCapella:
-		if v.Capella == nil {
-			return nil, errors.New("no capella block")
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
 		}
 		return v.Capella.Message.Body.ProposerSlashings, nil
 	default:
@@ -255,8 +311,70 @@ func (v *VersionedSignedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlas
 	}
 }
 
+// VoluntaryExits returns the voluntary exits of the beacon block.
+func (v *VersionedSignedBeaconBlock) VoluntaryExits() ([]*phase0.SignedVoluntaryExit, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
+		}
+		return v.Phase0.Message.Body.VoluntaryExits, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+		return v.Altair.Message.Body.VoluntaryExits, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.Message.Body.VoluntaryExits, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		return v.Capella.Message.Body.VoluntaryExits, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// SyncAggregate returns the sync aggregate of the beacon block.
+func (v *VersionedSignedBeaconBlock) SyncAggregate() (*altair.SyncAggregate, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have sync aggregate")
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+		return v.Altair.Message.Body.SyncAggregate, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		return v.Bellatrix.Message.Body.SyncAggregate, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		return v.Capella.Message.Body.SyncAggregate, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedSignedBeaconBlock) String() string {
+	if v == nil {
+		return ""
+	}
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -282,3 +400,41 @@ func (v *VersionedSignedBeaconBlock) String() string {
 		return "unknown version"
 	}
 }
+
+// Validate checks that the structure holds complete data for its version, and no data for other versions.
+func (v *VersionedSignedBeaconBlock) Validate() error {
+	if v == nil {
+		return ErrDataMissing
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
+			return fmt.Errorf("no phase0 block: %w", ErrDataMissing)
+		}
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		if v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return fmt.Errorf("no bellatrix execution payload: %w", ErrDataMissing)
+		}
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		if v.Capella.Message.Body.ExecutionPayload == nil {
+			return fmt.Errorf("no capella execution payload: %w", ErrDataMissing)
+		}
+	default:
+		return errors.New("unknown version")
+	}
+	if populatedVersions(v.Phase0 != nil, v.Altair != nil, v.Bellatrix != nil, v.Capella != nil) != 1 {
+		return errors.New("data present for multiple versions")
+	}
+
+	return nil
+}
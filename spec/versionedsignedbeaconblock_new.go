@@ -0,0 +1,122 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// NewVersionedSignedBeaconBlock creates a versioned signed beacon block of the given
+// version.  The block can be either a beacon block, in which case the signature is
+// empty and should be added with WithSignature(), or a signed beacon block.  An error
+// is returned if the type of the block does not match the version.
+func NewVersionedSignedBeaconBlock(version DataVersion, block interface{}) (*VersionedSignedBeaconBlock, error) {
+	if block == nil {
+		return nil, ErrDataMissing
+	}
+
+	res := &VersionedSignedBeaconBlock{
+		Version: version,
+	}
+	switch version {
+	case DataVersionPhase0:
+		switch b := block.(type) {
+		case *phase0.BeaconBlock:
+			res.Phase0 = &phase0.SignedBeaconBlock{Message: b}
+		case *phase0.SignedBeaconBlock:
+			res.Phase0 = b
+		default:
+			return nil, fmt.Errorf("block of type %T does not match version %s", block, version)
+		}
+	case DataVersionAltair:
+		switch b := block.(type) {
+		case *altair.BeaconBlock:
+			res.Altair = &altair.SignedBeaconBlock{Message: b}
+		case *altair.SignedBeaconBlock:
+			res.Altair = b
+		default:
+			return nil, fmt.Errorf("block of type %T does not match version %s", block, version)
+		}
+	case DataVersionBellatrix:
+		switch b := block.(type) {
+		case *bellatrix.BeaconBlock:
+			res.Bellatrix = &bellatrix.SignedBeaconBlock{Message: b}
+		case *bellatrix.SignedBeaconBlock:
+			res.Bellatrix = b
+		default:
+			return nil, fmt.Errorf("block of type %T does not match version %s", block, version)
+		}
+	case DataVersionCapella:
+		switch b := block.(type) {
+		case *capella.BeaconBlock:
+			res.Capella = &capella.SignedBeaconBlock{Message: b}
+		case *capella.SignedBeaconBlock:
+			res.Capella = b
+		default:
+			return nil, fmt.Errorf("block of type %T does not match version %s", block, version)
+		}
+	default:
+		return nil, errors.New("unknown version")
+	}
+	// Ensure that a typed nil block has not been supplied.
+	if _, err := res.Slot(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// WithSignature returns a copy of the versioned signed beacon block with the given
+// signature.  The block itself is shared with the original.
+func (v *VersionedSignedBeaconBlock) WithSignature(signature phase0.BLSSignature) (*VersionedSignedBeaconBlock, error) {
+	if v == nil {
+		return nil, ErrDataMissing
+	}
+
+	res := &VersionedSignedBeaconBlock{
+		Version: v.Version,
+	}
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil {
+			return nil, fmt.Errorf("no phase0 block: %w", ErrDataMissing)
+		}
+		res.Phase0 = &phase0.SignedBeaconBlock{Message: v.Phase0.Message, Signature: signature}
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil {
+			return nil, fmt.Errorf("no altair block: %w", ErrDataMissing)
+		}
+		res.Altair = &altair.SignedBeaconBlock{Message: v.Altair.Message, Signature: signature}
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return nil, fmt.Errorf("no bellatrix block: %w", ErrDataMissing)
+		}
+		res.Bellatrix = &bellatrix.SignedBeaconBlock{Message: v.Bellatrix.Message, Signature: signature}
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return nil, fmt.Errorf("no capella block: %w", ErrDataMissing)
+		}
+		res.Capella = &capella.SignedBeaconBlock{Message: v.Capella.Message, Signature: signature}
+	default:
+		return nil, errors.New("unknown version")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionedSignedBeaconBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		version spec.DataVersion
+		block   interface{}
+		err     string
+	}{
+		{
+			name:    "Nil",
+			version: spec.DataVersionPhase0,
+			err:     "data missing",
+		},
+		{
+			name:    "TypedNil",
+			version: spec.DataVersionAltair,
+			block:   (*altair.BeaconBlock)(nil),
+			err:     "no altair block: data missing",
+		},
+		{
+			name:    "UnknownVersion",
+			version: spec.DataVersion(99),
+			block:   &phase0.BeaconBlock{},
+			err:     "unknown version",
+		},
+		{
+			name:    "Mismatch",
+			version: spec.DataVersionCapella,
+			block:   &bellatrix.BeaconBlock{Slot: 1},
+			err:     "block of type *bellatrix.BeaconBlock does not match version capella",
+		},
+		{
+			name:    "NotBlock",
+			version: spec.DataVersionPhase0,
+			block:   &phase0.BeaconBlockHeader{},
+			err:     "block of type *phase0.BeaconBlockHeader does not match version phase0",
+		},
+		{
+			name:    "Phase0",
+			version: spec.DataVersionPhase0,
+			block:   &phase0.BeaconBlock{Slot: 1},
+		},
+		{
+			name:    "Altair",
+			version: spec.DataVersionAltair,
+			block:   &altair.BeaconBlock{Slot: 1},
+		},
+		{
+			name:    "Bellatrix",
+			version: spec.DataVersionBellatrix,
+			block:   &bellatrix.BeaconBlock{Slot: 1},
+		},
+		{
+			name:    "Capella",
+			version: spec.DataVersionCapella,
+			block:   &capella.BeaconBlock{Slot: 1},
+		},
+		{
+			name:    "CapellaSigned",
+			version: spec.DataVersionCapella,
+			block:   &capella.SignedBeaconBlock{Message: &capella.BeaconBlock{Slot: 1}, Signature: phase0.BLSSignature{0x01}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block, err := spec.NewVersionedSignedBeaconBlock(test.version, test.block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.version, block.Version)
+			slot, err := block.Slot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(1), slot)
+		})
+	}
+}
+
+func TestVersionedSignedBeaconBlockWithSignature(t *testing.T) {
+	_, err := (*spec.VersionedSignedBeaconBlock)(nil).WithSignature(phase0.BLSSignature{})
+	require.EqualError(t, err, "data missing")
+	_, err = (&spec.VersionedSignedBeaconBlock{Version: spec.DataVersionBellatrix}).WithSignature(phase0.BLSSignature{})
+	require.EqualError(t, err, "no bellatrix block: data missing")
+
+	unsigned, err := spec.NewVersionedSignedBeaconBlock(spec.DataVersionCapella, &capella.BeaconBlock{Slot: 1})
+	require.NoError(t, err)
+	signed, err := unsigned.WithSignature(phase0.BLSSignature{0x01, 0x02})
+	require.NoError(t, err)
+	require.Equal(t, phase0.BLSSignature{0x01, 0x02}, signed.Capella.Signature)
+	require.Equal(t, unsigned.Capella.Message, signed.Capella.Message)
+	// Original is unchanged.
+	require.Equal(t, phase0.BLSSignature{}, unsigned.Capella.Signature)
+}
@@ -0,0 +1,153 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedSignedBeaconBlockNilSafety(t *testing.T) {
+	blocks := []struct {
+		name  string
+		block *spec.VersionedSignedBeaconBlock
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name:  "NoData",
+			block: &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionCapella},
+		},
+		{
+			name: "NoMessage",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.SignedBeaconBlock{},
+			},
+		},
+		{
+			name: "NoBody",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionAltair,
+				Altair: &altair.SignedBeaconBlock{
+					Message: &altair.BeaconBlock{},
+				},
+			},
+		},
+	}
+
+	for _, test := range blocks {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.block.Root()
+			require.True(t, errors.Is(err, spec.ErrDataMissing))
+			_, err = test.block.BodyRoot()
+			require.True(t, errors.Is(err, spec.ErrDataMissing))
+			_, err = test.block.Attestations()
+			require.True(t, errors.Is(err, spec.ErrDataMissing))
+			_, err = test.block.ProposerSlashings()
+			require.True(t, errors.Is(err, spec.ErrDataMissing))
+			_, err = test.block.VoluntaryExits()
+			require.True(t, errors.Is(err, spec.ErrDataMissing))
+			require.True(t, errors.Is(test.block.Validate(), spec.ErrDataMissing))
+		})
+	}
+}
+
+func TestVersionedBeaconBlockRootNilSafety(t *testing.T) {
+	block := &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0:  &phase0.BeaconBlock{},
+	}
+	_, err := block.Root()
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+
+	// Body without its ETH1 data cannot be hashed.
+	block.Phase0.Body = &phase0.BeaconBlockBody{}
+	_, err = block.Root()
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+	_, err = block.BodyRoot()
+	require.True(t, errors.Is(err, spec.ErrDataMissing))
+
+	block.Phase0.Body.ETH1Data = &phase0.ETH1Data{BlockHash: make([]byte, 32)}
+	_, err = block.Root()
+	require.NoError(t, err)
+}
+
+func TestVersionedSignedBeaconBlockValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *spec.VersionedSignedBeaconBlock
+		err   string
+	}{
+		{
+			name:  "UnknownVersion",
+			block: &spec.VersionedSignedBeaconBlock{Version: 99},
+			err:   "unknown version",
+		},
+		{
+			name: "NoExecutionPayload",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.SignedBeaconBlock{
+					Message: &capella.BeaconBlock{
+						Body: &capella.BeaconBlockBody{},
+					},
+				},
+			},
+			err: "no capella execution payload: data missing",
+		},
+		{
+			name: "MultipleVersions",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionAltair,
+				Phase0:  &phase0.SignedBeaconBlock{},
+				Altair: &altair.SignedBeaconBlock{
+					Message: &altair.BeaconBlock{
+						Body: &altair.BeaconBlockBody{},
+					},
+				},
+			},
+			err: "data present for multiple versions",
+		},
+		{
+			name: "Good",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionAltair,
+				Altair: &altair.SignedBeaconBlock{
+					Message: &altair.BeaconBlock{
+						Body: &altair.BeaconBlockBody{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.block.Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
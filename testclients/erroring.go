@@ -296,6 +296,18 @@ func (s *Erroring) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv
 	return next.BeaconBlockHeader(ctx, blockID)
 }
 
+// BeaconBlockHeadersRange provides the block headers for a range of slots.
+func (s *Erroring) BeaconBlockHeadersRange(ctx context.Context, startSlot phase0.Slot, count uint64) ([]*apiv1.BeaconBlockHeader, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersRangeProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockHeadersRange(ctx, startSlot, count)
+}
+
 // BeaconBlockRoot fetches a block's root given a block ID.
 func (s *Erroring) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -332,6 +344,37 @@ func (s *Erroring) BeaconCommitteesAtEpoch(ctx context.Context, stateID string,
 	return next.BeaconCommitteesAtEpoch(ctx, stateID, epoch)
 }
 
+// AllAttesterAssignments fetches the beacon committees for the given epoch and provides
+// the assignment of each validator in them, keyed by validator index.
+func (s *Erroring) AllAttesterAssignments(ctx context.Context, epoch phase0.Epoch) (map[phase0.ValidatorIndex]*apiv1.AttesterAssignment, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttesterAssignmentsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AllAttesterAssignments(ctx, epoch)
+}
+
+// IndexedBeaconCommittees fetches the beacon committees matching the filter at the given state.
+func (s *Erroring) IndexedBeaconCommittees(ctx context.Context,
+	stateID string,
+	filter *apiv1.BeaconCommitteesFilter,
+) (
+	*apiv1.BeaconCommittees,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.IndexedBeaconCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.IndexedBeaconCommittees(ctx, stateID, filter)
+}
+
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Erroring) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -344,6 +387,18 @@ func (s *Erroring) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ra
 	return next.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
 }
 
+// Proposal fetches a proposal for signing.
+func (s *Erroring) Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Proposal(ctx, slot, randaoReveal, graffiti)
+}
+
 // SubmitBeaconBlock submits a beacon block.
 func (s *Erroring) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -356,6 +411,24 @@ func (s *Erroring) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedS
 	return next.SubmitBeaconBlock(ctx, block)
 }
 
+// SubmitBeaconBlockWithValidation submits a beacon block with broadcast validation.
+func (s *Erroring) SubmitBeaconBlockWithValidation(ctx context.Context,
+	block *spec.VersionedSignedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatedBeaconBlockSubmitter)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBeaconBlockWithValidation(ctx, block, validation)
+}
+
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Erroring) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -380,6 +453,24 @@ func (s *Erroring) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Vers
 	return next.SubmitBlindedBeaconBlock(ctx, block)
 }
 
+// SubmitBlindedBeaconBlockWithValidation submits a blinded beacon block with broadcast validation.
+func (s *Erroring) SubmitBlindedBeaconBlockWithValidation(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatedBlindedBeaconBlockSubmitter)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBlindedBeaconBlockWithValidation(ctx, block, validation)
+}
+
 // SubmitValidatorRegistrations submits a validator registration.
 func (s *Erroring) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -464,6 +555,42 @@ func (s *Erroring) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
 	return next.ForkSchedule(ctx)
 }
 
+// ForkAtEpoch provides the fork in effect at the given epoch, according to the fork schedule.
+func (s *Erroring) ForkAtEpoch(ctx context.Context, epoch phase0.Epoch) (*phase0.Fork, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ForkVersionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkAtEpoch(ctx, epoch)
+}
+
+// ForkVersionAtSlot provides the fork version in effect at the given slot, according to the fork schedule.
+func (s *Erroring) ForkVersionAtSlot(ctx context.Context, slot phase0.Slot) (phase0.Version, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return phase0.Version{}, err
+	}
+	next, isNext := s.next.(consensusclient.ForkVersionsProvider)
+	if !isNext {
+		return phase0.Version{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkVersionAtSlot(ctx, slot)
+}
+
+// DataVersionAtSlot provides the data version in effect at the given slot, according to the fork schedule.
+func (s *Erroring) DataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return spec.DataVersionPhase0, err
+	}
+	next, isNext := s.next.(consensusclient.ForkVersionsProvider)
+	if !isNext {
+		return spec.DataVersionPhase0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.DataVersionAtSlot(ctx, slot)
+}
+
 // Genesis fetches genesis information for the chain.
 func (s *Erroring) Genesis(ctx context.Context) (*apiv1.Genesis, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -562,6 +689,27 @@ func (s *Erroring) Spec(ctx context.Context) (map[string]interface{}, error) {
 	return next.Spec(ctx)
 }
 
+// ValidatorBalanceHistory provides the balances of a validator at the start of every step epochs from
+// fromEpoch to toEpoch inclusive.
+func (s *Erroring) ValidatorBalanceHistory(ctx context.Context,
+	index phase0.ValidatorIndex,
+	fromEpoch phase0.Epoch,
+	toEpoch phase0.Epoch,
+	step uint64,
+) (
+	*apiv1.ValidatorBalanceHistory,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorBalanceHistoryProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ValidatorBalanceHistory(ctx, index, fromEpoch, toEpoch, step)
+}
+
 // ValidatorBalances provides the validator balances for a given state.
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
@@ -667,6 +815,18 @@ func (s *Erroring) SignedBeaconBlock(ctx context.Context, blockID string) (*spec
 	return next.SignedBeaconBlock(ctx, blockID)
 }
 
+// SignedBeaconBlocks fetches signed beacon blocks given their roots.
+func (s *Erroring) SignedBeaconBlocks(ctx context.Context, roots []phase0.Root) (map[phase0.Root]*spec.VersionedSignedBeaconBlock, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SignedBeaconBlocksProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SignedBeaconBlocks(ctx, roots)
+}
+
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Erroring) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -228,6 +228,22 @@ func (s *Sleepy) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.
 	return next.BeaconBlockHeader(ctx, blockID)
 }
 
+// IndexedBeaconCommittees fetches the beacon committees matching the filter at the given state.
+func (s *Sleepy) IndexedBeaconCommittees(ctx context.Context,
+	stateID string,
+	filter *apiv1.BeaconCommitteesFilter,
+) (
+	*apiv1.BeaconCommittees,
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.IndexedBeaconCommitteesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.IndexedBeaconCommittees(ctx, stateID, filter)
+}
+
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Sleepy) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
 	s.sleep(ctx)
@@ -238,6 +254,16 @@ func (s *Sleepy) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, rand
 	return next.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
 }
 
+// Proposal fetches a proposal for signing.
+func (s *Sleepy) Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedProposal, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.Proposal(ctx, slot, randaoReveal, graffiti)
+}
+
 // SubmitBeaconBlock submits a beacon block.
 func (s *Sleepy) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
 	s.sleep(ctx)
@@ -248,6 +274,22 @@ func (s *Sleepy) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSig
 	return next.SubmitBeaconBlock(ctx, block)
 }
 
+// SubmitBeaconBlockWithValidation submits a beacon block with broadcast validation.
+func (s *Sleepy) SubmitBeaconBlockWithValidation(ctx context.Context,
+	block *spec.VersionedSignedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatedBeaconBlockSubmitter)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SubmitBeaconBlockWithValidation(ctx, block, validation)
+}
+
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Sleepy) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
 	s.sleep(ctx)
@@ -278,6 +320,22 @@ func (s *Sleepy) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Versio
 	return next.SubmitBlindedBeaconBlock(ctx, block)
 }
 
+// SubmitBlindedBeaconBlockWithValidation submits a blinded beacon block with broadcast validation.
+func (s *Sleepy) SubmitBlindedBeaconBlockWithValidation(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	validation apiv1.BroadcastValidation,
+) (
+	*apiv1.SubmissionReceipt,
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatedBlindedBeaconBlockSubmitter)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SubmitBlindedBeaconBlockWithValidation(ctx, block, validation)
+}
+
 // SubmitValidatorRegistrations submits a validator registration.
 func (s *Sleepy) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
 	s.sleep(ctx)
@@ -102,3 +102,19 @@ func TestSleep(t *testing.T) {
 		require.LessOrEqual(t, duration.Milliseconds(), (maxSleep + 50*time.Millisecond).Milliseconds())
 	}
 }
+
+func TestSleepyIndexedBeaconCommittees(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s, err := testclients.NewSleepy(ctx, time.Millisecond, 2*time.Millisecond, client)
+	require.NoError(t, err)
+
+	require.Implements(t, (*consensusclient.IndexedBeaconCommitteesProvider)(nil), s)
+	_, err = s.(consensusclient.IndexedBeaconCommitteesProvider).IndexedBeaconCommittees(ctx, "head", nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,363 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/attestations"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// attestationInclusionBuffer is the number of inclusions that can be queued
+// for a slow consumer before block processing blocks.
+const attestationInclusionBuffer = 256
+
+// AttestationInclusion is the inclusion of a validator's attestation in a block.
+type AttestationInclusion struct {
+	// ValidatorIndex is the index of the validator that attested.
+	ValidatorIndex phase0.ValidatorIndex
+	// Slot is the slot for which the validator attested.
+	Slot phase0.Slot
+	// InclusionSlot is the slot of the block that included the attestation.
+	InclusionSlot phase0.Slot
+	// InclusionBlockRoot is the root of the block that included the attestation.
+	InclusionBlockRoot phase0.Root
+	// InclusionDelay is the number of slots between the attestation slot and its inclusion.
+	InclusionDelay phase0.Slot
+	// CorrectHead is true if the attestation voted for the canonical block at its slot.
+	CorrectHead bool
+	// CorrectTarget is true if the attestation voted for the canonical checkpoint of its epoch.
+	CorrectTarget bool
+}
+
+// AttestationPerformanceTracker follows new blocks and reports when the attestations of
+// a set of validators are included.  Each validator's attestation for a slot is reported
+// once, for its first inclusion.
+type AttestationPerformanceTracker struct {
+	log                      zerolog.Logger
+	blockProvider            consensusclient.SignedBeaconBlockProvider
+	beaconCommitteesProvider consensusclient.BeaconCommitteesProvider
+	beaconBlockRootProvider  consensusclient.BeaconBlockRootProvider
+	slotsPerEpoch            uint64
+	validators               map[phase0.ValidatorIndex]struct{}
+	inclusions               chan *AttestationInclusion
+
+	// mu is held whilst processing a block, and protects the caches below.
+	mu         sync.Mutex
+	closed     bool
+	committees map[phase0.Epoch][]*apiv1.BeaconCommittee
+	roots      map[phase0.Slot]phase0.Root
+	reported   map[phase0.Slot]map[phase0.ValidatorIndex]struct{}
+}
+
+// NewAttestationPerformanceTracker creates a new attestation performance tracker for
+// the given validators.  The tracker follows block events until the supplied context
+// is done, at which point the inclusions channel is closed.
+func NewAttestationPerformanceTracker(ctx context.Context,
+	validators []phase0.ValidatorIndex,
+	params ...Parameter,
+) (
+	*AttestationPerformanceTracker,
+	error,
+) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+	if len(validators) == 0 {
+		return nil, errors.New("no validators specified")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "tracker").Str("impl", "attestationperformance").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	blockProvider, isProvider := parameters.client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+	beaconCommitteesProvider, isProvider := parameters.client.(consensusclient.BeaconCommitteesProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon committees")
+	}
+	beaconBlockRootProvider, isProvider := parameters.client.(consensusclient.BeaconBlockRootProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon block roots")
+	}
+	slotsPerEpochProvider, isProvider := parameters.client.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide slots per epoch")
+	}
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+
+	t := &AttestationPerformanceTracker{
+		log:                      log,
+		blockProvider:            blockProvider,
+		beaconCommitteesProvider: beaconCommitteesProvider,
+		beaconBlockRootProvider:  beaconBlockRootProvider,
+		slotsPerEpoch:            slotsPerEpoch,
+		validators:               make(map[phase0.ValidatorIndex]struct{}, len(validators)),
+		inclusions:               make(chan *AttestationInclusion, attestationInclusionBuffer),
+		committees:               make(map[phase0.Epoch][]*apiv1.BeaconCommittee),
+		roots:                    make(map[phase0.Slot]phase0.Root),
+		reported:                 make(map[phase0.Slot]map[phase0.ValidatorIndex]struct{}),
+	}
+	for _, validator := range validators {
+		t.validators[validator] = struct{}{}
+	}
+
+	if err := eventsProvider.Events(ctx, []string{"block"}, func(event *apiv1.Event) {
+		t.handleEvent(ctx, event)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to block events")
+	}
+
+	go func() {
+		<-ctx.Done()
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.closed = true
+		close(t.inclusions)
+	}()
+
+	return t, nil
+}
+
+// Inclusions provides a channel of attestation inclusions.
+func (t *AttestationPerformanceTracker) Inclusions() <-chan *AttestationInclusion {
+	return t.inclusions
+}
+
+func (t *AttestationPerformanceTracker) handleEvent(ctx context.Context, event *apiv1.Event) {
+	if event == nil || event.Data == nil {
+		return
+	}
+	blockEvent, isBlockEvent := event.Data.(*apiv1.BlockEvent)
+	if !isBlockEvent {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+
+	if err := t.processBlock(ctx, blockEvent.Block); err != nil {
+		t.log.Warn().Err(err).Str("block_root", fmt.Sprintf("%#x", blockEvent.Block)).Msg("Failed to process block")
+	}
+}
+
+// processBlock reports the inclusions in the given block; the caller must hold the lock.
+func (t *AttestationPerformanceTracker) processBlock(ctx context.Context, root phase0.Root) error {
+	block, err := t.blockProvider.SignedBeaconBlock(ctx, fmt.Sprintf("%#x", root))
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block")
+	}
+	if block == nil {
+		return errors.New("no block returned")
+	}
+	slot, err := block.Slot()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block slot")
+	}
+	blockAttestations, err := block.Attestations()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block attestations")
+	}
+
+	for _, attestation := range blockAttestations {
+		if attestation == nil || attestation.Data == nil || attestation.Data.Target == nil {
+			continue
+		}
+		if err := t.processAttestation(ctx, slot, root, attestation); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// A single bad attestation should not stop the rest of the block being reported.
+			t.log.Warn().Err(err).Uint64("slot", uint64(slot)).Uint64("attestation_slot", uint64(attestation.Data.Slot)).Msg("Failed to process attestation")
+			continue
+		}
+	}
+
+	t.prune(slot)
+
+	return nil
+}
+
+func (t *AttestationPerformanceTracker) processAttestation(ctx context.Context,
+	slot phase0.Slot,
+	root phase0.Root,
+	attestation *phase0.Attestation,
+) error {
+	committees, err := t.committeesForSlot(ctx, attestation.Data.Slot)
+	if err != nil {
+		return err
+	}
+	participants, err := attestations.Participants(attestation, committees)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain attestation participants")
+	}
+
+	var inclusion *AttestationInclusion
+	for _, participant := range participants {
+		if _, tracked := t.validators[participant]; !tracked {
+			continue
+		}
+		reported, exists := t.reported[attestation.Data.Slot]
+		if !exists {
+			reported = make(map[phase0.ValidatorIndex]struct{})
+			t.reported[attestation.Data.Slot] = reported
+		}
+		if _, isReported := reported[participant]; isReported {
+			continue
+		}
+
+		if inclusion == nil {
+			// Correctness is common to all participants, so only calculate it once.
+			inclusion, err = t.inclusion(ctx, slot, root, attestation.Data)
+			if err != nil {
+				return err
+			}
+		}
+		res := *inclusion
+		res.ValidatorIndex = participant
+
+		select {
+		case t.inclusions <- &res:
+			reported[participant] = struct{}{}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// inclusion provides the inclusion information common to all participants of an attestation.
+func (t *AttestationPerformanceTracker) inclusion(ctx context.Context,
+	slot phase0.Slot,
+	root phase0.Root,
+	data *phase0.AttestationData,
+) (
+	*AttestationInclusion,
+	error,
+) {
+	headRoot, err := t.canonicalRoot(ctx, data.Slot)
+	if err != nil {
+		return nil, err
+	}
+	targetRoot, err := t.canonicalRoot(ctx, phase0.Slot(uint64(data.Target.Epoch)*t.slotsPerEpoch))
+	if err != nil {
+		return nil, err
+	}
+
+	inclusion := &AttestationInclusion{
+		Slot:               data.Slot,
+		InclusionSlot:      slot,
+		InclusionBlockRoot: root,
+		CorrectHead:        data.BeaconBlockRoot == headRoot,
+		CorrectTarget:      data.Target.Root == targetRoot,
+	}
+	if slot > data.Slot {
+		inclusion.InclusionDelay = slot - data.Slot
+	}
+
+	return inclusion, nil
+}
+
+// committeesForSlot provides the committees for the epoch of the given slot.
+func (t *AttestationPerformanceTracker) committeesForSlot(ctx context.Context, slot phase0.Slot) ([]*apiv1.BeaconCommittee, error) {
+	epoch := phase0.Epoch(uint64(slot) / t.slotsPerEpoch)
+	if committees, exists := t.committees[epoch]; exists {
+		return committees, nil
+	}
+
+	committees, err := t.beaconCommitteesProvider.BeaconCommittees(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon committees")
+	}
+	t.committees[epoch] = committees
+
+	return committees, nil
+}
+
+// canonicalRoot provides the root of the canonical block at the given slot, or of the
+// most recent block prior to it if the slot is empty.
+func (t *AttestationPerformanceTracker) canonicalRoot(ctx context.Context, slot phase0.Slot) (phase0.Root, error) {
+	if root, exists := t.roots[slot]; exists {
+		return root, nil
+	}
+
+	for s := slot; ; s-- {
+		root, err := t.beaconBlockRootProvider.BeaconBlockRoot(ctx, fmt.Sprintf("%d", s))
+		if err != nil {
+			return phase0.Root{}, errors.Wrap(err, "failed to obtain beacon block root")
+		}
+		if root != nil {
+			t.roots[slot] = *root
+			return *root, nil
+		}
+		if s == 0 || slot-s >= phase0.Slot(t.slotsPerEpoch) {
+			return phase0.Root{}, fmt.Errorf("no block found for slot %d", slot)
+		}
+	}
+}
+
+// prune removes cached information that is no longer required.  Attestations are
+// considered for inclusion for up to two epochs.
+func (t *AttestationPerformanceTracker) prune(slot phase0.Slot) {
+	window := phase0.Slot(2 * t.slotsPerEpoch)
+	if slot < window {
+		return
+	}
+	minSlot := slot - window
+	minEpoch := phase0.Epoch(uint64(minSlot) / t.slotsPerEpoch)
+
+	for s := range t.reported {
+		if s < minSlot {
+			delete(t.reported, s)
+		}
+	}
+	for s := range t.roots {
+		if s < minSlot {
+			delete(t.roots, s)
+		}
+	}
+	for epoch := range t.committees {
+		if epoch < minEpoch {
+			delete(t.committees, epoch)
+		}
+	}
+}
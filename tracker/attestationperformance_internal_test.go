@@ -0,0 +1,250 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// performanceClient is a client with a set of blocks and a canonical chain.
+type performanceClient struct {
+	mu      sync.Mutex
+	handler consensusclient.EventHandlerFunc
+	blocks  map[string]*spec.VersionedSignedBeaconBlock
+	roots   map[phase0.Slot]phase0.Root
+}
+
+func (c *performanceClient) Name() string    { return "performance" }
+func (c *performanceClient) Address() string { return "performance" }
+
+func (c *performanceClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handler = handler
+
+	return nil
+}
+
+func (c *performanceClient) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.blocks[blockID], nil
+}
+
+func (c *performanceClient) BeaconBlockRoot(_ context.Context, blockID string) (*phase0.Root, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for slot, root := range c.roots {
+		if fmt.Sprintf("%d", slot) == blockID {
+			res := root
+			return &res, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *performanceClient) BeaconCommittees(_ context.Context, _ string) ([]*apiv1.BeaconCommittee, error) {
+	committees := make([]*apiv1.BeaconCommittee, 0)
+	for slot := phase0.Slot(0); slot < 8; slot++ {
+		committees = append(committees, &apiv1.BeaconCommittee{
+			Slot:       slot,
+			Index:      0,
+			Validators: []phase0.ValidatorIndex{phase0.ValidatorIndex(slot * 10), phase0.ValidatorIndex(slot*10 + 1)},
+		})
+	}
+
+	return committees, nil
+}
+
+func (c *performanceClient) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, _ phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	return c.BeaconCommittees(ctx, stateID)
+}
+
+func (c *performanceClient) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 8, nil
+}
+
+// addBlock adds a block with the given attestations, returning its root.
+func (c *performanceClient) addBlock(slot phase0.Slot, attestations []*phase0.Attestation) phase0.Root {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: slot,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      attestations,
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+				},
+			},
+		},
+	}
+	root, _ := block.Root()
+	c.blocks[fmt.Sprintf("%#x", root)] = block
+	c.roots[slot] = root
+
+	return root
+}
+
+func (c *performanceClient) notifyBlock(root phase0.Root) {
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	handler(&apiv1.Event{Topic: "block", Data: &apiv1.BlockEvent{Block: root}})
+}
+
+func performanceAttestation(slot phase0.Slot, head phase0.Root, target phase0.Root, bits ...uint64) *phase0.Attestation {
+	aggregationBits := bitfield.NewBitlist(2)
+	for _, bit := range bits {
+		aggregationBits.SetBitAt(bit, true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &phase0.AttestationData{
+			Slot:            slot,
+			BeaconBlockRoot: head,
+			Source:          &phase0.Checkpoint{},
+			Target:          &phase0.Checkpoint{Root: target},
+		},
+	}
+}
+
+func TestAttestationPerformanceTracker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &performanceClient{
+		blocks: make(map[string]*spec.VersionedSignedBeaconBlock),
+		roots:  make(map[phase0.Slot]phase0.Root),
+	}
+	genesisRoot := client.addBlock(0, []*phase0.Attestation{})
+	slot1Root := client.addBlock(1, []*phase0.Attestation{})
+
+	tracker, err := NewAttestationPerformanceTracker(ctx,
+		[]phase0.ValidatorIndex{10, 21},
+		WithLogLevel(zerolog.Disabled),
+		WithClient(client),
+	)
+	require.NoError(t, err)
+
+	// Slot 2 is empty, so the correct head vote for slot 2 is the block at slot 1.
+	root := client.addBlock(3, []*phase0.Attestation{
+		// Validators 10 and 11, correct.
+		performanceAttestation(1, slot1Root, genesisRoot, 0, 1),
+		// Validator 21, wrong head.
+		performanceAttestation(2, genesisRoot, genesisRoot, 1),
+	})
+	client.notifyBlock(root)
+
+	inclusion := <-tracker.Inclusions()
+	require.Equal(t, &AttestationInclusion{
+		ValidatorIndex:     10,
+		Slot:               1,
+		InclusionSlot:      3,
+		InclusionBlockRoot: root,
+		InclusionDelay:     2,
+		CorrectHead:        true,
+		CorrectTarget:      true,
+	}, inclusion)
+	inclusion = <-tracker.Inclusions()
+	require.Equal(t, phase0.ValidatorIndex(21), inclusion.ValidatorIndex)
+	require.Equal(t, phase0.Slot(1), inclusion.InclusionDelay)
+	require.False(t, inclusion.CorrectHead)
+	require.True(t, inclusion.CorrectTarget)
+
+	// A later inclusion of an attestation already reported is ignored.
+	root = client.addBlock(4, []*phase0.Attestation{
+		performanceAttestation(1, slot1Root, genesisRoot, 0),
+	})
+	client.notifyBlock(root)
+
+	cancel()
+	select {
+	case inclusion, ok := <-tracker.Inclusions():
+		require.False(t, ok, "unexpected inclusion %v", inclusion)
+	case <-time.After(time.Second):
+		require.Fail(t, "inclusions channel not closed")
+	}
+}
+
+func TestAttestationPerformanceTrackerBadAttestation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &performanceClient{
+		blocks: make(map[string]*spec.VersionedSignedBeaconBlock),
+		roots:  make(map[phase0.Slot]phase0.Root),
+	}
+	genesisRoot := client.addBlock(0, []*phase0.Attestation{})
+	slot1Root := client.addBlock(1, []*phase0.Attestation{})
+
+	tracker, err := NewAttestationPerformanceTracker(ctx,
+		[]phase0.ValidatorIndex{10},
+		WithLogLevel(zerolog.Disabled),
+		WithClient(client),
+	)
+	require.NoError(t, err)
+
+	// The first attestation is for a committee that does not exist, but does not
+	// prevent the second from being reported.
+	bad := performanceAttestation(1, slot1Root, genesisRoot, 0)
+	bad.Data.Index = 5
+	root := client.addBlock(2, []*phase0.Attestation{
+		bad,
+		performanceAttestation(1, slot1Root, genesisRoot, 0),
+	})
+	client.notifyBlock(root)
+
+	select {
+	case inclusion := <-tracker.Inclusions():
+		require.Equal(t, phase0.ValidatorIndex(10), inclusion.ValidatorIndex)
+		require.Equal(t, phase0.Slot(2), inclusion.InclusionSlot)
+	case <-time.After(time.Second):
+		require.Fail(t, "inclusion not reported")
+	}
+}
+
+func TestNewAttestationPerformanceTracker(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewAttestationPerformanceTracker(ctx, []phase0.ValidatorIndex{1})
+	require.EqualError(t, err, "problem with parameters: no client specified")
+
+	_, err = NewAttestationPerformanceTracker(ctx, nil, WithClient(&performanceClient{}))
+	require.EqualError(t, err, "no validators specified")
+
+	_, err = NewAttestationPerformanceTracker(ctx, []phase0.ValidatorIndex{1}, WithClient(&exitClient{}))
+	require.EqualError(t, err, "client does not provide beacon committees")
+}
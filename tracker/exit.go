@@ -0,0 +1,256 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// ExitConfirmation is the confirmation that a voluntary exit has been included on chain.
+type ExitConfirmation struct {
+	// Slot is the slot of the block that included the exit.
+	Slot phase0.Slot
+	// BlockRoot is the root of the block that included the exit.
+	BlockRoot phase0.Root
+	// State is the state of the validator after the block that included the exit.
+	State apiv1.ValidatorState
+}
+
+// ExitTracker submits voluntary exits and tracks them until they are included on chain.
+type ExitTracker struct {
+	log                    zerolog.Logger
+	voluntaryExitSubmitter consensusclient.VoluntaryExitSubmitter
+	eventsProvider         consensusclient.EventsProvider
+	blockProvider          consensusclient.SignedBeaconBlockProvider
+	validatorsProvider     consensusclient.ValidatorsProvider
+}
+
+// NewExitTracker creates a new exit tracker.
+func NewExitTracker(_ context.Context, params ...Parameter) (*ExitTracker, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "tracker").Str("impl", "exit").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	voluntaryExitSubmitter, isProvider := parameters.client.(consensusclient.VoluntaryExitSubmitter)
+	if !isProvider {
+		return nil, errors.New("client does not submit voluntary exits")
+	}
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	blockProvider, isProvider := parameters.client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+	validatorsProvider, isProvider := parameters.client.(consensusclient.ValidatorsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide validators")
+	}
+
+	return &ExitTracker{
+		log:                    log,
+		voluntaryExitSubmitter: voluntaryExitSubmitter,
+		eventsProvider:         eventsProvider,
+		blockProvider:          blockProvider,
+		validatorsProvider:     validatorsProvider,
+	}, nil
+}
+
+// SubmitVoluntaryExitAndWait submits a voluntary exit and waits for it to be included
+// in a block on the canonical chain, confirming the inclusion by the exit state
+// of the validator.  It returns when the exit is confirmed, or the context is done.
+func (t *ExitTracker) SubmitVoluntaryExitAndWait(ctx context.Context,
+	voluntaryExit *phase0.SignedVoluntaryExit,
+) (
+	*ExitConfirmation,
+	error,
+) {
+	if voluntaryExit == nil || voluntaryExit.Message == nil {
+		return nil, errors.New("no voluntary exit supplied")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Start listening for head events before submitting, to avoid missing the
+	// block that includes the exit.  Only the fact that the head has changed is
+	// of interest, as each update walks back through the chain.
+	headUpdated := make(chan struct{}, 1)
+	if err := t.eventsProvider.Events(ctx, []string{"head"}, func(event *apiv1.Event) {
+		select {
+		case headUpdated <- struct{}{}:
+		default:
+		}
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to head events")
+	}
+
+	startBlock, err := t.blockProvider.SignedBeaconBlock(ctx, "head")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain head block")
+	}
+	if startBlock == nil {
+		return nil, errors.New("no head block returned")
+	}
+	startSlot, err := startBlock.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain head slot")
+	}
+
+	if err := t.voluntaryExitSubmitter.SubmitVoluntaryExit(ctx, voluntaryExit); err != nil {
+		return nil, errors.Wrap(err, "failed to submit voluntary exit")
+	}
+	t.log.Trace().Uint64("validator_index", uint64(voluntaryExit.Message.ValidatorIndex)).Msg("Voluntary exit submitted; waiting for inclusion")
+
+	// Blocks that have been checked and do not contain the exit.
+	checked := make(map[phase0.Root]bool)
+	for {
+		select {
+		case <-headUpdated:
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "voluntary exit not confirmed")
+		}
+
+		confirmation, err := t.checkChain(ctx, voluntaryExit, startSlot, checked)
+		if err != nil {
+			t.log.Debug().Err(err).Msg("Failed to check chain for voluntary exit")
+			continue
+		}
+		if confirmation != nil {
+			return confirmation, nil
+		}
+	}
+}
+
+// checkChain walks back from the head of the chain to the start slot looking for
+// a block that includes the voluntary exit, returning a confirmation if found.
+func (t *ExitTracker) checkChain(ctx context.Context,
+	voluntaryExit *phase0.SignedVoluntaryExit,
+	startSlot phase0.Slot,
+	checked map[phase0.Root]bool,
+) (
+	*ExitConfirmation,
+	error,
+) {
+	blockID := "head"
+	for {
+		block, err := t.blockProvider.SignedBeaconBlock(ctx, blockID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain block")
+		}
+		if block == nil {
+			return nil, fmt.Errorf("block %s not returned", blockID)
+		}
+		slot, err := block.Slot()
+		if err != nil {
+			return nil, err
+		}
+		if slot <= startSlot {
+			// Reached the start of the chain that we are interested in.
+			return nil, nil
+		}
+		root, err := block.Root()
+		if err != nil {
+			return nil, err
+		}
+		if checked[root] {
+			// This block and its ancestors have already been checked.
+			return nil, nil
+		}
+
+		included, err := blockIncludesExit(block, voluntaryExit)
+		if err != nil {
+			return nil, err
+		}
+		if included {
+			return t.confirm(ctx, voluntaryExit, slot, root)
+		}
+
+		parentRoot, err := block.ParentRoot()
+		if err != nil {
+			return nil, err
+		}
+		// Only mark the block as checked once we know that its parent can be walked to.
+		checked[root] = true
+		blockID = fmt.Sprintf("%#x", parentRoot)
+	}
+}
+
+// confirm confirms the exit by the state of the validator after the including block.
+func (t *ExitTracker) confirm(ctx context.Context,
+	voluntaryExit *phase0.SignedVoluntaryExit,
+	slot phase0.Slot,
+	root phase0.Root,
+) (
+	*ExitConfirmation,
+	error,
+) {
+	validatorIndex := voluntaryExit.Message.ValidatorIndex
+	validators, err := t.validatorsProvider.Validators(ctx, fmt.Sprintf("%d", slot), []phase0.ValidatorIndex{validatorIndex})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validator")
+	}
+	validator, exists := validators[validatorIndex]
+	if !exists || validator == nil {
+		return nil, fmt.Errorf("validator %d not returned", validatorIndex)
+	}
+	if validator.Status != apiv1.ValidatorStateActiveExiting && !validator.Status.HasExited() {
+		return nil, fmt.Errorf("validator %d included exit at slot %d but has state %v", validatorIndex, slot, validator.Status)
+	}
+
+	t.log.Trace().Uint64("validator_index", uint64(validatorIndex)).Uint64("slot", uint64(slot)).Msg("Voluntary exit confirmed")
+
+	return &ExitConfirmation{
+		Slot:      slot,
+		BlockRoot: root,
+		State:     validator.Status,
+	}, nil
+}
+
+// blockIncludesExit returns true if the block includes the voluntary exit.
+func blockIncludesExit(block *spec.VersionedSignedBeaconBlock, voluntaryExit *phase0.SignedVoluntaryExit) (bool, error) {
+	exits, err := block.VoluntaryExits()
+	if err != nil {
+		return false, err
+	}
+	for _, exit := range exits {
+		if exit == nil || exit.Message == nil {
+			continue
+		}
+		if exit.Message.ValidatorIndex == voluntaryExit.Message.ValidatorIndex &&
+			exit.Message.Epoch == voluntaryExit.Message.Epoch &&
+			exit.Signature == voluntaryExit.Signature {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
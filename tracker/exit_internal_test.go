@@ -0,0 +1,238 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// exitClient is a client with a simple chain of phase0 blocks.
+type exitClient struct {
+	mu        sync.Mutex
+	handler   consensusclient.EventHandlerFunc
+	blocks    map[string]*spec.VersionedSignedBeaconBlock
+	head      *spec.VersionedSignedBeaconBlock
+	submitted chan *phase0.SignedVoluntaryExit
+	state     apiv1.ValidatorState
+}
+
+func newExitClient() *exitClient {
+	c := &exitClient{
+		blocks:    make(map[string]*spec.VersionedSignedBeaconBlock),
+		submitted: make(chan *phase0.SignedVoluntaryExit, 1),
+		state:     apiv1.ValidatorStateActiveExiting,
+	}
+	c.addBlock(100, nil)
+
+	return c
+}
+
+// addBlock adds a block to the head of the chain, returning its root.
+func (c *exitClient) addBlock(slot phase0.Slot, exits []*phase0.SignedVoluntaryExit) phase0.Root {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	parentRoot := phase0.Root{}
+	if c.head != nil {
+		parentRoot, _ = c.head.Root()
+	}
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:       slot,
+				ParentRoot: parentRoot,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      []*phase0.Attestation{},
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    exits,
+				},
+			},
+		},
+	}
+	if exits == nil {
+		block.Phase0.Message.Body.VoluntaryExits = []*phase0.SignedVoluntaryExit{}
+	}
+	root, _ := block.Root()
+	c.blocks[fmt.Sprintf("%#x", root)] = block
+	c.head = block
+
+	return root
+}
+
+func (c *exitClient) notifyHead() {
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+}
+
+func (c *exitClient) Name() string    { return "exit" }
+func (c *exitClient) Address() string { return "exit" }
+
+func (c *exitClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handler = handler
+
+	return nil
+}
+
+func (c *exitClient) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if blockID == "head" {
+		return c.head, nil
+	}
+
+	return c.blocks[blockID], nil
+}
+
+func (c *exitClient) SubmitVoluntaryExit(_ context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
+	c.submitted <- voluntaryExit
+	return nil
+}
+
+func (c *exitClient) Validators(_ context.Context, _ string, indices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	for _, index := range indices {
+		res[index] = &apiv1.Validator{Index: index, Status: c.state}
+	}
+
+	return res, nil
+}
+
+func (c *exitClient) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	return nil, nil
+}
+
+func TestSubmitVoluntaryExitAndWait(t *testing.T) {
+	ctx := context.Background()
+
+	client := newExitClient()
+	exitTracker, err := NewExitTracker(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClient(client),
+	)
+	require.NoError(t, err)
+
+	exit := &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			Epoch:          10,
+			ValidatorIndex: 5,
+		},
+		Signature: phase0.BLSSignature{0x01},
+	}
+	otherExit := &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			Epoch:          10,
+			ValidatorIndex: 6,
+		},
+	}
+
+	type result struct {
+		confirmation *ExitConfirmation
+		err          error
+	}
+	done := make(chan result)
+	go func() {
+		confirmation, err := exitTracker.SubmitVoluntaryExitAndWait(ctx, exit)
+		done <- result{confirmation, err}
+	}()
+	<-client.submitted
+
+	// A block without the exit should not confirm.
+	client.addBlock(101, []*phase0.SignedVoluntaryExit{otherExit})
+	client.notifyHead()
+	// The exit is included in a block whose head event is missed.
+	root := client.addBlock(102, []*phase0.SignedVoluntaryExit{otherExit, exit})
+	client.addBlock(103, nil)
+	client.notifyHead()
+
+	select {
+	case res := <-done:
+		require.NoError(t, res.err)
+		require.Equal(t, &ExitConfirmation{
+			Slot:      102,
+			BlockRoot: root,
+			State:     apiv1.ValidatorStateActiveExiting,
+		}, res.confirmation)
+	case <-time.After(time.Second):
+		require.Fail(t, "exit not confirmed")
+	}
+}
+
+func TestSubmitVoluntaryExitAndWaitTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	client := newExitClient()
+	// The validator state does not reflect the exit, so it cannot be confirmed.
+	client.state = apiv1.ValidatorStateActiveOngoing
+	exitTracker, err := NewExitTracker(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClient(client),
+	)
+	require.NoError(t, err)
+
+	exit := &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			ValidatorIndex: 5,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	go func() {
+		<-client.submitted
+		client.addBlock(101, []*phase0.SignedVoluntaryExit{exit})
+		client.notifyHead()
+	}()
+	_, err = exitTracker.SubmitVoluntaryExitAndWait(ctx, exit)
+	require.EqualError(t, err, "voluntary exit not confirmed: context deadline exceeded")
+
+	_, err = exitTracker.SubmitVoluntaryExitAndWait(ctx, nil)
+	require.EqualError(t, err, "no voluntary exit supplied")
+}
+
+func TestNewExitTracker(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewExitTracker(ctx, WithLogLevel(zerolog.Disabled))
+	require.EqualError(t, err, "problem with parameters: no client specified")
+
+	_, err = NewExitTracker(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClient(&nonExitClient{}),
+	)
+	require.EqualError(t, err, "client does not submit voluntary exits")
+}
+
+type nonExitClient struct{}
+
+func (c *nonExitClient) Name() string    { return "non-exit" }
+func (c *nonExitClient) Address() string { return "non-exit" }
@@ -0,0 +1,198 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// FinalityTracker maintains the latest finality checkpoints of the chain.
+type FinalityTracker struct {
+	log              zerolog.Logger
+	finalityProvider consensusclient.FinalityProvider
+
+	finalityMu sync.RWMutex
+	finality   *apiv1.Finality
+	// updated is closed, and replaced, whenever finality is updated.
+	updated chan struct{}
+}
+
+// NewFinalityTracker creates a new finality tracker.
+// The tracker obtains the current finality from the client and then keeps
+// it up to date by listening to finalized checkpoint events, until the
+// supplied context is done.
+func NewFinalityTracker(ctx context.Context, params ...Parameter) (*FinalityTracker, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "tracker").Str("impl", "finality").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	finalityProvider, isProvider := parameters.client.(consensusclient.FinalityProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide finality")
+	}
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+
+	t := &FinalityTracker{
+		log:              log,
+		finalityProvider: finalityProvider,
+		updated:          make(chan struct{}),
+	}
+
+	// Start listening for events before fetching the initial finality, to
+	// avoid missing an update between the two.
+	if err := eventsProvider.Events(ctx, []string{"finalized_checkpoint"}, func(event *apiv1.Event) {
+		t.handleEvent(ctx, event)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to finalized checkpoint events")
+	}
+
+	finality, err := finalityProvider.Finality(ctx, "head")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain initial finality")
+	}
+	if finality == nil {
+		return nil, errors.New("no initial finality returned")
+	}
+	t.update(finality)
+
+	return t, nil
+}
+
+// Finality returns the latest known finality.
+func (t *FinalityTracker) Finality() *apiv1.Finality {
+	t.finalityMu.RLock()
+	defer t.finalityMu.RUnlock()
+
+	return t.finality
+}
+
+// FinalizedCheckpoint returns the latest known finalized checkpoint.
+func (t *FinalityTracker) FinalizedCheckpoint() *phase0.Checkpoint {
+	t.finalityMu.RLock()
+	defer t.finalityMu.RUnlock()
+
+	if t.finality == nil {
+		return nil
+	}
+	return t.finality.Finalized
+}
+
+// JustifiedCheckpoint returns the latest known justified checkpoint.
+func (t *FinalityTracker) JustifiedCheckpoint() *phase0.Checkpoint {
+	t.finalityMu.RLock()
+	defer t.finalityMu.RUnlock()
+
+	if t.finality == nil {
+		return nil
+	}
+	return t.finality.Justified
+}
+
+// WaitForFinalizedEpoch blocks until the given epoch is finalized, or the context is done.
+func (t *FinalityTracker) WaitForFinalizedEpoch(ctx context.Context, epoch phase0.Epoch) error {
+	for {
+		t.finalityMu.RLock()
+		finalized := t.finality != nil && t.finality.Finalized != nil && t.finality.Finalized.Epoch >= epoch
+		updated := t.updated
+		t.finalityMu.RUnlock()
+
+		if finalized {
+			return nil
+		}
+
+		select {
+		case <-updated:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// handleEvent handles finalized checkpoint events.
+func (t *FinalityTracker) handleEvent(ctx context.Context, event *apiv1.Event) {
+	if event == nil || event.Data == nil {
+		return
+	}
+	data, isFinalizedCheckpoint := event.Data.(*apiv1.FinalizedCheckpointEvent)
+	if !isFinalizedCheckpoint {
+		t.log.Debug().Str("topic", event.Topic).Msg("Unexpected event; ignoring")
+		return
+	}
+
+	current := t.FinalizedCheckpoint()
+	if current != nil && current.Epoch >= data.Epoch {
+		t.log.Trace().Uint64("epoch", uint64(data.Epoch)).Msg("Finalized checkpoint not newer than current; ignoring")
+		return
+	}
+
+	// The event does not contain justification information, so refetch the full finality.
+	finality, err := t.finalityProvider.Finality(ctx, "head")
+	if err == nil && finality != nil && finality.Finalized != nil && finality.Finalized.Epoch >= data.Epoch {
+		t.update(finality)
+		return
+	}
+	if err != nil {
+		t.log.Warn().Err(err).Msg("Failed to obtain finality; updating finalized checkpoint from event only")
+	}
+
+	// Fall back to updating only the finalized checkpoint.
+	t.finalityMu.RLock()
+	finality = &apiv1.Finality{}
+	if t.finality != nil {
+		*finality = *t.finality
+	}
+	t.finalityMu.RUnlock()
+	finality.Finalized = &phase0.Checkpoint{
+		Epoch: data.Epoch,
+		Root:  data.Block,
+	}
+	t.update(finality)
+}
+
+// update updates the finality and notifies any waiters.
+func (t *FinalityTracker) update(finality *apiv1.Finality) {
+	t.finalityMu.Lock()
+	defer t.finalityMu.Unlock()
+
+	if t.finality != nil && t.finality.Finalized != nil &&
+		finality.Finalized != nil && finality.Finalized.Epoch < t.finality.Finalized.Epoch {
+		// Do not go backwards.
+		return
+	}
+	t.finality = finality
+	close(t.updated)
+	t.updated = make(chan struct{})
+
+	if finality.Finalized != nil {
+		t.log.Trace().Uint64("finalized_epoch", uint64(finality.Finalized.Epoch)).Msg("Finality updated")
+	}
+}
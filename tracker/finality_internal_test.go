@@ -0,0 +1,73 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalityTrackerEvents(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	finalityTracker, err := NewFinalityTracker(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClient(mockClient),
+	)
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- finalityTracker.WaitForFinalizedEpoch(ctx, 8)
+	}()
+
+	// Event with an old epoch should be ignored.
+	finalityTracker.handleEvent(ctx, &apiv1.Event{
+		Topic: "finalized_checkpoint",
+		Data: &apiv1.FinalizedCheckpointEvent{
+			Epoch: 5,
+		},
+	})
+	require.Equal(t, phase0.Epoch(6), finalityTracker.FinalizedCheckpoint().Epoch)
+
+	// Event with a newer epoch should update the finalized checkpoint.
+	finalityTracker.handleEvent(ctx, &apiv1.Event{
+		Topic: "finalized_checkpoint",
+		Data: &apiv1.FinalizedCheckpointEvent{
+			Block: phase0.Root{0x01},
+			Epoch: 8,
+		},
+	})
+	require.Equal(t, phase0.Epoch(8), finalityTracker.FinalizedCheckpoint().Epoch)
+	require.Equal(t, phase0.Root{0x01}, finalityTracker.FinalizedCheckpoint().Root)
+	// Justified checkpoint should be retained.
+	require.Equal(t, phase0.Epoch(7), finalityTracker.JustifiedCheckpoint().Epoch)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "wait did not complete")
+	}
+}
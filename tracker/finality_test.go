@@ -0,0 +1,99 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/tracker"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type nonFinalityClient struct{}
+
+func (c *nonFinalityClient) Name() string    { return "non-finality" }
+func (c *nonFinalityClient) Address() string { return "non-finality" }
+
+func TestNewFinalityTracker(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []tracker.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "ClientNotFinalityProvider",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+				tracker.WithClient(&nonFinalityClient{}),
+			},
+			err: "client does not provide finality",
+		},
+		{
+			name: "Good",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+				tracker.WithClient(mockClient),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := tracker.NewFinalityTracker(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWaitForFinalizedEpoch(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	finalityTracker, err := tracker.NewFinalityTracker(ctx,
+		tracker.WithLogLevel(zerolog.Disabled),
+		tracker.WithClient(mockClient),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, finalityTracker.Finality())
+
+	// Mock finalized epoch is 6, so this should return immediately.
+	require.NoError(t, finalityTracker.WaitForFinalizedEpoch(ctx, 6))
+
+	// Epoch 7 is not finalized, so this should time out.
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.EqualError(t, finalityTracker.WaitForFinalizedEpoch(waitCtx, 7), context.DeadlineExceeded.Error())
+}
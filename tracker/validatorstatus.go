@@ -0,0 +1,302 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// validatorTransitionBuffer is the number of transitions that can be queued
+// for a slow consumer before polling blocks.
+const validatorTransitionBuffer = 256
+
+// ValidatorTransitionType is the type of a validator status transition.
+type ValidatorTransitionType int
+
+const (
+	// ValidatorTransitionUnknown is an unknown transition.
+	ValidatorTransitionUnknown ValidatorTransitionType = iota
+	// ValidatorTransitionQueued is a validator joining the activation queue.
+	ValidatorTransitionQueued
+	// ValidatorTransitionActivated is a validator becoming active.
+	ValidatorTransitionActivated
+	// ValidatorTransitionExiting is a validator initiating its exit.
+	ValidatorTransitionExiting
+	// ValidatorTransitionSlashed is a validator being slashed.
+	ValidatorTransitionSlashed
+	// ValidatorTransitionExited is a validator exiting.
+	ValidatorTransitionExited
+	// ValidatorTransitionWithdrawable is a validator's balance becoming withdrawable.
+	ValidatorTransitionWithdrawable
+	// ValidatorTransitionWithdrawn is a validator's balance being withdrawn.
+	ValidatorTransitionWithdrawn
+)
+
+var validatorTransitionTypeStrings = [...]string{
+	"unknown",
+	"queued",
+	"activated",
+	"exiting",
+	"slashed",
+	"exited",
+	"withdrawable",
+	"withdrawn",
+}
+
+// String returns a string representation of the transition type.
+func (t ValidatorTransitionType) String() string {
+	if int(t) < 0 || int(t) >= len(validatorTransitionTypeStrings) {
+		return "unknown"
+	}
+
+	return validatorTransitionTypeStrings[t]
+}
+
+// ValidatorTransition is a change in the status of a validator.
+// If a validator passes through multiple states between polls only a single transition
+// is reported, with its type determined by the new state.
+type ValidatorTransition struct {
+	// Type is the type of the transition.
+	Type ValidatorTransitionType
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Epoch is the epoch at which the transition was observed.
+	Epoch phase0.Epoch
+	// From is the state of the validator prior to the transition.
+	From apiv1.ValidatorState
+	// To is the state of the validator after the transition.
+	To apiv1.ValidatorState
+}
+
+// ValidatorStatusWatcher watches a set of validators and reports changes in their status.
+// It polls the validators on head events that cross an epoch boundary, every poll interval
+// epochs.
+type ValidatorStatusWatcher struct {
+	log                zerolog.Logger
+	validatorsProvider consensusclient.ValidatorsProvider
+	slotsPerEpoch      uint64
+	pollInterval       uint64
+	indices            []phase0.ValidatorIndex
+	transitions        chan *ValidatorTransition
+
+	// mu is held whilst polling, and protects the fields below.
+	mu         sync.Mutex
+	closed     bool
+	lastPolled phase0.Epoch
+	states     map[phase0.ValidatorIndex]apiv1.ValidatorState
+}
+
+// NewValidatorStatusWatcher creates a new validator status watcher for the given validators.
+// The watcher obtains the current status of the validators and then reports transitions
+// until the supplied context is done, at which point the transitions channel is closed.
+func NewValidatorStatusWatcher(ctx context.Context,
+	validators []phase0.ValidatorIndex,
+	params ...Parameter,
+) (
+	*ValidatorStatusWatcher,
+	error,
+) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+	if len(validators) == 0 {
+		return nil, errors.New("no validators specified")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "tracker").Str("impl", "validatorstatus").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	validatorsProvider, isProvider := parameters.client.(consensusclient.ValidatorsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide validators")
+	}
+	slotsPerEpochProvider, isProvider := parameters.client.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide slots per epoch")
+	}
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+
+	w := &ValidatorStatusWatcher{
+		log:                log,
+		validatorsProvider: validatorsProvider,
+		slotsPerEpoch:      slotsPerEpoch,
+		pollInterval:       parameters.pollInterval,
+		indices:            validators,
+		transitions:        make(chan *ValidatorTransition, validatorTransitionBuffer),
+		states:             make(map[phase0.ValidatorIndex]apiv1.ValidatorState, len(validators)),
+	}
+
+	// Obtain the initial states before listening for events, so that they are in place
+	// for the first poll.
+	states, err := w.fetchStates(ctx, "head")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain initial validator states")
+	}
+	w.states = states
+
+	if err := eventsProvider.Events(ctx, []string{"head"}, func(event *apiv1.Event) {
+		w.handleEvent(ctx, event)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to head events")
+	}
+
+	go func() {
+		<-ctx.Done()
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.closed = true
+		close(w.transitions)
+	}()
+
+	return w, nil
+}
+
+// Transitions provides a channel of validator status transitions.
+func (w *ValidatorStatusWatcher) Transitions() <-chan *ValidatorTransition {
+	return w.transitions
+}
+
+// State provides the last known state of the validator.
+func (w *ValidatorStatusWatcher) State(index phase0.ValidatorIndex) apiv1.ValidatorState {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.states[index]
+}
+
+func (w *ValidatorStatusWatcher) handleEvent(ctx context.Context, event *apiv1.Event) {
+	if event == nil || event.Data == nil {
+		return
+	}
+	headEvent, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+	if !isHeadEvent {
+		return
+	}
+	epoch := phase0.Epoch(uint64(headEvent.Slot) / w.slotsPerEpoch)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	if uint64(epoch)%w.pollInterval != 0 || epoch <= w.lastPolled {
+		return
+	}
+
+	if err := w.poll(ctx, headEvent.Slot, epoch); err != nil {
+		w.log.Warn().Err(err).Uint64("epoch", uint64(epoch)).Msg("Failed to poll validator states")
+		return
+	}
+	w.lastPolled = epoch
+}
+
+// poll obtains the states of the validators and reports transitions; the caller must hold the lock.
+func (w *ValidatorStatusWatcher) poll(ctx context.Context, slot phase0.Slot, epoch phase0.Epoch) error {
+	states, err := w.fetchStates(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		return err
+	}
+
+	for _, index := range w.indices {
+		to, exists := states[index]
+		if !exists {
+			continue
+		}
+		from := w.states[index]
+		if from == to {
+			continue
+		}
+		transition := &ValidatorTransition{
+			Type:           validatorTransitionType(from, to),
+			ValidatorIndex: index,
+			Epoch:          epoch,
+			From:           from,
+			To:             to,
+		}
+		select {
+		case w.transitions <- transition:
+			w.states[index] = to
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// fetchStates obtains the states of the validators at the given state.
+func (w *ValidatorStatusWatcher) fetchStates(ctx context.Context, stateID string) (map[phase0.ValidatorIndex]apiv1.ValidatorState, error) {
+	validators, err := w.validatorsProvider.Validators(ctx, stateID, w.indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validators")
+	}
+
+	states := make(map[phase0.ValidatorIndex]apiv1.ValidatorState, len(validators))
+	for index, validator := range validators {
+		if validator != nil {
+			states[index] = validator.Status
+		}
+	}
+
+	return states, nil
+}
+
+// validatorTransitionType provides the type of the transition between the given states.
+func validatorTransitionType(from apiv1.ValidatorState, to apiv1.ValidatorState) ValidatorTransitionType {
+	isSlashed := func(state apiv1.ValidatorState) bool {
+		return state == apiv1.ValidatorStateActiveSlashed || state == apiv1.ValidatorStateExitedSlashed
+	}
+
+	switch {
+	case isSlashed(to) && !isSlashed(from):
+		return ValidatorTransitionSlashed
+	case to == apiv1.ValidatorStatePendingQueued:
+		return ValidatorTransitionQueued
+	case to == apiv1.ValidatorStateActiveOngoing:
+		return ValidatorTransitionActivated
+	case to == apiv1.ValidatorStateActiveExiting:
+		return ValidatorTransitionExiting
+	case to == apiv1.ValidatorStateExitedUnslashed || to == apiv1.ValidatorStateExitedSlashed:
+		return ValidatorTransitionExited
+	case to == apiv1.ValidatorStateWithdrawalPossible:
+		return ValidatorTransitionWithdrawable
+	case to == apiv1.ValidatorStateWithdrawalDone:
+		return ValidatorTransitionWithdrawn
+	default:
+		return ValidatorTransitionUnknown
+	}
+}
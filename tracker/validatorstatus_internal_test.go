@@ -0,0 +1,180 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// statusClient is a client with validators whose states can be changed.
+type statusClient struct {
+	mu       sync.Mutex
+	handler  consensusclient.EventHandlerFunc
+	states   map[phase0.ValidatorIndex]apiv1.ValidatorState
+	stateIDs []string
+}
+
+func (c *statusClient) Name() string    { return "status" }
+func (c *statusClient) Address() string { return "status" }
+
+func (c *statusClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handler = handler
+
+	return nil
+}
+
+func (c *statusClient) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 32, nil
+}
+
+func (c *statusClient) Validators(_ context.Context, stateID string, indices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stateIDs = append(c.stateIDs, stateID)
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	for _, index := range indices {
+		if state, exists := c.states[index]; exists {
+			res[index] = &apiv1.Validator{Index: index, Status: state}
+		}
+	}
+
+	return res, nil
+}
+
+func (c *statusClient) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	return nil, nil
+}
+
+func (c *statusClient) setState(index phase0.ValidatorIndex, state apiv1.ValidatorState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.states[index] = state
+}
+
+func (c *statusClient) notifyHead(slot phase0.Slot) {
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: slot}})
+}
+
+func TestValidatorStatusWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &statusClient{
+		states: map[phase0.ValidatorIndex]apiv1.ValidatorState{
+			1: apiv1.ValidatorStatePendingQueued,
+			2: apiv1.ValidatorStateActiveOngoing,
+			3: apiv1.ValidatorStateExitedUnslashed,
+		},
+	}
+	watcher, err := NewValidatorStatusWatcher(ctx,
+		[]phase0.ValidatorIndex{1, 2, 3},
+		WithLogLevel(zerolog.Disabled),
+		WithClient(client),
+		WithPollInterval(2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ValidatorStateActiveOngoing, watcher.State(2))
+
+	client.setState(1, apiv1.ValidatorStateActiveOngoing)
+	client.setState(2, apiv1.ValidatorStateActiveSlashed)
+	// Epoch 1 is not polled with an interval of 2.
+	client.notifyHead(32)
+	select {
+	case transition := <-watcher.Transitions():
+		require.Fail(t, "unexpected transition", "%v", transition)
+	default:
+	}
+
+	client.notifyHead(64)
+	transition := <-watcher.Transitions()
+	require.Equal(t, &ValidatorTransition{
+		Type:           ValidatorTransitionActivated,
+		ValidatorIndex: 1,
+		Epoch:          2,
+		From:           apiv1.ValidatorStatePendingQueued,
+		To:             apiv1.ValidatorStateActiveOngoing,
+	}, transition)
+	transition = <-watcher.Transitions()
+	require.Equal(t, ValidatorTransitionSlashed, transition.Type)
+	require.Equal(t, phase0.ValidatorIndex(2), transition.ValidatorIndex)
+
+	// Only polled once per epoch.
+	client.setState(3, apiv1.ValidatorStateWithdrawalPossible)
+	client.notifyHead(65)
+	client.notifyHead(128)
+	transition = <-watcher.Transitions()
+	require.Equal(t, ValidatorTransitionWithdrawable, transition.Type)
+	require.Equal(t, phase0.Epoch(4), transition.Epoch)
+	require.Equal(t, []string{"head", "64", "128"}, client.stateIDs)
+
+	cancel()
+	select {
+	case transition, ok := <-watcher.Transitions():
+		require.False(t, ok, "unexpected transition %v", transition)
+	case <-time.After(time.Second):
+		require.Fail(t, "transitions channel not closed")
+	}
+}
+
+func TestValidatorTransitionType(t *testing.T) {
+	tests := []struct {
+		from     apiv1.ValidatorState
+		to       apiv1.ValidatorState
+		expected ValidatorTransitionType
+	}{
+		{apiv1.ValidatorStatePendingInitialized, apiv1.ValidatorStatePendingQueued, ValidatorTransitionQueued},
+		{apiv1.ValidatorStatePendingQueued, apiv1.ValidatorStateActiveOngoing, ValidatorTransitionActivated},
+		{apiv1.ValidatorStateActiveOngoing, apiv1.ValidatorStateActiveExiting, ValidatorTransitionExiting},
+		{apiv1.ValidatorStateActiveOngoing, apiv1.ValidatorStateActiveSlashed, ValidatorTransitionSlashed},
+		{apiv1.ValidatorStateActiveOngoing, apiv1.ValidatorStateExitedSlashed, ValidatorTransitionSlashed},
+		{apiv1.ValidatorStateActiveSlashed, apiv1.ValidatorStateExitedSlashed, ValidatorTransitionExited},
+		{apiv1.ValidatorStateActiveExiting, apiv1.ValidatorStateExitedUnslashed, ValidatorTransitionExited},
+		{apiv1.ValidatorStateExitedUnslashed, apiv1.ValidatorStateWithdrawalPossible, ValidatorTransitionWithdrawable},
+		{apiv1.ValidatorStateWithdrawalPossible, apiv1.ValidatorStateWithdrawalDone, ValidatorTransitionWithdrawn},
+	}
+
+	for _, test := range tests {
+		t.Run(test.from.String()+"-"+test.to.String(), func(t *testing.T) {
+			require.Equal(t, test.expected, validatorTransitionType(test.from, test.to))
+		})
+	}
+}
+
+func TestNewValidatorStatusWatcher(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewValidatorStatusWatcher(ctx, []phase0.ValidatorIndex{1}, WithClient(&statusClient{}), WithPollInterval(0))
+	require.EqualError(t, err, "problem with parameters: poll interval must be at least 1")
+
+	_, err = NewValidatorStatusWatcher(ctx, nil, WithClient(&statusClient{}))
+	require.EqualError(t, err, "no validators specified")
+
+	_, err = NewValidatorStatusWatcher(ctx, []phase0.ValidatorIndex{1}, WithClient(&performanceClient{}))
+	require.EqualError(t, err, "client does not provide validators")
+}
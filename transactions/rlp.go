@@ -0,0 +1,129 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transactions
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// maxRLPDepth is the maximum depth of lists nested within the outermost list.  The
+// deepest nesting in a valid transaction is the storage keys of an access list entry.
+const maxRLPDepth = 3
+
+// rlpItem is a decoded RLP item, being either a byte string or a list of items.
+type rlpItem struct {
+	isList bool
+	data   []byte
+	items  []*rlpItem
+}
+
+// decodeRLP decodes a single RLP item that spans the whole of the input.
+func decodeRLP(input []byte) (*rlpItem, error) {
+	item, rest, err := decodeRLPItem(input, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after RLP item")
+	}
+
+	return item, nil
+}
+
+// decodeRLPItem decodes the RLP item at the start of the input, returning the item and
+// the remaining input.  depth is the number of lists that enclose the item.
+func decodeRLPItem(input []byte, depth int) (*rlpItem, []byte, error) {
+	if len(input) == 0 {
+		return nil, nil, errors.New("RLP item missing")
+	}
+
+	prefix := input[0]
+	switch {
+	case prefix < 0x80:
+		// Single byte.
+		return &rlpItem{data: input[:1]}, input[1:], nil
+	case prefix < 0xb8:
+		// Short string.
+		return decodeRLPString(input[1:], uint64(prefix-0x80))
+	case prefix < 0xc0:
+		// Long string.
+		length, rest, err := decodeRLPLength(input[1:], int(prefix-0xb7))
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeRLPString(rest, length)
+	case prefix < 0xf8:
+		// Short list.
+		return decodeRLPList(input[1:], uint64(prefix-0xc0), depth)
+	default:
+		// Long list.
+		length, rest, err := decodeRLPLength(input[1:], int(prefix-0xf7))
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeRLPList(rest, length, depth)
+	}
+}
+
+// decodeRLPLength decodes the big-endian length of the given size at the start of the input.
+func decodeRLPLength(input []byte, size int) (uint64, []byte, error) {
+	if len(input) < size {
+		return 0, nil, errors.New("RLP length truncated")
+	}
+	if input[0] == 0 {
+		return 0, nil, errors.New("RLP length has leading zeros")
+	}
+	buf := make([]byte, 8)
+	copy(buf[8-size:], input[:size])
+
+	return binary.BigEndian.Uint64(buf), input[size:], nil
+}
+
+// decodeRLPString decodes a string of the given length at the start of the input.
+func decodeRLPString(input []byte, length uint64) (*rlpItem, []byte, error) {
+	if uint64(len(input)) < length {
+		return nil, nil, errors.New("RLP string truncated")
+	}
+
+	return &rlpItem{data: input[:length]}, input[length:], nil
+}
+
+// decodeRLPList decodes a list whose items have the given total length at the start of the input.
+func decodeRLPList(input []byte, length uint64, depth int) (*rlpItem, []byte, error) {
+	if depth > maxRLPDepth {
+		return nil, nil, errors.New("RLP lists nested too deeply")
+	}
+	if uint64(len(input)) < length {
+		return nil, nil, errors.New("RLP list truncated")
+	}
+
+	item := &rlpItem{
+		isList: true,
+		items:  make([]*rlpItem, 0),
+	}
+	contents := input[:length]
+	for len(contents) > 0 {
+		var child *rlpItem
+		var err error
+		child, contents, err = decodeRLPItem(contents, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.items = append(item.items, child)
+	}
+
+	return item, input[length:], nil
+}
@@ -0,0 +1,240 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transactions decodes the execution layer transactions contained in execution
+// payloads, providing the commonly used fields of each transaction envelope.
+package transactions
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/pkg/errors"
+)
+
+// Transaction types.
+const (
+	// TypeLegacy is a legacy transaction.
+	TypeLegacy = 0x00
+	// TypeAccessList is an EIP-2930 access list transaction.
+	TypeAccessList = 0x01
+	// TypeDynamicFee is an EIP-1559 dynamic fee transaction.
+	TypeDynamicFee = 0x02
+	// TypeBlob is an EIP-4844 blob transaction.
+	TypeBlob = 0x03
+	// TypeSetCode is an EIP-7702 set code transaction.
+	TypeSetCode = 0x04
+)
+
+// VersionedHash is the versioned hash of a blob.
+type VersionedHash [32]byte
+
+// Transaction is a decoded execution layer transaction envelope.
+// Transactions of types that are not known are returned with only their type set.
+type Transaction struct {
+	// Type is the type of the transaction.
+	Type uint8
+	// ChainID is the chain ID of the transaction.  It is nil for legacy transactions
+	// without replay protection.
+	ChainID *big.Int
+	// Nonce is the nonce of the transaction.
+	Nonce uint64
+	// Gas is the gas limit of the transaction.
+	Gas uint64
+	// To is the recipient of the transaction.  It is nil for contract creation.
+	To *bellatrix.ExecutionAddress
+	// Value is the value transferred by the transaction, in wei.
+	Value *big.Int
+	// Data is the input data of the transaction.
+	Data []byte
+	// BlobVersionedHashes are the versioned hashes of the blobs of a blob transaction.
+	BlobVersionedHashes []VersionedHash
+}
+
+// transactionLayout is the position of fields within the RLP list of a transaction type.
+type transactionLayout struct {
+	items      int
+	chainID    int
+	nonce      int
+	gas        int
+	to         int
+	value      int
+	data       int
+	blobHashes int
+}
+
+var transactionLayouts = map[uint8]*transactionLayout{
+	TypeLegacy:     {items: 9, chainID: -1, nonce: 0, gas: 2, to: 3, value: 4, data: 5, blobHashes: -1},
+	TypeAccessList: {items: 11, chainID: 0, nonce: 1, gas: 3, to: 4, value: 5, data: 6, blobHashes: -1},
+	TypeDynamicFee: {items: 12, chainID: 0, nonce: 1, gas: 4, to: 5, value: 6, data: 7, blobHashes: -1},
+	TypeBlob:       {items: 14, chainID: 0, nonce: 1, gas: 4, to: 5, value: 6, data: 7, blobHashes: 10},
+	TypeSetCode:    {items: 13, chainID: 0, nonce: 1, gas: 4, to: 5, value: 6, data: 7, blobHashes: -1},
+}
+
+// DecodeTransactions decodes the transactions in the execution payload.
+func DecodeTransactions(payload *api.VersionedExecutionPayload) ([]*Transaction, error) {
+	if payload == nil {
+		return nil, errors.New("no execution payload supplied")
+	}
+
+	var txs []bellatrix.Transaction
+	switch payload.Version {
+	case spec.DataVersionBellatrix:
+		if payload.Bellatrix == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+		txs = payload.Bellatrix.Transactions
+	case spec.DataVersionCapella:
+		if payload.Capella == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+		txs = payload.Capella.Transactions
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	res := make([]*Transaction, len(txs))
+	for i := range txs {
+		tx, err := DecodeTransaction(txs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode transaction %d", i)
+		}
+		res[i] = tx
+	}
+
+	return res, nil
+}
+
+// DecodeTransaction decodes a single transaction in its execution payload encoding.
+func DecodeTransaction(tx bellatrix.Transaction) (*Transaction, error) {
+	if len(tx) == 0 {
+		return nil, errors.New("empty transaction")
+	}
+
+	txType := uint8(TypeLegacy)
+	payload := []byte(tx)
+	if tx[0] < 0x80 {
+		// Typed transaction envelope.
+		txType = tx[0]
+		payload = payload[1:]
+	}
+	layout, exists := transactionLayouts[txType]
+	if !exists {
+		return &Transaction{Type: txType}, nil
+	}
+
+	item, err := decodeRLP(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid RLP")
+	}
+	if !item.isList {
+		return nil, errors.New("transaction is not an RLP list")
+	}
+	if len(item.items) != layout.items {
+		return nil, fmt.Errorf("transaction type %d has %d fields; expected %d", txType, len(item.items), layout.items)
+	}
+
+	res := &Transaction{
+		Type: txType,
+	}
+	if res.Nonce, err = rlpUint64(item.items[layout.nonce]); err != nil {
+		return nil, errors.Wrap(err, "invalid nonce")
+	}
+	if res.Gas, err = rlpUint64(item.items[layout.gas]); err != nil {
+		return nil, errors.Wrap(err, "invalid gas")
+	}
+	if res.To, err = rlpAddress(item.items[layout.to]); err != nil {
+		return nil, errors.Wrap(err, "invalid to")
+	}
+	if res.Value, err = rlpBigInt(item.items[layout.value]); err != nil {
+		return nil, errors.Wrap(err, "invalid value")
+	}
+	dataItem := item.items[layout.data]
+	if dataItem.isList {
+		return nil, errors.New("invalid data")
+	}
+	res.Data = append([]byte{}, dataItem.data...)
+
+	if layout.chainID >= 0 {
+		if res.ChainID, err = rlpBigInt(item.items[layout.chainID]); err != nil {
+			return nil, errors.Wrap(err, "invalid chain ID")
+		}
+	} else {
+		// Legacy transactions carry the chain ID in v if they are replay protected.
+		v, err := rlpBigInt(item.items[6])
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid v")
+		}
+		if v.Cmp(big.NewInt(35)) >= 0 {
+			res.ChainID = new(big.Int).Rsh(new(big.Int).Sub(v, big.NewInt(35)), 1)
+		}
+	}
+
+	if layout.blobHashes >= 0 {
+		hashesItem := item.items[layout.blobHashes]
+		if !hashesItem.isList {
+			return nil, errors.New("invalid blob versioned hashes")
+		}
+		res.BlobVersionedHashes = make([]VersionedHash, len(hashesItem.items))
+		for i, hashItem := range hashesItem.items {
+			if hashItem.isList || len(hashItem.data) != len(VersionedHash{}) {
+				return nil, errors.New("invalid blob versioned hash")
+			}
+			copy(res.BlobVersionedHashes[i][:], hashItem.data)
+		}
+	}
+
+	return res, nil
+}
+
+// rlpUint64 decodes an RLP item as an unsigned 64-bit integer.
+func rlpUint64(item *rlpItem) (uint64, error) {
+	if item.isList || len(item.data) > 8 {
+		return 0, errors.New("not a 64-bit integer")
+	}
+	res := uint64(0)
+	for _, b := range item.data {
+		res = res<<8 | uint64(b)
+	}
+
+	return res, nil
+}
+
+// rlpBigInt decodes an RLP item as an unsigned integer of arbitrary size.
+func rlpBigInt(item *rlpItem) (*big.Int, error) {
+	if item.isList || len(item.data) > 32 {
+		return nil, errors.New("not a 256-bit integer")
+	}
+
+	return new(big.Int).SetBytes(item.data), nil
+}
+
+// rlpAddress decodes an RLP item as an execution address, returning nil if it is empty.
+func rlpAddress(item *rlpItem) (*bellatrix.ExecutionAddress, error) {
+	if item.isList {
+		return nil, errors.New("not an address")
+	}
+	if len(item.data) == 0 {
+		return nil, nil
+	}
+	if len(item.data) != bellatrix.ExecutionAddressLength {
+		return nil, errors.New("incorrect length for address")
+	}
+	var address bellatrix.ExecutionAddress
+	copy(address[:], item.data)
+
+	return &address, nil
+}
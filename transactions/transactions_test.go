@@ -0,0 +1,238 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transactions_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/transactions"
+	"github.com/stretchr/testify/require"
+)
+
+// rlpString encodes a byte string as RLP.
+func rlpString(data []byte) []byte {
+	if len(data) == 1 && data[0] < 0x80 {
+		return data
+	}
+	return append(rlpPrefix(0x80, len(data)), data...)
+}
+
+// rlpList encodes the already-encoded items as an RLP list.
+func rlpList(items ...[]byte) []byte {
+	contents := make([]byte, 0)
+	for _, item := range items {
+		contents = append(contents, item...)
+	}
+	return append(rlpPrefix(0xc0, len(contents)), contents...)
+}
+
+func rlpPrefix(offset byte, length int) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+	lengthBytes := big.NewInt(int64(length)).Bytes()
+	return append([]byte{offset + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
+
+func rlpUint(val uint64) []byte {
+	return rlpString(new(big.Int).SetUint64(val).Bytes())
+}
+
+func mustDecodeHex(t *testing.T, input string) []byte {
+	t.Helper()
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	require.NoError(t, err)
+	return res
+}
+
+func TestDecodeTransaction(t *testing.T) {
+	to := bellatrix.ExecutionAddress{0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35, 0x35}
+	oneEther, _ := new(big.Int).SetString("1000000000000000000", 10)
+	blobHash := transactions.VersionedHash{0x01, 0x02}
+	signature := [][]byte{rlpUint(1), rlpString(make([]byte, 32)), rlpString(make([]byte, 32))}
+
+	dynamicFee := append([]byte{transactions.TypeDynamicFee}, rlpList(append([][]byte{
+		rlpUint(1), rlpUint(7), rlpUint(1000), rlpUint(2000), rlpUint(50000),
+		rlpString(to[:]), rlpUint(5), rlpString(make([]byte, 100)), rlpList(),
+	}, signature...)...)...)
+	creation := append([]byte{transactions.TypeDynamicFee}, rlpList(append([][]byte{
+		rlpUint(1), rlpUint(7), rlpUint(1000), rlpUint(2000), rlpUint(50000),
+		rlpString(nil), rlpUint(0), rlpString([]byte{0x60, 0x80}), rlpList(),
+	}, signature...)...)...)
+	accessList := append([]byte{transactions.TypeDynamicFee}, rlpList(append([][]byte{
+		rlpUint(1), rlpUint(7), rlpUint(1000), rlpUint(2000), rlpUint(50000),
+		rlpString(to[:]), rlpUint(5), rlpString(nil),
+		rlpList(rlpList(rlpString(to[:]), rlpList(rlpString(make([]byte, 32))))),
+	}, signature...)...)...)
+	blob := append([]byte{transactions.TypeBlob}, rlpList(append([][]byte{
+		rlpUint(17000), rlpUint(0), rlpUint(1000), rlpUint(2000), rlpUint(21000),
+		rlpString(to[:]), rlpUint(0), rlpString(nil), rlpList(), rlpUint(1),
+		rlpList(rlpString(blobHash[:])),
+	}, signature...)...)...)
+
+	tests := []struct {
+		name     string
+		input    []byte
+		expected *transactions.Transaction
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "empty transaction",
+		},
+		{
+			// EIP-155 example transaction.
+			name:  "Legacy",
+			input: mustDecodeHex(t, "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"),
+			expected: &transactions.Transaction{
+				Type:    transactions.TypeLegacy,
+				ChainID: big.NewInt(1),
+				Nonce:   9,
+				Gas:     21000,
+				To:      &to,
+				Value:   oneEther,
+				Data:    []byte{},
+			},
+		},
+		{
+			name:  "DynamicFee",
+			input: dynamicFee,
+			expected: &transactions.Transaction{
+				Type:    transactions.TypeDynamicFee,
+				ChainID: big.NewInt(1),
+				Nonce:   7,
+				Gas:     50000,
+				To:      &to,
+				Value:   big.NewInt(5),
+				Data:    make([]byte, 100),
+			},
+		},
+		{
+			name:  "AccessList",
+			input: accessList,
+			expected: &transactions.Transaction{
+				Type:    transactions.TypeDynamicFee,
+				ChainID: big.NewInt(1),
+				Nonce:   7,
+				Gas:     50000,
+				To:      &to,
+				Value:   big.NewInt(5),
+				Data:    []byte{},
+			},
+		},
+		{
+			name:  "ContractCreation",
+			input: creation,
+			expected: &transactions.Transaction{
+				Type:    transactions.TypeDynamicFee,
+				ChainID: big.NewInt(1),
+				Nonce:   7,
+				Gas:     50000,
+				Value:   big.NewInt(0),
+				Data:    []byte{0x60, 0x80},
+			},
+		},
+		{
+			name:  "Blob",
+			input: blob,
+			expected: &transactions.Transaction{
+				Type:                transactions.TypeBlob,
+				ChainID:             big.NewInt(17000),
+				Nonce:               0,
+				Gas:                 21000,
+				To:                  &to,
+				Value:               big.NewInt(0),
+				Data:                []byte{},
+				BlobVersionedHashes: []transactions.VersionedHash{blobHash},
+			},
+		},
+		{
+			name:     "UnknownType",
+			input:    []byte{0x7e, 0xc0},
+			expected: &transactions.Transaction{Type: 0x7e},
+		},
+		{
+			name:  "Truncated",
+			input: dynamicFee[:len(dynamicFee)-1],
+			err:   "invalid RLP: RLP list truncated",
+		},
+		{
+			name:  "TrailingData",
+			input: append(append([]byte{}, dynamicFee...), 0x00),
+			err:   "invalid RLP: trailing data after RLP item",
+		},
+		{
+			name:  "NestedTooDeeply",
+			input: append([]byte{transactions.TypeDynamicFee}, rlpList(rlpList(rlpList(rlpList(rlpList()))))...),
+			err:   "invalid RLP: RLP lists nested too deeply",
+		},
+		{
+			name:  "NotList",
+			input: []byte{transactions.TypeDynamicFee, 0x81, 0x80},
+			err:   "transaction is not an RLP list",
+		},
+		{
+			name:  "FieldsMissing",
+			input: append([]byte{transactions.TypeDynamicFee}, rlpList(rlpUint(1))...),
+			err:   "transaction type 2 has 1 fields; expected 12",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := transactions.DecodeTransaction(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestDecodeTransactions(t *testing.T) {
+	legacy := bellatrix.Transaction(mustDecodeHex(t, "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"))
+
+	_, err := transactions.DecodeTransactions(nil)
+	require.EqualError(t, err, "no execution payload supplied")
+
+	_, err = transactions.DecodeTransactions(&api.VersionedExecutionPayload{Version: spec.DataVersionCapella})
+	require.EqualError(t, err, "no capella execution payload")
+
+	_, err = transactions.DecodeTransactions(&api.VersionedExecutionPayload{
+		Version: spec.DataVersionBellatrix,
+		Bellatrix: &bellatrix.ExecutionPayload{
+			Transactions: []bellatrix.Transaction{legacy, {0x02, 0xc0}},
+		},
+	})
+	require.EqualError(t, err, "failed to decode transaction 1: transaction type 2 has 0 fields; expected 12")
+
+	res, err := transactions.DecodeTransactions(&api.VersionedExecutionPayload{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.ExecutionPayload{
+			Transactions: []bellatrix.Transaction{legacy, legacy},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, uint64(9), res[1].Nonce)
+}
@@ -0,0 +1,196 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatorindex
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service maintains a bidirectional mapping between validator public keys and indices
+// for a set of public keys.
+// Once a validator has an index it never changes, so the service only fetches
+// information for public keys that have yet to be resolved, doing so on each head event
+// until the validator appears on the chain.
+type Service struct {
+	log                zerolog.Logger
+	validatorsProvider consensusclient.ValidatorsProvider
+
+	mu         sync.RWMutex
+	indices    map[phase0.BLSPubKey]phase0.ValidatorIndex
+	pubKeys    map[phase0.ValidatorIndex]phase0.BLSPubKey
+	unresolved map[phase0.BLSPubKey]struct{}
+
+	// refreshMu ensures that only one refresh runs at a time.
+	refreshMu sync.Mutex
+}
+
+// New creates a new validator index service.
+// The service resolves the supplied public keys and then keeps resolving those
+// that are not yet known to the chain on each head event, until the supplied
+// context is done.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "validatorindex").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	validatorsProvider, isProvider := parameters.client.(consensusclient.ValidatorsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide validators")
+	}
+	eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+
+	s := &Service{
+		log:                log,
+		validatorsProvider: validatorsProvider,
+		indices:            make(map[phase0.BLSPubKey]phase0.ValidatorIndex),
+		pubKeys:            make(map[phase0.ValidatorIndex]phase0.BLSPubKey),
+		unresolved:         make(map[phase0.BLSPubKey]struct{}),
+	}
+
+	// Start listening for events before the initial resolution, to avoid
+	// missing validators that appear between the two.
+	if err := eventsProvider.Events(ctx, []string{"head"}, func(event *apiv1.Event) {
+		s.handleEvent(ctx, event)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to head events")
+	}
+
+	if err := s.AddPubKeys(ctx, parameters.pubKeys); err != nil {
+		return nil, errors.Wrap(err, "failed to resolve initial public keys")
+	}
+
+	return s, nil
+}
+
+// AddPubKeys adds public keys to the set maintained by the service, resolving them immediately.
+func (s *Service) AddPubKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) error {
+	s.mu.Lock()
+	for _, pubKey := range pubKeys {
+		if _, exists := s.indices[pubKey]; exists {
+			continue
+		}
+		s.unresolved[pubKey] = struct{}{}
+	}
+	s.mu.Unlock()
+
+	return s.refresh(ctx)
+}
+
+// Index returns the index of the validator with the given public key.
+// The second return value is false if the validator is not known.
+func (s *Service) Index(pubKey phase0.BLSPubKey) (phase0.ValidatorIndex, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	index, exists := s.indices[pubKey]
+	return index, exists
+}
+
+// PubKey returns the public key of the validator with the given index.
+// The second return value is false if the validator is not known.
+func (s *Service) PubKey(index phase0.ValidatorIndex) (phase0.BLSPubKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pubKey, exists := s.pubKeys[index]
+	return pubKey, exists
+}
+
+// Indices returns the indices of all known validators, keyed by public key.
+func (s *Service) Indices() map[phase0.BLSPubKey]phase0.ValidatorIndex {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make(map[phase0.BLSPubKey]phase0.ValidatorIndex, len(s.indices))
+	for pubKey, index := range s.indices {
+		res[pubKey] = index
+	}
+	return res
+}
+
+// Unresolved returns the public keys that are not yet known to the chain.
+func (s *Service) Unresolved() []phase0.BLSPubKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]phase0.BLSPubKey, 0, len(s.unresolved))
+	for pubKey := range s.unresolved {
+		res = append(res, pubKey)
+	}
+	return res
+}
+
+// handleEvent handles head events.
+func (s *Service) handleEvent(ctx context.Context, event *apiv1.Event) {
+	if event == nil || event.Topic != "head" {
+		return
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		s.log.Warn().Err(err).Msg("Failed to resolve public keys")
+	}
+}
+
+// refresh resolves the unresolved public keys.
+func (s *Service) refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	pubKeys := s.Unresolved()
+	if len(pubKeys) == 0 {
+		return nil
+	}
+
+	validators, err := s.validatorsProvider.ValidatorsByPubKey(ctx, "head", pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain validators")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for index, validator := range validators {
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+		pubKey := validator.Validator.PublicKey
+		if _, exists := s.unresolved[pubKey]; !exists {
+			// Not one of ours.
+			continue
+		}
+		s.indices[pubKey] = index
+		s.pubKeys[index] = pubKey
+		delete(s.unresolved, pubKey)
+	}
+	s.log.Trace().Int("resolved", len(validators)).Int("unresolved", len(s.unresolved)).Msg("Refreshed validator indices")
+
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatorindex_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/validatorindex"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// chainClient is a client with a controllable set of validators.
+type chainClient struct {
+	mu         sync.Mutex
+	validators map[phase0.ValidatorIndex]*apiv1.Validator
+	requested  [][]phase0.BLSPubKey
+	handler    consensusclient.EventHandlerFunc
+}
+
+func (c *chainClient) Name() string    { return "chain" }
+func (c *chainClient) Address() string { return "chain" }
+
+func (c *chainClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.handler = handler
+	return nil
+}
+
+func (c *chainClient) Validators(_ context.Context, _ string, _ []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	return nil, nil
+}
+
+func (c *chainClient) ValidatorsByPubKey(_ context.Context, _ string, pubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requested = append(c.requested, pubKeys)
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	for index, validator := range c.validators {
+		for _, pubKey := range pubKeys {
+			if validator.Validator.PublicKey == pubKey {
+				res[index] = validator
+			}
+		}
+	}
+	return res, nil
+}
+
+func (c *chainClient) addValidator(index phase0.ValidatorIndex, pubKey phase0.BLSPubKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.validators[index] = &apiv1.Validator{
+		Index: index,
+		Validator: &phase0.Validator{
+			PublicKey: pubKey,
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []validatorindex.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []validatorindex.Parameter{
+				validatorindex.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "Good",
+			params: []validatorindex.Parameter{
+				validatorindex.WithLogLevel(zerolog.Disabled),
+				validatorindex.WithClient(mockClient),
+				validatorindex.WithPubKeys([]phase0.BLSPubKey{{0x01}}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := validatorindex.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResolution(t *testing.T) {
+	ctx := context.Background()
+
+	client := &chainClient{
+		validators: make(map[phase0.ValidatorIndex]*apiv1.Validator),
+	}
+	client.addValidator(1, phase0.BLSPubKey{0x01})
+	client.addValidator(2, phase0.BLSPubKey{0x02})
+
+	s, err := validatorindex.New(ctx,
+		validatorindex.WithLogLevel(zerolog.Disabled),
+		validatorindex.WithClient(client),
+		validatorindex.WithPubKeys([]phase0.BLSPubKey{{0x01}, {0x03}}),
+	)
+	require.NoError(t, err)
+
+	index, exists := s.Index(phase0.BLSPubKey{0x01})
+	require.True(t, exists)
+	require.Equal(t, phase0.ValidatorIndex(1), index)
+	pubKey, exists := s.PubKey(1)
+	require.True(t, exists)
+	require.Equal(t, phase0.BLSPubKey{0x01}, pubKey)
+	// Validator 2 is on the chain but not in our set.
+	_, exists = s.PubKey(2)
+	require.False(t, exists)
+	_, exists = s.Index(phase0.BLSPubKey{0x03})
+	require.False(t, exists)
+	require.Equal(t, []phase0.BLSPubKey{{0x03}}, s.Unresolved())
+
+	// Validator 3 appears on the chain; the next head event should pick it up,
+	// requesting only the unresolved key.
+	client.addValidator(3, phase0.BLSPubKey{0x03})
+	client.handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+	require.Equal(t, []phase0.BLSPubKey{{0x03}}, client.requested[len(client.requested)-1])
+	index, exists = s.Index(phase0.BLSPubKey{0x03})
+	require.True(t, exists)
+	require.Equal(t, phase0.ValidatorIndex(3), index)
+	require.Empty(t, s.Unresolved())
+	require.Len(t, s.Indices(), 2)
+
+	// With nothing unresolved, further head events should not request anything.
+	requests := len(client.requested)
+	client.handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+	require.Len(t, client.requested, requests)
+
+	// Adding a key resolves it immediately.
+	require.NoError(t, s.AddPubKeys(ctx, []phase0.BLSPubKey{{0x02}}))
+	index, exists = s.Index(phase0.BLSPubKey{0x02})
+	require.True(t, exists)
+	require.Equal(t, phase0.ValidatorIndex(2), index)
+}